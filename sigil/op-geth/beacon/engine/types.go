@@ -56,6 +56,48 @@ type PayloadAttributes struct {
 	NoTxPool bool `json:"noTxPool,omitempty" gencodec:"optional"`
 	// GasLimit is a field for rollups: if set, this sets the exact gas limit the block produced with.
 	GasLimit *uint64 `json:"gasLimit,omitempty" gencodec:"optional"`
+	// OrderingPolicy is a field for rollups: if set, this selects how the transactions from the tx-pool
+	// are ordered in the block produced.
+	OrderingPolicy *OrderingPolicy `json:"orderingPolicy,omitempty" gencodec:"optional"`
+}
+
+// Transaction ordering policies of rollup blocks.
+const (
+	// OrderingPolicyFee orders transactions by effective tip, like blocks built without an ordering policy.
+	OrderingPolicyFee = "fee"
+	// OrderingPolicyFIFO orders transactions by the time they were first seen.
+	OrderingPolicyFIFO = "fifo"
+	// OrderingPolicyFairFee orders transactions by effective tip, with a cap on the number of
+	// transactions of each sender.
+	OrderingPolicyFairFee = "fair-fee"
+)
+
+// OrderingPolicy selects how a rollup block is filled with transactions from the tx-pool.
+type OrderingPolicy struct {
+	// Name is one of the OrderingPolicy constants.
+	Name string `json:"name"`
+	// SenderCap is the maximum number of tx-pool transactions per sender, with the fair-fee policy.
+	SenderCap hexutil.Uint64 `json:"senderCap,omitempty"`
+	// PrivateLane includes the transactions of the private lane of the block builder, ahead of the
+	// tx-pool transactions.
+	PrivateLane bool `json:"privateLane,omitempty"`
+}
+
+// Validate checks that the policy is known, and that its parameters are valid for the policy.
+func (p *OrderingPolicy) Validate() error {
+	switch p.Name {
+	case OrderingPolicyFee, OrderingPolicyFIFO:
+		if p.SenderCap != 0 {
+			return fmt.Errorf("sender cap is not supported by the %q ordering policy", p.Name)
+		}
+	case OrderingPolicyFairFee:
+		if p.SenderCap == 0 {
+			return fmt.Errorf("the %q ordering policy requires a sender cap", p.Name)
+		}
+	default:
+		return fmt.Errorf("unknown ordering policy %q", p.Name)
+	}
+	return nil
 }
 
 // JSON type overrides for PayloadAttributes.
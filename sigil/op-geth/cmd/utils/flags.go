@@ -978,6 +978,12 @@ var (
 		Category: flags.RollupCategory,
 		Value:    5000,
 	}
+	RollupPrivateLaneSizeFlag = &cli.IntFlag{
+		Name:     "rollup.privatelanesize",
+		Usage:    "Maximum number of transactions in the private lane, that serves the authenticated eth_sendPrivateRawTransaction endpoint. Private transactions are included at the top of blocks that the rollup node builds with the private lane. Disabled if 0.",
+		Category: flags.RollupCategory,
+		Value:    0,
+	}
 
 	// Metrics flags
 	MetricsEnabledFlag = &cli.BoolFlag{
@@ -1710,6 +1716,9 @@ func setMiner(ctx *cli.Context, cfg *miner.Config) {
 	if ctx.IsSet(RollupComputePendingBlock.Name) {
 		cfg.RollupComputePendingBlock = ctx.Bool(RollupComputePendingBlock.Name)
 	}
+	if ctx.IsSet(RollupPrivateLaneSizeFlag.Name) {
+		cfg.RollupPrivateLaneSize = ctx.Int(RollupPrivateLaneSizeFlag.Name)
+	}
 }
 
 func setRequiredBlocks(ctx *cli.Context, cfg *ethconfig.Config) {
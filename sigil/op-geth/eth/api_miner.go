@@ -19,7 +19,9 @@ package eth
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 // MinerAPI provides an API to control the miner.
@@ -56,3 +58,28 @@ func (api *MinerAPI) SetGasLimit(gasLimit hexutil.Uint64) bool {
 	api.e.Miner().SetGasCeil(uint64(gasLimit))
 	return true
 }
+
+// PrivateLaneAPI provides an API to submit transactions to the private lane of the
+// miner. It is only served on the authenticated RPC endpoint.
+type PrivateLaneAPI struct {
+	e *Ethereum
+}
+
+// NewPrivateLaneAPI creates a new PrivateLaneAPI instance.
+func NewPrivateLaneAPI(e *Ethereum) *PrivateLaneAPI {
+	return &PrivateLaneAPI{e}
+}
+
+// SendPrivateRawTransaction adds the signed transaction to the private lane, to be
+// included at the top of the blocks that are built with the private lane. The
+// transaction is not added to the transaction pool, nor gossiped.
+func (api *PrivateLaneAPI) SendPrivateRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	if err := api.e.Miner().AddPrivateTransaction(tx); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
@@ -365,6 +365,15 @@ func (s *Ethereum) APIs() []rpc.API {
 		costRateLimit := rate.Limit(s.config.RollupSequencerTxConditionalCostRateLimit)
 		apis = append(apis, sequencerapi.GetSendRawTxConditionalAPI(s.APIBackend, s.seqRPCService, costRateLimit))
 	}
+	// The private lane is only served on the authenticated RPC endpoint
+	if s.config.Miner.RollupPrivateLaneSize > 0 {
+		log.Info("Enabling eth_sendPrivateRawTransaction endpoint support", "size", s.config.Miner.RollupPrivateLaneSize)
+		apis = append(apis, rpc.API{
+			Namespace:     "eth",
+			Service:       NewPrivateLaneAPI(s),
+			Authenticated: true,
+		})
+	}
 
 	// Append all the local APIs and return
 	return append(apis, []rpc.API{
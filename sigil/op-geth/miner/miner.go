@@ -57,6 +57,7 @@ type Config struct {
 
 	RollupComputePendingBlock             bool // Compute the pending block from tx-pool, instead of copying the latest-block
 	RollupTransactionConditionalRateLimit int  // Total number of conditional cost units allowed in a second
+	RollupPrivateLaneSize                 int  // Maximum number of transactions in the private lane, disabled if 0
 
 	EffectiveGasCeil uint64 // if non-zero, a gas ceiling to apply independent of the header's gaslimit value
 }
@@ -84,13 +85,14 @@ type Miner struct {
 	chain       *core.BlockChain
 	pending     *pending
 	pendingMu   sync.Mutex // Lock protects the pending block
+	privateLane *privateLane
 
 	backend Backend
 }
 
 // New creates a new miner with provided config.
 func New(eth Backend, config Config, engine consensus.Engine) *Miner {
-	return &Miner{
+	miner := &Miner{
 		backend:     eth,
 		config:      &config,
 		chainConfig: eth.BlockChain().Config(),
@@ -99,6 +101,10 @@ func New(eth Backend, config Config, engine consensus.Engine) *Miner {
 		chain:       eth.BlockChain(),
 		pending:     &pending{},
 	}
+	if config.RollupPrivateLaneSize > 0 {
+		miner.privateLane = newPrivateLane(config.RollupPrivateLaneSize)
+	}
+	return miner
 }
 
 // Pending returns the currently pending block and associated receipts, logs
@@ -0,0 +1,202 @@
+// Copyright 2024 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"container/heap"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+// orderedTransactions is a set of transactions that returns them in the order of
+// an ordering policy, while honouring the nonce order of each account.
+type orderedTransactions interface {
+	// Peek returns the next transaction, and its effective miner gasTipCap.
+	Peek() (*txpool.LazyTransaction, *uint256.Int)
+	// Shift replaces the next transaction with the next one from the same account.
+	Shift()
+	// Pop removes the next transaction, and all subsequent ones from the same account.
+	Pop()
+	// Empty returns if there are no transactions left.
+	Empty() bool
+	// Clear removes all transactions.
+	Clear()
+}
+
+// newOrderedTransactions creates a transaction set that orders the given transactions
+// by the given policy, or by price if the policy is nil. The sender counts are shared
+// between the sets that fill a single block, to apply the sender cap of the fair-fee
+// policy to all of them together.
+//
+// Note, the input map is reowned so the caller should not interact any more with
+// if after providing it to the constructor.
+func newOrderedTransactions(policy *engine.OrderingPolicy, signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int, senderCounts map[common.Address]uint64) orderedTransactions {
+	if policy == nil {
+		return newTransactionsByPriceAndNonce(signer, txs, baseFee)
+	}
+	switch policy.Name {
+	case engine.OrderingPolicyFIFO:
+		return newTransactionsByTimeAndNonce(txs, baseFee)
+	case engine.OrderingPolicyFairFee:
+		return &transactionsWithSenderCap{
+			transactionsByPriceAndNonce: newTransactionsByPriceAndNonce(signer, txs, baseFee),
+			cap:                         uint64(policy.SenderCap),
+			counts:                      senderCounts,
+		}
+	default:
+		return newTransactionsByPriceAndNonce(signer, txs, baseFee)
+	}
+}
+
+// blobFirst reports whether the next blob transaction goes into the block before the
+// next plain transaction: by the time they were first seen in FIFO order, by tip otherwise.
+func blobFirst(plainTxs orderedTransactions, pltx *txpool.LazyTransaction, ptip *uint256.Int, bltx *txpool.LazyTransaction, btip *uint256.Int) bool {
+	if _, fifo := plainTxs.(*transactionsByTimeAndNonce); fifo {
+		return bltx.Time.Before(pltx.Time)
+	}
+	return ptip.Lt(btip)
+}
+
+// txByTimeAndPrice implements the heap interface, ordering transactions by the time
+// they were first seen, and by price when seen at the same time.
+type txByTimeAndPrice []*txWithMinerFee
+
+func (s txByTimeAndPrice) Len() int { return len(s) }
+func (s txByTimeAndPrice) Less(i, j int) bool {
+	if s[i].tx.Time.Equal(s[j].tx.Time) {
+		return s[i].fees.Cmp(s[j].fees) > 0
+	}
+	return s[i].tx.Time.Before(s[j].tx.Time)
+}
+func (s txByTimeAndPrice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s *txByTimeAndPrice) Push(x interface{}) {
+	*s = append(*s, x.(*txWithMinerFee))
+}
+
+func (s *txByTimeAndPrice) Pop() interface{} {
+	old := *s
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*s = old[0 : n-1]
+	return x
+}
+
+// transactionsByTimeAndNonce represents a set of transactions that can return
+// transactions in first-seen order, while supporting removing entire batches of
+// transactions for non-executable accounts.
+type transactionsByTimeAndNonce struct {
+	txs     map[common.Address][]*txpool.LazyTransaction // Per account nonce-sorted list of transactions
+	heads   txByTimeAndPrice                             // Next transaction for each unique account (time heap)
+	baseFee *uint256.Int                                 // Current base fee
+}
+
+// newTransactionsByTimeAndNonce creates a transaction set that can retrieve
+// first-seen sorted transactions in a nonce-honouring way.
+func newTransactionsByTimeAndNonce(txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) *transactionsByTimeAndNonce {
+	var baseFeeUint *uint256.Int
+	if baseFee != nil {
+		baseFeeUint = uint256.MustFromBig(baseFee)
+	}
+	heads := make(txByTimeAndPrice, 0, len(txs))
+	for from, accTxs := range txs {
+		wrapped, err := newTxWithMinerFee(accTxs[0], from, baseFeeUint)
+		if err != nil {
+			delete(txs, from)
+			continue
+		}
+		heads = append(heads, wrapped)
+		txs[from] = accTxs[1:]
+	}
+	heap.Init(&heads)
+
+	return &transactionsByTimeAndNonce{
+		txs:     txs,
+		heads:   heads,
+		baseFee: baseFeeUint,
+	}
+}
+
+func (t *transactionsByTimeAndNonce) Peek() (*txpool.LazyTransaction, *uint256.Int) {
+	if len(t.heads) == 0 {
+		return nil, nil
+	}
+	return t.heads[0].tx, t.heads[0].fees
+}
+
+func (t *transactionsByTimeAndNonce) Shift() {
+	acc := t.heads[0].from
+	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
+		if wrapped, err := newTxWithMinerFee(txs[0], acc, t.baseFee); err == nil {
+			t.heads[0], t.txs[acc] = wrapped, txs[1:]
+			heap.Fix(&t.heads, 0)
+			return
+		}
+	}
+	heap.Pop(&t.heads)
+}
+
+func (t *transactionsByTimeAndNonce) Pop() {
+	heap.Pop(&t.heads)
+}
+
+func (t *transactionsByTimeAndNonce) Empty() bool {
+	return len(t.heads) == 0
+}
+
+func (t *transactionsByTimeAndNonce) Clear() {
+	t.heads, t.txs = nil, nil
+}
+
+// transactionsWithSenderCap orders transactions by price like transactionsByPriceAndNonce,
+// but takes at most cap transactions from each account, so that a single sender cannot
+// fill a block by outbidding the others.
+type transactionsWithSenderCap struct {
+	*transactionsByPriceAndNonce
+
+	cap    uint64
+	counts map[common.Address]uint64 // Number of transactions taken per account
+}
+
+// Shift counts the next transaction towards the cap of its account, and replaces it with
+// the next one from the same account if the account is below its cap. Transactions that
+// are shifted past for a too low nonce count too, which only happens when the txpool
+// lags the chain.
+func (t *transactionsWithSenderCap) Shift() {
+	acc := t.heads[0].from
+	t.counts[acc]++
+	if t.counts[acc] >= t.cap {
+		t.Pop()
+		return
+	}
+	t.transactionsByPriceAndNonce.Shift()
+}
+
+// Peek returns the next transaction by price, skipping accounts that reached their
+// cap in other transaction sets of the same block.
+func (t *transactionsWithSenderCap) Peek() (*txpool.LazyTransaction, *uint256.Int) {
+	for len(t.heads) > 0 && t.counts[t.heads[0].from] >= t.cap {
+		t.Pop()
+	}
+	return t.transactionsByPriceAndNonce.Peek()
+}
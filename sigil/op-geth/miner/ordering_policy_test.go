@@ -0,0 +1,119 @@
+// Copyright 2024 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+)
+
+// orderingTestTxs creates count transactions of the given key, with the given gas
+// price and first-seen times a second apart, starting at the given time.
+func orderingTestTxs(signer types.Signer, key *ecdsa.PrivateKey, count int, gasPrice int64, start time.Time) []*txpool.LazyTransaction {
+	var txs []*txpool.LazyTransaction
+	for nonce := 0; nonce < count; nonce++ {
+		tx, _ := types.SignTx(types.NewTransaction(uint64(nonce), common.Address{}, big.NewInt(100), 100, big.NewInt(gasPrice), nil), signer, key)
+		tx.SetTime(start.Add(time.Duration(nonce) * time.Second))
+		txs = append(txs, &txpool.LazyTransaction{
+			Hash:      tx.Hash(),
+			Tx:        tx,
+			Time:      tx.Time(),
+			GasFeeCap: uint256.MustFromBig(tx.GasFeeCap()),
+			GasTipCap: uint256.MustFromBig(tx.GasTipCap()),
+			Gas:       tx.Gas(),
+		})
+	}
+	return txs
+}
+
+func drainOrdered(txset orderedTransactions) types.Transactions {
+	txs := types.Transactions{}
+	for tx, _ := txset.Peek(); tx != nil; tx, _ = txset.Peek() {
+		txs = append(txs, tx.Tx)
+		txset.Shift()
+	}
+	return txs
+}
+
+// Tests that the FIFO policy orders transactions by the time they were first seen,
+// regardless of their price, while honouring the nonce order of each account.
+func TestTransactionFIFOSort(t *testing.T) {
+	t.Parallel()
+	signer := types.HomesteadSigner{}
+	cheapKey, _ := crypto.GenerateKey()
+	pricyKey, _ := crypto.GenerateKey()
+	start := time.Unix(1000, 0)
+	groups := map[common.Address][]*txpool.LazyTransaction{
+		// seen at start+0s, start+1s, start+2s
+		crypto.PubkeyToAddress(cheapKey.PublicKey): orderingTestTxs(signer, cheapKey, 3, 1, start),
+		// seen at start+0.5s, start+1.5s
+		crypto.PubkeyToAddress(pricyKey.PublicKey): orderingTestTxs(signer, pricyKey, 2, 100, start.Add(500*time.Millisecond)),
+	}
+	policy := &engine.OrderingPolicy{Name: engine.OrderingPolicyFIFO}
+	txs := drainOrdered(newOrderedTransactions(policy, signer, groups, nil, nil))
+	if len(txs) != 5 {
+		t.Fatalf("expected 5 transactions, found %d", len(txs))
+	}
+	for i := 1; i < len(txs); i++ {
+		if txs[i].Time().Before(txs[i-1].Time()) {
+			t.Errorf("invalid received time ordering: tx #%d (T=%v) < tx #%d (T=%v)", i, txs[i].Time(), i-1, txs[i-1].Time())
+		}
+	}
+}
+
+// Tests that the fair-fee policy orders transactions by price, but takes at most the
+// sender cap of transactions from each account, across transaction sets.
+func TestTransactionSenderCapSort(t *testing.T) {
+	t.Parallel()
+	signer := types.HomesteadSigner{}
+	cheapKey, _ := crypto.GenerateKey()
+	pricyKey, _ := crypto.GenerateKey()
+	cheap, pricy := crypto.PubkeyToAddress(cheapKey.PublicKey), crypto.PubkeyToAddress(pricyKey.PublicKey)
+	start := time.Unix(1000, 0)
+	policy := &engine.OrderingPolicy{Name: engine.OrderingPolicyFairFee, SenderCap: 2}
+	counts := make(map[common.Address]uint64)
+
+	groups := map[common.Address][]*txpool.LazyTransaction{
+		cheap: orderingTestTxs(signer, cheapKey, 3, 1, start),
+		pricy: orderingTestTxs(signer, pricyKey, 5, 100, start),
+	}
+	txs := drainOrdered(newOrderedTransactions(policy, signer, groups, nil, counts))
+	if len(txs) != 4 {
+		t.Fatalf("expected 4 transactions, found %d", len(txs))
+	}
+	for i, expected := range []common.Address{pricy, pricy, cheap, cheap} {
+		if from, _ := types.Sender(signer, txs[i]); from != expected {
+			t.Errorf("tx #%d: expected sender %x, got %x", i, expected, from)
+		}
+	}
+	// The caps are reached already, so another set of the same block yields nothing from these senders
+	groups = map[common.Address][]*txpool.LazyTransaction{
+		pricy: orderingTestTxs(signer, pricyKey, 1, 100, start),
+	}
+	if txs := drainOrdered(newOrderedTransactions(policy, signer, groups, nil, counts)); len(txs) != 0 {
+		t.Errorf("expected no transactions past the sender cap, found %d", len(txs))
+	}
+}
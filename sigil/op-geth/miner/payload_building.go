@@ -47,9 +47,10 @@ type BuildPayloadArgs struct {
 	BeaconRoot   *common.Hash          // The provided beaconRoot (Cancun)
 	Version      engine.PayloadVersion // Versioning byte for payload id calculation.
 
-	NoTxPool     bool                 // Optimism addition: option to disable tx pool contents from being included
-	Transactions []*types.Transaction // Optimism addition: txs forced into the block via engine API
-	GasLimit     *uint64              // Optimism addition: override gas limit of the block to build
+	NoTxPool       bool                   // Optimism addition: option to disable tx pool contents from being included
+	Transactions   []*types.Transaction   // Optimism addition: txs forced into the block via engine API
+	GasLimit       *uint64                // Optimism addition: override gas limit of the block to build
+	OrderingPolicy *engine.OrderingPolicy // Optimism addition: ordering of the tx pool contents in the block to build
 }
 
 // Id computes an 8-byte identifier by hashing the components of the payload arguments.
@@ -75,6 +76,11 @@ func (args *BuildPayloadArgs) Id() engine.PayloadID {
 	if args.GasLimit != nil {
 		binary.Write(hasher, binary.BigEndian, *args.GasLimit)
 	}
+	if args.OrderingPolicy != nil {
+		hasher.Write([]byte(args.OrderingPolicy.Name))
+		binary.Write(hasher, binary.BigEndian, uint64(args.OrderingPolicy.SenderCap))
+		binary.Write(hasher, binary.BigEndian, args.OrderingPolicy.PrivateLane)
+	}
 
 	var out engine.PayloadID
 	copy(out[:], hasher.Sum(nil)[:8])
@@ -314,6 +320,8 @@ func (miner *Miner) buildPayload(args *BuildPayloadArgs, witness bool) (*Payload
 		noTxs:       false,
 		txs:         args.Transactions,
 		gasLimit:    args.GasLimit,
+
+		orderingPolicy: args.OrderingPolicy,
 	}
 
 	// Since we skip building the empty block when using the tx pool, we need to explicitly
@@ -17,6 +17,7 @@
 package miner
 
 import (
+	"errors"
 	"math/big"
 	"reflect"
 	"testing"
@@ -233,6 +234,58 @@ func testBuildPayload(t *testing.T, noTxPool, interrupt bool) {
 	}
 }
 
+func TestBuildPayloadPrivateLane(t *testing.T) {
+	t.Parallel()
+	db := rawdb.NewMemoryDatabase()
+	backend := newTestWorkerBackend(t, params.TestChainConfig, ethash.NewFaker(), db, 0)
+	config := testConfig
+	config.RollupPrivateLaneSize = 2
+	w := New(backend, config, ethash.NewFaker())
+
+	// The private transaction conflicts with the pending pool transaction of the same
+	// nonce, and goes first with the private lane.
+	signer := types.LatestSigner(params.TestChainConfig)
+	private := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+		Nonce:    0,
+		To:       &common.Address{0x42},
+		Value:    big.NewInt(1),
+		Gas:      params.TxGas,
+		GasPrice: big.NewInt(params.InitialBaseFee),
+	})
+	if err := w.AddPrivateTransaction(private); err != nil {
+		t.Fatalf("Failed to add private transaction: %v", err)
+	}
+	if err := w.AddPrivateTransaction(private); !errors.Is(err, txpool.ErrAlreadyKnown) {
+		t.Fatalf("Expected known private transaction to be rejected, got %v", err)
+	}
+	backend.txPool.Add(pendingTxs, true, true)
+
+	build := func(policy *engine.OrderingPolicy) types.Transactions {
+		t.Helper()
+		payload, err := w.buildPayload(&BuildPayloadArgs{
+			Parent:         backend.chain.CurrentBlock().Hash(),
+			Timestamp:      uint64(time.Now().Unix()),
+			FeeRecipient:   common.HexToAddress("0xdeadbeef"),
+			OrderingPolicy: policy,
+		}, false)
+		if err != nil {
+			t.Fatalf("Failed to build payload %v", err)
+		}
+		payload.WaitFull()
+		block, err := engine.ExecutableDataToBlockNoHash(*payload.ResolveFull().ExecutionPayload, nil, nil)
+		if err != nil {
+			t.Fatalf("Failed to convert payload: %v", err)
+		}
+		return block.Transactions()
+	}
+	if txs := build(&engine.OrderingPolicy{Name: engine.OrderingPolicyFIFO}); len(txs) != 1 || txs[0].Hash() != pendingTxs[0].Hash() {
+		t.Fatal("Expected the pool transaction without the private lane")
+	}
+	if txs := build(&engine.OrderingPolicy{Name: engine.OrderingPolicyFIFO, PrivateLane: true}); len(txs) != 1 || txs[0].Hash() != private.Hash() {
+		t.Fatal("Expected the private transaction with the private lane")
+	}
+}
+
 func genTxs(startNonce, count uint64) types.Transactions {
 	txs := make(types.Transactions, 0, count)
 	signer := types.LatestSigner(params.TestChainConfig)
@@ -317,6 +370,22 @@ func TestPayloadId(t *testing.T) {
 				},
 			},
 		},
+		// Different ordering policy
+		{
+			Parent:         common.Hash{2},
+			Timestamp:      2,
+			Random:         common.Hash{0x2},
+			FeeRecipient:   common.Address{0x2},
+			OrderingPolicy: &engine.OrderingPolicy{Name: engine.OrderingPolicyFIFO},
+		},
+		// Different ordering policy with the private lane
+		{
+			Parent:         common.Hash{2},
+			Timestamp:      2,
+			Random:         common.Hash{0x2},
+			FeeRecipient:   common.Address{0x2},
+			OrderingPolicy: &engine.OrderingPolicy{Name: engine.OrderingPolicyFIFO, PrivateLane: true},
+		},
 	} {
 		id := tt.Id().String()
 		if prev, exists := ids[id]; exists {
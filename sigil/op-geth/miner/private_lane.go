@@ -0,0 +1,206 @@
+// Copyright 2024 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// privateLaneLifetime is how long a private transaction is kept for inclusion.
+const privateLaneLifetime = 10 * time.Minute
+
+var (
+	errPrivateLaneDisabled = errors.New("private lane is disabled")
+	errPrivateLaneFull     = errors.New("private lane is full")
+
+	privateLaneAddedCounter   = metrics.NewRegisteredCounter("miner/privatelane/added", nil)
+	privateLaneDroppedCounter = metrics.NewRegisteredCounter("miner/privatelane/dropped", nil)
+)
+
+type privateTx struct {
+	tx   *types.Transaction
+	from common.Address
+	time time.Time
+}
+
+// privateLane holds the transactions that were submitted to the miner directly,
+// to be included at the top of blocks built with the private lane, ahead of the
+// txpool transactions. Private transactions are not gossiped, and are kept until
+// they are included in the chain, or expire.
+type privateLane struct {
+	mu   sync.Mutex
+	size int
+	txs  []*privateTx // Transactions in the order that they were added
+}
+
+func newPrivateLane(size int) *privateLane {
+	return &privateLane{size: size}
+}
+
+func (l *privateLane) add(tx *types.Transaction, from common.Address) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, ptx := range l.txs {
+		if ptx.tx.Hash() == tx.Hash() {
+			return txpool.ErrAlreadyKnown
+		}
+	}
+	if len(l.txs) >= l.size {
+		return errPrivateLaneFull
+	}
+	l.txs = append(l.txs, &privateTx{tx: tx, from: from, time: time.Now()})
+	privateLaneAddedCounter.Inc(1)
+	return nil
+}
+
+// pending drops the expired transactions, and returns the remaining ones in the
+// order that they were added, except that the transactions of each account are
+// reordered by nonce among the positions that the account holds.
+func (l *privateLane) pending(now time.Time) []*privateTx {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.txs = slices.DeleteFunc(l.txs, func(ptx *privateTx) bool {
+		if now.Sub(ptx.time) > privateLaneLifetime {
+			log.Debug("Dropping expired private transaction", "hash", ptx.tx.Hash(), "sender", ptx.from)
+			privateLaneDroppedCounter.Inc(1)
+			return true
+		}
+		return false
+	})
+	positions := make(map[common.Address][]int)
+	for i, ptx := range l.txs {
+		positions[ptx.from] = append(positions[ptx.from], i)
+	}
+	out := make([]*privateTx, len(l.txs))
+	for _, indices := range positions {
+		accTxs := make([]*privateTx, len(indices))
+		for i, index := range indices {
+			accTxs[i] = l.txs[index]
+		}
+		slices.SortStableFunc(accTxs, func(a, b *privateTx) int {
+			return cmp.Compare(a.tx.Nonce(), b.tx.Nonce())
+		})
+		for i, index := range indices {
+			out[index] = accTxs[i]
+		}
+	}
+	return out
+}
+
+// remove drops the given transactions, that were included in the chain or can
+// no longer be.
+func (l *privateLane) remove(drop map[common.Hash]struct{}) {
+	if len(drop) == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.txs = slices.DeleteFunc(l.txs, func(ptx *privateTx) bool {
+		_, ok := drop[ptx.tx.Hash()]
+		return ok
+	})
+}
+
+// AddPrivateTransaction adds a transaction to the private lane, to be included at
+// the top of the blocks that are built with the private lane.
+func (miner *Miner) AddPrivateTransaction(tx *types.Transaction) error {
+	if miner.privateLane == nil {
+		return errPrivateLaneDisabled
+	}
+	switch tx.Type() {
+	case types.DepositTxType:
+		return core.ErrTxTypeNotSupported
+	case types.BlobTxType:
+		return errors.New("blob transactions are not supported in the private lane")
+	}
+	from, err := types.Sender(types.LatestSigner(miner.chainConfig), tx)
+	if err != nil {
+		return txpool.ErrInvalidSender
+	}
+	head := miner.chain.CurrentBlock()
+	if tx.Gas() > head.GasLimit {
+		return txpool.ErrGasLimit
+	}
+	statedb, err := miner.chain.StateAt(head.Root)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce() < statedb.GetNonce(from) {
+		return core.ErrNonceTooLow
+	}
+	return miner.privateLane.add(tx, from)
+}
+
+// commitPrivateTransactions fills the private transactions into the given sealing
+// block. Private transactions that are no longer executable are dropped from the
+// private lane, while those that do not fit the block, or fail, are kept for later
+// blocks. Transactions are only dropped from the private lane once included in the
+// chain, as the block that is built may not be.
+func (miner *Miner) commitPrivateTransactions(env *environment, interrupt *atomic.Int32) error {
+	ptxs := miner.privateLane.pending(time.Now())
+	if len(ptxs) == 0 {
+		return nil
+	}
+	drop := make(map[common.Hash]struct{})
+	defer miner.privateLane.remove(drop)
+
+	skip := make(map[common.Address]struct{})
+	for _, ptx := range ptxs {
+		if interrupt != nil {
+			if signal := interrupt.Load(); signal != commitInterruptNone {
+				return signalToErr(signal)
+			}
+		}
+		if env.gasPool.Gas() < params.TxGas {
+			break
+		}
+		if _, ok := skip[ptx.from]; ok {
+			continue
+		}
+		tx := ptx.tx
+		if nonce := env.state.GetNonce(ptx.from); tx.Nonce() < nonce {
+			// Included in the parent chain of the block, or replaced
+			drop[tx.Hash()] = struct{}{}
+			continue
+		}
+		if env.gasPool.Gas() < tx.Gas() {
+			log.Trace("Not enough gas left for private transaction", "hash", tx.Hash(), "left", env.gasPool.Gas(), "needed", tx.Gas())
+			skip[ptx.from] = struct{}{}
+			continue
+		}
+		env.state.SetTxContext(tx.Hash(), env.tcount)
+		if err := miner.commitTransaction(env, tx); err != nil {
+			// Subsequent transactions of the account are not executable either
+			log.Debug("Private transaction failed, account skipped", "hash", tx.Hash(), "sender", ptx.from, "err", err)
+			skip[ptx.from] = struct{}{}
+		}
+	}
+	return nil
+}
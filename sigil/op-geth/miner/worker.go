@@ -24,6 +24,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
@@ -111,6 +112,8 @@ type generateParams struct {
 	gasLimit  *uint64            // Optional gas limit override
 	interrupt *atomic.Int32      // Optional interruption signal to pass down to worker.generateWork
 	isUpdate  bool               // Optional flag indicating that this is building a discardable update
+
+	orderingPolicy *engine.OrderingPolicy // Optional ordering of the tx-pool transactions
 }
 
 // generateWork generates a sealing block based on the given parameters.
@@ -155,7 +158,7 @@ func (miner *Miner) generateWork(params *generateParams, witness bool) *newPaylo
 			interrupt.Store(commitInterruptTimeout)
 		})
 
-		err := miner.fillTransactions(interrupt, work)
+		err := miner.fillTransactions(interrupt, work, params.orderingPolicy)
 		timer.Stop() // don't need timeout interruption any more
 		if errors.Is(err, errBlockInterruptedByTimeout) {
 			log.Warn("Block building is interrupted", "allowance", common.PrettyDuration(miner.config.Recommit))
@@ -393,7 +396,7 @@ func (miner *Miner) applyTransaction(env *environment, tx *types.Transaction) (*
 	return receipt, err
 }
 
-func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs *transactionsByPriceAndNonce, interrupt *atomic.Int32) error {
+func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs orderedTransactions, interrupt *atomic.Int32) error {
 	gasLimit := env.header.GasLimit
 	if env.gasPool == nil {
 		env.gasPool = new(core.GasPool).AddGas(gasLimit)
@@ -420,7 +423,7 @@ func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs *tran
 		// Retrieve the next transaction and abort if all done.
 		var (
 			ltx *txpool.LazyTransaction
-			txs *transactionsByPriceAndNonce
+			txs orderedTransactions
 		)
 		pltx, ptip := plainTxs.Peek()
 		bltx, btip := blobTxs.Peek()
@@ -431,7 +434,7 @@ func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs *tran
 		case bltx == nil:
 			txs, ltx = plainTxs, pltx
 		default:
-			if ptip.Lt(btip) {
+			if blobFirst(plainTxs, pltx, ptip, bltx, btip) {
 				txs, ltx = blobTxs, bltx
 			} else {
 				txs, ltx = plainTxs, pltx
@@ -503,9 +506,15 @@ func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs *tran
 }
 
 // fillTransactions retrieves the pending transactions from the txpool and fills them
-// into the given sealing block. The transaction selection and ordering strategy can
-// be customized with the plugin in the future.
-func (miner *Miner) fillTransactions(interrupt *atomic.Int32, env *environment) error {
+// into the given sealing block. The transactions are ordered by the given ordering
+// policy, or by price if none is given. If the policy includes the private lane, the
+// private transactions are filled in ahead of the txpool transactions.
+func (miner *Miner) fillTransactions(interrupt *atomic.Int32, env *environment, policy *engine.OrderingPolicy) error {
+	if policy != nil && policy.PrivateLane {
+		if err := miner.commitPrivateTransactions(env, interrupt); err != nil {
+			return err
+		}
+	}
 	miner.confMu.RLock()
 	tip := miner.config.GasPrice
 	miner.confMu.RUnlock()
@@ -541,17 +550,19 @@ func (miner *Miner) fillTransactions(interrupt *atomic.Int32, env *environment)
 		}
 	}
 	// Fill the block with all available pending transactions.
+	// The sender caps of the fair-fee policy apply to locals and remotes together.
+	senderCounts := make(map[common.Address]uint64)
 	if len(localPlainTxs) > 0 || len(localBlobTxs) > 0 {
-		plainTxs := newTransactionsByPriceAndNonce(env.signer, localPlainTxs, env.header.BaseFee)
-		blobTxs := newTransactionsByPriceAndNonce(env.signer, localBlobTxs, env.header.BaseFee)
+		plainTxs := newOrderedTransactions(policy, env.signer, localPlainTxs, env.header.BaseFee, senderCounts)
+		blobTxs := newOrderedTransactions(policy, env.signer, localBlobTxs, env.header.BaseFee, senderCounts)
 
 		if err := miner.commitTransactions(env, plainTxs, blobTxs, interrupt); err != nil {
 			return err
 		}
 	}
 	if len(remotePlainTxs) > 0 || len(remoteBlobTxs) > 0 {
-		plainTxs := newTransactionsByPriceAndNonce(env.signer, remotePlainTxs, env.header.BaseFee)
-		blobTxs := newTransactionsByPriceAndNonce(env.signer, remoteBlobTxs, env.header.BaseFee)
+		plainTxs := newOrderedTransactions(policy, env.signer, remotePlainTxs, env.header.BaseFee, senderCounts)
+		blobTxs := newOrderedTransactions(policy, env.signer, remoteBlobTxs, env.header.BaseFee, senderCounts)
 
 		if err := miner.commitTransactions(env, plainTxs, blobTxs, interrupt); err != nil {
 			return err
@@ -8,6 +8,7 @@ require (
 	github.com/ethereum/go-ethereum v1.14.8
 	github.com/gorilla/mux v1.8.1
 	github.com/joho/godotenv v1.5.1
+	github.com/lib/pq v1.10.9
 	github.com/mattn/go-sqlite3 v1.14.16
 	github.com/prometheus/client_golang v1.20.2
 	github.com/slack-go/slack v0.14.0
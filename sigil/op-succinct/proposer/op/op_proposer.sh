@@ -18,8 +18,32 @@
     --db-path=${DB_PATH:-/usr/local/bin/dbdata} \
     --op-succinct-server-url=${OP_SUCCINCT_SERVER_URL:-http://op-succinct-server:3000} \
     --max-block-range-per-span-proof=${MAX_BLOCK_RANGE_PER_SPAN_PROOF:-300} \
+    --span-strategy=${SPAN_STRATEGY:-basic} \
+    ${L2_RPC:+--l2-eth-rpc=${L2_RPC}} \
+    --max-gas-per-span-proof=${MAX_GAS_PER_SPAN_PROOF:-0} \
+    --max-txs-per-span-proof=${MAX_TXS_PER_SPAN_PROOF:-0} \
+    --span-cycle-budget=${SPAN_CYCLE_BUDGET:-0} \
+    --target-span-proof-duration=${TARGET_SPAN_PROOF_DURATION:-30m} \
     --use-cached-db=${USE_CACHED_DB:-false} \
     --metrics.enabled=${METRICS_ENABLED:-true} \
     --metrics.port=${METRICS_PORT:-7300} \
     --mock=${OP_SUCCINCT_MOCK:-false} \
+    ${PROVER_BACKENDS:+--prover-backends=${PROVER_BACKENDS}} \
+    --rpc.enable-admin=${RPC_ENABLE_ADMIN:-false} \
+    --rpc.port=${RPC_PORT:-8545} \
+    --leader-election=${LEADER_ELECTION:-false} \
+    ${DB_URL:+--db-url=${DB_URL}} \
+    ${REPLICA_ID:+--replica-id=${REPLICA_ID}} \
+    ${SLACK_CHANNEL:+--slack-channel=${SLACK_CHANNEL}} \
+    ${NOTIFY_WEBHOOK_URL:+--notify-webhook-url=${NOTIFY_WEBHOOK_URL}} \
+    ${PAGERDUTY_ROUTING_KEY:+--pagerduty-routing-key=${PAGERDUTY_ROUTING_KEY}} \
+    --alert-contract-lag=${ALERT_CONTRACT_LAG:-0} \
+    --alert-range-failures=${ALERT_RANGE_FAILURES:-3} \
+    --alert-min-balance=${ALERT_MIN_BALANCE:-0} \
+    --alert-server-unreachable-checks=${ALERT_SERVER_UNREACHABLE_CHECKS:-3} \
+    --submission-max-base-fee=${SUBMISSION_MAX_BASE_FEE:-0} \
+    --submission-deadline=${SUBMISSION_DEADLINE:-1h} \
+    --submission-timeout=${SUBMISSION_TIMEOUT:-10m} \
+    --bundle-checkpoint=${BUNDLE_CHECKPOINT:-false} \
+    ${MULTICALL3_ADDRESS:+--multicall3-address=${MULTICALL3_ADDRESS}} \
     ${SLACK_TOKEN:+--slack-token=${SLACK_TOKEN}} \  # Pass the Slack token if it is set.
@@ -0,0 +1,180 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/notify"
+)
+
+// Keys of the alerts raised by the proposer.
+const (
+	alertContractLag       = "contract-lag"
+	alertLowBalance        = "low-balance"
+	alertServerUnreachable = "server-unreachable"
+)
+
+// rangeFailuresAlertKey is the key of the alert raised when the proof of the request's range keeps failing.
+func rangeFailuresAlertKey(req *ent.ProofRequest) string {
+	return fmt.Sprintf("range-failures-%s-%d-%d", req.Type, req.StartBlock, req.EndBlock)
+}
+
+// checkAlerts raises and resolves the alerts about the state of the proposer. Failing to check an alert is logged,
+// and doesn't stop the proposer.
+func (l *L2OutputSubmitter) checkAlerts(ctx context.Context, proposerMetrics opsuccinctmetrics.ProposerMetrics) {
+	if err := l.checkContractLag(ctx, proposerMetrics); err != nil {
+		l.Log.Error("failed to check contract lag", "err", err)
+	}
+	if err := l.checkWalletBalance(ctx, l.Txmgr.From()); err != nil {
+		l.Log.Error("failed to check proposer wallet balance", "err", err)
+	}
+	for _, backend := range l.provers.backends {
+		if err := l.checkServerReachable(ctx, backend); err != nil {
+			l.Log.Error("failed to check OP Succinct server", "backend", backend.Name, "err", err)
+		}
+	}
+}
+
+// contractLag returns how far the latest block on the L2OO is behind the L2 finalized block.
+func (l *L2OutputSubmitter) contractLag(ctx context.Context, proposerMetrics opsuccinctmetrics.ProposerMetrics) (time.Duration, error) {
+	if proposerMetrics.L2FinalizedBlock <= proposerMetrics.LatestContractL2Block {
+		return 0, nil
+	}
+
+	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting rollup client: %w", err)
+	}
+	cfg, err := rollupClient.RollupConfig(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting rollup config: %w", err)
+	}
+
+	blocksBehind := proposerMetrics.L2FinalizedBlock - proposerMetrics.LatestContractL2Block
+	return time.Duration(blocksBehind*cfg.BlockTime) * time.Second, nil
+}
+
+// checkContractLag alerts when the latest block on the L2OO is more than AlertContractLag behind the L2 finalized block.
+func (l *L2OutputSubmitter) checkContractLag(ctx context.Context, proposerMetrics opsuccinctmetrics.ProposerMetrics) error {
+	if l.Cfg.AlertContractLag == 0 {
+		return nil
+	}
+
+	lag, err := l.contractLag(ctx, proposerMetrics)
+	if err != nil {
+		return err
+	}
+	if lag < l.Cfg.AlertContractLag {
+		return l.alerter.Resolve(ctx, alertContractLag, fmt.Sprintf("The contract is %d minutes behind L2 finalized.", int(lag.Minutes())))
+	}
+	return l.alerter.Raise(ctx, notify.Notification{
+		Key:      alertContractLag,
+		Severity: notify.SeverityCritical,
+		Title:    fmt.Sprintf("Chain %d contract is behind L2 finalized", l.Cfg.L2ChainID),
+		Message: fmt.Sprintf("The contract is at L2 block %d, %d minutes behind L2 finalized block %d.",
+			proposerMetrics.LatestContractL2Block, int(lag.Minutes()), proposerMetrics.L2FinalizedBlock),
+	})
+}
+
+// checkWalletBalance alerts when the balance of the proposer wallet is below AlertMinBalance.
+func (l *L2OutputSubmitter) checkWalletBalance(ctx context.Context, address common.Address) error {
+	if l.Cfg.AlertMinBalance == nil || l.Cfg.AlertMinBalance.Sign() == 0 {
+		return nil
+	}
+
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	balance, err := l.L1Client.BalanceAt(cCtx, address, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get balance of %s: %w", address, err)
+	}
+
+	message := fmt.Sprintf("The proposer wallet %s has %s ETH, the minimum is %s ETH.", address, formatEther(balance), formatEther(l.Cfg.AlertMinBalance))
+	if balance.Cmp(l.Cfg.AlertMinBalance) >= 0 {
+		return l.alerter.Resolve(ctx, alertLowBalance, message)
+	}
+	return l.alerter.Raise(ctx, notify.Notification{
+		Key:      alertLowBalance,
+		Severity: notify.SeverityWarning,
+		Title:    fmt.Sprintf("Chain %d proposer wallet balance is low", l.Cfg.L2ChainID),
+		Message:  message,
+	})
+}
+
+// checkServerReachable alerts when the OP Succinct server of the prover backend is unreachable for
+// AlertServerUnreachableChecks consecutive checks. Any HTTP response means the server is reachable.
+func (l *L2OutputSubmitter) checkServerReachable(ctx context.Context, backend *ProverBackend) error {
+	if l.Cfg.AlertServerUnreachableChecks == 0 {
+		return nil
+	}
+	if l.serverUnreachableChecks == nil {
+		l.serverUnreachableChecks = make(map[string]uint64)
+	}
+	key := alertServerUnreachable + "-" + backend.Name
+
+	cCtx, cancel := context.WithTimeout(ctx, PROOF_STATUS_TIMEOUT)
+	defer cancel()
+	req, err := http.NewRequestWithContext(cCtx, http.MethodGet, backend.URL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err == nil {
+		resp.Body.Close()
+		l.serverUnreachableChecks[backend.Name] = 0
+		return l.alerter.Resolve(ctx, key, fmt.Sprintf("The OP Succinct server of prover backend %s at %s is reachable again.", backend.Name, backend.URL))
+	}
+
+	l.serverUnreachableChecks[backend.Name]++
+	checks := l.serverUnreachableChecks[backend.Name]
+	l.Log.Warn("OP Succinct server is unreachable", "backend", backend.Name, "url", backend.URL, "checks", checks, "err", err)
+	if checks < l.Cfg.AlertServerUnreachableChecks {
+		return nil
+	}
+	return l.alerter.Raise(ctx, notify.Notification{
+		Key:      key,
+		Severity: notify.SeverityCritical,
+		Title:    fmt.Sprintf("Chain %d OP Succinct server is unreachable", l.Cfg.L2ChainID),
+		Message:  fmt.Sprintf("The OP Succinct server of prover backend %s at %s failed %d consecutive checks: %v", backend.Name, backend.URL, checks, err),
+	})
+}
+
+// checkRangeFailures alerts when the proof of the request's range has failed AlertRangeFailures times.
+func (l *L2OutputSubmitter) checkRangeFailures(ctx context.Context, req *ent.ProofRequest, reason string) error {
+	if l.Cfg.AlertRangeFailures == 0 {
+		return nil
+	}
+
+	failures, err := l.db.CountFailedAttempts(req.Type, req.StartBlock, req.EndBlock)
+	if err != nil {
+		return err
+	}
+	if uint64(failures) < l.Cfg.AlertRangeFailures {
+		return nil
+	}
+	return l.alerter.Raise(ctx, notify.Notification{
+		Key:      rangeFailuresAlertKey(req),
+		Severity: notify.SeverityWarning,
+		Title:    fmt.Sprintf("Chain %d proof keeps failing", l.Cfg.L2ChainID),
+		Message:  fmt.Sprintf("The %s proof of blocks %d-%d failed %d times, last because: %s", req.Type, req.StartBlock, req.EndBlock, failures, reason),
+	})
+}
+
+// ResolveRangeFailures resolves the alert about repeated failures of the request's range, once the range is no
+// longer retried as is: it was proven, split into smaller ranges, or cancelled.
+func (l *L2OutputSubmitter) ResolveRangeFailures(ctx context.Context, req *ent.ProofRequest, message string) error {
+	return l.alerter.Resolve(ctx, rangeFailuresAlertKey(req), message)
+}
+
+// formatEther formats an amount of wei in ETH.
+func formatEther(wei *big.Int) string {
+	return new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether)).Text('f', 6)
+}
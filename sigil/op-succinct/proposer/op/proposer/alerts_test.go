@@ -0,0 +1,227 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/notify"
+	opsuccinctrpc "github.com/succinctlabs/op-succinct-go/proposer/rpc"
+	"github.com/succinctlabs/op-succinct-go/proposer/testutils"
+)
+
+// newWebhook sends the alerts of the submitter to a webhook server, which records the notifications posted to it.
+func newWebhook(t *testing.T, l *L2OutputSubmitter) *testutils.Server {
+	webhook := testutils.NewServer(t, func(http.ResponseWriter, testutils.Request) {})
+	l.Notifier = notify.NewWebhookNotifier(webhook.URL)
+	l.alerter = notify.NewAlerter(l.Notifier)
+	return webhook
+}
+
+// notifications returns the notifications posted to the webhook server.
+func notifications(t *testing.T, webhook *testutils.Server) []notify.Notification {
+	var received []notify.Notification
+	for _, req := range webhook.Requests() {
+		var n notify.Notification
+		require.NoError(t, json.Unmarshal(req.Body, &n))
+		received = append(received, n)
+	}
+	return received
+}
+
+// newAlertRPC starts a JSON-RPC server serving the L1 balance of the proposer and the rollup config.
+func newAlertRPC(t *testing.T, balance *atomic.Pointer[big.Int]) *testutils.Server {
+	return testutils.NewRPCServer(t, func(method string, _ []json.RawMessage) (any, error) {
+		switch method {
+		case "eth_getBalance":
+			return (*hexutil.Big)(balance.Load()), nil
+		case "optimism_rollupConfig":
+			return map[string]any{"block_time": 2}, nil
+		default:
+			return nil, fmt.Errorf("unexpected RPC method %s", method)
+		}
+	})
+}
+
+func TestContractLagAlert(t *testing.T) {
+	l := newTestSubmitter(t, ProposerConfig{AlertContractLag: time.Hour}, newAlertRPC(t, new(atomic.Pointer[big.Int])))
+	webhook := newWebhook(t, l)
+	ctx := context.Background()
+
+	// 1000 blocks of 2 seconds is 33 minutes.
+	require.NoError(t, l.checkContractLag(ctx, opsuccinctmetrics.ProposerMetrics{L2FinalizedBlock: 11_000, LatestContractL2Block: 10_000}))
+	require.Empty(t, notifications(t, webhook))
+
+	// 2000 blocks is 66 minutes.
+	behind := opsuccinctmetrics.ProposerMetrics{L2FinalizedBlock: 12_000, LatestContractL2Block: 10_000}
+	require.NoError(t, l.checkContractLag(ctx, behind))
+	require.NoError(t, l.checkContractLag(ctx, behind))
+	received := notifications(t, webhook)
+	require.Len(t, received, 1, "the alert is only sent once while the contract is behind")
+	require.Equal(t, alertContractLag, received[0].Key)
+	require.Equal(t, notify.SeverityCritical, received[0].Severity)
+	require.Contains(t, received[0].Message, "66 minutes behind")
+
+	require.NoError(t, l.checkContractLag(ctx, opsuccinctmetrics.ProposerMetrics{L2FinalizedBlock: 12_000, LatestContractL2Block: 12_000}))
+	received = notifications(t, webhook)
+	require.Len(t, received, 2)
+	require.True(t, received[1].Resolved)
+}
+
+func TestWalletBalanceAlert(t *testing.T) {
+	minBalance := big.NewInt(params.Ether)
+	balance := new(atomic.Pointer[big.Int])
+	l := newTestSubmitter(t, ProposerConfig{AlertMinBalance: minBalance}, newAlertRPC(t, balance))
+	webhook := newWebhook(t, l)
+	ctx := context.Background()
+	proposer := common.HexToAddress("0x1234")
+
+	balance.Store(big.NewInt(2 * params.Ether))
+	require.NoError(t, l.checkWalletBalance(ctx, proposer))
+	require.Empty(t, notifications(t, webhook))
+
+	balance.Store(big.NewInt(params.Ether / 2))
+	require.NoError(t, l.checkWalletBalance(ctx, proposer))
+	received := notifications(t, webhook)
+	require.Len(t, received, 1)
+	require.Equal(t, alertLowBalance, received[0].Key)
+	require.Contains(t, received[0].Message, "has 0.500000 ETH, the minimum is 1.000000 ETH")
+
+	balance.Store(big.NewInt(3 * params.Ether))
+	require.NoError(t, l.checkWalletBalance(ctx, proposer))
+	received = notifications(t, webhook)
+	require.Len(t, received, 2)
+	require.True(t, received[1].Resolved)
+}
+
+func TestServerUnreachableAlert(t *testing.T) {
+	// Any response, even a 404, means the server is reachable.
+	server := testutils.NewServer(t, func(w http.ResponseWriter, _ testutils.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	l := newTestSubmitter(t, ProposerConfig{AlertServerUnreachableChecks: 2}, nil)
+	webhook := newWebhook(t, l)
+	backend := &ProverBackend{ProverBackendConfig: ProverBackendConfig{Name: "local", URL: server.URL}}
+	ctx := context.Background()
+
+	require.NoError(t, l.checkServerReachable(ctx, backend))
+	require.Empty(t, notifications(t, webhook))
+
+	server.Close()
+	require.NoError(t, l.checkServerReachable(ctx, backend))
+	require.Empty(t, notifications(t, webhook), "a single failed check doesn't alert")
+	require.NoError(t, l.checkServerReachable(ctx, backend))
+	received := notifications(t, webhook)
+	require.Len(t, received, 1)
+	require.Equal(t, "server-unreachable-local", received[0].Key)
+
+	restarted := testutils.NewServer(t, func(w http.ResponseWriter, _ testutils.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	backend.URL = restarted.URL
+	require.NoError(t, l.checkServerReachable(ctx, backend))
+	received = notifications(t, webhook)
+	require.Len(t, received, 2)
+	require.True(t, received[1].Resolved)
+	require.Zero(t, l.serverUnreachableChecks["local"])
+}
+
+func TestRangeFailuresAlert(t *testing.T) {
+	proofStatus := ProofStatusResponse{FulfillmentStatus: SP1FulfillmentStatusFulfilled, Proof: []byte{0x01}}
+	server := testutils.NewServer(t, func(w http.ResponseWriter, r testutils.Request) {
+		require.Equal(t, "/status/abcd", r.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(proofStatus))
+	})
+	l := newTestSubmitter(t, ProposerConfig{AlertRangeFailures: 3}, nil)
+	webhook := newWebhook(t, l)
+	l.provers = newProverRouter([]ProverBackendConfig{{Name: DefaultProverBackend, URL: server.URL, Weight: 1}}, time.Minute, l.Log, l.Metr)
+
+	require.NoError(t, l.db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	for range 3 {
+		require.Empty(t, notifications(t, webhook))
+		req, err := l.db.GetNextUnrequestedSpanProof()
+		require.NoError(t, err)
+		require.NoError(t, l.RetryRequest(req, ProofStatusResponse{}, "witness generation timed out"))
+	}
+	received := notifications(t, webhook)
+	require.Len(t, received, 1)
+	require.Equal(t, "range-failures-SPAN-100-200", received[0].Key)
+	require.Equal(t, "The SPAN proof of blocks 100-200 failed 3 times, last because: witness generation timed out", received[0].Message)
+
+	// The alert is resolved once the range is proven.
+	req, err := l.db.GetNextUnrequestedSpanProof()
+	require.NoError(t, err)
+	claimed, err := l.db.ClaimProofRequest(req.ID, "replica-a", "")
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.NoError(t, l.db.SetProverRequestID(req.ID, []byte{0xab, 0xcd}))
+	require.NoError(t, l.db.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+	require.NoError(t, l.ProcessProvingRequests())
+	received = notifications(t, webhook)
+	require.Len(t, received, 2)
+	require.True(t, received[1].Resolved)
+}
+
+func TestRangeFailuresAlertResolvedOnSplit(t *testing.T) {
+	ctx := context.Background()
+	// failTwice fails the proof of the range twice, raising the alert, and returns the request queued for the range.
+	failTwice := func(t *testing.T, l *L2OutputSubmitter, start, end uint64) *ent.ProofRequest {
+		require.NoError(t, l.db.NewEntry(proofrequest.TypeSPAN, start, end))
+		for range 2 {
+			req, err := l.db.GetNextUnrequestedSpanProof()
+			require.NoError(t, err)
+			require.NoError(t, l.RetryRequest(req, ProofStatusResponse{}, "witness generation timed out"))
+		}
+		req, err := l.db.GetNextUnrequestedSpanProof()
+		require.NoError(t, err)
+		require.True(t, l.alerter.IsActive(rangeFailuresAlertKey(req)))
+		return req
+	}
+	requireResolved := func(t *testing.T, l *L2OutputSubmitter, webhook *testutils.Server, req *ent.ProofRequest, message string) {
+		require.False(t, l.alerter.IsActive(rangeFailuresAlertKey(req)))
+		received := notifications(t, webhook)
+		require.Len(t, received, 2)
+		require.True(t, received[1].Resolved)
+		require.Equal(t, message, received[1].Message)
+	}
+
+	t.Run("split after execution failure", func(t *testing.T) {
+		l := newTestSubmitter(t, ProposerConfig{AlertRangeFailures: 2}, nil)
+		webhook := newWebhook(t, l)
+		req := failTwice(t, l, 100, 200)
+		unexecutable := ProofStatusResponse{FulfillmentStatus: SP1FulfillmentStatusUnfulfillable, ExecutionStatus: SP1ExecutionStatusUnexecutable}
+		require.NoError(t, l.RetryRequest(req, unexecutable, "unfulfillable"))
+		requireResolved(t, l, webhook, req, "The SPAN proof of blocks 100-200 was split into 2 proofs.")
+	})
+
+	t.Run("split via admin API", func(t *testing.T) {
+		l := newTestSubmitter(t, ProposerConfig{AlertRangeFailures: 2}, nil)
+		webhook := newWebhook(t, l)
+		req := failTwice(t, l, 100, 200)
+		_, err := opsuccinctrpc.NewAdminAPI(l.ProofDB(), l, l, l.Log).SplitProofRequest(ctx, req.ID, 150)
+		require.NoError(t, err)
+		requireResolved(t, l, webhook, req, "The SPAN proof of blocks 100-200 was split at block 150.")
+	})
+
+	t.Run("cancelled via admin API", func(t *testing.T) {
+		l := newTestSubmitter(t, ProposerConfig{AlertRangeFailures: 2}, nil)
+		webhook := newWebhook(t, l)
+		req := failTwice(t, l, 100, 200)
+		require.NoError(t, l.db.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+		require.NoError(t, opsuccinctrpc.NewAdminAPI(l.ProofDB(), l, l, l.Log).CancelProofRequest(ctx, req.ID))
+		requireResolved(t, l, webhook, req, "The SPAN proof of blocks 100-200 was cancelled.")
+	})
+}
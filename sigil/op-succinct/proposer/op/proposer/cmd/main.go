@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/succinctlabs/op-succinct-go/proposer"
 	"github.com/succinctlabs/op-succinct-go/proposer/flags"
+	"github.com/succinctlabs/op-succinct-go/proposer/proofs"
 )
 
 var (
@@ -36,6 +37,11 @@ func main() {
 			Name:        "doc",
 			Subcommands: doc.NewSubcommands(metrics.NewMetrics("default")),
 		},
+		{
+			Name:        "proofs",
+			Usage:       "Export, import and verify completed proofs",
+			Subcommands: proofs.Subcommands,
+		},
 	}
 
 	err := app.Run(os.Args)
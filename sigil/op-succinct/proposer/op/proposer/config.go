@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/succinctlabs/op-succinct-go/proposer/flags"
 )
 
@@ -71,8 +73,44 @@ type CLIConfig struct {
 	// UseCachedDb is a flag to use a cached database instead of creating a new one.
 	UseCachedDb bool
 
+	// DbUrl is the Postgres connection URL. If set, it is used instead of the SQLite DB at DbPath.
+	DbUrl string
+	// LeaderElection makes the replicas sharing the Postgres DB elect a single leader to submit to the L2OO.
+	LeaderElection bool
+	// ReplicaID identifies this replica on the proof requests it claims.
+	ReplicaID string
+
 	// SlackToken is the token for the Slack API.
 	SlackToken string
+	// SlackChannel is the Slack channel to post status reports and alerts to.
+	SlackChannel string
+	// NotifyWebhookUrl is the URL to POST status reports and alerts to as JSON.
+	NotifyWebhookUrl string
+	// PagerDutyRoutingKey is the routing key of the PagerDuty Events API v2 integration to send alerts to.
+	PagerDutyRoutingKey string
+	// AlertContractLag is how far the L2OO can fall behind the L2 finalized block before alerting. 0 disables the alert.
+	AlertContractLag time.Duration
+	// AlertRangeFailures is how many times the proof of a range can fail before alerting. 0 disables the alert.
+	AlertRangeFailures uint64
+	// AlertMinBalance is the balance of the proposer wallet, in ETH, below which to alert. 0 disables the alert.
+	AlertMinBalance float64
+	// AlertServerUnreachableChecks is how many consecutive checks the OP Succinct server can be unreachable before
+	// alerting. 0 disables the alert.
+	AlertServerUnreachableChecks uint64
+
+	// SubmissionMaxBaseFee is the L1 base fee, in gwei, above which the submission of AGG proofs is deferred. 0 disables
+	// the cap.
+	SubmissionMaxBaseFee float64
+	// SubmissionDeadline is how long a completed AGG proof waits for the base fee to drop below the cap before it is
+	// submitted anyway. 0 waits indefinitely.
+	SubmissionDeadline time.Duration
+	// SubmissionTimeout is the maximum time spent submitting an AGG proof.
+	SubmissionTimeout time.Duration
+	// BundleCheckpoint checkpoints the L1 block hash of AGG proofs in the same transaction as their proposal, through
+	// Multicall3, instead of in a separate transaction when the AGG proof is requested.
+	BundleCheckpoint bool
+	// Multicall3Address is the address of the Multicall3 contract on L1.
+	Multicall3Address string
 
 	// L1 Beacon RPC URL used to determine span batch boundaries.
 	BeaconRpc string
@@ -80,6 +118,19 @@ type CLIConfig struct {
 	TxCacheOutDir string
 	// The max size (in blocks) of a proof we will attempt to generate. If span batches are larger, we break them up.
 	MaxBlockRangePerSpanProof uint64
+	// SpanStrategy is how the range of finalized L2 blocks is split into span proofs.
+	SpanStrategy string
+	// L2EthRpc is the HTTP provider URL for L2, used by the weighted span strategy to read block receipts.
+	L2EthRpc string
+	// The max gas used by the blocks of a span proof with the weighted span strategy. 0 means no limit.
+	MaxGasPerSpanProof uint64
+	// The max number of transactions in the blocks of a span proof with the weighted span strategy. 0 means no limit.
+	MaxTxsPerSpanProof uint64
+	// The number of cycles a span proof should use with the adaptive span strategy.
+	SpanCycleBudget uint64
+	// How long span proofs should take to prove with the adaptive span strategy. Spans grow back after shrinking while
+	// proofs complete faster than this. 0 means spans don't grow back.
+	TargetSpanProofDuration time.Duration
 	// The max number of concurrent witness generation processes.
 	MaxConcurrentWitnessGen uint64
 	// The max time we will wait for a witness to be generated before giving up.
@@ -92,6 +143,9 @@ type CLIConfig struct {
 	OPSuccinctServerUrl string
 	// The maximum proofs that can be requested from the server concurrently.
 	MaxConcurrentProofRequests uint64
+	// ProverBackendsFile is the path of a JSON file configuring the prover backends to request proofs from. If empty,
+	// proofs are requested from OPSuccinctServerUrl.
+	ProverBackendsFile string
 	// The batch inbox on L1 to read batches from. Note that this is ignored if L2 Chain ID is in rollup config.
 	BatchInbox string
 	// The batcher address to include transactions from. Note that this is ignored if L2 Chain ID is in rollup config.
@@ -118,6 +172,78 @@ func (c *CLIConfig) Check() error {
 		return errors.New("the `L2OutputOracle` address was not provided")
 	}
 
+	if c.LeaderElection && c.DbUrl == "" {
+		return errors.New("leader election requires a Postgres DB URL")
+	}
+	// Replicas sharing a Postgres DB would all plan spans and create AGG proofs for the same ranges without a leader.
+	if c.DbUrl != "" && !c.LeaderElection {
+		return errors.New("a Postgres DB URL requires leader election")
+	}
+
+	if c.SlackToken != "" && c.SlackChannel == "" {
+		return errors.New("a Slack channel is required to send Slack notifications")
+	}
+	if c.AlertMinBalance < 0 {
+		return errors.New("the alert min balance must not be negative")
+	}
+
+	if c.SubmissionMaxBaseFee < 0 {
+		return errors.New("the submission max base fee must not be negative")
+	}
+	if c.SubmissionTimeout <= 0 {
+		return errors.New("the submission timeout must be positive")
+	}
+	if c.BundleCheckpoint && !common.IsHexAddress(c.Multicall3Address) {
+		return fmt.Errorf("invalid Multicall3 address %q", c.Multicall3Address)
+	}
+	if err := c.checkBundleCheckpointWindow(); err != nil {
+		return err
+	}
+
+	if err := SpanStrategy(c.SpanStrategy).Check(); err != nil {
+		return err
+	}
+	if SpanStrategy(c.SpanStrategy) == SpanStrategyWeighted {
+		if c.L2EthRpc == "" {
+			return errors.New("the weighted span strategy requires an L2 RPC")
+		}
+		if c.MaxGasPerSpanProof == 0 && c.MaxTxsPerSpanProof == 0 {
+			return errors.New("the weighted span strategy requires a max gas or max transactions per span proof")
+		}
+	}
+	if SpanStrategy(c.SpanStrategy) == SpanStrategyAdaptive {
+		if c.L2EthRpc == "" {
+			return errors.New("the adaptive span strategy requires an L2 RPC")
+		}
+		if c.SpanCycleBudget == 0 {
+			return errors.New("the adaptive span strategy requires a span cycle budget")
+		}
+	}
+
+	return nil
+}
+
+// checkBundleCheckpointWindow checks that with bundled checkpoints, AGG proofs are always submitted before their L1
+// block is too old to checkpoint. The L1 block is chosen when the AGG proof is requested, so generating the witness,
+// proving, and deferring the submission while the base fee is above the cap must all fit in the checkpoint window.
+// Otherwise the AGG proof is dropped, and the next one can time out the same way.
+func (c *CLIConfig) checkBundleCheckpointWindow() error {
+	if !c.BundleCheckpoint {
+		return nil
+	}
+	if c.ProofTimeout == 0 {
+		return errors.New("bundled checkpoints require a proof timeout")
+	}
+	maxAge := time.Duration(c.WitnessGenTimeout+c.ProofTimeout) * time.Second
+	if c.SubmissionMaxBaseFee > 0 {
+		if c.SubmissionDeadline == 0 {
+			return errors.New("bundled checkpoints with a submission max base fee require a submission deadline")
+		}
+		maxAge += c.SubmissionDeadline
+	}
+	if maxAge > bundleCheckpointWindow {
+		return fmt.Errorf("bundled checkpoints require the witness generation timeout, proof timeout and submission deadline to add up to at most %s, got %s", bundleCheckpointWindow, maxAge)
+	}
 	return nil
 }
 
@@ -137,6 +263,14 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 	dbPath := ctx.String(flags.DbPathFlag.Name)
 	dbPath = filepath.Join(dbPath, fmt.Sprintf("%d", rollupConfig.L2ChainID.Uint64()), "proofs.db")
 
+	replicaID := ctx.String(flags.ReplicaIDFlag.Name)
+	if replicaID == "" {
+		replicaID, err = os.Hostname()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	return &CLIConfig{
 		// Required Flags
 		L1EthRpc:     ctx.String(flags.L1EthRpcFlag.Name),
@@ -157,14 +291,36 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		WaitNodeSync:                 ctx.Bool(flags.WaitNodeSyncFlag.Name),
 		DbPath:                       dbPath,
 		UseCachedDb:                  ctx.Bool(flags.UseCachedDbFlag.Name),
+		DbUrl:                        ctx.String(flags.DbUrlFlag.Name),
+		LeaderElection:               ctx.Bool(flags.LeaderElectionFlag.Name),
+		ReplicaID:                    replicaID,
 		SlackToken:                   ctx.String(flags.SlackTokenFlag.Name),
+		SlackChannel:                 ctx.String(flags.SlackChannelFlag.Name),
+		NotifyWebhookUrl:             ctx.String(flags.NotifyWebhookUrlFlag.Name),
+		PagerDutyRoutingKey:          ctx.String(flags.PagerDutyRoutingKeyFlag.Name),
+		AlertContractLag:             ctx.Duration(flags.AlertContractLagFlag.Name),
+		AlertRangeFailures:           ctx.Uint64(flags.AlertRangeFailuresFlag.Name),
+		AlertMinBalance:              ctx.Float64(flags.AlertMinBalanceFlag.Name),
+		AlertServerUnreachableChecks: ctx.Uint64(flags.AlertServerUnreachableChecksFlag.Name),
+		SubmissionMaxBaseFee:         ctx.Float64(flags.SubmissionMaxBaseFeeFlag.Name),
+		SubmissionDeadline:           ctx.Duration(flags.SubmissionDeadlineFlag.Name),
+		SubmissionTimeout:            ctx.Duration(flags.SubmissionTimeoutFlag.Name),
+		BundleCheckpoint:             ctx.Bool(flags.BundleCheckpointFlag.Name),
+		Multicall3Address:            ctx.String(flags.Multicall3AddressFlag.Name),
 		MaxBlockRangePerSpanProof:    ctx.Uint64(flags.MaxBlockRangePerSpanProofFlag.Name),
+		SpanStrategy:                 ctx.String(flags.SpanStrategyFlag.Name),
+		L2EthRpc:                     ctx.String(flags.L2EthRpcFlag.Name),
+		MaxGasPerSpanProof:           ctx.Uint64(flags.MaxGasPerSpanProofFlag.Name),
+		MaxTxsPerSpanProof:           ctx.Uint64(flags.MaxTxsPerSpanProofFlag.Name),
+		SpanCycleBudget:              ctx.Uint64(flags.SpanCycleBudgetFlag.Name),
+		TargetSpanProofDuration:      ctx.Duration(flags.TargetSpanProofDurationFlag.Name),
 		MaxConcurrentWitnessGen:      ctx.Uint64(flags.MaxConcurrentWitnessGenFlag.Name),
 		WitnessGenTimeout:            ctx.Uint64(flags.WitnessGenTimeoutFlag.Name),
 		ProofTimeout:                 ctx.Uint64(flags.ProofTimeoutFlag.Name),
 		TxCacheOutDir:                ctx.String(flags.TxCacheOutDirFlag.Name),
 		OPSuccinctServerUrl:          ctx.String(flags.OPSuccinctServerUrlFlag.Name),
 		MaxConcurrentProofRequests:   ctx.Uint64(flags.MaxConcurrentProofRequestsFlag.Name),
+		ProverBackendsFile:           ctx.String(flags.ProverBackendsFlag.Name),
 		BatchInbox:                   ctx.String(flags.BatchInboxFlag.Name),
 		BatcherAddress:               ctx.String(flags.BatcherAddressFlag.Name),
 		Mock:                         ctx.Bool(flags.MockFlag.Name),
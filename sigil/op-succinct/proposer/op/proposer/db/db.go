@@ -0,0 +1,1220 @@
+package db
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// leaderLockID is the key of the Postgres advisory lock held by the leader among the proposer replicas sharing a DB.
+const leaderLockID int64 = 0x6f705f7375636363
+
+// leaderLock is the connection holding the leader lock, if this replica holds it.
+type leaderLock struct {
+	mu   sync.Mutex
+	conn *stdsql.Conn
+}
+
+type ProofDB struct {
+	writeClient *ent.Client
+	readClient  *ent.Client
+	dialect     string
+
+	// sqlDB and leader are only set for Postgres.
+	sqlDB  *stdsql.DB
+	leader *leaderLock
+
+	metrics StageMetricer
+}
+
+// InitDB initializes the database and returns a handle to it.
+// If useCachedDb is false, the existing DB at the path will be deleted (if it exists).
+func InitDB(dbPath string, useCachedDb bool) (*ProofDB, error) {
+	if !useCachedDb {
+		os.Remove(dbPath)
+	} else {
+		fmt.Printf("Using cached DB at %s\n", dbPath)
+	}
+
+	// Create the intermediate directories if they don't exist
+	dir := filepath.Dir(dbPath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directories for DB: %w", err)
+	}
+
+	// Use the TL;DR SQLite settings from https://kerkour.com/sqlite-for-servers.
+	connectionUrl := fmt.Sprintf("file:%s?_fk=1&journal_mode=WAL&synchronous=normal&cache_size=100000000&busy_timeout=30000&_txlock=immediate", dbPath)
+
+	writeDrv, err := sql.Open("sqlite3", connectionUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening connection to sqlite: %v", err)
+	}
+	writeDb := writeDrv.DB()
+
+	// The write lock only allows one connection to the DB at a time.
+	writeDb.SetMaxOpenConns(1)
+	writeDb.SetConnMaxLifetime(10 * time.Minute)
+
+	readDrv, err := sql.Open("sqlite3", connectionUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening connection to sqlite: %v", err)
+	}
+	readDb := readDrv.DB()
+	readDb.SetMaxOpenConns(max(4, runtime.NumCPU()/4))
+	readDb.SetConnMaxLifetime(10 * time.Minute)
+
+	readClient := ent.NewClient(ent.Driver(readDrv))
+	writeClient := ent.NewClient(ent.Driver(writeDrv))
+
+	if err := readClient.Schema.Create(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed creating schema resources: %v", err)
+	}
+	if err := writeClient.Schema.Create(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed creating schema resources: %v", err)
+	}
+
+	return &ProofDB{writeClient: writeClient, readClient: readClient, dialect: dialect.SQLite}, nil
+}
+
+// InitPostgresDB initializes a Postgres database and returns a handle to it. Unlike InitDB, existing proof requests
+// are always kept, since the DB may be shared by several proposer replicas.
+func InitPostgresDB(connectionUrl string) (*ProofDB, error) {
+	drv, err := sql.Open(dialect.Postgres, connectionUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening connection to postgres: %v", err)
+	}
+	sqlDB := drv.DB()
+	sqlDB.SetMaxOpenConns(max(8, runtime.NumCPU()))
+	sqlDB.SetConnMaxLifetime(10 * time.Minute)
+
+	client := ent.NewClient(ent.Driver(drv))
+	if err := client.Schema.Create(context.Background(), schema.WithHooks(withoutTableOptions)); err != nil {
+		return nil, fmt.Errorf("failed creating schema resources: %v", err)
+	}
+
+	// Postgres handles concurrent writers, so reads and writes share the same connection pool.
+	return &ProofDB{writeClient: client, readClient: client, dialect: dialect.Postgres, sqlDB: sqlDB, leader: new(leaderLock)}, nil
+}
+
+// withoutTableOptions drops the SQLite-only STRICT table option from the schema before it is created in Postgres.
+func withoutTableOptions(next schema.Creator) schema.Creator {
+	return schema.CreateFunc(func(ctx context.Context, tables ...*schema.Table) error {
+		for _, t := range tables {
+			if t.Annotation != nil {
+				t.Annotation.Options = ""
+			}
+		}
+		return next.Create(ctx, tables...)
+	})
+}
+
+// CloseDB closes the connection to the database.
+func (db *ProofDB) CloseDB() error {
+	if err := db.leader.close(); err != nil {
+		return fmt.Errorf("error closing leader connection: %w", err)
+	}
+	if db.writeClient != nil {
+		if err := db.writeClient.Close(); err != nil {
+			return fmt.Errorf("error closing database: %w", err)
+		}
+	}
+	if db.readClient != nil && db.readClient != db.writeClient {
+		if err := db.readClient.Close(); err != nil {
+			return fmt.Errorf("error closing database: %w", err)
+		}
+	}
+	return nil
+}
+
+// NewEntry creates a new proof request entry in the database.
+func (db *ProofDB) NewEntry(proofType proofrequest.Type, start, end uint64) error {
+	return db.newEntry(proofType, start, end, "")
+}
+
+// NewEntryOnBackend adds a new proof request that can only be requested from the given prover backend.
+func (db *ProofDB) NewEntryOnBackend(proofType proofrequest.Type, start, end uint64, proverBackend string) error {
+	return db.newEntry(proofType, start, end, proverBackend)
+}
+
+func (db *ProofDB) newEntry(proofType proofrequest.Type, start, end uint64, proverBackend string) error {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := uint64(time.Now().Unix())
+	create := tx.ProofRequest.
+		Create().
+		SetType(proofType).
+		SetStartBlock(start).
+		SetEndBlock(end).
+		SetStatus(proofrequest.StatusUNREQ).
+		SetRequestAddedTime(now).
+		SetLastUpdatedTime(now)
+	reason := ""
+	if proverBackend != "" {
+		create.SetProverBackend(proverBackend)
+		reason = "routed to prover backend " + proverBackend
+	}
+	_, err = createProofRequest(ctx, tx, create, reason)
+
+	if err != nil {
+		return fmt.Errorf("failed to create new entry: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateProofStatus updates the status of a proof request in the database.
+func (db *ProofDB) UpdateProofStatus(id int, proofStatus proofrequest.Status) error {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	req, err := tx.ProofRequest.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to find proof request %d: %w", id, err)
+	}
+	transition, err := transitionProofRequest(ctx, tx, req, tx.ProofRequest.UpdateOne(req), proofStatus, "", "")
+	if err != nil {
+		return fmt.Errorf("failed to update proof status: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	db.recordStageTransitions(transition)
+
+	return nil
+}
+
+// ClaimProofRequest moves an UNREQ proof request to WITNESSGEN on behalf of the given replica, to be requested from the
+// given prover backend. Returns false if the request is no longer unrequested, e.g. because another replica sharing the
+// DB claimed it first.
+func (db *ProofDB) ClaimProofRequest(id int, replicaID, proverBackend string) (bool, error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := tx.ProofRequest.Query().
+		Where(
+			proofrequest.ID(id),
+			proofrequest.StatusEQ(proofrequest.StatusUNREQ),
+		)
+	// Lock the row so that a concurrent claim from another replica skips it instead of claiming it twice. SQLite
+	// doesn't support row locks, but its write transactions are already serialized by the immediate transaction lock.
+	if db.dialect == dialect.Postgres {
+		query = query.ForUpdate(sql.WithLockAction(sql.SkipLocked))
+	}
+	req, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to query proof request %d: %w", id, err)
+	}
+
+	update := tx.ProofRequest.UpdateOne(req).SetReplicaID(replicaID)
+	reason := "claimed by " + replicaID
+	if proverBackend != "" {
+		update.SetProverBackend(proverBackend)
+		reason += " for prover backend " + proverBackend
+	}
+	transition, err := transitionProofRequest(ctx, tx, req, update, proofrequest.StatusWITNESSGEN, reason, "")
+	if err != nil {
+		return false, fmt.Errorf("failed to claim proof request %d: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	db.recordStageTransitions(transition)
+
+	return true, nil
+}
+
+// FailProofRequest sets a proof request that hasn't failed, completed or been cancelled yet to FAILED, recording why it
+// failed and the prover's response, if any. Returns false if the request was already FAILED, COMPLETE or CANCELLED, so
+// that concurrent retries of the same request only retry it once, and cancelled requests aren't retried.
+func (db *ProofDB) FailProofRequest(id int, reason, proverResponse string) (bool, error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := tx.ProofRequest.Query().
+		Where(
+			proofrequest.ID(id),
+			proofrequest.StatusNotIn(proofrequest.StatusFAILED, proofrequest.StatusCOMPLETE, proofrequest.StatusCANCELLED),
+		)
+	if db.dialect == dialect.Postgres {
+		query = query.ForUpdate()
+	}
+	req, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to query proof request %d: %w", id, err)
+	}
+
+	transition, err := transitionProofRequest(ctx, tx, req, tx.ProofRequest.UpdateOne(req), proofrequest.StatusFAILED, reason, proverResponse)
+	if err != nil {
+		return false, fmt.Errorf("failed to set proof request %d to FAILED: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	db.recordStageTransitions(transition)
+
+	return true, nil
+}
+
+// ExpireAggProof sets a COMPLETE AGG proof that can no longer be submitted to FAILED, recording why. Returns false if
+// the request isn't a COMPLETE AGG proof.
+func (db *ProofDB) ExpireAggProof(id int, reason string) (bool, error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := tx.ProofRequest.Query().
+		Where(
+			proofrequest.ID(id),
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+		)
+	if db.dialect == dialect.Postgres {
+		query = query.ForUpdate()
+	}
+	req, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to query proof request %d: %w", id, err)
+	}
+
+	transition, err := transitionProofRequest(ctx, tx, req, tx.ProofRequest.UpdateOne(req), proofrequest.StatusFAILED, reason, "")
+	if err != nil {
+		return false, fmt.Errorf("failed to set proof request %d to FAILED: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	db.recordStageTransitions(transition)
+
+	return true, nil
+}
+
+// ReplaceAggProof sets an AGG proof that hasn't failed or been cancelled to FAILED, recording why, and adds a new UNREQ
+// request for the same range. The new request has no L1 block info, so a fresh L1 block hash is checkpointed when it is
+// requested. Returns nil if the request isn't an AGG proof that hasn't failed or been cancelled.
+func (db *ProofDB) ReplaceAggProof(id int, reason string) (*ent.ProofRequest, error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := tx.ProofRequest.Query().
+		Where(
+			proofrequest.ID(id),
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StatusNotIn(proofrequest.StatusFAILED, proofrequest.StatusCANCELLED),
+		)
+	if db.dialect == dialect.Postgres {
+		query = query.ForUpdate()
+	}
+	req, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query proof request %d: %w", id, err)
+	}
+
+	transition, err := transitionProofRequest(ctx, tx, req, tx.ProofRequest.UpdateOne(req), proofrequest.StatusFAILED, reason, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to set proof request %d to FAILED: %w", id, err)
+	}
+
+	now := uint64(time.Now().Unix())
+	create := tx.ProofRequest.
+		Create().
+		SetType(proofrequest.TypeAGG).
+		SetStartBlock(req.StartBlock).
+		SetEndBlock(req.EndBlock).
+		SetStatus(proofrequest.StatusUNREQ).
+		SetRequestAddedTime(now).
+		SetLastUpdatedTime(now)
+	replacement, err := createProofRequest(ctx, tx, create, fmt.Sprintf("replaces proof request %d", id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replacement of proof request %d: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	db.recordStageTransitions(transition)
+
+	return replacement, nil
+}
+
+// TryAcquireLeadership tries to take the advisory lock that elects the leader among the proposer replicas sharing a
+// Postgres DB, and returns whether this replica holds it. The lock is held by a dedicated connection, so it is
+// released as soon as the leader stops or loses its connection to the DB.
+func (db *ProofDB) TryAcquireLeadership(ctx context.Context) (bool, error) {
+	if db.sqlDB == nil {
+		return false, errors.New("leader election requires a Postgres DB")
+	}
+	db.leader.mu.Lock()
+	defer db.leader.mu.Unlock()
+
+	if db.leader.conn != nil {
+		// The lock is held for as long as the connection that took it is alive.
+		if err := db.leader.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+		db.leader.conn.Close()
+		db.leader.conn = nil
+	}
+
+	conn, err := db.sqlDB.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get connection: %w", err)
+	}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", leaderLockID).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, fmt.Errorf("failed to try leader lock: %w", err)
+	}
+	if !acquired {
+		conn.Close()
+		return false, nil
+	}
+
+	db.leader.conn = conn
+	return true, nil
+}
+
+// close releases the leader lock, if it is held.
+func (l *leaderLock) close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return nil
+	}
+	err := l.conn.Close()
+	l.conn = nil
+	return err
+}
+
+// SetProverRequestID sets the prover request ID for a proof request in the database.
+func (db *ProofDB) SetProverRequestID(id int, proverRequestID []byte) error {
+	// Convert the []byte to a hex string.
+	proverRequestIDHex := hex.EncodeToString(proverRequestID)
+
+	_, err := db.writeClient.ProofRequest.Update().
+		Where(proofrequest.ID(id)).
+		SetProverRequestID(proverRequestIDHex).
+		SetProofRequestTime(uint64(time.Now().Unix())).
+		SetLastUpdatedTime(uint64(time.Now().Unix())).
+		Save(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to set prover network id: %w", err)
+	}
+
+	return nil
+}
+
+// AddFulfilledProof adds a proof to a proof request in the database and sets the status to COMPLETE. The cycles used to
+// execute the program are recorded if known.
+func (db *ProofDB) AddFulfilledProof(id int, proof []byte, cycles uint64) error {
+	// Start a transaction
+	tx, err := db.writeClient.Tx(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Query the existing proof request
+	existingProof, err := tx.ProofRequest.
+		Query().
+		Where(proofrequest.ID(id)).
+		Only(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to find existing proof: %w", err)
+	}
+
+	// Check if the status is PROVING.
+	if existingProof.Status != proofrequest.StatusPROVING {
+		return fmt.Errorf("proof request status is not PROVING: %v", id)
+	}
+
+	// Check if the proof is already set.
+	if existingProof.Proof != nil {
+		return fmt.Errorf("proof is already set: %v", id)
+	}
+
+	// Update the proof and status
+	update := tx.ProofRequest.UpdateOne(existingProof).SetProof(proof)
+	if cycles > 0 {
+		update = update.SetCycles(cycles)
+	}
+	transition, err := transitionProofRequest(context.Background(), tx, existingProof, update, proofrequest.StatusCOMPLETE, "", "")
+	if err != nil {
+		return fmt.Errorf("failed to update proof and status: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	db.recordStageTransitions(transition)
+
+	return nil
+}
+
+// SetProofGasUsed sets the L2 gas used by the blocks of a proof request.
+func (db *ProofDB) SetProofGasUsed(id int, gasUsed uint64) error {
+	_, err := db.writeClient.ProofRequest.UpdateOneID(id).
+		SetGasUsed(gasUsed).
+		Save(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to set gas used of proof request %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// ImportedProof is a completed proof imported from another proposer's DB.
+type ImportedProof struct {
+	Type          proofrequest.Type
+	StartBlock    uint64
+	EndBlock      uint64
+	L1BlockNumber uint64
+	L1BlockHash   string
+	Proof         []byte
+	Cycles        uint64
+	GasUsed       uint64
+}
+
+// ImportProof adds a COMPLETE proof request with the given proof. The proof is skipped, and false is returned, if a
+// request of the same type that isn't FAILED or CANCELLED already overlaps its block range.
+func (db *ProofDB) ImportProof(p ImportedProof) (bool, error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	overlapping, err := tx.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(p.Type),
+			proofrequest.StatusNotIn(proofrequest.StatusFAILED, proofrequest.StatusCANCELLED),
+			proofrequest.StartBlockLT(p.EndBlock),
+			proofrequest.EndBlockGT(p.StartBlock),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query overlapping proof requests: %w", err)
+	}
+	if overlapping {
+		return false, nil
+	}
+
+	now := uint64(time.Now().Unix())
+	create := tx.ProofRequest.
+		Create().
+		SetType(p.Type).
+		SetStartBlock(p.StartBlock).
+		SetEndBlock(p.EndBlock).
+		SetStatus(proofrequest.StatusCOMPLETE).
+		SetRequestAddedTime(now).
+		SetLastUpdatedTime(now).
+		SetProof(p.Proof)
+	if p.Type == proofrequest.TypeAGG {
+		create.SetL1BlockNumber(p.L1BlockNumber).SetL1BlockHash(p.L1BlockHash)
+	}
+	if p.Cycles > 0 {
+		create.SetCycles(p.Cycles)
+	}
+	if p.GasUsed > 0 {
+		create.SetGasUsed(p.GasUsed)
+	}
+	if _, err := createProofRequest(ctx, tx, create, "imported"); err != nil {
+		return false, fmt.Errorf("failed to import proof: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetRecentSpanProofStats returns the most recently completed SPAN proofs with both their cycles and gas used
+// recorded, oldest first.
+func (db *ProofDB) GetRecentSpanProofStats(limit int) ([]*ent.ProofRequest, error) {
+	reqs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeSPAN),
+			proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+			proofrequest.CyclesGT(0),
+			proofrequest.GasUsedGT(0),
+		).
+		Order(ent.Desc(proofrequest.FieldLastUpdatedTime), ent.Desc(proofrequest.FieldID)).
+		Limit(limit).
+		Select(
+			proofrequest.FieldID,
+			proofrequest.FieldStartBlock,
+			proofrequest.FieldEndBlock,
+			proofrequest.FieldProofRequestTime,
+			proofrequest.FieldLastUpdatedTime,
+			proofrequest.FieldCycles,
+			proofrequest.FieldGasUsed,
+		).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query span proof stats: %w", err)
+	}
+	slices.Reverse(reqs)
+
+	return reqs, nil
+}
+
+// GetNumberOfProofsWithStatuses returns the number of proofs with the given status(es).
+func (db *ProofDB) GetNumberOfRequestsWithStatuses(statuses ...proofrequest.Status) (int, error) {
+	count, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusIn(statuses...),
+		).
+		Count(context.Background())
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count requests with statuses %v: %w", statuses, err)
+	}
+
+	return count, nil
+}
+
+// CountInFlightSpanProofsByBackend returns the number of SPAN proof requests in WITNESSGEN or PROVING on each prover
+// backend. Requests made before prover backends were recorded are counted under the empty name.
+func (db *ProofDB) CountInFlightSpanProofsByBackend() (map[string]int, error) {
+	reqs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeSPAN),
+			proofrequest.StatusIn(proofrequest.StatusWITNESSGEN, proofrequest.StatusPROVING),
+		).
+		Select(proofrequest.FieldProverBackend).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query in-flight span proofs: %w", err)
+	}
+
+	counts := make(map[string]int)
+	for _, req := range reqs {
+		counts[req.ProverBackend]++
+	}
+	return counts, nil
+}
+
+// CountFailedAttempts returns the number of FAILED requests of the given type for exactly the range [start, end].
+func (db *ProofDB) CountFailedAttempts(proofType proofrequest.Type, start, end uint64) (int, error) {
+	count, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofType),
+			proofrequest.StartBlockEQ(start),
+			proofrequest.EndBlockEQ(end),
+			proofrequest.StatusEQ(proofrequest.StatusFAILED),
+		).
+		Count(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("failed to count failed attempts of %s proof %d-%d: %w", proofType, start, end, err)
+	}
+
+	return count, nil
+}
+
+// AddL1BlockInfoToAggRequest adds the L1 block info to the existing AGG proof request.
+func (db *ProofDB) AddL1BlockInfoToAggRequest(startBlock, endBlock, l1BlockNumber uint64, l1BlockHash string) (*ent.ProofRequest, error) {
+	// Perform the update
+	rowsAffected, err := db.writeClient.ProofRequest.Update().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StatusEQ(proofrequest.StatusUNREQ),
+			proofrequest.StartBlockEQ(startBlock),
+			proofrequest.EndBlockEQ(endBlock),
+		).
+		SetL1BlockNumber(l1BlockNumber).
+		SetL1BlockHash(l1BlockHash).
+		SetLastUpdatedTime(uint64(time.Now().Unix())).
+		Save(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update L1 block info: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("no matching proof request found to update")
+	}
+
+	// Fetch the updated ProofRequest
+	updatedProof, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StatusEQ(proofrequest.StatusUNREQ),
+			proofrequest.StartBlockEQ(startBlock),
+			proofrequest.EndBlockEQ(endBlock),
+			proofrequest.L1BlockNumberEQ(l1BlockNumber),
+			proofrequest.L1BlockHashEQ(l1BlockHash),
+		).
+		Only(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated proof request: %w", err)
+	}
+
+	return updatedProof, nil
+}
+
+// GetLatestEndBlock returns the latest end block of a proof request in the database.
+func (db *ProofDB) GetLatestEndBlock() (uint64, error) {
+	maxEnd, err := db.readClient.ProofRequest.Query().
+		Order(ent.Desc(proofrequest.FieldEndBlock)).
+		Select(proofrequest.FieldEndBlock).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, err
+		}
+		return 0, fmt.Errorf("failed to get latest end requested: %w", err)
+	}
+	return uint64(maxEnd.EndBlock), nil
+}
+
+// GetPendingAggProofsWithL1BlockInfo returns the AGG proofs starting at or after the given block that haven't failed or
+// been cancelled and have an L1 block hash. Their L1 block must stay canonical until they're submitted.
+func (db *ProofDB) GetPendingAggProofsWithL1BlockInfo(from uint64) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StatusNotIn(proofrequest.StatusFAILED, proofrequest.StatusCANCELLED),
+			proofrequest.StartBlockGTE(from),
+			proofrequest.L1BlockHashNEQ(""),
+		).
+		Order(ent.Asc(proofrequest.FieldL1BlockNumber)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query AGG proofs with L1 block info: %w", err)
+	}
+
+	return proofs, nil
+}
+
+// GetAllProofsWithStatus returns all proofs with the given status.
+func (db *ProofDB) GetAllProofsWithStatus(status proofrequest.Status) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusEQ(status),
+		).
+		All(context.Background())
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query proofs with status %s: %w", status, err)
+	}
+
+	return proofs, nil
+}
+
+// GetAllClaimedProofsWithStatus returns all proofs with the given status that were claimed by the given replica.
+func (db *ProofDB) GetAllClaimedProofsWithStatus(status proofrequest.Status, replicaID string) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusEQ(status),
+			proofrequest.ReplicaIDEQ(replicaID),
+		).
+		All(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query proofs with status %s claimed by %s: %w", status, replicaID, err)
+	}
+
+	return proofs, nil
+}
+
+// GetNextUnrequestedSpanProof returns the unrequested SPAN proof with the lowest start block, ignoring AGG proofs.
+func (db *ProofDB) GetNextUnrequestedSpanProof() (*ent.ProofRequest, error) {
+	spanProof, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusEQ(proofrequest.StatusUNREQ),
+			proofrequest.TypeEQ(proofrequest.TypeSPAN),
+		).
+		Order(ent.Asc(proofrequest.FieldStartBlock)).
+		First(context.Background())
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query SPAN unrequested proof: %w", err)
+	}
+
+	return spanProof, nil
+}
+
+// GetNextUnrequestedProof returns the next unrequested proof in the database.
+func (db *ProofDB) GetNextUnrequestedProof() (*ent.ProofRequest, error) {
+	// Get the unrequested AGG proof with the lowest start block.
+	aggProof, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusEQ(proofrequest.StatusUNREQ),
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+		).
+		Order(ent.Asc(proofrequest.FieldStartBlock)).
+		First(context.Background())
+
+	if err == nil {
+		// We found an AGG proof, return it
+		return aggProof, nil
+	} else if !ent.IsNotFound(err) {
+		// An error occurred that wasn't "not found"
+		return nil, fmt.Errorf("failed to query AGG unrequested proof: %w", err)
+	}
+
+	// If there's no AGG proof available, get the unrequested SPAN proof with the lowest start block.
+	return db.GetNextUnrequestedSpanProof()
+}
+
+// GetAllCompletedAggProofs returns all completed AGG proofs for a given start block.
+func (db *ProofDB) GetAllCompletedAggProofs(startBlock uint64) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StartBlockEQ(startBlock),
+			proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+		).
+		All(context.Background())
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query completed AGG proof: %w", err)
+	}
+
+	return proofs, nil
+}
+
+// TryCreateAggProofFromSpanProofs tries to create an AGG proof from the span proofs that cover the range [from, minTo).
+// Returns true if a new AGG proof was created, false otherwise.
+func (db *ProofDB) TryCreateAggProofFromSpanProofs(from, minTo uint64) (bool, uint64, error) {
+	return db.tryCreateAggProof(from, minTo, false)
+}
+
+// TryCreateLongerAggProof tries to create an AGG proof from the span proofs that cover the range [from, minTo), while
+// the AGG proofs with the same start block are COMPLETE but not submitted yet. This lets a deferred submission skip to
+// a newer AGG proof covering more blocks.
+func (db *ProofDB) TryCreateLongerAggProof(from, minTo uint64) (bool, uint64, error) {
+	return db.tryCreateAggProof(from, minTo, true)
+}
+
+func (db *ProofDB) tryCreateAggProof(from, minTo uint64, allowCompleted bool) (bool, uint64, error) {
+	// If there's already an AGG proof in progress/completed with the same start block, return.
+	blocking := []proofrequest.Status{proofrequest.StatusFAILED, proofrequest.StatusCANCELLED}
+	if allowCompleted {
+		blocking = append(blocking, proofrequest.StatusCOMPLETE)
+	}
+	count, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StartBlockEQ(from),
+			proofrequest.StatusNotIn(blocking...),
+		).
+		Count(context.Background())
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to query DB for AGG proof with start block %d: %w", from, err)
+	}
+	if count > 0 {
+		// There's already an AGG proof in progress with the same start block.
+		return false, 0, nil
+	}
+
+	// Get the longest contiguous span proof chain so far.
+	maxContigousEnd, err := db.GetMaxContiguousSpanProofRange(from)
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to get max contiguous span proof range: %w", err)
+	}
+
+	if maxContigousEnd < minTo {
+		// There's no contiguous span proof chain that ends before minTo, so we can't create an AGG proof.
+		return false, 0, nil
+	}
+
+	// Create a new AGG proof request
+	err = db.NewEntry("AGG", from, maxContigousEnd)
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to insert AGG proof request: %w", err)
+	}
+
+	return true, maxContigousEnd, nil
+}
+
+// GetMaxContiguousSpanProofRange returns the start and end of the contiguous span proof chain.
+func (db *ProofDB) GetMaxContiguousSpanProofRange(start uint64) (uint64, error) {
+	ctx := context.Background()
+
+	query := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeSPAN),
+			proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+			proofrequest.StartBlockGTE(start),
+		).
+		Order(ent.Asc(proofrequest.FieldStartBlock))
+
+	// Execute the query.
+	spans, err := query.All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query span proofs: %w", err)
+	}
+
+	// Keep iterating until we can't find another contiguous span proof.
+	currentBlock := start
+	for _, span := range spans {
+		if span.StartBlock != currentBlock {
+			break
+		}
+		currentBlock = span.EndBlock
+	}
+
+	// The current block is at minimum the start block, and at maximum the end block of the last span proof.
+	return currentBlock, nil
+}
+
+// GetConsecutiveSpanProofs returns the span proofs that cover the range [start, end].
+// If there's a gap in the proofs, or the proofs don't fully cover the range, return an error.
+func (db *ProofDB) GetConsecutiveSpanProofs(start, end uint64) ([][]byte, error) {
+	ctx := context.Background()
+
+	// Query the DB for the span proofs that cover the range [start, end].
+	query := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeSPAN),
+			proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+			proofrequest.StartBlockGTE(start),
+			proofrequest.EndBlockLTE(end),
+		).
+		Order(ent.Asc(proofrequest.FieldStartBlock))
+
+	// Execute the query.
+	spans, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query span proofs: %w", err)
+	}
+
+	// Verify that the proofs are consecutive and cover the entire range.
+	var result [][]byte
+	currentBlock := start
+
+	for _, span := range spans {
+		if span.StartBlock != currentBlock {
+			return nil, fmt.Errorf("gap in proof chain: expected start block %d, got %d", currentBlock, span.StartBlock)
+		}
+		result = append(result, span.Proof)
+		currentBlock = span.EndBlock
+	}
+
+	if currentBlock != end {
+		return nil, fmt.Errorf("incomplete proof chain: ends at block %d, expected %d", currentBlock, end)
+	}
+
+	return result, nil
+}
+
+// ProofRequestFilter restricts the proof requests returned by ListProofRequests. Zero-valued fields are ignored.
+type ProofRequestFilter struct {
+	Type   proofrequest.Type
+	Status proofrequest.Status
+	// FromBlock and ToBlock select the requests whose [start, end) range overlaps [FromBlock, ToBlock].
+	FromBlock uint64
+	ToBlock   uint64
+	// Limit caps the number of returned requests.
+	Limit int
+}
+
+// ListProofRequests returns the proof requests matching the filter, ordered by start block.
+func (db *ProofDB) ListProofRequests(filter ProofRequestFilter) ([]*ent.ProofRequest, error) {
+	query := db.readClient.ProofRequest.Query()
+	if filter.Type != "" {
+		query = query.Where(proofrequest.TypeEQ(filter.Type))
+	}
+	if filter.Status != "" {
+		query = query.Where(proofrequest.StatusEQ(filter.Status))
+	}
+	if filter.FromBlock != 0 {
+		query = query.Where(proofrequest.EndBlockGT(filter.FromBlock))
+	}
+	if filter.ToBlock != 0 {
+		query = query.Where(proofrequest.StartBlockLTE(filter.ToBlock))
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	proofs, err := query.
+		Order(ent.Asc(proofrequest.FieldStartBlock), ent.Asc(proofrequest.FieldID)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list proof requests: %w", err)
+	}
+
+	return proofs, nil
+}
+
+// GetProofRequestHistory returns every proof request of the same type whose block range overlaps the range of the
+// given request, ordered by the time they were added. Because retries and splits create new rows, this is the full
+// history of attempts at proving the request's range.
+func (db *ProofDB) GetProofRequestHistory(id int) ([]*ent.ProofRequest, error) {
+	req, err := db.readClient.ProofRequest.Get(context.Background(), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find proof request %d: %w", id, err)
+	}
+
+	history, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(req.Type),
+			proofrequest.StartBlockLT(req.EndBlock),
+			proofrequest.EndBlockGT(req.StartBlock),
+		).
+		WithEvents(func(q *ent.ProofRequestEventQuery) {
+			q.Order(ent.Asc(proofrequestevent.FieldTime), ent.Asc(proofrequestevent.FieldID))
+		}).
+		Order(ent.Asc(proofrequest.FieldRequestAddedTime), ent.Asc(proofrequest.FieldID)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query history of proof request %d: %w", id, err)
+	}
+
+	return history, nil
+}
+
+// checkNoActiveOverlap returns an error if a proof request of the same type as req, other than req itself, overlaps
+// its range and is not FAILED or CANCELLED, so that the range of req can be queued again without proving blocks twice.
+func checkNoActiveOverlap(ctx context.Context, tx *ent.Tx, req *ent.ProofRequest) error {
+	active, err := tx.ProofRequest.Query().
+		Where(
+			proofrequest.IDNEQ(req.ID),
+			proofrequest.TypeEQ(req.Type),
+			proofrequest.StartBlockLT(req.EndBlock),
+			proofrequest.EndBlockGT(req.StartBlock),
+			proofrequest.StatusNotIn(proofrequest.StatusFAILED, proofrequest.StatusCANCELLED),
+		).
+		Order(ent.Asc(proofrequest.FieldStartBlock)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to query active requests for range: %w", err)
+	}
+	return fmt.Errorf("range [%d, %d) overlaps active %s request %d for range [%d, %d)", req.StartBlock, req.EndBlock, req.Type, active.ID, active.StartBlock, active.EndBlock)
+}
+
+// RequeueFailedRequest adds a new UNREQ entry for the range of a FAILED or CANCELLED proof request. The original request
+// is kept so that its history is preserved. Returns an error if an active request overlaps the range.
+func (db *ProofDB) RequeueFailedRequest(id int) (*ent.ProofRequest, error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	req, err := tx.ProofRequest.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find proof request %d: %w", id, err)
+	}
+	if req.Status != proofrequest.StatusFAILED && req.Status != proofrequest.StatusCANCELLED {
+		return nil, fmt.Errorf("proof request %d has status %s, only FAILED or CANCELLED requests can be requeued", id, req.Status)
+	}
+
+	if err := checkNoActiveOverlap(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	now := uint64(time.Now().Unix())
+	create := tx.ProofRequest.
+		Create().
+		SetType(req.Type).
+		SetStartBlock(req.StartBlock).
+		SetEndBlock(req.EndBlock).
+		SetStatus(proofrequest.StatusUNREQ).
+		SetRequestAddedTime(now).
+		SetLastUpdatedTime(now)
+	// AGG proofs keep their checkpointed L1 block, since it was already committed to on the L2OO.
+	if req.Type == proofrequest.TypeAGG && req.L1BlockHash != "" {
+		create = create.SetL1BlockNumber(req.L1BlockNumber).SetL1BlockHash(req.L1BlockHash)
+	}
+	requeued, err := createProofRequest(ctx, tx, create, fmt.Sprintf("requeue of request %d via admin API", id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create requeued entry: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return requeued, nil
+}
+
+// CancelProvingRequest sets a PROVING proof request to CANCELLED, and returns it as it was before it was cancelled, so
+// that it can be cancelled on its prover backend. Any proof later returned by the prover for this request is ignored,
+// since only PROVING requests are polled, and CANCELLED requests aren't retried.
+func (db *ProofDB) CancelProvingRequest(id int) (*ent.ProofRequest, error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := tx.ProofRequest.Query().
+		Where(
+			proofrequest.ID(id),
+			proofrequest.StatusEQ(proofrequest.StatusPROVING),
+		)
+	if db.dialect == dialect.Postgres {
+		query = query.ForUpdate()
+	}
+	req, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("no PROVING proof request with id %d", id)
+		}
+		return nil, fmt.Errorf("failed to query proof request %d: %w", id, err)
+	}
+
+	transition, err := transitionProofRequest(ctx, tx, req, tx.ProofRequest.UpdateOne(req), proofrequest.StatusCANCELLED, "cancelled via admin API", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel proof request %d: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	db.recordStageTransitions(transition)
+
+	return req, nil
+}
+
+// SplitSpanRequest splits an UNREQ, FAILED or CANCELLED SPAN proof request at splitBlock into two new UNREQ requests. If
+// splitBlock is 0, the range is split in half. An UNREQ request is marked FAILED so that it isn't requested. Returns an
+// error if another active request overlaps the range.
+func (db *ProofDB) SplitSpanRequest(id int, splitBlock uint64) ([]*ent.ProofRequest, error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	req, err := tx.ProofRequest.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find proof request %d: %w", id, err)
+	}
+	if req.Type != proofrequest.TypeSPAN {
+		return nil, fmt.Errorf("proof request %d is a %s request, only SPAN requests can be split", id, req.Type)
+	}
+	if req.Status != proofrequest.StatusUNREQ && req.Status != proofrequest.StatusFAILED && req.Status != proofrequest.StatusCANCELLED {
+		return nil, fmt.Errorf("proof request %d has status %s, only UNREQ, FAILED or CANCELLED requests can be split", id, req.Status)
+	}
+	if splitBlock == 0 {
+		splitBlock = (req.StartBlock + req.EndBlock) / 2
+	}
+	if splitBlock <= req.StartBlock || splitBlock >= req.EndBlock {
+		return nil, fmt.Errorf("split block %d is not inside the range (%d, %d)", splitBlock, req.StartBlock, req.EndBlock)
+	}
+	if err := checkNoActiveOverlap(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	var transitions []stageTransition
+	if req.Status == proofrequest.StatusUNREQ {
+		reason := fmt.Sprintf("split at block %d via admin API", splitBlock)
+		transition, err := transitionProofRequest(ctx, tx, req, tx.ProofRequest.UpdateOne(req), proofrequest.StatusFAILED, reason, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to update proof status: %w", err)
+		}
+		transitions = append(transitions, transition)
+	}
+
+	now := uint64(time.Now().Unix())
+	var spans []*ent.ProofRequest
+	for _, r := range [][2]uint64{{req.StartBlock, splitBlock}, {splitBlock, req.EndBlock}} {
+		span, err := createProofRequest(ctx, tx, tx.ProofRequest.
+			Create().
+			SetType(proofrequest.TypeSPAN).
+			SetStartBlock(r[0]).
+			SetEndBlock(r[1]).
+			SetStatus(proofrequest.StatusUNREQ).
+			SetRequestAddedTime(now).
+			SetLastUpdatedTime(now), fmt.Sprintf("split from request %d", id))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create split entry: %w", err)
+		}
+		spans = append(spans, span)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	db.recordStageTransitions(transitions...)
+
+	return spans, nil
+}
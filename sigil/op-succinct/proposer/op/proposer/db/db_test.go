@@ -0,0 +1,276 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+	"github.com/succinctlabs/op-succinct-go/proposer/testutils"
+)
+
+func TestProofQueueAdmin(t *testing.T) {
+	proofDB := testutils.NewTestDB(t)
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 0, 100))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 0, 200))
+
+	t.Run("list with filters", func(t *testing.T) {
+		all, err := proofDB.ListProofRequests(db.ProofRequestFilter{})
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+
+		spans, err := proofDB.ListProofRequests(db.ProofRequestFilter{Type: proofrequest.TypeSPAN, FromBlock: 150})
+		require.NoError(t, err)
+		require.Len(t, spans, 1)
+		require.Equal(t, uint64(100), spans[0].StartBlock)
+
+		limited, err := proofDB.ListProofRequests(db.ProofRequestFilter{Status: proofrequest.StatusUNREQ, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, limited, 2)
+	})
+
+	t.Run("split and history", func(t *testing.T) {
+		spans, err := proofDB.ListProofRequests(db.ProofRequestFilter{Type: proofrequest.TypeSPAN, ToBlock: 50})
+		require.NoError(t, err)
+		require.Len(t, spans, 1)
+		orig := spans[0]
+
+		_, err = proofDB.SplitSpanRequest(orig.ID, 100)
+		require.Error(t, err, "split block must be inside the range")
+
+		split, err := proofDB.SplitSpanRequest(orig.ID, 40)
+		require.NoError(t, err)
+		require.Len(t, split, 2)
+		require.Equal(t, [2]uint64{0, 40}, [2]uint64{split[0].StartBlock, split[0].EndBlock})
+		require.Equal(t, [2]uint64{40, 100}, [2]uint64{split[1].StartBlock, split[1].EndBlock})
+
+		history, err := proofDB.GetProofRequestHistory(orig.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 3)
+		require.Equal(t, proofrequest.StatusFAILED, history[0].Status)
+	})
+
+	t.Run("cancel and requeue", func(t *testing.T) {
+		aggs, err := proofDB.ListProofRequests(db.ProofRequestFilter{Type: proofrequest.TypeAGG})
+		require.NoError(t, err)
+		agg := aggs[0]
+
+		_, err = proofDB.SplitSpanRequest(agg.ID, 0)
+		require.Error(t, err, "AGG requests cannot be split")
+		_, err = proofDB.CancelProvingRequest(agg.ID)
+		require.Error(t, err, "only PROVING requests can be cancelled")
+
+		require.NoError(t, proofDB.UpdateProofStatus(agg.ID, proofrequest.StatusPROVING))
+		cancelled, err := proofDB.CancelProvingRequest(agg.ID)
+		require.NoError(t, err)
+		require.Equal(t, agg.ID, cancelled.ID)
+		history, err := proofDB.GetProofRequestHistory(agg.ID)
+		require.NoError(t, err)
+		require.Equal(t, proofrequest.StatusCANCELLED, history[0].Status)
+
+		failed, err := proofDB.FailProofRequest(agg.ID, "unfulfillable", "")
+		require.NoError(t, err)
+		require.False(t, failed, "cancelled requests aren't retried")
+
+		requeued, err := proofDB.RequeueFailedRequest(agg.ID)
+		require.NoError(t, err)
+		require.Equal(t, proofrequest.StatusUNREQ, requeued.Status)
+		require.Equal(t, agg.StartBlock, requeued.StartBlock)
+		require.Equal(t, agg.EndBlock, requeued.EndBlock)
+
+		_, err = proofDB.RequeueFailedRequest(agg.ID)
+		require.Error(t, err, "range is already queued")
+		_, err = proofDB.RequeueFailedRequest(requeued.ID)
+		require.Error(t, err, "only FAILED or CANCELLED requests can be requeued")
+	})
+}
+
+func TestProofQueueAdminOverlap(t *testing.T) {
+	proofDB := testutils.NewTestDB(t)
+
+	// failedSpan adds a FAILED SPAN request for the range.
+	failedSpan := func(start, end uint64) int {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, start, end))
+		reqs, err := proofDB.ListProofRequests(db.ProofRequestFilter{Type: proofrequest.TypeSPAN, Status: proofrequest.StatusUNREQ, FromBlock: start, ToBlock: start + 1})
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		failed, err := proofDB.FailProofRequest(reqs[0].ID, "unfulfillable", "")
+		require.NoError(t, err)
+		require.True(t, failed)
+		return reqs[0].ID
+	}
+
+	t.Run("active request starts inside the range", func(t *testing.T) {
+		id := failedSpan(0, 100)
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 50, 150))
+
+		_, err := proofDB.RequeueFailedRequest(id)
+		require.ErrorContains(t, err, "range [0, 100) overlaps active SPAN request")
+		_, err = proofDB.SplitSpanRequest(id, 0)
+		require.ErrorContains(t, err, "range [0, 100) overlaps active SPAN request")
+	})
+
+	t.Run("active request ends inside the range", func(t *testing.T) {
+		id := failedSpan(250, 350)
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 300))
+
+		_, err := proofDB.RequeueFailedRequest(id)
+		require.ErrorContains(t, err, "range [250, 350) overlaps active SPAN request")
+		_, err = proofDB.SplitSpanRequest(id, 0)
+		require.ErrorContains(t, err, "range [250, 350) overlaps active SPAN request")
+	})
+
+	t.Run("adjacent and failed requests don't overlap", func(t *testing.T) {
+		id := failedSpan(400, 500)
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 500, 600))
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 400, 500))
+		failedSpan(450, 550)
+
+		split, err := proofDB.SplitSpanRequest(id, 0)
+		require.NoError(t, err)
+		require.Len(t, split, 2)
+	})
+}
+
+func TestClaimAndFailProofRequest(t *testing.T) {
+	proofDB := testutils.NewTestDB(t)
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 0, 100))
+	req, err := proofDB.GetNextUnrequestedSpanProof()
+	require.NoError(t, err)
+
+	claimed, err := proofDB.ClaimProofRequest(req.ID, "replica-a", "")
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	claimed, err = proofDB.ClaimProofRequest(req.ID, "replica-b", "")
+	require.NoError(t, err)
+	require.False(t, claimed, "a request can only be claimed once")
+
+	mine, err := proofDB.GetAllClaimedProofsWithStatus(proofrequest.StatusWITNESSGEN, "replica-a")
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+	theirs, err := proofDB.GetAllClaimedProofsWithStatus(proofrequest.StatusWITNESSGEN, "replica-b")
+	require.NoError(t, err)
+	require.Empty(t, theirs)
+
+	failed, err := proofDB.FailProofRequest(req.ID, "witness generation timed out", "")
+	require.NoError(t, err)
+	require.True(t, failed)
+
+	failed, err = proofDB.FailProofRequest(req.ID, "witness generation timed out", "")
+	require.NoError(t, err)
+	require.False(t, failed, "a request can only be failed once")
+
+	_, err = proofDB.TryAcquireLeadership(context.Background())
+	require.Error(t, err, "leader election is not supported on SQLite")
+}
+
+type stageRecord struct {
+	proofType, stage, result string
+	blocks                   uint64
+}
+
+type testStageMetricer struct {
+	records []stageRecord
+}
+
+func (m *testStageMetricer) RecordProofStageDuration(proofType, stage, result string, blocks uint64, duration time.Duration) {
+	m.records = append(m.records, stageRecord{proofType, stage, result, blocks})
+}
+
+func TestProofRequestEvents(t *testing.T) {
+	proofDB := testutils.NewTestDB(t)
+	metr := new(testStageMetricer)
+	proofDB.SetMetrics(metr)
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 0, 100))
+	req, err := proofDB.GetNextUnrequestedSpanProof()
+	require.NoError(t, err)
+
+	claimed, err := proofDB.ClaimProofRequest(req.ID, "replica-a", "")
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.NoError(t, proofDB.SetProverRequestID(req.ID, []byte{0xab, 0xcd}))
+	require.NoError(t, proofDB.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+	failed, err := proofDB.FailProofRequest(req.ID, "unfulfillable", "fulfillment_status=Unfulfillable execution_status=Unexecutable")
+	require.NoError(t, err)
+	require.True(t, failed)
+
+	events, err := proofDB.GetProofRequestEvents(req.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	require.Empty(t, events[0].FromStatus, "the creation event has no previous status")
+	require.Equal(t, proofrequestevent.ToStatusUNREQ, events[0].ToStatus)
+
+	require.Equal(t, proofrequestevent.FromStatusUNREQ, events[1].FromStatus)
+	require.Equal(t, proofrequestevent.ToStatusWITNESSGEN, events[1].ToStatus)
+	require.Equal(t, "claimed by replica-a", events[1].Reason)
+
+	require.Equal(t, proofrequestevent.ToStatusPROVING, events[2].ToStatus)
+	require.Equal(t, "abcd", events[2].ProverRequestID)
+
+	require.Equal(t, proofrequestevent.FromStatusPROVING, events[3].FromStatus)
+	require.Equal(t, proofrequestevent.ToStatusFAILED, events[3].ToStatus)
+	require.Equal(t, "unfulfillable", events[3].Reason)
+	require.Equal(t, "fulfillment_status=Unfulfillable execution_status=Unexecutable", events[3].ProverResponse)
+
+	require.Equal(t, []stageRecord{
+		{"SPAN", "UNREQ", "WITNESSGEN", 100},
+		{"SPAN", "WITNESSGEN", "PROVING", 100},
+		{"SPAN", "PROVING", "FAILED", 100},
+	}, metr.records)
+
+	// Failing a request that already failed doesn't record another transition.
+	failed, err = proofDB.FailProofRequest(req.ID, "unfulfillable", "")
+	require.NoError(t, err)
+	require.False(t, failed)
+	events, err = proofDB.GetProofRequestEvents(req.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+}
+
+func TestGetRecentSpanProofStats(t *testing.T) {
+	proofDB := testutils.NewTestDB(t)
+
+	// completeSpan proves the span [start, end], recording the cycles and gas used if non-zero.
+	completeSpan := func(start, end, cycles, gasUsed uint64) {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, start, end))
+		req, err := proofDB.GetNextUnrequestedSpanProof()
+		require.NoError(t, err)
+		claimed, err := proofDB.ClaimProofRequest(req.ID, "replica-a", "")
+		require.NoError(t, err)
+		require.True(t, claimed)
+		require.NoError(t, proofDB.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.AddFulfilledProof(req.ID, []byte{0x01}, cycles))
+		if gasUsed > 0 {
+			require.NoError(t, proofDB.SetProofGasUsed(req.ID, gasUsed))
+		}
+	}
+	completeSpan(0, 100, 1_000_000, 50_000)
+	completeSpan(100, 200, 2_000_000, 0)
+	completeSpan(200, 300, 0, 60_000)
+	completeSpan(300, 400, 3_000_000, 70_000)
+	completeSpan(400, 500, 4_000_000, 80_000)
+
+	stats, err := proofDB.GetRecentSpanProofStats(50)
+	require.NoError(t, err)
+	require.Len(t, stats, 3, "only spans with both cycles and gas used are returned")
+	require.Equal(t, uint64(0), stats[0].StartBlock, "stats are ordered oldest first")
+	require.Equal(t, uint64(1_000_000), stats[0].Cycles)
+	require.Equal(t, uint64(50_000), stats[0].GasUsed)
+	require.Equal(t, uint64(400), stats[2].StartBlock)
+
+	stats, err = proofDB.GetRecentSpanProofStats(2)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, uint64(300), stats[0].StartBlock)
+	require.Equal(t, uint64(400), stats[1].StartBlock)
+}
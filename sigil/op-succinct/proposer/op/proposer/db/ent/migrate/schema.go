@@ -0,0 +1,86 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// ProofRequestsColumns holds the columns for the "proof_requests" table.
+	ProofRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"SPAN", "AGG"}},
+		{Name: "start_block", Type: field.TypeUint64},
+		{Name: "end_block", Type: field.TypeUint64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE", "CANCELLED"}},
+		{Name: "request_added_time", Type: field.TypeUint64},
+		{Name: "prover_request_id", Type: field.TypeString, Nullable: true},
+		{Name: "proof_request_time", Type: field.TypeUint64, Nullable: true},
+		{Name: "last_updated_time", Type: field.TypeUint64},
+		{Name: "l1_block_number", Type: field.TypeUint64, Nullable: true},
+		{Name: "l1_block_hash", Type: field.TypeString, Nullable: true},
+		{Name: "proof", Type: field.TypeBytes, Nullable: true},
+		{Name: "replica_id", Type: field.TypeString, Nullable: true},
+		{Name: "cycles", Type: field.TypeUint64, Nullable: true},
+		{Name: "gas_used", Type: field.TypeUint64, Nullable: true},
+		{Name: "prover_backend", Type: field.TypeString, Nullable: true},
+	}
+	// ProofRequestsTable holds the schema information for the "proof_requests" table.
+	ProofRequestsTable = &schema.Table{
+		Name:       "proof_requests",
+		Columns:    ProofRequestsColumns,
+		PrimaryKey: []*schema.Column{ProofRequestsColumns[0]},
+	}
+	// ProofRequestEventsColumns holds the columns for the "proof_request_events" table.
+	ProofRequestEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE", "CANCELLED"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE", "CANCELLED"}},
+		{Name: "time", Type: field.TypeUint64},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "prover_request_id", Type: field.TypeString, Nullable: true},
+		{Name: "prover_response", Type: field.TypeString, Nullable: true},
+		{Name: "proof_request_id", Type: field.TypeInt},
+	}
+	// ProofRequestEventsTable holds the schema information for the "proof_request_events" table.
+	ProofRequestEventsTable = &schema.Table{
+		Name:       "proof_request_events",
+		Columns:    ProofRequestEventsColumns,
+		PrimaryKey: []*schema.Column{ProofRequestEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "proof_request_events_proof_requests_events",
+				Columns:    []*schema.Column{ProofRequestEventsColumns[7]},
+				RefColumns: []*schema.Column{ProofRequestsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "proofrequestevent_proof_request_id_time",
+				Unique:  false,
+				Columns: []*schema.Column{ProofRequestEventsColumns[7], ProofRequestEventsColumns[3]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		ProofRequestsTable,
+		ProofRequestEventsTable,
+	}
+)
+
+func init() {
+	ProofRequestsTable.Annotation = &entsql.Annotation{
+		Table:   "proof_requests",
+		Options: "STRICT",
+	}
+	ProofRequestEventsTable.ForeignKeys[0].RefTable = ProofRequestsTable
+	ProofRequestEventsTable.Annotation = &entsql.Annotation{
+		Table:   "proof_request_events",
+		Options: "STRICT",
+	}
+}
@@ -0,0 +1,2468 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeProofRequest      = "ProofRequest"
+	TypeProofRequestEvent = "ProofRequestEvent"
+)
+
+// ProofRequestMutation represents an operation that mutates the ProofRequest nodes in the graph.
+type ProofRequestMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	_type                 *proofrequest.Type
+	start_block           *uint64
+	addstart_block        *int64
+	end_block             *uint64
+	addend_block          *int64
+	status                *proofrequest.Status
+	request_added_time    *uint64
+	addrequest_added_time *int64
+	prover_request_id     *string
+	proof_request_time    *uint64
+	addproof_request_time *int64
+	last_updated_time     *uint64
+	addlast_updated_time  *int64
+	l1_block_number       *uint64
+	addl1_block_number    *int64
+	l1_block_hash         *string
+	proof                 *[]byte
+	replica_id            *string
+	cycles                *uint64
+	addcycles             *int64
+	gas_used              *uint64
+	addgas_used           *int64
+	prover_backend        *string
+	clearedFields         map[string]struct{}
+	events                map[int]struct{}
+	removedevents         map[int]struct{}
+	clearedevents         bool
+	done                  bool
+	oldValue              func(context.Context) (*ProofRequest, error)
+	predicates            []predicate.ProofRequest
+}
+
+var _ ent.Mutation = (*ProofRequestMutation)(nil)
+
+// proofrequestOption allows management of the mutation configuration using functional options.
+type proofrequestOption func(*ProofRequestMutation)
+
+// newProofRequestMutation creates new mutation for the ProofRequest entity.
+func newProofRequestMutation(c config, op Op, opts ...proofrequestOption) *ProofRequestMutation {
+	m := &ProofRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProofRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProofRequestID sets the ID field of the mutation.
+func withProofRequestID(id int) proofrequestOption {
+	return func(m *ProofRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProofRequest
+		)
+		m.oldValue = func(ctx context.Context) (*ProofRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProofRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProofRequest sets the old ProofRequest of the mutation.
+func withProofRequest(node *ProofRequest) proofrequestOption {
+	return func(m *ProofRequestMutation) {
+		m.oldValue = func(context.Context) (*ProofRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProofRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProofRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProofRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProofRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProofRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *ProofRequestMutation) SetType(pr proofrequest.Type) {
+	m._type = &pr
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProofRequestMutation) GetType() (r proofrequest.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldType(ctx context.Context) (v proofrequest.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProofRequestMutation) ResetType() {
+	m._type = nil
+}
+
+// SetStartBlock sets the "start_block" field.
+func (m *ProofRequestMutation) SetStartBlock(u uint64) {
+	m.start_block = &u
+	m.addstart_block = nil
+}
+
+// StartBlock returns the value of the "start_block" field in the mutation.
+func (m *ProofRequestMutation) StartBlock() (r uint64, exists bool) {
+	v := m.start_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartBlock returns the old "start_block" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldStartBlock(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartBlock: %w", err)
+	}
+	return oldValue.StartBlock, nil
+}
+
+// AddStartBlock adds u to the "start_block" field.
+func (m *ProofRequestMutation) AddStartBlock(u int64) {
+	if m.addstart_block != nil {
+		*m.addstart_block += u
+	} else {
+		m.addstart_block = &u
+	}
+}
+
+// AddedStartBlock returns the value that was added to the "start_block" field in this mutation.
+func (m *ProofRequestMutation) AddedStartBlock() (r int64, exists bool) {
+	v := m.addstart_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStartBlock resets all changes to the "start_block" field.
+func (m *ProofRequestMutation) ResetStartBlock() {
+	m.start_block = nil
+	m.addstart_block = nil
+}
+
+// SetEndBlock sets the "end_block" field.
+func (m *ProofRequestMutation) SetEndBlock(u uint64) {
+	m.end_block = &u
+	m.addend_block = nil
+}
+
+// EndBlock returns the value of the "end_block" field in the mutation.
+func (m *ProofRequestMutation) EndBlock() (r uint64, exists bool) {
+	v := m.end_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndBlock returns the old "end_block" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldEndBlock(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndBlock: %w", err)
+	}
+	return oldValue.EndBlock, nil
+}
+
+// AddEndBlock adds u to the "end_block" field.
+func (m *ProofRequestMutation) AddEndBlock(u int64) {
+	if m.addend_block != nil {
+		*m.addend_block += u
+	} else {
+		m.addend_block = &u
+	}
+}
+
+// AddedEndBlock returns the value that was added to the "end_block" field in this mutation.
+func (m *ProofRequestMutation) AddedEndBlock() (r int64, exists bool) {
+	v := m.addend_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEndBlock resets all changes to the "end_block" field.
+func (m *ProofRequestMutation) ResetEndBlock() {
+	m.end_block = nil
+	m.addend_block = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ProofRequestMutation) SetStatus(pr proofrequest.Status) {
+	m.status = &pr
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ProofRequestMutation) Status() (r proofrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldStatus(ctx context.Context) (v proofrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ProofRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRequestAddedTime sets the "request_added_time" field.
+func (m *ProofRequestMutation) SetRequestAddedTime(u uint64) {
+	m.request_added_time = &u
+	m.addrequest_added_time = nil
+}
+
+// RequestAddedTime returns the value of the "request_added_time" field in the mutation.
+func (m *ProofRequestMutation) RequestAddedTime() (r uint64, exists bool) {
+	v := m.request_added_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestAddedTime returns the old "request_added_time" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldRequestAddedTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestAddedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestAddedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestAddedTime: %w", err)
+	}
+	return oldValue.RequestAddedTime, nil
+}
+
+// AddRequestAddedTime adds u to the "request_added_time" field.
+func (m *ProofRequestMutation) AddRequestAddedTime(u int64) {
+	if m.addrequest_added_time != nil {
+		*m.addrequest_added_time += u
+	} else {
+		m.addrequest_added_time = &u
+	}
+}
+
+// AddedRequestAddedTime returns the value that was added to the "request_added_time" field in this mutation.
+func (m *ProofRequestMutation) AddedRequestAddedTime() (r int64, exists bool) {
+	v := m.addrequest_added_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequestAddedTime resets all changes to the "request_added_time" field.
+func (m *ProofRequestMutation) ResetRequestAddedTime() {
+	m.request_added_time = nil
+	m.addrequest_added_time = nil
+}
+
+// SetProverRequestID sets the "prover_request_id" field.
+func (m *ProofRequestMutation) SetProverRequestID(s string) {
+	m.prover_request_id = &s
+}
+
+// ProverRequestID returns the value of the "prover_request_id" field in the mutation.
+func (m *ProofRequestMutation) ProverRequestID() (r string, exists bool) {
+	v := m.prover_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProverRequestID returns the old "prover_request_id" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProverRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProverRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProverRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProverRequestID: %w", err)
+	}
+	return oldValue.ProverRequestID, nil
+}
+
+// ClearProverRequestID clears the value of the "prover_request_id" field.
+func (m *ProofRequestMutation) ClearProverRequestID() {
+	m.prover_request_id = nil
+	m.clearedFields[proofrequest.FieldProverRequestID] = struct{}{}
+}
+
+// ProverRequestIDCleared returns if the "prover_request_id" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProverRequestIDCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProverRequestID]
+	return ok
+}
+
+// ResetProverRequestID resets all changes to the "prover_request_id" field.
+func (m *ProofRequestMutation) ResetProverRequestID() {
+	m.prover_request_id = nil
+	delete(m.clearedFields, proofrequest.FieldProverRequestID)
+}
+
+// SetProofRequestTime sets the "proof_request_time" field.
+func (m *ProofRequestMutation) SetProofRequestTime(u uint64) {
+	m.proof_request_time = &u
+	m.addproof_request_time = nil
+}
+
+// ProofRequestTime returns the value of the "proof_request_time" field in the mutation.
+func (m *ProofRequestMutation) ProofRequestTime() (r uint64, exists bool) {
+	v := m.proof_request_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProofRequestTime returns the old "proof_request_time" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProofRequestTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProofRequestTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProofRequestTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProofRequestTime: %w", err)
+	}
+	return oldValue.ProofRequestTime, nil
+}
+
+// AddProofRequestTime adds u to the "proof_request_time" field.
+func (m *ProofRequestMutation) AddProofRequestTime(u int64) {
+	if m.addproof_request_time != nil {
+		*m.addproof_request_time += u
+	} else {
+		m.addproof_request_time = &u
+	}
+}
+
+// AddedProofRequestTime returns the value that was added to the "proof_request_time" field in this mutation.
+func (m *ProofRequestMutation) AddedProofRequestTime() (r int64, exists bool) {
+	v := m.addproof_request_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProofRequestTime clears the value of the "proof_request_time" field.
+func (m *ProofRequestMutation) ClearProofRequestTime() {
+	m.proof_request_time = nil
+	m.addproof_request_time = nil
+	m.clearedFields[proofrequest.FieldProofRequestTime] = struct{}{}
+}
+
+// ProofRequestTimeCleared returns if the "proof_request_time" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProofRequestTimeCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProofRequestTime]
+	return ok
+}
+
+// ResetProofRequestTime resets all changes to the "proof_request_time" field.
+func (m *ProofRequestMutation) ResetProofRequestTime() {
+	m.proof_request_time = nil
+	m.addproof_request_time = nil
+	delete(m.clearedFields, proofrequest.FieldProofRequestTime)
+}
+
+// SetLastUpdatedTime sets the "last_updated_time" field.
+func (m *ProofRequestMutation) SetLastUpdatedTime(u uint64) {
+	m.last_updated_time = &u
+	m.addlast_updated_time = nil
+}
+
+// LastUpdatedTime returns the value of the "last_updated_time" field in the mutation.
+func (m *ProofRequestMutation) LastUpdatedTime() (r uint64, exists bool) {
+	v := m.last_updated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUpdatedTime returns the old "last_updated_time" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldLastUpdatedTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUpdatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUpdatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUpdatedTime: %w", err)
+	}
+	return oldValue.LastUpdatedTime, nil
+}
+
+// AddLastUpdatedTime adds u to the "last_updated_time" field.
+func (m *ProofRequestMutation) AddLastUpdatedTime(u int64) {
+	if m.addlast_updated_time != nil {
+		*m.addlast_updated_time += u
+	} else {
+		m.addlast_updated_time = &u
+	}
+}
+
+// AddedLastUpdatedTime returns the value that was added to the "last_updated_time" field in this mutation.
+func (m *ProofRequestMutation) AddedLastUpdatedTime() (r int64, exists bool) {
+	v := m.addlast_updated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastUpdatedTime resets all changes to the "last_updated_time" field.
+func (m *ProofRequestMutation) ResetLastUpdatedTime() {
+	m.last_updated_time = nil
+	m.addlast_updated_time = nil
+}
+
+// SetL1BlockNumber sets the "l1_block_number" field.
+func (m *ProofRequestMutation) SetL1BlockNumber(u uint64) {
+	m.l1_block_number = &u
+	m.addl1_block_number = nil
+}
+
+// L1BlockNumber returns the value of the "l1_block_number" field in the mutation.
+func (m *ProofRequestMutation) L1BlockNumber() (r uint64, exists bool) {
+	v := m.l1_block_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldL1BlockNumber returns the old "l1_block_number" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldL1BlockNumber(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldL1BlockNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldL1BlockNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldL1BlockNumber: %w", err)
+	}
+	return oldValue.L1BlockNumber, nil
+}
+
+// AddL1BlockNumber adds u to the "l1_block_number" field.
+func (m *ProofRequestMutation) AddL1BlockNumber(u int64) {
+	if m.addl1_block_number != nil {
+		*m.addl1_block_number += u
+	} else {
+		m.addl1_block_number = &u
+	}
+}
+
+// AddedL1BlockNumber returns the value that was added to the "l1_block_number" field in this mutation.
+func (m *ProofRequestMutation) AddedL1BlockNumber() (r int64, exists bool) {
+	v := m.addl1_block_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearL1BlockNumber clears the value of the "l1_block_number" field.
+func (m *ProofRequestMutation) ClearL1BlockNumber() {
+	m.l1_block_number = nil
+	m.addl1_block_number = nil
+	m.clearedFields[proofrequest.FieldL1BlockNumber] = struct{}{}
+}
+
+// L1BlockNumberCleared returns if the "l1_block_number" field was cleared in this mutation.
+func (m *ProofRequestMutation) L1BlockNumberCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldL1BlockNumber]
+	return ok
+}
+
+// ResetL1BlockNumber resets all changes to the "l1_block_number" field.
+func (m *ProofRequestMutation) ResetL1BlockNumber() {
+	m.l1_block_number = nil
+	m.addl1_block_number = nil
+	delete(m.clearedFields, proofrequest.FieldL1BlockNumber)
+}
+
+// SetL1BlockHash sets the "l1_block_hash" field.
+func (m *ProofRequestMutation) SetL1BlockHash(s string) {
+	m.l1_block_hash = &s
+}
+
+// L1BlockHash returns the value of the "l1_block_hash" field in the mutation.
+func (m *ProofRequestMutation) L1BlockHash() (r string, exists bool) {
+	v := m.l1_block_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldL1BlockHash returns the old "l1_block_hash" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldL1BlockHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldL1BlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldL1BlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldL1BlockHash: %w", err)
+	}
+	return oldValue.L1BlockHash, nil
+}
+
+// ClearL1BlockHash clears the value of the "l1_block_hash" field.
+func (m *ProofRequestMutation) ClearL1BlockHash() {
+	m.l1_block_hash = nil
+	m.clearedFields[proofrequest.FieldL1BlockHash] = struct{}{}
+}
+
+// L1BlockHashCleared returns if the "l1_block_hash" field was cleared in this mutation.
+func (m *ProofRequestMutation) L1BlockHashCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldL1BlockHash]
+	return ok
+}
+
+// ResetL1BlockHash resets all changes to the "l1_block_hash" field.
+func (m *ProofRequestMutation) ResetL1BlockHash() {
+	m.l1_block_hash = nil
+	delete(m.clearedFields, proofrequest.FieldL1BlockHash)
+}
+
+// SetProof sets the "proof" field.
+func (m *ProofRequestMutation) SetProof(b []byte) {
+	m.proof = &b
+}
+
+// Proof returns the value of the "proof" field in the mutation.
+func (m *ProofRequestMutation) Proof() (r []byte, exists bool) {
+	v := m.proof
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProof returns the old "proof" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProof(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProof is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProof requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProof: %w", err)
+	}
+	return oldValue.Proof, nil
+}
+
+// ClearProof clears the value of the "proof" field.
+func (m *ProofRequestMutation) ClearProof() {
+	m.proof = nil
+	m.clearedFields[proofrequest.FieldProof] = struct{}{}
+}
+
+// ProofCleared returns if the "proof" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProofCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProof]
+	return ok
+}
+
+// ResetProof resets all changes to the "proof" field.
+func (m *ProofRequestMutation) ResetProof() {
+	m.proof = nil
+	delete(m.clearedFields, proofrequest.FieldProof)
+}
+
+// SetReplicaID sets the "replica_id" field.
+func (m *ProofRequestMutation) SetReplicaID(s string) {
+	m.replica_id = &s
+}
+
+// ReplicaID returns the value of the "replica_id" field in the mutation.
+func (m *ProofRequestMutation) ReplicaID() (r string, exists bool) {
+	v := m.replica_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplicaID returns the old "replica_id" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldReplicaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplicaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplicaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplicaID: %w", err)
+	}
+	return oldValue.ReplicaID, nil
+}
+
+// ClearReplicaID clears the value of the "replica_id" field.
+func (m *ProofRequestMutation) ClearReplicaID() {
+	m.replica_id = nil
+	m.clearedFields[proofrequest.FieldReplicaID] = struct{}{}
+}
+
+// ReplicaIDCleared returns if the "replica_id" field was cleared in this mutation.
+func (m *ProofRequestMutation) ReplicaIDCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldReplicaID]
+	return ok
+}
+
+// ResetReplicaID resets all changes to the "replica_id" field.
+func (m *ProofRequestMutation) ResetReplicaID() {
+	m.replica_id = nil
+	delete(m.clearedFields, proofrequest.FieldReplicaID)
+}
+
+// SetCycles sets the "cycles" field.
+func (m *ProofRequestMutation) SetCycles(u uint64) {
+	m.cycles = &u
+	m.addcycles = nil
+}
+
+// Cycles returns the value of the "cycles" field in the mutation.
+func (m *ProofRequestMutation) Cycles() (r uint64, exists bool) {
+	v := m.cycles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCycles returns the old "cycles" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldCycles(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCycles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCycles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCycles: %w", err)
+	}
+	return oldValue.Cycles, nil
+}
+
+// AddCycles adds u to the "cycles" field.
+func (m *ProofRequestMutation) AddCycles(u int64) {
+	if m.addcycles != nil {
+		*m.addcycles += u
+	} else {
+		m.addcycles = &u
+	}
+}
+
+// AddedCycles returns the value that was added to the "cycles" field in this mutation.
+func (m *ProofRequestMutation) AddedCycles() (r int64, exists bool) {
+	v := m.addcycles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCycles clears the value of the "cycles" field.
+func (m *ProofRequestMutation) ClearCycles() {
+	m.cycles = nil
+	m.addcycles = nil
+	m.clearedFields[proofrequest.FieldCycles] = struct{}{}
+}
+
+// CyclesCleared returns if the "cycles" field was cleared in this mutation.
+func (m *ProofRequestMutation) CyclesCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldCycles]
+	return ok
+}
+
+// ResetCycles resets all changes to the "cycles" field.
+func (m *ProofRequestMutation) ResetCycles() {
+	m.cycles = nil
+	m.addcycles = nil
+	delete(m.clearedFields, proofrequest.FieldCycles)
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (m *ProofRequestMutation) SetGasUsed(u uint64) {
+	m.gas_used = &u
+	m.addgas_used = nil
+}
+
+// GasUsed returns the value of the "gas_used" field in the mutation.
+func (m *ProofRequestMutation) GasUsed() (r uint64, exists bool) {
+	v := m.gas_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGasUsed returns the old "gas_used" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldGasUsed(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGasUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGasUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGasUsed: %w", err)
+	}
+	return oldValue.GasUsed, nil
+}
+
+// AddGasUsed adds u to the "gas_used" field.
+func (m *ProofRequestMutation) AddGasUsed(u int64) {
+	if m.addgas_used != nil {
+		*m.addgas_used += u
+	} else {
+		m.addgas_used = &u
+	}
+}
+
+// AddedGasUsed returns the value that was added to the "gas_used" field in this mutation.
+func (m *ProofRequestMutation) AddedGasUsed() (r int64, exists bool) {
+	v := m.addgas_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGasUsed clears the value of the "gas_used" field.
+func (m *ProofRequestMutation) ClearGasUsed() {
+	m.gas_used = nil
+	m.addgas_used = nil
+	m.clearedFields[proofrequest.FieldGasUsed] = struct{}{}
+}
+
+// GasUsedCleared returns if the "gas_used" field was cleared in this mutation.
+func (m *ProofRequestMutation) GasUsedCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldGasUsed]
+	return ok
+}
+
+// ResetGasUsed resets all changes to the "gas_used" field.
+func (m *ProofRequestMutation) ResetGasUsed() {
+	m.gas_used = nil
+	m.addgas_used = nil
+	delete(m.clearedFields, proofrequest.FieldGasUsed)
+}
+
+// SetProverBackend sets the "prover_backend" field.
+func (m *ProofRequestMutation) SetProverBackend(s string) {
+	m.prover_backend = &s
+}
+
+// ProverBackend returns the value of the "prover_backend" field in the mutation.
+func (m *ProofRequestMutation) ProverBackend() (r string, exists bool) {
+	v := m.prover_backend
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProverBackend returns the old "prover_backend" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProverBackend(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProverBackend is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProverBackend requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProverBackend: %w", err)
+	}
+	return oldValue.ProverBackend, nil
+}
+
+// ClearProverBackend clears the value of the "prover_backend" field.
+func (m *ProofRequestMutation) ClearProverBackend() {
+	m.prover_backend = nil
+	m.clearedFields[proofrequest.FieldProverBackend] = struct{}{}
+}
+
+// ProverBackendCleared returns if the "prover_backend" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProverBackendCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProverBackend]
+	return ok
+}
+
+// ResetProverBackend resets all changes to the "prover_backend" field.
+func (m *ProofRequestMutation) ResetProverBackend() {
+	m.prover_backend = nil
+	delete(m.clearedFields, proofrequest.FieldProverBackend)
+}
+
+// AddEventIDs adds the "events" edge to the ProofRequestEvent entity by ids.
+func (m *ProofRequestMutation) AddEventIDs(ids ...int) {
+	if m.events == nil {
+		m.events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the ProofRequestEvent entity.
+func (m *ProofRequestMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the ProofRequestEvent entity was cleared.
+func (m *ProofRequestMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the ProofRequestEvent entity by IDs.
+func (m *ProofRequestMutation) RemoveEventIDs(ids ...int) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the ProofRequestEvent entity.
+func (m *ProofRequestMutation) RemovedEventsIDs() (ids []int) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *ProofRequestMutation) EventsIDs() (ids []int) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEvents resets all changes to the "events" edge.
+func (m *ProofRequestMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// Where appends a list predicates to the ProofRequestMutation builder.
+func (m *ProofRequestMutation) Where(ps ...predicate.ProofRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProofRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProofRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProofRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProofRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProofRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProofRequest).
+func (m *ProofRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProofRequestMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m._type != nil {
+		fields = append(fields, proofrequest.FieldType)
+	}
+	if m.start_block != nil {
+		fields = append(fields, proofrequest.FieldStartBlock)
+	}
+	if m.end_block != nil {
+		fields = append(fields, proofrequest.FieldEndBlock)
+	}
+	if m.status != nil {
+		fields = append(fields, proofrequest.FieldStatus)
+	}
+	if m.request_added_time != nil {
+		fields = append(fields, proofrequest.FieldRequestAddedTime)
+	}
+	if m.prover_request_id != nil {
+		fields = append(fields, proofrequest.FieldProverRequestID)
+	}
+	if m.proof_request_time != nil {
+		fields = append(fields, proofrequest.FieldProofRequestTime)
+	}
+	if m.last_updated_time != nil {
+		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+	}
+	if m.l1_block_number != nil {
+		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	}
+	if m.l1_block_hash != nil {
+		fields = append(fields, proofrequest.FieldL1BlockHash)
+	}
+	if m.proof != nil {
+		fields = append(fields, proofrequest.FieldProof)
+	}
+	if m.replica_id != nil {
+		fields = append(fields, proofrequest.FieldReplicaID)
+	}
+	if m.cycles != nil {
+		fields = append(fields, proofrequest.FieldCycles)
+	}
+	if m.gas_used != nil {
+		fields = append(fields, proofrequest.FieldGasUsed)
+	}
+	if m.prover_backend != nil {
+		fields = append(fields, proofrequest.FieldProverBackend)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProofRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequest.FieldType:
+		return m.GetType()
+	case proofrequest.FieldStartBlock:
+		return m.StartBlock()
+	case proofrequest.FieldEndBlock:
+		return m.EndBlock()
+	case proofrequest.FieldStatus:
+		return m.Status()
+	case proofrequest.FieldRequestAddedTime:
+		return m.RequestAddedTime()
+	case proofrequest.FieldProverRequestID:
+		return m.ProverRequestID()
+	case proofrequest.FieldProofRequestTime:
+		return m.ProofRequestTime()
+	case proofrequest.FieldLastUpdatedTime:
+		return m.LastUpdatedTime()
+	case proofrequest.FieldL1BlockNumber:
+		return m.L1BlockNumber()
+	case proofrequest.FieldL1BlockHash:
+		return m.L1BlockHash()
+	case proofrequest.FieldProof:
+		return m.Proof()
+	case proofrequest.FieldReplicaID:
+		return m.ReplicaID()
+	case proofrequest.FieldCycles:
+		return m.Cycles()
+	case proofrequest.FieldGasUsed:
+		return m.GasUsed()
+	case proofrequest.FieldProverBackend:
+		return m.ProverBackend()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProofRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case proofrequest.FieldType:
+		return m.OldType(ctx)
+	case proofrequest.FieldStartBlock:
+		return m.OldStartBlock(ctx)
+	case proofrequest.FieldEndBlock:
+		return m.OldEndBlock(ctx)
+	case proofrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case proofrequest.FieldRequestAddedTime:
+		return m.OldRequestAddedTime(ctx)
+	case proofrequest.FieldProverRequestID:
+		return m.OldProverRequestID(ctx)
+	case proofrequest.FieldProofRequestTime:
+		return m.OldProofRequestTime(ctx)
+	case proofrequest.FieldLastUpdatedTime:
+		return m.OldLastUpdatedTime(ctx)
+	case proofrequest.FieldL1BlockNumber:
+		return m.OldL1BlockNumber(ctx)
+	case proofrequest.FieldL1BlockHash:
+		return m.OldL1BlockHash(ctx)
+	case proofrequest.FieldProof:
+		return m.OldProof(ctx)
+	case proofrequest.FieldReplicaID:
+		return m.OldReplicaID(ctx)
+	case proofrequest.FieldCycles:
+		return m.OldCycles(ctx)
+	case proofrequest.FieldGasUsed:
+		return m.OldGasUsed(ctx)
+	case proofrequest.FieldProverBackend:
+		return m.OldProverBackend(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProofRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case proofrequest.FieldType:
+		v, ok := value.(proofrequest.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case proofrequest.FieldStartBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartBlock(v)
+		return nil
+	case proofrequest.FieldEndBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndBlock(v)
+		return nil
+	case proofrequest.FieldStatus:
+		v, ok := value.(proofrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case proofrequest.FieldRequestAddedTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestAddedTime(v)
+		return nil
+	case proofrequest.FieldProverRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProverRequestID(v)
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProofRequestTime(v)
+		return nil
+	case proofrequest.FieldLastUpdatedTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpdatedTime(v)
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetL1BlockNumber(v)
+		return nil
+	case proofrequest.FieldL1BlockHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetL1BlockHash(v)
+		return nil
+	case proofrequest.FieldProof:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProof(v)
+		return nil
+	case proofrequest.FieldReplicaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplicaID(v)
+		return nil
+	case proofrequest.FieldCycles:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCycles(v)
+		return nil
+	case proofrequest.FieldGasUsed:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGasUsed(v)
+		return nil
+	case proofrequest.FieldProverBackend:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProverBackend(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProofRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addstart_block != nil {
+		fields = append(fields, proofrequest.FieldStartBlock)
+	}
+	if m.addend_block != nil {
+		fields = append(fields, proofrequest.FieldEndBlock)
+	}
+	if m.addrequest_added_time != nil {
+		fields = append(fields, proofrequest.FieldRequestAddedTime)
+	}
+	if m.addproof_request_time != nil {
+		fields = append(fields, proofrequest.FieldProofRequestTime)
+	}
+	if m.addlast_updated_time != nil {
+		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+	}
+	if m.addl1_block_number != nil {
+		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	}
+	if m.addcycles != nil {
+		fields = append(fields, proofrequest.FieldCycles)
+	}
+	if m.addgas_used != nil {
+		fields = append(fields, proofrequest.FieldGasUsed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProofRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequest.FieldStartBlock:
+		return m.AddedStartBlock()
+	case proofrequest.FieldEndBlock:
+		return m.AddedEndBlock()
+	case proofrequest.FieldRequestAddedTime:
+		return m.AddedRequestAddedTime()
+	case proofrequest.FieldProofRequestTime:
+		return m.AddedProofRequestTime()
+	case proofrequest.FieldLastUpdatedTime:
+		return m.AddedLastUpdatedTime()
+	case proofrequest.FieldL1BlockNumber:
+		return m.AddedL1BlockNumber()
+	case proofrequest.FieldCycles:
+		return m.AddedCycles()
+	case proofrequest.FieldGasUsed:
+		return m.AddedGasUsed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case proofrequest.FieldStartBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartBlock(v)
+		return nil
+	case proofrequest.FieldEndBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndBlock(v)
+		return nil
+	case proofrequest.FieldRequestAddedTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequestAddedTime(v)
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProofRequestTime(v)
+		return nil
+	case proofrequest.FieldLastUpdatedTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastUpdatedTime(v)
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddL1BlockNumber(v)
+		return nil
+	case proofrequest.FieldCycles:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCycles(v)
+		return nil
+	case proofrequest.FieldGasUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGasUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProofRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(proofrequest.FieldProverRequestID) {
+		fields = append(fields, proofrequest.FieldProverRequestID)
+	}
+	if m.FieldCleared(proofrequest.FieldProofRequestTime) {
+		fields = append(fields, proofrequest.FieldProofRequestTime)
+	}
+	if m.FieldCleared(proofrequest.FieldL1BlockNumber) {
+		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	}
+	if m.FieldCleared(proofrequest.FieldL1BlockHash) {
+		fields = append(fields, proofrequest.FieldL1BlockHash)
+	}
+	if m.FieldCleared(proofrequest.FieldProof) {
+		fields = append(fields, proofrequest.FieldProof)
+	}
+	if m.FieldCleared(proofrequest.FieldReplicaID) {
+		fields = append(fields, proofrequest.FieldReplicaID)
+	}
+	if m.FieldCleared(proofrequest.FieldCycles) {
+		fields = append(fields, proofrequest.FieldCycles)
+	}
+	if m.FieldCleared(proofrequest.FieldGasUsed) {
+		fields = append(fields, proofrequest.FieldGasUsed)
+	}
+	if m.FieldCleared(proofrequest.FieldProverBackend) {
+		fields = append(fields, proofrequest.FieldProverBackend)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProofRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProofRequestMutation) ClearField(name string) error {
+	switch name {
+	case proofrequest.FieldProverRequestID:
+		m.ClearProverRequestID()
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		m.ClearProofRequestTime()
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		m.ClearL1BlockNumber()
+		return nil
+	case proofrequest.FieldL1BlockHash:
+		m.ClearL1BlockHash()
+		return nil
+	case proofrequest.FieldProof:
+		m.ClearProof()
+		return nil
+	case proofrequest.FieldReplicaID:
+		m.ClearReplicaID()
+		return nil
+	case proofrequest.FieldCycles:
+		m.ClearCycles()
+		return nil
+	case proofrequest.FieldGasUsed:
+		m.ClearGasUsed()
+		return nil
+	case proofrequest.FieldProverBackend:
+		m.ClearProverBackend()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProofRequestMutation) ResetField(name string) error {
+	switch name {
+	case proofrequest.FieldType:
+		m.ResetType()
+		return nil
+	case proofrequest.FieldStartBlock:
+		m.ResetStartBlock()
+		return nil
+	case proofrequest.FieldEndBlock:
+		m.ResetEndBlock()
+		return nil
+	case proofrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case proofrequest.FieldRequestAddedTime:
+		m.ResetRequestAddedTime()
+		return nil
+	case proofrequest.FieldProverRequestID:
+		m.ResetProverRequestID()
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		m.ResetProofRequestTime()
+		return nil
+	case proofrequest.FieldLastUpdatedTime:
+		m.ResetLastUpdatedTime()
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		m.ResetL1BlockNumber()
+		return nil
+	case proofrequest.FieldL1BlockHash:
+		m.ResetL1BlockHash()
+		return nil
+	case proofrequest.FieldProof:
+		m.ResetProof()
+		return nil
+	case proofrequest.FieldReplicaID:
+		m.ResetReplicaID()
+		return nil
+	case proofrequest.FieldCycles:
+		m.ResetCycles()
+		return nil
+	case proofrequest.FieldGasUsed:
+		m.ResetGasUsed()
+		return nil
+	case proofrequest.FieldProverBackend:
+		m.ResetProverBackend()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProofRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.events != nil {
+		edges = append(edges, proofrequest.EdgeEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProofRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case proofrequest.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProofRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedevents != nil {
+		edges = append(edges, proofrequest.EdgeEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProofRequestMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case proofrequest.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProofRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedevents {
+		edges = append(edges, proofrequest.EdgeEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProofRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case proofrequest.EdgeEvents:
+		return m.clearedevents
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProofRequestMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProofRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProofRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case proofrequest.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest edge %s", name)
+}
+
+// ProofRequestEventMutation represents an operation that mutates the ProofRequestEvent nodes in the graph.
+type ProofRequestEventMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	from_status          *proofrequestevent.FromStatus
+	to_status            *proofrequestevent.ToStatus
+	time                 *uint64
+	addtime              *int64
+	reason               *string
+	prover_request_id    *string
+	prover_response      *string
+	clearedFields        map[string]struct{}
+	proof_request        *int
+	clearedproof_request bool
+	done                 bool
+	oldValue             func(context.Context) (*ProofRequestEvent, error)
+	predicates           []predicate.ProofRequestEvent
+}
+
+var _ ent.Mutation = (*ProofRequestEventMutation)(nil)
+
+// proofrequesteventOption allows management of the mutation configuration using functional options.
+type proofrequesteventOption func(*ProofRequestEventMutation)
+
+// newProofRequestEventMutation creates new mutation for the ProofRequestEvent entity.
+func newProofRequestEventMutation(c config, op Op, opts ...proofrequesteventOption) *ProofRequestEventMutation {
+	m := &ProofRequestEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProofRequestEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProofRequestEventID sets the ID field of the mutation.
+func withProofRequestEventID(id int) proofrequesteventOption {
+	return func(m *ProofRequestEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProofRequestEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ProofRequestEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProofRequestEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProofRequestEvent sets the old ProofRequestEvent of the mutation.
+func withProofRequestEvent(node *ProofRequestEvent) proofrequesteventOption {
+	return func(m *ProofRequestEventMutation) {
+		m.oldValue = func(context.Context) (*ProofRequestEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProofRequestEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProofRequestEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProofRequestEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProofRequestEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProofRequestEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProofRequestID sets the "proof_request_id" field.
+func (m *ProofRequestEventMutation) SetProofRequestID(i int) {
+	m.proof_request = &i
+}
+
+// ProofRequestID returns the value of the "proof_request_id" field in the mutation.
+func (m *ProofRequestEventMutation) ProofRequestID() (r int, exists bool) {
+	v := m.proof_request
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProofRequestID returns the old "proof_request_id" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldProofRequestID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProofRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProofRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProofRequestID: %w", err)
+	}
+	return oldValue.ProofRequestID, nil
+}
+
+// ResetProofRequestID resets all changes to the "proof_request_id" field.
+func (m *ProofRequestEventMutation) ResetProofRequestID() {
+	m.proof_request = nil
+}
+
+// SetFromStatus sets the "from_status" field.
+func (m *ProofRequestEventMutation) SetFromStatus(ps proofrequestevent.FromStatus) {
+	m.from_status = &ps
+}
+
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *ProofRequestEventMutation) FromStatus() (r proofrequestevent.FromStatus, exists bool) {
+	v := m.from_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromStatus returns the old "from_status" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldFromStatus(ctx context.Context) (v proofrequestevent.FromStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
+	}
+	return oldValue.FromStatus, nil
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (m *ProofRequestEventMutation) ClearFromStatus() {
+	m.from_status = nil
+	m.clearedFields[proofrequestevent.FieldFromStatus] = struct{}{}
+}
+
+// FromStatusCleared returns if the "from_status" field was cleared in this mutation.
+func (m *ProofRequestEventMutation) FromStatusCleared() bool {
+	_, ok := m.clearedFields[proofrequestevent.FieldFromStatus]
+	return ok
+}
+
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *ProofRequestEventMutation) ResetFromStatus() {
+	m.from_status = nil
+	delete(m.clearedFields, proofrequestevent.FieldFromStatus)
+}
+
+// SetToStatus sets the "to_status" field.
+func (m *ProofRequestEventMutation) SetToStatus(ps proofrequestevent.ToStatus) {
+	m.to_status = &ps
+}
+
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *ProofRequestEventMutation) ToStatus() (r proofrequestevent.ToStatus, exists bool) {
+	v := m.to_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToStatus returns the old "to_status" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldToStatus(ctx context.Context) (v proofrequestevent.ToStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
+}
+
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *ProofRequestEventMutation) ResetToStatus() {
+	m.to_status = nil
+}
+
+// SetTime sets the "time" field.
+func (m *ProofRequestEventMutation) SetTime(u uint64) {
+	m.time = &u
+	m.addtime = nil
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *ProofRequestEventMutation) Time() (r uint64, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// AddTime adds u to the "time" field.
+func (m *ProofRequestEventMutation) AddTime(u int64) {
+	if m.addtime != nil {
+		*m.addtime += u
+	} else {
+		m.addtime = &u
+	}
+}
+
+// AddedTime returns the value that was added to the "time" field in this mutation.
+func (m *ProofRequestEventMutation) AddedTime() (r int64, exists bool) {
+	v := m.addtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTime resets all changes to the "time" field.
+func (m *ProofRequestEventMutation) ResetTime() {
+	m.time = nil
+	m.addtime = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ProofRequestEventMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ProofRequestEventMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ProofRequestEventMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[proofrequestevent.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ProofRequestEventMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[proofrequestevent.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ProofRequestEventMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, proofrequestevent.FieldReason)
+}
+
+// SetProverRequestID sets the "prover_request_id" field.
+func (m *ProofRequestEventMutation) SetProverRequestID(s string) {
+	m.prover_request_id = &s
+}
+
+// ProverRequestID returns the value of the "prover_request_id" field in the mutation.
+func (m *ProofRequestEventMutation) ProverRequestID() (r string, exists bool) {
+	v := m.prover_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProverRequestID returns the old "prover_request_id" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldProverRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProverRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProverRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProverRequestID: %w", err)
+	}
+	return oldValue.ProverRequestID, nil
+}
+
+// ClearProverRequestID clears the value of the "prover_request_id" field.
+func (m *ProofRequestEventMutation) ClearProverRequestID() {
+	m.prover_request_id = nil
+	m.clearedFields[proofrequestevent.FieldProverRequestID] = struct{}{}
+}
+
+// ProverRequestIDCleared returns if the "prover_request_id" field was cleared in this mutation.
+func (m *ProofRequestEventMutation) ProverRequestIDCleared() bool {
+	_, ok := m.clearedFields[proofrequestevent.FieldProverRequestID]
+	return ok
+}
+
+// ResetProverRequestID resets all changes to the "prover_request_id" field.
+func (m *ProofRequestEventMutation) ResetProverRequestID() {
+	m.prover_request_id = nil
+	delete(m.clearedFields, proofrequestevent.FieldProverRequestID)
+}
+
+// SetProverResponse sets the "prover_response" field.
+func (m *ProofRequestEventMutation) SetProverResponse(s string) {
+	m.prover_response = &s
+}
+
+// ProverResponse returns the value of the "prover_response" field in the mutation.
+func (m *ProofRequestEventMutation) ProverResponse() (r string, exists bool) {
+	v := m.prover_response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProverResponse returns the old "prover_response" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldProverResponse(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProverResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProverResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProverResponse: %w", err)
+	}
+	return oldValue.ProverResponse, nil
+}
+
+// ClearProverResponse clears the value of the "prover_response" field.
+func (m *ProofRequestEventMutation) ClearProverResponse() {
+	m.prover_response = nil
+	m.clearedFields[proofrequestevent.FieldProverResponse] = struct{}{}
+}
+
+// ProverResponseCleared returns if the "prover_response" field was cleared in this mutation.
+func (m *ProofRequestEventMutation) ProverResponseCleared() bool {
+	_, ok := m.clearedFields[proofrequestevent.FieldProverResponse]
+	return ok
+}
+
+// ResetProverResponse resets all changes to the "prover_response" field.
+func (m *ProofRequestEventMutation) ResetProverResponse() {
+	m.prover_response = nil
+	delete(m.clearedFields, proofrequestevent.FieldProverResponse)
+}
+
+// ClearProofRequest clears the "proof_request" edge to the ProofRequest entity.
+func (m *ProofRequestEventMutation) ClearProofRequest() {
+	m.clearedproof_request = true
+	m.clearedFields[proofrequestevent.FieldProofRequestID] = struct{}{}
+}
+
+// ProofRequestCleared reports if the "proof_request" edge to the ProofRequest entity was cleared.
+func (m *ProofRequestEventMutation) ProofRequestCleared() bool {
+	return m.clearedproof_request
+}
+
+// ProofRequestIDs returns the "proof_request" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProofRequestID instead. It exists only for internal usage by the builders.
+func (m *ProofRequestEventMutation) ProofRequestIDs() (ids []int) {
+	if id := m.proof_request; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProofRequest resets all changes to the "proof_request" edge.
+func (m *ProofRequestEventMutation) ResetProofRequest() {
+	m.proof_request = nil
+	m.clearedproof_request = false
+}
+
+// Where appends a list predicates to the ProofRequestEventMutation builder.
+func (m *ProofRequestEventMutation) Where(ps ...predicate.ProofRequestEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProofRequestEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProofRequestEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProofRequestEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProofRequestEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProofRequestEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProofRequestEvent).
+func (m *ProofRequestEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProofRequestEventMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.proof_request != nil {
+		fields = append(fields, proofrequestevent.FieldProofRequestID)
+	}
+	if m.from_status != nil {
+		fields = append(fields, proofrequestevent.FieldFromStatus)
+	}
+	if m.to_status != nil {
+		fields = append(fields, proofrequestevent.FieldToStatus)
+	}
+	if m.time != nil {
+		fields = append(fields, proofrequestevent.FieldTime)
+	}
+	if m.reason != nil {
+		fields = append(fields, proofrequestevent.FieldReason)
+	}
+	if m.prover_request_id != nil {
+		fields = append(fields, proofrequestevent.FieldProverRequestID)
+	}
+	if m.prover_response != nil {
+		fields = append(fields, proofrequestevent.FieldProverResponse)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProofRequestEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		return m.ProofRequestID()
+	case proofrequestevent.FieldFromStatus:
+		return m.FromStatus()
+	case proofrequestevent.FieldToStatus:
+		return m.ToStatus()
+	case proofrequestevent.FieldTime:
+		return m.Time()
+	case proofrequestevent.FieldReason:
+		return m.Reason()
+	case proofrequestevent.FieldProverRequestID:
+		return m.ProverRequestID()
+	case proofrequestevent.FieldProverResponse:
+		return m.ProverResponse()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProofRequestEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		return m.OldProofRequestID(ctx)
+	case proofrequestevent.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case proofrequestevent.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case proofrequestevent.FieldTime:
+		return m.OldTime(ctx)
+	case proofrequestevent.FieldReason:
+		return m.OldReason(ctx)
+	case proofrequestevent.FieldProverRequestID:
+		return m.OldProverRequestID(ctx)
+	case proofrequestevent.FieldProverResponse:
+		return m.OldProverResponse(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProofRequestEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProofRequestID(v)
+		return nil
+	case proofrequestevent.FieldFromStatus:
+		v, ok := value.(proofrequestevent.FromStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromStatus(v)
+		return nil
+	case proofrequestevent.FieldToStatus:
+		v, ok := value.(proofrequestevent.ToStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToStatus(v)
+		return nil
+	case proofrequestevent.FieldTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case proofrequestevent.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case proofrequestevent.FieldProverRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProverRequestID(v)
+		return nil
+	case proofrequestevent.FieldProverResponse:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProverResponse(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequestEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProofRequestEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addtime != nil {
+		fields = append(fields, proofrequestevent.FieldTime)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProofRequestEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequestevent.FieldTime:
+		return m.AddedTime()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case proofrequestevent.FieldTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequestEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProofRequestEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(proofrequestevent.FieldFromStatus) {
+		fields = append(fields, proofrequestevent.FieldFromStatus)
+	}
+	if m.FieldCleared(proofrequestevent.FieldReason) {
+		fields = append(fields, proofrequestevent.FieldReason)
+	}
+	if m.FieldCleared(proofrequestevent.FieldProverRequestID) {
+		fields = append(fields, proofrequestevent.FieldProverRequestID)
+	}
+	if m.FieldCleared(proofrequestevent.FieldProverResponse) {
+		fields = append(fields, proofrequestevent.FieldProverResponse)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProofRequestEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProofRequestEventMutation) ClearField(name string) error {
+	switch name {
+	case proofrequestevent.FieldFromStatus:
+		m.ClearFromStatus()
+		return nil
+	case proofrequestevent.FieldReason:
+		m.ClearReason()
+		return nil
+	case proofrequestevent.FieldProverRequestID:
+		m.ClearProverRequestID()
+		return nil
+	case proofrequestevent.FieldProverResponse:
+		m.ClearProverResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequestEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProofRequestEventMutation) ResetField(name string) error {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		m.ResetProofRequestID()
+		return nil
+	case proofrequestevent.FieldFromStatus:
+		m.ResetFromStatus()
+		return nil
+	case proofrequestevent.FieldToStatus:
+		m.ResetToStatus()
+		return nil
+	case proofrequestevent.FieldTime:
+		m.ResetTime()
+		return nil
+	case proofrequestevent.FieldReason:
+		m.ResetReason()
+		return nil
+	case proofrequestevent.FieldProverRequestID:
+		m.ResetProverRequestID()
+		return nil
+	case proofrequestevent.FieldProverResponse:
+		m.ResetProverResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequestEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProofRequestEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.proof_request != nil {
+		edges = append(edges, proofrequestevent.EdgeProofRequest)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProofRequestEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case proofrequestevent.EdgeProofRequest:
+		if id := m.proof_request; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProofRequestEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProofRequestEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProofRequestEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproof_request {
+		edges = append(edges, proofrequestevent.EdgeProofRequest)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProofRequestEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case proofrequestevent.EdgeProofRequest:
+		return m.clearedproof_request
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProofRequestEventMutation) ClearEdge(name string) error {
+	switch name {
+	case proofrequestevent.EdgeProofRequest:
+		m.ClearProofRequest()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequestEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProofRequestEventMutation) ResetEdge(name string) error {
+	switch name {
+	case proofrequestevent.EdgeProofRequest:
+		m.ResetProofRequest()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequestEvent edge %s", name)
+}
@@ -0,0 +1,13 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProofRequest is the predicate function for proofrequest builders.
+type ProofRequest func(*sql.Selector)
+
+// ProofRequestEvent is the predicate function for proofrequestevent builders.
+type ProofRequestEvent func(*sql.Selector)
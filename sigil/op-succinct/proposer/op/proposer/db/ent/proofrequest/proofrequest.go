@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package proofrequest
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the proofrequest type in the database.
+	Label = "proof_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldStartBlock holds the string denoting the start_block field in the database.
+	FieldStartBlock = "start_block"
+	// FieldEndBlock holds the string denoting the end_block field in the database.
+	FieldEndBlock = "end_block"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRequestAddedTime holds the string denoting the request_added_time field in the database.
+	FieldRequestAddedTime = "request_added_time"
+	// FieldProverRequestID holds the string denoting the prover_request_id field in the database.
+	FieldProverRequestID = "prover_request_id"
+	// FieldProofRequestTime holds the string denoting the proof_request_time field in the database.
+	FieldProofRequestTime = "proof_request_time"
+	// FieldLastUpdatedTime holds the string denoting the last_updated_time field in the database.
+	FieldLastUpdatedTime = "last_updated_time"
+	// FieldL1BlockNumber holds the string denoting the l1_block_number field in the database.
+	FieldL1BlockNumber = "l1_block_number"
+	// FieldL1BlockHash holds the string denoting the l1_block_hash field in the database.
+	FieldL1BlockHash = "l1_block_hash"
+	// FieldProof holds the string denoting the proof field in the database.
+	FieldProof = "proof"
+	// FieldReplicaID holds the string denoting the replica_id field in the database.
+	FieldReplicaID = "replica_id"
+	// FieldCycles holds the string denoting the cycles field in the database.
+	FieldCycles = "cycles"
+	// FieldGasUsed holds the string denoting the gas_used field in the database.
+	FieldGasUsed = "gas_used"
+	// FieldProverBackend holds the string denoting the prover_backend field in the database.
+	FieldProverBackend = "prover_backend"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
+	// Table holds the table name of the proofrequest in the database.
+	Table = "proof_requests"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "proof_request_events"
+	// EventsInverseTable is the table name for the ProofRequestEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "proofrequestevent" package.
+	EventsInverseTable = "proof_request_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "proof_request_id"
+)
+
+// Columns holds all SQL columns for proofrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+	FieldStartBlock,
+	FieldEndBlock,
+	FieldStatus,
+	FieldRequestAddedTime,
+	FieldProverRequestID,
+	FieldProofRequestTime,
+	FieldLastUpdatedTime,
+	FieldL1BlockNumber,
+	FieldL1BlockHash,
+	FieldProof,
+	FieldReplicaID,
+	FieldCycles,
+	FieldGasUsed,
+	FieldProverBackend,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeSPAN Type = "SPAN"
+	TypeAGG  Type = "AGG"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeSPAN, TypeAGG:
+		return nil
+	default:
+		return fmt.Errorf("proofrequest: invalid enum value for type field: %q", _type)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusUNREQ      Status = "UNREQ"
+	StatusWITNESSGEN Status = "WITNESSGEN"
+	StatusPROVING    Status = "PROVING"
+	StatusFAILED     Status = "FAILED"
+	StatusCOMPLETE   Status = "COMPLETE"
+	StatusCANCELLED  Status = "CANCELLED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusUNREQ, StatusWITNESSGEN, StatusPROVING, StatusFAILED, StatusCOMPLETE, StatusCANCELLED:
+		return nil
+	default:
+		return fmt.Errorf("proofrequest: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ProofRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByStartBlock orders the results by the start_block field.
+func ByStartBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartBlock, opts...).ToFunc()
+}
+
+// ByEndBlock orders the results by the end_block field.
+func ByEndBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndBlock, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRequestAddedTime orders the results by the request_added_time field.
+func ByRequestAddedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestAddedTime, opts...).ToFunc()
+}
+
+// ByProverRequestID orders the results by the prover_request_id field.
+func ByProverRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProverRequestID, opts...).ToFunc()
+}
+
+// ByProofRequestTime orders the results by the proof_request_time field.
+func ByProofRequestTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProofRequestTime, opts...).ToFunc()
+}
+
+// ByLastUpdatedTime orders the results by the last_updated_time field.
+func ByLastUpdatedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUpdatedTime, opts...).ToFunc()
+}
+
+// ByL1BlockNumber orders the results by the l1_block_number field.
+func ByL1BlockNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldL1BlockNumber, opts...).ToFunc()
+}
+
+// ByL1BlockHash orders the results by the l1_block_hash field.
+func ByL1BlockHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldL1BlockHash, opts...).ToFunc()
+}
+
+// ByReplicaID orders the results by the replica_id field.
+func ByReplicaID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplicaID, opts...).ToFunc()
+}
+
+// ByCycles orders the results by the cycles field.
+func ByCycles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCycles, opts...).ToFunc()
+}
+
+// ByGasUsed orders the results by the gas_used field.
+func ByGasUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGasUsed, opts...).ToFunc()
+}
+
+// ByProverBackend orders the results by the prover_backend field.
+func ByProverBackend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProverBackend, opts...).ToFunc()
+}
+
+// ByEventsCount orders the results by events count.
+func ByEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventsStep(), opts...)
+	}
+}
+
+// ByEvents orders the results by events terms.
+func ByEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+	)
+}
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+// ProofRequestEvent is the model entity for the ProofRequestEvent schema.
+type ProofRequestEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProofRequestID holds the value of the "proof_request_id" field.
+	ProofRequestID int `json:"proof_request_id,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus proofrequestevent.FromStatus `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus proofrequestevent.ToStatus `json:"to_status,omitempty"`
+	// Time holds the value of the "time" field.
+	Time uint64 `json:"time,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// ProverRequestID holds the value of the "prover_request_id" field.
+	ProverRequestID string `json:"prover_request_id,omitempty"`
+	// ProverResponse holds the value of the "prover_response" field.
+	ProverResponse string `json:"prover_response,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProofRequestEventQuery when eager-loading is set.
+	Edges        ProofRequestEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProofRequestEventEdges holds the relations/edges for other nodes in the graph.
+type ProofRequestEventEdges struct {
+	// ProofRequest holds the value of the proof_request edge.
+	ProofRequest *ProofRequest `json:"proof_request,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProofRequestOrErr returns the ProofRequest value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProofRequestEventEdges) ProofRequestOrErr() (*ProofRequest, error) {
+	if e.ProofRequest != nil {
+		return e.ProofRequest, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: proofrequest.Label}
+	}
+	return nil, &NotLoadedError{edge: "proof_request"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProofRequestEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case proofrequestevent.FieldID, proofrequestevent.FieldProofRequestID, proofrequestevent.FieldTime:
+			values[i] = new(sql.NullInt64)
+		case proofrequestevent.FieldFromStatus, proofrequestevent.FieldToStatus, proofrequestevent.FieldReason, proofrequestevent.FieldProverRequestID, proofrequestevent.FieldProverResponse:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProofRequestEvent fields.
+func (pre *ProofRequestEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case proofrequestevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pre.ID = int(value.Int64)
+		case proofrequestevent.FieldProofRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field proof_request_id", values[i])
+			} else if value.Valid {
+				pre.ProofRequestID = int(value.Int64)
+			}
+		case proofrequestevent.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				pre.FromStatus = proofrequestevent.FromStatus(value.String)
+			}
+		case proofrequestevent.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				pre.ToStatus = proofrequestevent.ToStatus(value.String)
+			}
+		case proofrequestevent.FieldTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field time", values[i])
+			} else if value.Valid {
+				pre.Time = uint64(value.Int64)
+			}
+		case proofrequestevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				pre.Reason = value.String
+			}
+		case proofrequestevent.FieldProverRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prover_request_id", values[i])
+			} else if value.Valid {
+				pre.ProverRequestID = value.String
+			}
+		case proofrequestevent.FieldProverResponse:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prover_response", values[i])
+			} else if value.Valid {
+				pre.ProverResponse = value.String
+			}
+		default:
+			pre.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProofRequestEvent.
+// This includes values selected through modifiers, order, etc.
+func (pre *ProofRequestEvent) Value(name string) (ent.Value, error) {
+	return pre.selectValues.Get(name)
+}
+
+// QueryProofRequest queries the "proof_request" edge of the ProofRequestEvent entity.
+func (pre *ProofRequestEvent) QueryProofRequest() *ProofRequestQuery {
+	return NewProofRequestEventClient(pre.config).QueryProofRequest(pre)
+}
+
+// Update returns a builder for updating this ProofRequestEvent.
+// Note that you need to call ProofRequestEvent.Unwrap() before calling this method if this ProofRequestEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pre *ProofRequestEvent) Update() *ProofRequestEventUpdateOne {
+	return NewProofRequestEventClient(pre.config).UpdateOne(pre)
+}
+
+// Unwrap unwraps the ProofRequestEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pre *ProofRequestEvent) Unwrap() *ProofRequestEvent {
+	_tx, ok := pre.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProofRequestEvent is not a transactional entity")
+	}
+	pre.config.driver = _tx.drv
+	return pre
+}
+
+// String implements the fmt.Stringer.
+func (pre *ProofRequestEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProofRequestEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pre.ID))
+	builder.WriteString("proof_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", pre.ProofRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(fmt.Sprintf("%v", pre.FromStatus))
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", pre.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("time=")
+	builder.WriteString(fmt.Sprintf("%v", pre.Time))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(pre.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("prover_request_id=")
+	builder.WriteString(pre.ProverRequestID)
+	builder.WriteString(", ")
+	builder.WriteString("prover_response=")
+	builder.WriteString(pre.ProverResponse)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProofRequestEvents is a parsable slice of ProofRequestEvent.
+type ProofRequestEvents []*ProofRequestEvent
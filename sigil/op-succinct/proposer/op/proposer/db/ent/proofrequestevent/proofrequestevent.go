@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package proofrequestevent
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the proofrequestevent type in the database.
+	Label = "proof_request_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProofRequestID holds the string denoting the proof_request_id field in the database.
+	FieldProofRequestID = "proof_request_id"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldTime holds the string denoting the time field in the database.
+	FieldTime = "time"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldProverRequestID holds the string denoting the prover_request_id field in the database.
+	FieldProverRequestID = "prover_request_id"
+	// FieldProverResponse holds the string denoting the prover_response field in the database.
+	FieldProverResponse = "prover_response"
+	// EdgeProofRequest holds the string denoting the proof_request edge name in mutations.
+	EdgeProofRequest = "proof_request"
+	// Table holds the table name of the proofrequestevent in the database.
+	Table = "proof_request_events"
+	// ProofRequestTable is the table that holds the proof_request relation/edge.
+	ProofRequestTable = "proof_request_events"
+	// ProofRequestInverseTable is the table name for the ProofRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "proofrequest" package.
+	ProofRequestInverseTable = "proof_requests"
+	// ProofRequestColumn is the table column denoting the proof_request relation/edge.
+	ProofRequestColumn = "proof_request_id"
+)
+
+// Columns holds all SQL columns for proofrequestevent fields.
+var Columns = []string{
+	FieldID,
+	FieldProofRequestID,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldTime,
+	FieldReason,
+	FieldProverRequestID,
+	FieldProverResponse,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// FromStatus defines the type for the "from_status" enum field.
+type FromStatus string
+
+// FromStatus values.
+const (
+	FromStatusUNREQ      FromStatus = "UNREQ"
+	FromStatusWITNESSGEN FromStatus = "WITNESSGEN"
+	FromStatusPROVING    FromStatus = "PROVING"
+	FromStatusFAILED     FromStatus = "FAILED"
+	FromStatusCOMPLETE   FromStatus = "COMPLETE"
+	FromStatusCANCELLED  FromStatus = "CANCELLED"
+)
+
+func (fs FromStatus) String() string {
+	return string(fs)
+}
+
+// FromStatusValidator is a validator for the "from_status" field enum values. It is called by the builders before save.
+func FromStatusValidator(fs FromStatus) error {
+	switch fs {
+	case FromStatusUNREQ, FromStatusWITNESSGEN, FromStatusPROVING, FromStatusFAILED, FromStatusCOMPLETE, FromStatusCANCELLED:
+		return nil
+	default:
+		return fmt.Errorf("proofrequestevent: invalid enum value for from_status field: %q", fs)
+	}
+}
+
+// ToStatus defines the type for the "to_status" enum field.
+type ToStatus string
+
+// ToStatus values.
+const (
+	ToStatusUNREQ      ToStatus = "UNREQ"
+	ToStatusWITNESSGEN ToStatus = "WITNESSGEN"
+	ToStatusPROVING    ToStatus = "PROVING"
+	ToStatusFAILED     ToStatus = "FAILED"
+	ToStatusCOMPLETE   ToStatus = "COMPLETE"
+	ToStatusCANCELLED  ToStatus = "CANCELLED"
+)
+
+func (ts ToStatus) String() string {
+	return string(ts)
+}
+
+// ToStatusValidator is a validator for the "to_status" field enum values. It is called by the builders before save.
+func ToStatusValidator(ts ToStatus) error {
+	switch ts {
+	case ToStatusUNREQ, ToStatusWITNESSGEN, ToStatusPROVING, ToStatusFAILED, ToStatusCOMPLETE, ToStatusCANCELLED:
+		return nil
+	default:
+		return fmt.Errorf("proofrequestevent: invalid enum value for to_status field: %q", ts)
+	}
+}
+
+// OrderOption defines the ordering options for the ProofRequestEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProofRequestID orders the results by the proof_request_id field.
+func ByProofRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProofRequestID, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByTime orders the results by the time field.
+func ByTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTime, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByProverRequestID orders the results by the prover_request_id field.
+func ByProverRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProverRequestID, opts...).ToFunc()
+}
+
+// ByProverResponse orders the results by the prover_response field.
+func ByProverResponse(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProverResponse, opts...).ToFunc()
+}
+
+// ByProofRequestField orders the results by proof_request field.
+func ByProofRequestField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProofRequestStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProofRequestStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProofRequestInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProofRequestTable, ProofRequestColumn),
+	)
+}
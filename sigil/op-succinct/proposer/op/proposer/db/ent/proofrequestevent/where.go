@@ -0,0 +1,452 @@
+// Code generated by ent, DO NOT EDIT.
+
+package proofrequestevent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLTE(FieldID, id))
+}
+
+// ProofRequestID applies equality check predicate on the "proof_request_id" field. It's identical to ProofRequestIDEQ.
+func ProofRequestID(v int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldProofRequestID, v))
+}
+
+// Time applies equality check predicate on the "time" field. It's identical to TimeEQ.
+func Time(v uint64) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldTime, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// ProverRequestID applies equality check predicate on the "prover_request_id" field. It's identical to ProverRequestIDEQ.
+func ProverRequestID(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldProverRequestID, v))
+}
+
+// ProverResponse applies equality check predicate on the "prover_response" field. It's identical to ProverResponseEQ.
+func ProverResponse(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldProverResponse, v))
+}
+
+// ProofRequestIDEQ applies the EQ predicate on the "proof_request_id" field.
+func ProofRequestIDEQ(v int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldProofRequestID, v))
+}
+
+// ProofRequestIDNEQ applies the NEQ predicate on the "proof_request_id" field.
+func ProofRequestIDNEQ(v int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNEQ(FieldProofRequestID, v))
+}
+
+// ProofRequestIDIn applies the In predicate on the "proof_request_id" field.
+func ProofRequestIDIn(vs ...int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIn(FieldProofRequestID, vs...))
+}
+
+// ProofRequestIDNotIn applies the NotIn predicate on the "proof_request_id" field.
+func ProofRequestIDNotIn(vs ...int) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotIn(FieldProofRequestID, vs...))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v FromStatus) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v FromStatus) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...FromStatus) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...FromStatus) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusIsNil applies the IsNil predicate on the "from_status" field.
+func FromStatusIsNil() predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIsNull(FieldFromStatus))
+}
+
+// FromStatusNotNil applies the NotNil predicate on the "from_status" field.
+func FromStatusNotNil() predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotNull(FieldFromStatus))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v ToStatus) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v ToStatus) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...ToStatus) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...ToStatus) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// TimeEQ applies the EQ predicate on the "time" field.
+func TimeEQ(v uint64) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldTime, v))
+}
+
+// TimeNEQ applies the NEQ predicate on the "time" field.
+func TimeNEQ(v uint64) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNEQ(FieldTime, v))
+}
+
+// TimeIn applies the In predicate on the "time" field.
+func TimeIn(vs ...uint64) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIn(FieldTime, vs...))
+}
+
+// TimeNotIn applies the NotIn predicate on the "time" field.
+func TimeNotIn(vs ...uint64) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotIn(FieldTime, vs...))
+}
+
+// TimeGT applies the GT predicate on the "time" field.
+func TimeGT(v uint64) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGT(FieldTime, v))
+}
+
+// TimeGTE applies the GTE predicate on the "time" field.
+func TimeGTE(v uint64) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGTE(FieldTime, v))
+}
+
+// TimeLT applies the LT predicate on the "time" field.
+func TimeLT(v uint64) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLT(FieldTime, v))
+}
+
+// TimeLTE applies the LTE predicate on the "time" field.
+func TimeLTE(v uint64) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLTE(FieldTime, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ProverRequestIDEQ applies the EQ predicate on the "prover_request_id" field.
+func ProverRequestIDEQ(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldProverRequestID, v))
+}
+
+// ProverRequestIDNEQ applies the NEQ predicate on the "prover_request_id" field.
+func ProverRequestIDNEQ(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNEQ(FieldProverRequestID, v))
+}
+
+// ProverRequestIDIn applies the In predicate on the "prover_request_id" field.
+func ProverRequestIDIn(vs ...string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIn(FieldProverRequestID, vs...))
+}
+
+// ProverRequestIDNotIn applies the NotIn predicate on the "prover_request_id" field.
+func ProverRequestIDNotIn(vs ...string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotIn(FieldProverRequestID, vs...))
+}
+
+// ProverRequestIDGT applies the GT predicate on the "prover_request_id" field.
+func ProverRequestIDGT(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGT(FieldProverRequestID, v))
+}
+
+// ProverRequestIDGTE applies the GTE predicate on the "prover_request_id" field.
+func ProverRequestIDGTE(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGTE(FieldProverRequestID, v))
+}
+
+// ProverRequestIDLT applies the LT predicate on the "prover_request_id" field.
+func ProverRequestIDLT(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLT(FieldProverRequestID, v))
+}
+
+// ProverRequestIDLTE applies the LTE predicate on the "prover_request_id" field.
+func ProverRequestIDLTE(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLTE(FieldProverRequestID, v))
+}
+
+// ProverRequestIDContains applies the Contains predicate on the "prover_request_id" field.
+func ProverRequestIDContains(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldContains(FieldProverRequestID, v))
+}
+
+// ProverRequestIDHasPrefix applies the HasPrefix predicate on the "prover_request_id" field.
+func ProverRequestIDHasPrefix(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldHasPrefix(FieldProverRequestID, v))
+}
+
+// ProverRequestIDHasSuffix applies the HasSuffix predicate on the "prover_request_id" field.
+func ProverRequestIDHasSuffix(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldHasSuffix(FieldProverRequestID, v))
+}
+
+// ProverRequestIDIsNil applies the IsNil predicate on the "prover_request_id" field.
+func ProverRequestIDIsNil() predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIsNull(FieldProverRequestID))
+}
+
+// ProverRequestIDNotNil applies the NotNil predicate on the "prover_request_id" field.
+func ProverRequestIDNotNil() predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotNull(FieldProverRequestID))
+}
+
+// ProverRequestIDEqualFold applies the EqualFold predicate on the "prover_request_id" field.
+func ProverRequestIDEqualFold(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEqualFold(FieldProverRequestID, v))
+}
+
+// ProverRequestIDContainsFold applies the ContainsFold predicate on the "prover_request_id" field.
+func ProverRequestIDContainsFold(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldContainsFold(FieldProverRequestID, v))
+}
+
+// ProverResponseEQ applies the EQ predicate on the "prover_response" field.
+func ProverResponseEQ(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEQ(FieldProverResponse, v))
+}
+
+// ProverResponseNEQ applies the NEQ predicate on the "prover_response" field.
+func ProverResponseNEQ(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNEQ(FieldProverResponse, v))
+}
+
+// ProverResponseIn applies the In predicate on the "prover_response" field.
+func ProverResponseIn(vs ...string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIn(FieldProverResponse, vs...))
+}
+
+// ProverResponseNotIn applies the NotIn predicate on the "prover_response" field.
+func ProverResponseNotIn(vs ...string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotIn(FieldProverResponse, vs...))
+}
+
+// ProverResponseGT applies the GT predicate on the "prover_response" field.
+func ProverResponseGT(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGT(FieldProverResponse, v))
+}
+
+// ProverResponseGTE applies the GTE predicate on the "prover_response" field.
+func ProverResponseGTE(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldGTE(FieldProverResponse, v))
+}
+
+// ProverResponseLT applies the LT predicate on the "prover_response" field.
+func ProverResponseLT(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLT(FieldProverResponse, v))
+}
+
+// ProverResponseLTE applies the LTE predicate on the "prover_response" field.
+func ProverResponseLTE(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldLTE(FieldProverResponse, v))
+}
+
+// ProverResponseContains applies the Contains predicate on the "prover_response" field.
+func ProverResponseContains(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldContains(FieldProverResponse, v))
+}
+
+// ProverResponseHasPrefix applies the HasPrefix predicate on the "prover_response" field.
+func ProverResponseHasPrefix(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldHasPrefix(FieldProverResponse, v))
+}
+
+// ProverResponseHasSuffix applies the HasSuffix predicate on the "prover_response" field.
+func ProverResponseHasSuffix(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldHasSuffix(FieldProverResponse, v))
+}
+
+// ProverResponseIsNil applies the IsNil predicate on the "prover_response" field.
+func ProverResponseIsNil() predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldIsNull(FieldProverResponse))
+}
+
+// ProverResponseNotNil applies the NotNil predicate on the "prover_response" field.
+func ProverResponseNotNil() predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldNotNull(FieldProverResponse))
+}
+
+// ProverResponseEqualFold applies the EqualFold predicate on the "prover_response" field.
+func ProverResponseEqualFold(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldEqualFold(FieldProverResponse, v))
+}
+
+// ProverResponseContainsFold applies the ContainsFold predicate on the "prover_response" field.
+func ProverResponseContainsFold(v string) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.FieldContainsFold(FieldProverResponse, v))
+}
+
+// HasProofRequest applies the HasEdge predicate on the "proof_request" edge.
+func HasProofRequest() predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProofRequestTable, ProofRequestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProofRequestWith applies the HasEdge predicate on the "proof_request" edge with a given conditions (other predicates).
+func HasProofRequestWith(preds ...predicate.ProofRequest) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(func(s *sql.Selector) {
+		step := newProofRequestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProofRequestEvent) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProofRequestEvent) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProofRequestEvent) predicate.ProofRequestEvent {
+	return predicate.ProofRequestEvent(sql.NotPredicates(p))
+}
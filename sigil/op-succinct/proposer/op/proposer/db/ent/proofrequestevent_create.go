@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+// ProofRequestEventCreate is the builder for creating a ProofRequestEvent entity.
+type ProofRequestEventCreate struct {
+	config
+	mutation *ProofRequestEventMutation
+	hooks    []Hook
+}
+
+// SetProofRequestID sets the "proof_request_id" field.
+func (prec *ProofRequestEventCreate) SetProofRequestID(i int) *ProofRequestEventCreate {
+	prec.mutation.SetProofRequestID(i)
+	return prec
+}
+
+// SetFromStatus sets the "from_status" field.
+func (prec *ProofRequestEventCreate) SetFromStatus(ps proofrequestevent.FromStatus) *ProofRequestEventCreate {
+	prec.mutation.SetFromStatus(ps)
+	return prec
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (prec *ProofRequestEventCreate) SetNillableFromStatus(ps *proofrequestevent.FromStatus) *ProofRequestEventCreate {
+	if ps != nil {
+		prec.SetFromStatus(*ps)
+	}
+	return prec
+}
+
+// SetToStatus sets the "to_status" field.
+func (prec *ProofRequestEventCreate) SetToStatus(ps proofrequestevent.ToStatus) *ProofRequestEventCreate {
+	prec.mutation.SetToStatus(ps)
+	return prec
+}
+
+// SetTime sets the "time" field.
+func (prec *ProofRequestEventCreate) SetTime(u uint64) *ProofRequestEventCreate {
+	prec.mutation.SetTime(u)
+	return prec
+}
+
+// SetReason sets the "reason" field.
+func (prec *ProofRequestEventCreate) SetReason(s string) *ProofRequestEventCreate {
+	prec.mutation.SetReason(s)
+	return prec
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (prec *ProofRequestEventCreate) SetNillableReason(s *string) *ProofRequestEventCreate {
+	if s != nil {
+		prec.SetReason(*s)
+	}
+	return prec
+}
+
+// SetProverRequestID sets the "prover_request_id" field.
+func (prec *ProofRequestEventCreate) SetProverRequestID(s string) *ProofRequestEventCreate {
+	prec.mutation.SetProverRequestID(s)
+	return prec
+}
+
+// SetNillableProverRequestID sets the "prover_request_id" field if the given value is not nil.
+func (prec *ProofRequestEventCreate) SetNillableProverRequestID(s *string) *ProofRequestEventCreate {
+	if s != nil {
+		prec.SetProverRequestID(*s)
+	}
+	return prec
+}
+
+// SetProverResponse sets the "prover_response" field.
+func (prec *ProofRequestEventCreate) SetProverResponse(s string) *ProofRequestEventCreate {
+	prec.mutation.SetProverResponse(s)
+	return prec
+}
+
+// SetNillableProverResponse sets the "prover_response" field if the given value is not nil.
+func (prec *ProofRequestEventCreate) SetNillableProverResponse(s *string) *ProofRequestEventCreate {
+	if s != nil {
+		prec.SetProverResponse(*s)
+	}
+	return prec
+}
+
+// SetProofRequest sets the "proof_request" edge to the ProofRequest entity.
+func (prec *ProofRequestEventCreate) SetProofRequest(p *ProofRequest) *ProofRequestEventCreate {
+	return prec.SetProofRequestID(p.ID)
+}
+
+// Mutation returns the ProofRequestEventMutation object of the builder.
+func (prec *ProofRequestEventCreate) Mutation() *ProofRequestEventMutation {
+	return prec.mutation
+}
+
+// Save creates the ProofRequestEvent in the database.
+func (prec *ProofRequestEventCreate) Save(ctx context.Context) (*ProofRequestEvent, error) {
+	return withHooks(ctx, prec.sqlSave, prec.mutation, prec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prec *ProofRequestEventCreate) SaveX(ctx context.Context) *ProofRequestEvent {
+	v, err := prec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prec *ProofRequestEventCreate) Exec(ctx context.Context) error {
+	_, err := prec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prec *ProofRequestEventCreate) ExecX(ctx context.Context) {
+	if err := prec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prec *ProofRequestEventCreate) check() error {
+	if _, ok := prec.mutation.ProofRequestID(); !ok {
+		return &ValidationError{Name: "proof_request_id", err: errors.New(`ent: missing required field "ProofRequestEvent.proof_request_id"`)}
+	}
+	if v, ok := prec.mutation.FromStatus(); ok {
+		if err := proofrequestevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequestEvent.from_status": %w`, err)}
+		}
+	}
+	if _, ok := prec.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "ProofRequestEvent.to_status"`)}
+	}
+	if v, ok := prec.mutation.ToStatus(); ok {
+		if err := proofrequestevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequestEvent.to_status": %w`, err)}
+		}
+	}
+	if _, ok := prec.mutation.Time(); !ok {
+		return &ValidationError{Name: "time", err: errors.New(`ent: missing required field "ProofRequestEvent.time"`)}
+	}
+	if _, ok := prec.mutation.ProofRequestID(); !ok {
+		return &ValidationError{Name: "proof_request", err: errors.New(`ent: missing required edge "ProofRequestEvent.proof_request"`)}
+	}
+	return nil
+}
+
+func (prec *ProofRequestEventCreate) sqlSave(ctx context.Context) (*ProofRequestEvent, error) {
+	if err := prec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	prec.mutation.id = &_node.ID
+	prec.mutation.done = true
+	return _node, nil
+}
+
+func (prec *ProofRequestEventCreate) createSpec() (*ProofRequestEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProofRequestEvent{config: prec.config}
+		_spec = sqlgraph.NewCreateSpec(proofrequestevent.Table, sqlgraph.NewFieldSpec(proofrequestevent.FieldID, field.TypeInt))
+	)
+	if value, ok := prec.mutation.FromStatus(); ok {
+		_spec.SetField(proofrequestevent.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = value
+	}
+	if value, ok := prec.mutation.ToStatus(); ok {
+		_spec.SetField(proofrequestevent.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := prec.mutation.Time(); ok {
+		_spec.SetField(proofrequestevent.FieldTime, field.TypeUint64, value)
+		_node.Time = value
+	}
+	if value, ok := prec.mutation.Reason(); ok {
+		_spec.SetField(proofrequestevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := prec.mutation.ProverRequestID(); ok {
+		_spec.SetField(proofrequestevent.FieldProverRequestID, field.TypeString, value)
+		_node.ProverRequestID = value
+	}
+	if value, ok := prec.mutation.ProverResponse(); ok {
+		_spec.SetField(proofrequestevent.FieldProverResponse, field.TypeString, value)
+		_node.ProverResponse = value
+	}
+	if nodes := prec.mutation.ProofRequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequestevent.ProofRequestTable,
+			Columns: []string{proofrequestevent.ProofRequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProofRequestID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProofRequestEventCreateBulk is the builder for creating many ProofRequestEvent entities in bulk.
+type ProofRequestEventCreateBulk struct {
+	config
+	err      error
+	builders []*ProofRequestEventCreate
+}
+
+// Save creates the ProofRequestEvent entities in the database.
+func (precb *ProofRequestEventCreateBulk) Save(ctx context.Context) ([]*ProofRequestEvent, error) {
+	if precb.err != nil {
+		return nil, precb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(precb.builders))
+	nodes := make([]*ProofRequestEvent, len(precb.builders))
+	mutators := make([]Mutator, len(precb.builders))
+	for i := range precb.builders {
+		func(i int, root context.Context) {
+			builder := precb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProofRequestEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, precb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, precb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, precb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (precb *ProofRequestEventCreateBulk) SaveX(ctx context.Context) []*ProofRequestEvent {
+	v, err := precb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (precb *ProofRequestEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := precb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (precb *ProofRequestEventCreateBulk) ExecX(ctx context.Context) {
+	if err := precb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
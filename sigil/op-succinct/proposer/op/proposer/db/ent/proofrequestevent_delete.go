@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+// ProofRequestEventDelete is the builder for deleting a ProofRequestEvent entity.
+type ProofRequestEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProofRequestEventMutation
+}
+
+// Where appends a list predicates to the ProofRequestEventDelete builder.
+func (pred *ProofRequestEventDelete) Where(ps ...predicate.ProofRequestEvent) *ProofRequestEventDelete {
+	pred.mutation.Where(ps...)
+	return pred
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pred *ProofRequestEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pred.sqlExec, pred.mutation, pred.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pred *ProofRequestEventDelete) ExecX(ctx context.Context) int {
+	n, err := pred.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pred *ProofRequestEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(proofrequestevent.Table, sqlgraph.NewFieldSpec(proofrequestevent.FieldID, field.TypeInt))
+	if ps := pred.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pred.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pred.mutation.done = true
+	return affected, err
+}
+
+// ProofRequestEventDeleteOne is the builder for deleting a single ProofRequestEvent entity.
+type ProofRequestEventDeleteOne struct {
+	pred *ProofRequestEventDelete
+}
+
+// Where appends a list predicates to the ProofRequestEventDelete builder.
+func (predo *ProofRequestEventDeleteOne) Where(ps ...predicate.ProofRequestEvent) *ProofRequestEventDeleteOne {
+	predo.pred.mutation.Where(ps...)
+	return predo
+}
+
+// Exec executes the deletion query.
+func (predo *ProofRequestEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := predo.pred.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{proofrequestevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (predo *ProofRequestEventDeleteOne) ExecX(ctx context.Context) {
+	if err := predo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+// ProofRequestEventQuery is the builder for querying ProofRequestEvent entities.
+type ProofRequestEventQuery struct {
+	config
+	ctx              *QueryContext
+	order            []proofrequestevent.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.ProofRequestEvent
+	withProofRequest *ProofRequestQuery
+	modifiers        []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProofRequestEventQuery builder.
+func (preq *ProofRequestEventQuery) Where(ps ...predicate.ProofRequestEvent) *ProofRequestEventQuery {
+	preq.predicates = append(preq.predicates, ps...)
+	return preq
+}
+
+// Limit the number of records to be returned by this query.
+func (preq *ProofRequestEventQuery) Limit(limit int) *ProofRequestEventQuery {
+	preq.ctx.Limit = &limit
+	return preq
+}
+
+// Offset to start from.
+func (preq *ProofRequestEventQuery) Offset(offset int) *ProofRequestEventQuery {
+	preq.ctx.Offset = &offset
+	return preq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (preq *ProofRequestEventQuery) Unique(unique bool) *ProofRequestEventQuery {
+	preq.ctx.Unique = &unique
+	return preq
+}
+
+// Order specifies how the records should be ordered.
+func (preq *ProofRequestEventQuery) Order(o ...proofrequestevent.OrderOption) *ProofRequestEventQuery {
+	preq.order = append(preq.order, o...)
+	return preq
+}
+
+// QueryProofRequest chains the current query on the "proof_request" edge.
+func (preq *ProofRequestEventQuery) QueryProofRequest() *ProofRequestQuery {
+	query := (&ProofRequestClient{config: preq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := preq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := preq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(proofrequestevent.Table, proofrequestevent.FieldID, selector),
+			sqlgraph.To(proofrequest.Table, proofrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, proofrequestevent.ProofRequestTable, proofrequestevent.ProofRequestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(preq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProofRequestEvent entity from the query.
+// Returns a *NotFoundError when no ProofRequestEvent was found.
+func (preq *ProofRequestEventQuery) First(ctx context.Context) (*ProofRequestEvent, error) {
+	nodes, err := preq.Limit(1).All(setContextOp(ctx, preq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{proofrequestevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (preq *ProofRequestEventQuery) FirstX(ctx context.Context) *ProofRequestEvent {
+	node, err := preq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProofRequestEvent ID from the query.
+// Returns a *NotFoundError when no ProofRequestEvent ID was found.
+func (preq *ProofRequestEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = preq.Limit(1).IDs(setContextOp(ctx, preq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{proofrequestevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (preq *ProofRequestEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := preq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProofRequestEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProofRequestEvent entity is found.
+// Returns a *NotFoundError when no ProofRequestEvent entities are found.
+func (preq *ProofRequestEventQuery) Only(ctx context.Context) (*ProofRequestEvent, error) {
+	nodes, err := preq.Limit(2).All(setContextOp(ctx, preq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{proofrequestevent.Label}
+	default:
+		return nil, &NotSingularError{proofrequestevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (preq *ProofRequestEventQuery) OnlyX(ctx context.Context) *ProofRequestEvent {
+	node, err := preq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProofRequestEvent ID in the query.
+// Returns a *NotSingularError when more than one ProofRequestEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (preq *ProofRequestEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = preq.Limit(2).IDs(setContextOp(ctx, preq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{proofrequestevent.Label}
+	default:
+		err = &NotSingularError{proofrequestevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (preq *ProofRequestEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := preq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProofRequestEvents.
+func (preq *ProofRequestEventQuery) All(ctx context.Context) ([]*ProofRequestEvent, error) {
+	ctx = setContextOp(ctx, preq.ctx, "All")
+	if err := preq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProofRequestEvent, *ProofRequestEventQuery]()
+	return withInterceptors[[]*ProofRequestEvent](ctx, preq, qr, preq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (preq *ProofRequestEventQuery) AllX(ctx context.Context) []*ProofRequestEvent {
+	nodes, err := preq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProofRequestEvent IDs.
+func (preq *ProofRequestEventQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if preq.ctx.Unique == nil && preq.path != nil {
+		preq.Unique(true)
+	}
+	ctx = setContextOp(ctx, preq.ctx, "IDs")
+	if err = preq.Select(proofrequestevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (preq *ProofRequestEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := preq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (preq *ProofRequestEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, preq.ctx, "Count")
+	if err := preq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, preq, querierCount[*ProofRequestEventQuery](), preq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (preq *ProofRequestEventQuery) CountX(ctx context.Context) int {
+	count, err := preq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (preq *ProofRequestEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, preq.ctx, "Exist")
+	switch _, err := preq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (preq *ProofRequestEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := preq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProofRequestEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (preq *ProofRequestEventQuery) Clone() *ProofRequestEventQuery {
+	if preq == nil {
+		return nil
+	}
+	return &ProofRequestEventQuery{
+		config:           preq.config,
+		ctx:              preq.ctx.Clone(),
+		order:            append([]proofrequestevent.OrderOption{}, preq.order...),
+		inters:           append([]Interceptor{}, preq.inters...),
+		predicates:       append([]predicate.ProofRequestEvent{}, preq.predicates...),
+		withProofRequest: preq.withProofRequest.Clone(),
+		// clone intermediate query.
+		sql:  preq.sql.Clone(),
+		path: preq.path,
+	}
+}
+
+// WithProofRequest tells the query-builder to eager-load the nodes that are connected to
+// the "proof_request" edge. The optional arguments are used to configure the query builder of the edge.
+func (preq *ProofRequestEventQuery) WithProofRequest(opts ...func(*ProofRequestQuery)) *ProofRequestEventQuery {
+	query := (&ProofRequestClient{config: preq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	preq.withProofRequest = query
+	return preq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProofRequestID int `json:"proof_request_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProofRequestEvent.Query().
+//		GroupBy(proofrequestevent.FieldProofRequestID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (preq *ProofRequestEventQuery) GroupBy(field string, fields ...string) *ProofRequestEventGroupBy {
+	preq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProofRequestEventGroupBy{build: preq}
+	grbuild.flds = &preq.ctx.Fields
+	grbuild.label = proofrequestevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProofRequestID int `json:"proof_request_id,omitempty"`
+//	}
+//
+//	client.ProofRequestEvent.Query().
+//		Select(proofrequestevent.FieldProofRequestID).
+//		Scan(ctx, &v)
+func (preq *ProofRequestEventQuery) Select(fields ...string) *ProofRequestEventSelect {
+	preq.ctx.Fields = append(preq.ctx.Fields, fields...)
+	sbuild := &ProofRequestEventSelect{ProofRequestEventQuery: preq}
+	sbuild.label = proofrequestevent.Label
+	sbuild.flds, sbuild.scan = &preq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProofRequestEventSelect configured with the given aggregations.
+func (preq *ProofRequestEventQuery) Aggregate(fns ...AggregateFunc) *ProofRequestEventSelect {
+	return preq.Select().Aggregate(fns...)
+}
+
+func (preq *ProofRequestEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range preq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, preq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range preq.ctx.Fields {
+		if !proofrequestevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if preq.path != nil {
+		prev, err := preq.path(ctx)
+		if err != nil {
+			return err
+		}
+		preq.sql = prev
+	}
+	return nil
+}
+
+func (preq *ProofRequestEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProofRequestEvent, error) {
+	var (
+		nodes       = []*ProofRequestEvent{}
+		_spec       = preq.querySpec()
+		loadedTypes = [1]bool{
+			preq.withProofRequest != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProofRequestEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProofRequestEvent{config: preq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(preq.modifiers) > 0 {
+		_spec.Modifiers = preq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, preq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := preq.withProofRequest; query != nil {
+		if err := preq.loadProofRequest(ctx, query, nodes, nil,
+			func(n *ProofRequestEvent, e *ProofRequest) { n.Edges.ProofRequest = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (preq *ProofRequestEventQuery) loadProofRequest(ctx context.Context, query *ProofRequestQuery, nodes []*ProofRequestEvent, init func(*ProofRequestEvent), assign func(*ProofRequestEvent, *ProofRequest)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ProofRequestEvent)
+	for i := range nodes {
+		fk := nodes[i].ProofRequestID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(proofrequest.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "proof_request_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (preq *ProofRequestEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := preq.querySpec()
+	if len(preq.modifiers) > 0 {
+		_spec.Modifiers = preq.modifiers
+	}
+	_spec.Node.Columns = preq.ctx.Fields
+	if len(preq.ctx.Fields) > 0 {
+		_spec.Unique = preq.ctx.Unique != nil && *preq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, preq.driver, _spec)
+}
+
+func (preq *ProofRequestEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(proofrequestevent.Table, proofrequestevent.Columns, sqlgraph.NewFieldSpec(proofrequestevent.FieldID, field.TypeInt))
+	_spec.From = preq.sql
+	if unique := preq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if preq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := preq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, proofrequestevent.FieldID)
+		for i := range fields {
+			if fields[i] != proofrequestevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if preq.withProofRequest != nil {
+			_spec.Node.AddColumnOnce(proofrequestevent.FieldProofRequestID)
+		}
+	}
+	if ps := preq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := preq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := preq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := preq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (preq *ProofRequestEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(preq.driver.Dialect())
+	t1 := builder.Table(proofrequestevent.Table)
+	columns := preq.ctx.Fields
+	if len(columns) == 0 {
+		columns = proofrequestevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if preq.sql != nil {
+		selector = preq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if preq.ctx.Unique != nil && *preq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range preq.modifiers {
+		m(selector)
+	}
+	for _, p := range preq.predicates {
+		p(selector)
+	}
+	for _, p := range preq.order {
+		p(selector)
+	}
+	if offset := preq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := preq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (preq *ProofRequestEventQuery) ForUpdate(opts ...sql.LockOption) *ProofRequestEventQuery {
+	if preq.driver.Dialect() == dialect.Postgres {
+		preq.Unique(false)
+	}
+	preq.modifiers = append(preq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return preq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (preq *ProofRequestEventQuery) ForShare(opts ...sql.LockOption) *ProofRequestEventQuery {
+	if preq.driver.Dialect() == dialect.Postgres {
+		preq.Unique(false)
+	}
+	preq.modifiers = append(preq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return preq
+}
+
+// ProofRequestEventGroupBy is the group-by builder for ProofRequestEvent entities.
+type ProofRequestEventGroupBy struct {
+	selector
+	build *ProofRequestEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pregb *ProofRequestEventGroupBy) Aggregate(fns ...AggregateFunc) *ProofRequestEventGroupBy {
+	pregb.fns = append(pregb.fns, fns...)
+	return pregb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pregb *ProofRequestEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pregb.build.ctx, "GroupBy")
+	if err := pregb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProofRequestEventQuery, *ProofRequestEventGroupBy](ctx, pregb.build, pregb, pregb.build.inters, v)
+}
+
+func (pregb *ProofRequestEventGroupBy) sqlScan(ctx context.Context, root *ProofRequestEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pregb.fns))
+	for _, fn := range pregb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pregb.flds)+len(pregb.fns))
+		for _, f := range *pregb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pregb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pregb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProofRequestEventSelect is the builder for selecting fields of ProofRequestEvent entities.
+type ProofRequestEventSelect struct {
+	*ProofRequestEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pres *ProofRequestEventSelect) Aggregate(fns ...AggregateFunc) *ProofRequestEventSelect {
+	pres.fns = append(pres.fns, fns...)
+	return pres
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pres *ProofRequestEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pres.ctx, "Select")
+	if err := pres.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProofRequestEventQuery, *ProofRequestEventSelect](ctx, pres.ProofRequestEventQuery, pres, pres.inters, v)
+}
+
+func (pres *ProofRequestEventSelect) sqlScan(ctx context.Context, root *ProofRequestEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pres.fns))
+	for _, fn := range pres.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pres.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pres.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
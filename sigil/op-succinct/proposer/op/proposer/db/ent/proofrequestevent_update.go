@@ -0,0 +1,622 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+// ProofRequestEventUpdate is the builder for updating ProofRequestEvent entities.
+type ProofRequestEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProofRequestEventMutation
+}
+
+// Where appends a list predicates to the ProofRequestEventUpdate builder.
+func (preu *ProofRequestEventUpdate) Where(ps ...predicate.ProofRequestEvent) *ProofRequestEventUpdate {
+	preu.mutation.Where(ps...)
+	return preu
+}
+
+// SetProofRequestID sets the "proof_request_id" field.
+func (preu *ProofRequestEventUpdate) SetProofRequestID(i int) *ProofRequestEventUpdate {
+	preu.mutation.SetProofRequestID(i)
+	return preu
+}
+
+// SetNillableProofRequestID sets the "proof_request_id" field if the given value is not nil.
+func (preu *ProofRequestEventUpdate) SetNillableProofRequestID(i *int) *ProofRequestEventUpdate {
+	if i != nil {
+		preu.SetProofRequestID(*i)
+	}
+	return preu
+}
+
+// SetFromStatus sets the "from_status" field.
+func (preu *ProofRequestEventUpdate) SetFromStatus(ps proofrequestevent.FromStatus) *ProofRequestEventUpdate {
+	preu.mutation.SetFromStatus(ps)
+	return preu
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (preu *ProofRequestEventUpdate) SetNillableFromStatus(ps *proofrequestevent.FromStatus) *ProofRequestEventUpdate {
+	if ps != nil {
+		preu.SetFromStatus(*ps)
+	}
+	return preu
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (preu *ProofRequestEventUpdate) ClearFromStatus() *ProofRequestEventUpdate {
+	preu.mutation.ClearFromStatus()
+	return preu
+}
+
+// SetToStatus sets the "to_status" field.
+func (preu *ProofRequestEventUpdate) SetToStatus(ps proofrequestevent.ToStatus) *ProofRequestEventUpdate {
+	preu.mutation.SetToStatus(ps)
+	return preu
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (preu *ProofRequestEventUpdate) SetNillableToStatus(ps *proofrequestevent.ToStatus) *ProofRequestEventUpdate {
+	if ps != nil {
+		preu.SetToStatus(*ps)
+	}
+	return preu
+}
+
+// SetTime sets the "time" field.
+func (preu *ProofRequestEventUpdate) SetTime(u uint64) *ProofRequestEventUpdate {
+	preu.mutation.ResetTime()
+	preu.mutation.SetTime(u)
+	return preu
+}
+
+// SetNillableTime sets the "time" field if the given value is not nil.
+func (preu *ProofRequestEventUpdate) SetNillableTime(u *uint64) *ProofRequestEventUpdate {
+	if u != nil {
+		preu.SetTime(*u)
+	}
+	return preu
+}
+
+// AddTime adds u to the "time" field.
+func (preu *ProofRequestEventUpdate) AddTime(u int64) *ProofRequestEventUpdate {
+	preu.mutation.AddTime(u)
+	return preu
+}
+
+// SetReason sets the "reason" field.
+func (preu *ProofRequestEventUpdate) SetReason(s string) *ProofRequestEventUpdate {
+	preu.mutation.SetReason(s)
+	return preu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (preu *ProofRequestEventUpdate) SetNillableReason(s *string) *ProofRequestEventUpdate {
+	if s != nil {
+		preu.SetReason(*s)
+	}
+	return preu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (preu *ProofRequestEventUpdate) ClearReason() *ProofRequestEventUpdate {
+	preu.mutation.ClearReason()
+	return preu
+}
+
+// SetProverRequestID sets the "prover_request_id" field.
+func (preu *ProofRequestEventUpdate) SetProverRequestID(s string) *ProofRequestEventUpdate {
+	preu.mutation.SetProverRequestID(s)
+	return preu
+}
+
+// SetNillableProverRequestID sets the "prover_request_id" field if the given value is not nil.
+func (preu *ProofRequestEventUpdate) SetNillableProverRequestID(s *string) *ProofRequestEventUpdate {
+	if s != nil {
+		preu.SetProverRequestID(*s)
+	}
+	return preu
+}
+
+// ClearProverRequestID clears the value of the "prover_request_id" field.
+func (preu *ProofRequestEventUpdate) ClearProverRequestID() *ProofRequestEventUpdate {
+	preu.mutation.ClearProverRequestID()
+	return preu
+}
+
+// SetProverResponse sets the "prover_response" field.
+func (preu *ProofRequestEventUpdate) SetProverResponse(s string) *ProofRequestEventUpdate {
+	preu.mutation.SetProverResponse(s)
+	return preu
+}
+
+// SetNillableProverResponse sets the "prover_response" field if the given value is not nil.
+func (preu *ProofRequestEventUpdate) SetNillableProverResponse(s *string) *ProofRequestEventUpdate {
+	if s != nil {
+		preu.SetProverResponse(*s)
+	}
+	return preu
+}
+
+// ClearProverResponse clears the value of the "prover_response" field.
+func (preu *ProofRequestEventUpdate) ClearProverResponse() *ProofRequestEventUpdate {
+	preu.mutation.ClearProverResponse()
+	return preu
+}
+
+// SetProofRequest sets the "proof_request" edge to the ProofRequest entity.
+func (preu *ProofRequestEventUpdate) SetProofRequest(p *ProofRequest) *ProofRequestEventUpdate {
+	return preu.SetProofRequestID(p.ID)
+}
+
+// Mutation returns the ProofRequestEventMutation object of the builder.
+func (preu *ProofRequestEventUpdate) Mutation() *ProofRequestEventMutation {
+	return preu.mutation
+}
+
+// ClearProofRequest clears the "proof_request" edge to the ProofRequest entity.
+func (preu *ProofRequestEventUpdate) ClearProofRequest() *ProofRequestEventUpdate {
+	preu.mutation.ClearProofRequest()
+	return preu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (preu *ProofRequestEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, preu.sqlSave, preu.mutation, preu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (preu *ProofRequestEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := preu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (preu *ProofRequestEventUpdate) Exec(ctx context.Context) error {
+	_, err := preu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (preu *ProofRequestEventUpdate) ExecX(ctx context.Context) {
+	if err := preu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (preu *ProofRequestEventUpdate) check() error {
+	if v, ok := preu.mutation.FromStatus(); ok {
+		if err := proofrequestevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequestEvent.from_status": %w`, err)}
+		}
+	}
+	if v, ok := preu.mutation.ToStatus(); ok {
+		if err := proofrequestevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequestEvent.to_status": %w`, err)}
+		}
+	}
+	if _, ok := preu.mutation.ProofRequestID(); preu.mutation.ProofRequestCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProofRequestEvent.proof_request"`)
+	}
+	return nil
+}
+
+func (preu *ProofRequestEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := preu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(proofrequestevent.Table, proofrequestevent.Columns, sqlgraph.NewFieldSpec(proofrequestevent.FieldID, field.TypeInt))
+	if ps := preu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := preu.mutation.FromStatus(); ok {
+		_spec.SetField(proofrequestevent.FieldFromStatus, field.TypeEnum, value)
+	}
+	if preu.mutation.FromStatusCleared() {
+		_spec.ClearField(proofrequestevent.FieldFromStatus, field.TypeEnum)
+	}
+	if value, ok := preu.mutation.ToStatus(); ok {
+		_spec.SetField(proofrequestevent.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := preu.mutation.Time(); ok {
+		_spec.SetField(proofrequestevent.FieldTime, field.TypeUint64, value)
+	}
+	if value, ok := preu.mutation.AddedTime(); ok {
+		_spec.AddField(proofrequestevent.FieldTime, field.TypeUint64, value)
+	}
+	if value, ok := preu.mutation.Reason(); ok {
+		_spec.SetField(proofrequestevent.FieldReason, field.TypeString, value)
+	}
+	if preu.mutation.ReasonCleared() {
+		_spec.ClearField(proofrequestevent.FieldReason, field.TypeString)
+	}
+	if value, ok := preu.mutation.ProverRequestID(); ok {
+		_spec.SetField(proofrequestevent.FieldProverRequestID, field.TypeString, value)
+	}
+	if preu.mutation.ProverRequestIDCleared() {
+		_spec.ClearField(proofrequestevent.FieldProverRequestID, field.TypeString)
+	}
+	if value, ok := preu.mutation.ProverResponse(); ok {
+		_spec.SetField(proofrequestevent.FieldProverResponse, field.TypeString, value)
+	}
+	if preu.mutation.ProverResponseCleared() {
+		_spec.ClearField(proofrequestevent.FieldProverResponse, field.TypeString)
+	}
+	if preu.mutation.ProofRequestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequestevent.ProofRequestTable,
+			Columns: []string{proofrequestevent.ProofRequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := preu.mutation.ProofRequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequestevent.ProofRequestTable,
+			Columns: []string{proofrequestevent.ProofRequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, preu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{proofrequestevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	preu.mutation.done = true
+	return n, nil
+}
+
+// ProofRequestEventUpdateOne is the builder for updating a single ProofRequestEvent entity.
+type ProofRequestEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProofRequestEventMutation
+}
+
+// SetProofRequestID sets the "proof_request_id" field.
+func (preuo *ProofRequestEventUpdateOne) SetProofRequestID(i int) *ProofRequestEventUpdateOne {
+	preuo.mutation.SetProofRequestID(i)
+	return preuo
+}
+
+// SetNillableProofRequestID sets the "proof_request_id" field if the given value is not nil.
+func (preuo *ProofRequestEventUpdateOne) SetNillableProofRequestID(i *int) *ProofRequestEventUpdateOne {
+	if i != nil {
+		preuo.SetProofRequestID(*i)
+	}
+	return preuo
+}
+
+// SetFromStatus sets the "from_status" field.
+func (preuo *ProofRequestEventUpdateOne) SetFromStatus(ps proofrequestevent.FromStatus) *ProofRequestEventUpdateOne {
+	preuo.mutation.SetFromStatus(ps)
+	return preuo
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (preuo *ProofRequestEventUpdateOne) SetNillableFromStatus(ps *proofrequestevent.FromStatus) *ProofRequestEventUpdateOne {
+	if ps != nil {
+		preuo.SetFromStatus(*ps)
+	}
+	return preuo
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (preuo *ProofRequestEventUpdateOne) ClearFromStatus() *ProofRequestEventUpdateOne {
+	preuo.mutation.ClearFromStatus()
+	return preuo
+}
+
+// SetToStatus sets the "to_status" field.
+func (preuo *ProofRequestEventUpdateOne) SetToStatus(ps proofrequestevent.ToStatus) *ProofRequestEventUpdateOne {
+	preuo.mutation.SetToStatus(ps)
+	return preuo
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (preuo *ProofRequestEventUpdateOne) SetNillableToStatus(ps *proofrequestevent.ToStatus) *ProofRequestEventUpdateOne {
+	if ps != nil {
+		preuo.SetToStatus(*ps)
+	}
+	return preuo
+}
+
+// SetTime sets the "time" field.
+func (preuo *ProofRequestEventUpdateOne) SetTime(u uint64) *ProofRequestEventUpdateOne {
+	preuo.mutation.ResetTime()
+	preuo.mutation.SetTime(u)
+	return preuo
+}
+
+// SetNillableTime sets the "time" field if the given value is not nil.
+func (preuo *ProofRequestEventUpdateOne) SetNillableTime(u *uint64) *ProofRequestEventUpdateOne {
+	if u != nil {
+		preuo.SetTime(*u)
+	}
+	return preuo
+}
+
+// AddTime adds u to the "time" field.
+func (preuo *ProofRequestEventUpdateOne) AddTime(u int64) *ProofRequestEventUpdateOne {
+	preuo.mutation.AddTime(u)
+	return preuo
+}
+
+// SetReason sets the "reason" field.
+func (preuo *ProofRequestEventUpdateOne) SetReason(s string) *ProofRequestEventUpdateOne {
+	preuo.mutation.SetReason(s)
+	return preuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (preuo *ProofRequestEventUpdateOne) SetNillableReason(s *string) *ProofRequestEventUpdateOne {
+	if s != nil {
+		preuo.SetReason(*s)
+	}
+	return preuo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (preuo *ProofRequestEventUpdateOne) ClearReason() *ProofRequestEventUpdateOne {
+	preuo.mutation.ClearReason()
+	return preuo
+}
+
+// SetProverRequestID sets the "prover_request_id" field.
+func (preuo *ProofRequestEventUpdateOne) SetProverRequestID(s string) *ProofRequestEventUpdateOne {
+	preuo.mutation.SetProverRequestID(s)
+	return preuo
+}
+
+// SetNillableProverRequestID sets the "prover_request_id" field if the given value is not nil.
+func (preuo *ProofRequestEventUpdateOne) SetNillableProverRequestID(s *string) *ProofRequestEventUpdateOne {
+	if s != nil {
+		preuo.SetProverRequestID(*s)
+	}
+	return preuo
+}
+
+// ClearProverRequestID clears the value of the "prover_request_id" field.
+func (preuo *ProofRequestEventUpdateOne) ClearProverRequestID() *ProofRequestEventUpdateOne {
+	preuo.mutation.ClearProverRequestID()
+	return preuo
+}
+
+// SetProverResponse sets the "prover_response" field.
+func (preuo *ProofRequestEventUpdateOne) SetProverResponse(s string) *ProofRequestEventUpdateOne {
+	preuo.mutation.SetProverResponse(s)
+	return preuo
+}
+
+// SetNillableProverResponse sets the "prover_response" field if the given value is not nil.
+func (preuo *ProofRequestEventUpdateOne) SetNillableProverResponse(s *string) *ProofRequestEventUpdateOne {
+	if s != nil {
+		preuo.SetProverResponse(*s)
+	}
+	return preuo
+}
+
+// ClearProverResponse clears the value of the "prover_response" field.
+func (preuo *ProofRequestEventUpdateOne) ClearProverResponse() *ProofRequestEventUpdateOne {
+	preuo.mutation.ClearProverResponse()
+	return preuo
+}
+
+// SetProofRequest sets the "proof_request" edge to the ProofRequest entity.
+func (preuo *ProofRequestEventUpdateOne) SetProofRequest(p *ProofRequest) *ProofRequestEventUpdateOne {
+	return preuo.SetProofRequestID(p.ID)
+}
+
+// Mutation returns the ProofRequestEventMutation object of the builder.
+func (preuo *ProofRequestEventUpdateOne) Mutation() *ProofRequestEventMutation {
+	return preuo.mutation
+}
+
+// ClearProofRequest clears the "proof_request" edge to the ProofRequest entity.
+func (preuo *ProofRequestEventUpdateOne) ClearProofRequest() *ProofRequestEventUpdateOne {
+	preuo.mutation.ClearProofRequest()
+	return preuo
+}
+
+// Where appends a list predicates to the ProofRequestEventUpdate builder.
+func (preuo *ProofRequestEventUpdateOne) Where(ps ...predicate.ProofRequestEvent) *ProofRequestEventUpdateOne {
+	preuo.mutation.Where(ps...)
+	return preuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (preuo *ProofRequestEventUpdateOne) Select(field string, fields ...string) *ProofRequestEventUpdateOne {
+	preuo.fields = append([]string{field}, fields...)
+	return preuo
+}
+
+// Save executes the query and returns the updated ProofRequestEvent entity.
+func (preuo *ProofRequestEventUpdateOne) Save(ctx context.Context) (*ProofRequestEvent, error) {
+	return withHooks(ctx, preuo.sqlSave, preuo.mutation, preuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (preuo *ProofRequestEventUpdateOne) SaveX(ctx context.Context) *ProofRequestEvent {
+	node, err := preuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (preuo *ProofRequestEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := preuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (preuo *ProofRequestEventUpdateOne) ExecX(ctx context.Context) {
+	if err := preuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (preuo *ProofRequestEventUpdateOne) check() error {
+	if v, ok := preuo.mutation.FromStatus(); ok {
+		if err := proofrequestevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequestEvent.from_status": %w`, err)}
+		}
+	}
+	if v, ok := preuo.mutation.ToStatus(); ok {
+		if err := proofrequestevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequestEvent.to_status": %w`, err)}
+		}
+	}
+	if _, ok := preuo.mutation.ProofRequestID(); preuo.mutation.ProofRequestCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProofRequestEvent.proof_request"`)
+	}
+	return nil
+}
+
+func (preuo *ProofRequestEventUpdateOne) sqlSave(ctx context.Context) (_node *ProofRequestEvent, err error) {
+	if err := preuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(proofrequestevent.Table, proofrequestevent.Columns, sqlgraph.NewFieldSpec(proofrequestevent.FieldID, field.TypeInt))
+	id, ok := preuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProofRequestEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := preuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, proofrequestevent.FieldID)
+		for _, f := range fields {
+			if !proofrequestevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != proofrequestevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := preuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := preuo.mutation.FromStatus(); ok {
+		_spec.SetField(proofrequestevent.FieldFromStatus, field.TypeEnum, value)
+	}
+	if preuo.mutation.FromStatusCleared() {
+		_spec.ClearField(proofrequestevent.FieldFromStatus, field.TypeEnum)
+	}
+	if value, ok := preuo.mutation.ToStatus(); ok {
+		_spec.SetField(proofrequestevent.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := preuo.mutation.Time(); ok {
+		_spec.SetField(proofrequestevent.FieldTime, field.TypeUint64, value)
+	}
+	if value, ok := preuo.mutation.AddedTime(); ok {
+		_spec.AddField(proofrequestevent.FieldTime, field.TypeUint64, value)
+	}
+	if value, ok := preuo.mutation.Reason(); ok {
+		_spec.SetField(proofrequestevent.FieldReason, field.TypeString, value)
+	}
+	if preuo.mutation.ReasonCleared() {
+		_spec.ClearField(proofrequestevent.FieldReason, field.TypeString)
+	}
+	if value, ok := preuo.mutation.ProverRequestID(); ok {
+		_spec.SetField(proofrequestevent.FieldProverRequestID, field.TypeString, value)
+	}
+	if preuo.mutation.ProverRequestIDCleared() {
+		_spec.ClearField(proofrequestevent.FieldProverRequestID, field.TypeString)
+	}
+	if value, ok := preuo.mutation.ProverResponse(); ok {
+		_spec.SetField(proofrequestevent.FieldProverResponse, field.TypeString, value)
+	}
+	if preuo.mutation.ProverResponseCleared() {
+		_spec.ClearField(proofrequestevent.FieldProverResponse, field.TypeString)
+	}
+	if preuo.mutation.ProofRequestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequestevent.ProofRequestTable,
+			Columns: []string{proofrequestevent.ProofRequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := preuo.mutation.ProofRequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequestevent.ProofRequestTable,
+			Columns: []string{proofrequestevent.ProofRequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProofRequestEvent{config: preuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, preuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{proofrequestevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	preuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// statuses are the statuses a proof request goes through.
+var statuses = []string{"UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE", "CANCELLED"}
+
+// ProofRequest holds the schema definition for the ProofRequest entity.
+type ProofRequest struct {
+	ent.Schema
+}
+
+func (ProofRequest) Annotations() []schema.Annotation {
+	// Use STRICT mode to enforce strong typing.
+	return []schema.Annotation{
+		entsql.Annotation{Table: "proof_requests", Options: "STRICT"},
+	}
+}
+
+// Fields of the ProofRequest.
+func (ProofRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").Values("SPAN", "AGG"),
+		field.Uint64("start_block"),
+		field.Uint64("end_block"),
+		field.Enum("status").Values(statuses...),
+		field.Uint64("request_added_time"),
+		field.String("prover_request_id").Optional(),
+		field.Uint64("proof_request_time").Optional(),
+		field.Uint64("last_updated_time"),
+		field.Uint64("l1_block_number").Optional(),
+		field.String("l1_block_hash").Optional(),
+		field.Bytes("proof").Optional(),
+		// The proposer replica that claimed the request, when multiple replicas share a database.
+		field.String("replica_id").Optional(),
+		// The cycles used to execute the range program and the L2 gas used by the blocks of a completed SPAN proof.
+		field.Uint64("cycles").Optional(),
+		field.Uint64("gas_used").Optional(),
+		// The prover backend the request is routed to. Set when the request is claimed, or when it is created to move
+		// a timed out proof to another backend.
+		field.String("prover_backend").Optional(),
+	}
+}
+
+// Edges of the ProofRequest.
+func (ProofRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("events", ProofRequestEvent.Type),
+	}
+}
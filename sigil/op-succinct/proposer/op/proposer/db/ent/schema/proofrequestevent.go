@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProofRequestEvent holds the schema definition for the ProofRequestEvent entity. An event is recorded for every
+// status transition of a proof request, so that the history of a request is kept after its status is overwritten.
+type ProofRequestEvent struct {
+	ent.Schema
+}
+
+func (ProofRequestEvent) Annotations() []schema.Annotation {
+	// Use STRICT mode to enforce strong typing.
+	return []schema.Annotation{
+		entsql.Annotation{Table: "proof_request_events", Options: "STRICT"},
+	}
+}
+
+// Fields of the ProofRequestEvent.
+func (ProofRequestEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("proof_request_id"),
+		// The status before the transition. Not set for the event that creates the request.
+		field.Enum("from_status").Values(statuses...).Optional(),
+		field.Enum("to_status").Values(statuses...),
+		field.Uint64("time"),
+		// Why the transition happened, e.g. why a request failed.
+		field.String("reason").Optional(),
+		field.String("prover_request_id").Optional(),
+		// The fulfillment and execution status reported by the prover, if the transition was caused by it.
+		field.String("prover_response").Optional(),
+	}
+}
+
+// Edges of the ProofRequestEvent.
+func (ProofRequestEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("proof_request", ProofRequest.Type).
+			Ref("events").
+			Field("proof_request_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ProofRequestEvent.
+func (ProofRequestEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("proof_request_id", "time"),
+	}
+}
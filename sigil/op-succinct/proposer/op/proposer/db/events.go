@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+// StageMetricer records how long proof requests spend in each status.
+type StageMetricer interface {
+	RecordProofStageDuration(proofType, stage, result string, blocks uint64, duration time.Duration)
+}
+
+// SetMetrics sets the metricer that records the time spent by proof requests in each status.
+func (db *ProofDB) SetMetrics(m StageMetricer) {
+	db.metrics = m
+}
+
+// stageTransition is a status transition of a proof request, along with the time spent in the previous status.
+type stageTransition struct {
+	proofType proofrequest.Type
+	from      proofrequest.Status
+	to        proofrequest.Status
+	blocks    uint64
+	duration  time.Duration
+}
+
+// recordStageTransitions records the transitions in the metrics. It is called once the transaction that made the
+// transitions has been committed.
+func (db *ProofDB) recordStageTransitions(transitions ...stageTransition) {
+	if db.metrics == nil {
+		return
+	}
+	for _, t := range transitions {
+		db.metrics.RecordProofStageDuration(string(t.proofType), string(t.from), string(t.to), t.blocks, t.duration)
+	}
+}
+
+// createProofRequest saves a new proof request in the transaction, along with the event recording its creation.
+func createProofRequest(ctx context.Context, tx *ent.Tx, create *ent.ProofRequestCreate, reason string) (*ent.ProofRequest, error) {
+	req, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event := tx.ProofRequestEvent.Create().
+		SetProofRequestID(req.ID).
+		SetToStatus(proofrequestevent.ToStatus(req.Status)).
+		SetTime(req.RequestAddedTime)
+	if reason != "" {
+		event.SetReason(reason)
+	}
+	if err := event.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to record creation event: %w", err)
+	}
+
+	return req, nil
+}
+
+// transitionProofRequest saves the update of the proof request in the transaction, setting its status to the given
+// status, and records the transition as an event. The update may set other fields along with the status.
+func transitionProofRequest(ctx context.Context, tx *ent.Tx, req *ent.ProofRequest, update *ent.ProofRequestUpdateOne, to proofrequest.Status, reason, proverResponse string) (stageTransition, error) {
+	now := uint64(time.Now().Unix())
+
+	// The time spent in the previous status is measured from the last event, or from when the request was added for
+	// requests created before events were recorded.
+	since := req.RequestAddedTime
+	last, err := tx.ProofRequestEvent.Query().
+		Where(proofrequestevent.ProofRequestID(req.ID)).
+		Order(ent.Desc(proofrequestevent.FieldTime), ent.Desc(proofrequestevent.FieldID)).
+		First(ctx)
+	if err == nil {
+		since = last.Time
+	} else if !ent.IsNotFound(err) {
+		return stageTransition{}, fmt.Errorf("failed to query last event: %w", err)
+	}
+
+	updated, err := update.
+		SetStatus(to).
+		SetLastUpdatedTime(now).
+		Save(ctx)
+	if err != nil {
+		return stageTransition{}, err
+	}
+
+	event := tx.ProofRequestEvent.Create().
+		SetProofRequestID(req.ID).
+		SetFromStatus(proofrequestevent.FromStatus(req.Status)).
+		SetToStatus(proofrequestevent.ToStatus(to)).
+		SetTime(now)
+	if reason != "" {
+		event.SetReason(reason)
+	}
+	if updated.ProverRequestID != "" {
+		event.SetProverRequestID(updated.ProverRequestID)
+	}
+	if proverResponse != "" {
+		event.SetProverResponse(proverResponse)
+	}
+	if err := event.Exec(ctx); err != nil {
+		return stageTransition{}, fmt.Errorf("failed to record transition event: %w", err)
+	}
+
+	return stageTransition{
+		proofType: req.Type,
+		from:      req.Status,
+		to:        to,
+		blocks:    req.EndBlock - req.StartBlock,
+		duration:  time.Duration(max(now, since)-since) * time.Second,
+	}, nil
+}
+
+// GetProofRequestEvents returns the status transitions of a proof request, oldest first.
+func (db *ProofDB) GetProofRequestEvents(id int) ([]*ent.ProofRequestEvent, error) {
+	events, err := db.readClient.ProofRequestEvent.Query().
+		Where(proofrequestevent.ProofRequestID(id)).
+		Order(ent.Asc(proofrequestevent.FieldTime), ent.Asc(proofrequestevent.FieldID)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query events of proof request %d: %w", id, err)
+	}
+
+	return events, nil
+}
@@ -17,7 +17,6 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/slack-go/slack"
 
 	// Original Optimism Bindings
 
@@ -28,12 +27,14 @@ import (
 	// OP Succinct
 	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
 	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
 	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/notify"
 )
 
 var (
-	slackMetricsTickerInterval = 30 * time.Minute
+	statusReportTickerInterval = 30 * time.Minute
 	supportedL2OutputVersion   = eth.Bytes32{}
 	ErrProposerNotRunning      = errors.New("proposer is not running")
 )
@@ -57,6 +58,8 @@ type L2OOContract interface {
 	NextOutputIndex(*bind.CallOpts) (*big.Int, error)
 	StartingTimestamp(*bind.CallOpts) (*big.Int, error)
 	L2BLOCKTIME(*bind.CallOpts) (*big.Int, error)
+	HistoricBlockHashes(*bind.CallOpts, *big.Int) ([32]byte, error)
+	ApprovedProposers(*bind.CallOpts, common.Address) (bool, error)
 }
 
 type RollupClient interface {
@@ -73,6 +76,12 @@ type DriverSetup struct {
 
 	// RollupProvider's RollupClient() is used to retrieve output roots from
 	RollupProvider dial.RollupProvider
+
+	// Notifier receives the periodic status reports and the alerts.
+	Notifier notify.Notifier
+
+	// Provers replaces the prover backends of the config, for instance with in-process provers in simulations.
+	Provers []*ProverBackend
 }
 
 // L2OutputSubmitter is responsible for proposing outputs
@@ -92,6 +101,20 @@ type L2OutputSubmitter struct {
 	l2ooABI      *abi.ABI
 
 	db db.ProofDB
+
+	// isLeader is whether this replica held the leader lock at the last check. Only used with leader election.
+	isLeader bool
+
+	// spanSizer sizes span proofs from the cycle counts of completed span proofs. Only used with the adaptive span
+	// strategy.
+	spanSizer *spanSizer
+
+	alerter *notify.Alerter
+	// serverUnreachableChecks is the number of consecutive checks that failed to reach the OP Succinct server of each
+	// prover backend.
+	serverUnreachableChecks map[string]uint64
+
+	provers *proverRouter
 }
 
 // NewL2OutputSubmitter creates a new L2 Output Submitter
@@ -135,11 +158,44 @@ func newL2OOSubmitter(ctx context.Context, cancel context.CancelFunc, setup Driv
 		return nil, err
 	}
 
-	db, err := db.InitDB(setup.Cfg.DbPath, setup.Cfg.UseCachedDb)
+	var proofDB *db.ProofDB
+	if setup.Cfg.DbUrl != "" {
+		proofDB, err = db.InitPostgresDB(setup.Cfg.DbUrl)
+	} else {
+		proofDB, err = db.InitDB(setup.Cfg.DbPath, setup.Cfg.UseCachedDb)
+	}
 	if err != nil {
 		cancel()
 		return nil, err
 	}
+	proofDB.SetMetrics(setup.Metr)
+
+	if setup.Notifier == nil {
+		setup.Notifier = notify.NewLogNotifier(setup.Log)
+	}
+
+	backendCfgs := setup.Cfg.ProverBackends
+	if len(backendCfgs) == 0 {
+		backendCfgs, err = LoadProverBackendConfigs("", setup.Cfg.OPSuccinctServerUrl, setup.Cfg.Mock, setup.Cfg.MaxConcurrentProofRequests)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to load prover backend configs: %w", err)
+		}
+	}
+	var routerOpts []proverRouterOption
+	if len(setup.Provers) > 0 {
+		routerOpts = append(routerOpts, withProverBackends(setup.Provers))
+	}
+	provers := newProverRouter(backendCfgs, time.Duration(setup.Cfg.WitnessGenTimeout)*time.Second, setup.Log, setup.Metr, routerOpts...)
+
+	var sizer *spanSizer
+	if setup.Cfg.SpanStrategy == SpanStrategyAdaptive {
+		sizer, err = initSpanSizer(setup.Cfg, proofDB)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to initialize span sizer: %w", err)
+		}
+	}
 
 	return &L2OutputSubmitter{
 		DriverSetup: setup,
@@ -149,7 +205,10 @@ func newL2OOSubmitter(ctx context.Context, cancel context.CancelFunc, setup Driv
 
 		l2ooContract: l2ooContract,
 		l2ooABI:      parsed,
-		db:           *db,
+		db:           *proofDB,
+		spanSizer:    sizer,
+		alerter:      notify.NewAlerter(setup.Notifier),
+		provers:      provers,
 	}, nil
 }
 
@@ -165,12 +224,19 @@ func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
 	l.running = true
 
 	// When restarting the proposer using a cached database, we need to mark all proofs that are in witness generation state as failed, and retry them.
-	witnessGenReqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusWITNESSGEN)
+	// With leader election, other replicas may be generating witnesses, so only the proofs claimed by this replica are retried.
+	var witnessGenReqs []*ent.ProofRequest
+	var err error
+	if l.Cfg.LeaderElection {
+		witnessGenReqs, err = l.db.GetAllClaimedProofsWithStatus(proofrequest.StatusWITNESSGEN, l.Cfg.ReplicaID)
+	} else {
+		witnessGenReqs, err = l.db.GetAllProofsWithStatus(proofrequest.StatusWITNESSGEN)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get witness generation pending proofs: %w", err)
 	}
 	for _, req := range witnessGenReqs {
-		err = l.RetryRequest(req, ProofStatusResponse{})
+		err = l.RetryRequest(req, ProofStatusResponse{}, "proposer restarted during witness generation")
 		if err != nil {
 			return fmt.Errorf("failed to retry request: %w", err)
 		}
@@ -182,6 +248,9 @@ func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
 	if err != nil {
 		return fmt.Errorf("failed to validate config: %w", err)
 	}
+	if err := l.checkBundleCheckpoint(l.ctx); err != nil {
+		return fmt.Errorf("failed to validate config: %w", err)
+	}
 
 	l.wg.Add(1)
 	go l.loop()
@@ -223,6 +292,11 @@ func (l *L2OutputSubmitter) StopL2OutputSubmitting() error {
 	return nil
 }
 
+// ProofDB returns the handle on the DB that tracks the proof requests, for use by the admin API.
+func (l *L2OutputSubmitter) ProofDB() *db.ProofDB {
+	return &l.db
+}
+
 // GetProposerMetrics gets the performance metrics for the proposer.
 // TODO: Add a metric for the latest proven transaction.
 func (l *L2OutputSubmitter) GetProposerMetrics(ctx context.Context) (opsuccinctmetrics.ProposerMetrics, error) {
@@ -292,40 +366,22 @@ func (l *L2OutputSubmitter) GetProposerMetrics(ctx context.Context) (opsuccinctm
 	return metrics, nil
 }
 
-// SendSlackNotification sends a Slack notification with the proposer metrics.
-func (l *L2OutputSubmitter) SendSlackNotification(proposerMetrics opsuccinctmetrics.ProposerMetrics) error {
-	if l.Cfg.SlackToken == "" {
-		l.Log.Info("Slack notifications disabled, token not set")
-		return nil // Slack notifications disabled if token not set
-	}
-
-	api := slack.New(l.Cfg.SlackToken)
-	channelID := "op-succinct-tests"
-
+// SendStatusReport sends a status report with the proposer metrics to the notifier.
+func (l *L2OutputSubmitter) SendStatusReport(proposerMetrics opsuccinctmetrics.ProposerMetrics) error {
 	ctx, cancel := context.WithTimeout(l.ctx, l.Cfg.NetworkTimeout)
 	defer cancel()
 
-	rollupClient, err := l.RollupProvider.RollupClient(ctx)
-	if err != nil {
-		return fmt.Errorf("getting rollup client: %w", err)
-	}
-	cfg, err := rollupClient.RollupConfig(ctx)
+	lag, err := l.contractLag(ctx, proposerMetrics)
 	if err != nil {
-		return fmt.Errorf("getting rollup config: %w", err)
+		return err
 	}
-	l2BlockTime := cfg.BlockTime
-
-	// Get the number of minutes behind the L2 finalized block the contract is.
-	minutesBehind := (proposerMetrics.L2FinalizedBlock - proposerMetrics.LatestContractL2Block) * l2BlockTime / 60
 
-	message := fmt.Sprintf("*Chain %d Proposer Metrics*:\n"+
-		"Contract is %d minutes behind L2 Finalized\n"+
+	message := fmt.Sprintf("Contract is %d minutes behind L2 Finalized\n"+
 		"| L2 Unsafe | L2 Finalized | Contract L2 | Proven L2 | Min to Agg |\n"+
 		"| %-9d | %-12d | %-11d | %-9d | %-9d |\n"+
 		"| Proving   | Witness Gen | Unrequested |\n"+
 		"| %-9d | %-11d | %-11d |",
-		l.Cfg.L2ChainID,
-		minutesBehind,
+		int(lag.Minutes()),
 		proposerMetrics.L2UnsafeHeadBlock,
 		proposerMetrics.L2FinalizedBlock,
 		proposerMetrics.LatestContractL2Block,
@@ -335,15 +391,12 @@ func (l *L2OutputSubmitter) SendSlackNotification(proposerMetrics opsuccinctmetr
 		proposerMetrics.NumWitnessgen,
 		proposerMetrics.NumUnrequested)
 
-	_, _, err = api.PostMessage(
-		channelID,
-		slack.MsgOptionText(message, false),
-	)
-	if err != nil {
-		return fmt.Errorf("error sending Slack notification: %w", err)
-	}
-
-	return nil
+	return l.Notifier.Notify(ctx, notify.Notification{
+		Key:      "status",
+		Severity: notify.SeverityInfo,
+		Title:    fmt.Sprintf("Chain %d Proposer Metrics", l.Cfg.L2ChainID),
+		Message:  message,
+	})
 }
 
 func (l *L2OutputSubmitter) SubmitAggProofs(ctx context.Context) error {
@@ -368,13 +421,32 @@ func (l *L2OutputSubmitter) SubmitAggProofs(ctx context.Context) error {
 		return completedAggProofs[i].EndBlock > completedAggProofs[j].EndBlock
 	})
 
-	// Submit the agg proof with the highest L2 block number.
+	// Submit the agg proof with the highest L2 block number. Several AGG proofs are pending when a longer one was derived
+	// while the submission was deferred, and the older ones are skipped.
 	aggProof := completedAggProofs[0]
+	if len(completedAggProofs) > 1 {
+		l.Log.Info("Skipping to the newest AGG proof", "skipped", len(completedAggProofs)-1, "start", aggProof.StartBlock, "end", aggProof.EndBlock)
+	}
+
+	// The submission deadline counts from when the first of the pending AGG proofs completed.
+	pendingSince := aggProof.LastUpdatedTime
+	for _, p := range completedAggProofs {
+		pendingSince = min(pendingSince, p.LastUpdatedTime)
+	}
+	submit, err := l.checkBaseFee(ctx, time.Unix(int64(pendingSince), 0))
+	if err != nil {
+		return err
+	}
+	if !submit {
+		l.Metr.RecordSubmissionDeferred(deferBaseFee)
+		return l.deriveLongerAggProof(ctx, latestBlockNumber.Uint64(), aggProof.EndBlock)
+	}
+
 	output, err := l.FetchOutput(ctx, aggProof.EndBlock)
 	if err != nil {
 		return fmt.Errorf("failed to fetch output at block %d: %w", aggProof.EndBlock, err)
 	}
-	err = l.proposeOutput(ctx, output, aggProof.Proof, aggProof.L1BlockNumber)
+	err = l.proposeOutput(ctx, output, aggProof)
 	if err != nil {
 		return fmt.Errorf("failed to propose output: %w", err)
 	}
@@ -382,6 +454,23 @@ func (l *L2OutputSubmitter) SubmitAggProofs(ctx context.Context) error {
 	return nil
 }
 
+// deriveLongerAggProof queues an AGG proof covering at least one more submission interval than the pending AGG proof
+// ending at pendingEnd, so that the deferred submission proposes more blocks at once.
+func (l *L2OutputSubmitter) deriveLongerAggProof(ctx context.Context, latest, pendingEnd uint64) error {
+	next, err := l.l2ooContract.NextBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get next L2OO output: %w", err)
+	}
+	created, end, err := l.db.TryCreateLongerAggProof(latest, pendingEnd+next.Uint64()-latest)
+	if err != nil {
+		return fmt.Errorf("failed to create longer agg proof: %w", err)
+	}
+	if created {
+		l.Log.Info("created new AGG proof while the submission is deferred", "from", latest, "to", end)
+	}
+	return nil
+}
+
 // FetchL2OOOutput gets the next output proposal for the L2OO.
 // It queries the L2OO for the earliest next block number that should be proposed.
 // It returns the output to propose, and whether the proposal should be submitted at all.
@@ -517,7 +606,7 @@ func (l *L2OutputSubmitter) waitForL1Head(ctx context.Context, blockNum uint64)
 }
 
 // sendTransaction creates & sends transactions through the underlying transaction manager.
-func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.OutputResponse, proof []byte, l1BlockNum uint64) error {
+func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.OutputResponse, aggProof *ent.ProofRequest) error {
 	err := l.waitForL1Head(ctx, output.Status.HeadL1.Number+1)
 	if err != nil {
 		return err
@@ -528,16 +617,24 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 	if l.Cfg.DisputeGameFactoryAddr != nil {
 		return errors.New("not implemented")
 	} else {
-		data, err := l.ProposeL2OutputTxData(output, proof, l1BlockNum)
+		candidate, ok, err := l.proposalTx(ctx, output, aggProof)
 		if err != nil {
 			return err
 		}
+		if !ok {
+			// The L1 block hash the proof commits to can't be checkpointed anymore, so the proof will never be accepted.
+			// Failing it lets a new AGG proof be derived from the same span proofs.
+			l.Metr.RecordSubmissionDeferred(deferCheckpointExpired)
+			l.Log.Warn("L1 block of the AGG proof is too old to checkpoint, dropping the proof", "l1blocknum", aggProof.L1BlockNumber)
+			_, err := l.db.ExpireAggProof(aggProof.ID, fmt.Sprintf("L1 block %d is too old to checkpoint", aggProof.L1BlockNumber))
+			return err
+		}
+		if err := l.dryRun(ctx, candidate); err != nil {
+			l.Metr.RecordSubmissionDeferred(deferDryRunReverted)
+			return err
+		}
 		// TODO: This currently blocks the loop while it waits for the transaction to be confirmed. Up to 3 minutes.
-		receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
-			TxData:   data,
-			To:       l.Cfg.L2OutputOracleAddr,
-			GasLimit: 0,
-		})
+		receipt, err = l.Txmgr.Send(ctx, candidate)
 		if err != nil {
 			return err
 		}
@@ -568,6 +665,30 @@ func (l *L2OutputSubmitter) loop() {
 	l.loopL2OO(ctx)
 }
 
+// IsLeader returns whether this replica is the leader, as of the last leadership check. Without leader election, the
+// replica is always the leader.
+func (l *L2OutputSubmitter) IsLeader() bool {
+	return !l.Cfg.LeaderElection || l.isLeader
+}
+
+// checkLeadership tries to acquire or keep the leader lock, and returns whether this replica is the leader.
+func (l *L2OutputSubmitter) checkLeadership(ctx context.Context) bool {
+	if !l.Cfg.LeaderElection {
+		return true
+	}
+
+	leader, err := l.db.TryAcquireLeadership(ctx)
+	if err != nil {
+		l.Log.Error("failed to check leadership", "err", err)
+		leader = false
+	}
+	if leader != l.isLeader {
+		l.Log.Info("Leadership changed", "replica", l.Cfg.ReplicaID, "leader", leader)
+	}
+	l.isLeader = leader
+	return leader
+}
+
 func (l *L2OutputSubmitter) waitNodeSync() error {
 	cCtx, cancel := context.WithTimeout(l.ctx, l.Cfg.NetworkTimeout)
 	defer cancel()
@@ -590,9 +711,9 @@ func (l *L2OutputSubmitter) waitNodeSync() error {
 // proposes it.
 func (l *L2OutputSubmitter) loopL2OO(ctx context.Context) {
 	ticker := time.NewTicker(l.Cfg.PollInterval)
-	slackMetricsTicker := time.NewTicker(slackMetricsTickerInterval)
+	statusReportTicker := time.NewTicker(statusReportTickerInterval)
 	defer ticker.Stop()
-	defer slackMetricsTicker.Stop()
+	defer statusReportTicker.Stop()
 	for {
 		select {
 		case <-ticker.C:
@@ -604,6 +725,18 @@ func (l *L2OutputSubmitter) loopL2OO(ctx context.Context) {
 			}
 			l.Log.Info("Proposer status", "metrics", metrics)
 
+			// Replicas that aren't the leader only help with requesting span proofs. Planning the spans, tracking the
+			// requests, aggregating and submitting proofs is left to the leader.
+			if !l.checkLeadership(ctx) {
+				l.Log.Info("Not the leader, only requesting queued span proofs...")
+				if err := l.RequestQueuedProofs(ctx); err != nil {
+					l.Log.Error("failed to request unrequested proofs", "err", err)
+				}
+				continue
+			}
+
+			l.checkAlerts(ctx, metrics)
+
 			// 1) Queue up the range proofs that are ready to prove. Determine these range proofs based on the latest L2 finalized block,
 			// and the current L2 unsafe head.
 			l.Log.Info("Stage 1: Getting Range Proof Boundaries...")
@@ -641,6 +774,16 @@ func (l *L2OutputSubmitter) loopL2OO(ctx context.Context) {
 				continue
 			}
 
+			// 3) Check that the L1 blocks of the agg proofs are still canonical.
+			// If an L1 block was reorged out, its hash can't be checkpointed, so we set the agg proof to FAILED and
+			// queue it again, to be requested with a fresh checkpoint.
+			l.Log.Info("Stage 3: Checking Agg Proofs for L1 Reorgs...")
+			err = l.CheckL1Reorgs(ctx)
+			if err != nil {
+				l.Log.Error("failed to check agg proofs for L1 reorgs", "err", err)
+				continue
+			}
+
 			// 4) Request all unrequested proofs from the prover network.
 			// Any DB entry with status = "UNREQ" means it's queued up and ready.
 			// We request all of these (both span and agg) from the prover network.
@@ -659,15 +802,18 @@ func (l *L2OutputSubmitter) loopL2OO(ctx context.Context) {
 			if err != nil {
 				l.Log.Error("failed to submit agg proofs", "err", err)
 			}
-		case <-slackMetricsTicker.C:
+		case <-statusReportTicker.C:
+			if !l.IsLeader() {
+				continue
+			}
 			metrics, err := l.GetProposerMetrics(ctx)
 			if err != nil {
-				l.Log.Error("failed to get metrics for Slack notification", "err", err)
+				l.Log.Error("failed to get metrics for status report", "err", err)
 				continue
 			}
-			err = l.SendSlackNotification(metrics)
+			err = l.SendStatusReport(metrics)
 			if err != nil {
-				l.Log.Error("failed to send Slack notification", "err", err)
+				l.Log.Error("failed to send status report", "err", err)
 			}
 		case <-l.done:
 			return
@@ -675,8 +821,12 @@ func (l *L2OutputSubmitter) loopL2OO(ctx context.Context) {
 	}
 }
 
-func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.OutputResponse, proof []byte, l1BlockNum uint64) error {
-	cCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.OutputResponse, aggProof *ent.ProofRequest) error {
+	timeout := l.Cfg.SubmissionTimeout
+	if timeout == 0 {
+		timeout = defaultSubmissionTimeout
+	}
+	cCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// Get the current nextBlockNumber from the L2OO contract.
@@ -686,14 +836,14 @@ func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.Outpu
 		return err
 	}
 
-	if err := l.sendTransaction(cCtx, output, proof, l1BlockNum); err != nil {
+	if err := l.sendTransaction(cCtx, output, aggProof); err != nil {
 		l.Log.Error("Failed to send proposal transaction",
 			"err", err,
 			"expected_next_blocknum", nextBlockNumber.Uint64(),
 			"l2blocknum", output.BlockRef.Number,
-			"l1blocknum", l1BlockNum,
+			"l1blocknum", aggProof.L1BlockNumber,
 			"l1head", output.Status.HeadL1.Number,
-			"proof", proof)
+			"proof", aggProof.Proof)
 		return err
 	}
 	l.Log.Info("AGG proof submitted on-chain", "end", output.BlockRef.Number)
@@ -733,19 +883,29 @@ func (l *L2OutputSubmitter) checkpointBlockHash(ctx context.Context) (uint64, co
 		return blockNumber.Uint64(), blockHash, nil
 	}
 
+	// With bundled checkpoints, the blockhash is checkpointed by the proposal transaction instead.
+	if l.Cfg.BundleCheckpoint {
+		l.Log.Info("Deferring block hash checkpoint to the proposal", "block_number", blockNumber, "block_hash", blockHash)
+		return blockNumber.Uint64(), blockHash, nil
+	}
+
 	// If not, send a transaction to checkpoint the blockhash on the L2OO contract.
 	var receipt *types.Receipt
 	data, err := l.CheckpointBlockHashTxData(blockNumber)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
-
-	// TODO: This currently blocks the loop while it waits for the transaction to be confirmed. Up to 3 minutes.
-	receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
+	candidate := txmgr.TxCandidate{
 		TxData:   data,
 		To:       l.Cfg.L2OutputOracleAddr,
 		GasLimit: 0,
-	})
+	}
+	if err := l.dryRun(cCtx, candidate); err != nil {
+		return 0, common.Hash{}, err
+	}
+
+	// TODO: This currently blocks the loop while it waits for the transaction to be confirmed. Up to 3 minutes.
+	receipt, err = l.Txmgr.Send(ctx, candidate)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
@@ -10,6 +10,7 @@ import (
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
@@ -80,17 +81,138 @@ var (
 		Value:   false,
 		EnvVars: prefixEnvVars("USE_CACHED_DB"),
 	}
+	DbUrlFlag = &cli.StringFlag{
+		Name:    "db-url",
+		Usage:   "Postgres connection URL of the database used to track OP Succinct proof generation. Proposer replicas using the same DB share the proof queue. Requires leader-election. If not set, a SQLite DB at db-path is used.",
+		EnvVars: prefixEnvVars("DB_URL"),
+	}
+	LeaderElectionFlag = &cli.BoolFlag{
+		Name:    "leader-election",
+		Usage:   "Elect a leader among the proposer replicas sharing the Postgres DB. Only the leader plans spans, aggregates proofs and submits them to the L2OO. The other replicas only request span proofs. Required with db-url.",
+		Value:   false,
+		EnvVars: prefixEnvVars("LEADER_ELECTION"),
+	}
+	ReplicaIDFlag = &cli.StringFlag{
+		Name:    "replica-id",
+		Usage:   "Identifier of this proposer replica, recorded on the proof requests it claims. Defaults to the hostname.",
+		EnvVars: prefixEnvVars("REPLICA_ID"),
+	}
 	SlackTokenFlag = &cli.StringFlag{
 		Name:    "slack-token",
 		Usage:   "Token for the Slack API",
 		EnvVars: prefixEnvVars("SLACK_TOKEN"),
 	}
+	SlackChannelFlag = &cli.StringFlag{
+		Name:    "slack-channel",
+		Usage:   "Slack channel to post status reports and alerts to",
+		Value:   "op-succinct-tests",
+		EnvVars: prefixEnvVars("SLACK_CHANNEL"),
+	}
+	NotifyWebhookUrlFlag = &cli.StringFlag{
+		Name:    "notify-webhook-url",
+		Usage:   "URL to POST status reports and alerts to as JSON",
+		EnvVars: prefixEnvVars("NOTIFY_WEBHOOK_URL"),
+	}
+	PagerDutyRoutingKeyFlag = &cli.StringFlag{
+		Name:    "pagerduty-routing-key",
+		Usage:   "Routing key of the PagerDuty Events API v2 integration to send alerts to",
+		EnvVars: prefixEnvVars("PAGERDUTY_ROUTING_KEY"),
+	}
+	AlertContractLagFlag = &cli.DurationFlag{
+		Name:    "alert-contract-lag",
+		Usage:   "Alert when the latest block on the L2OO is this far behind the L2 finalized block. 0 disables the alert.",
+		Value:   0,
+		EnvVars: prefixEnvVars("ALERT_CONTRACT_LAG"),
+	}
+	AlertRangeFailuresFlag = &cli.Uint64Flag{
+		Name:    "alert-range-failures",
+		Usage:   "Alert when the proof of the same range fails this many times. 0 disables the alert.",
+		Value:   3,
+		EnvVars: prefixEnvVars("ALERT_RANGE_FAILURES"),
+	}
+	AlertMinBalanceFlag = &cli.Float64Flag{
+		Name:    "alert-min-balance",
+		Usage:   "Alert when the balance of the proposer wallet drops below this amount of ETH. 0 disables the alert.",
+		Value:   0,
+		EnvVars: prefixEnvVars("ALERT_MIN_BALANCE"),
+	}
+	AlertServerUnreachableChecksFlag = &cli.Uint64Flag{
+		Name:    "alert-server-unreachable-checks",
+		Usage:   "Alert when the OP Succinct server is unreachable for this many consecutive checks. 0 disables the alert.",
+		Value:   3,
+		EnvVars: prefixEnvVars("ALERT_SERVER_UNREACHABLE_CHECKS"),
+	}
+	SubmissionMaxBaseFeeFlag = &cli.Float64Flag{
+		Name:    "submission-max-base-fee",
+		Usage:   "L1 base fee, in gwei, above which the submission of AGG proofs is deferred. 0 disables the cap",
+		Value:   0,
+		EnvVars: prefixEnvVars("SUBMISSION_MAX_BASE_FEE"),
+	}
+	SubmissionDeadlineFlag = &cli.DurationFlag{
+		Name:    "submission-deadline",
+		Usage:   "How long a completed AGG proof waits for the L1 base fee to drop below the cap before it is submitted anyway. 0 waits indefinitely",
+		Value:   time.Hour,
+		EnvVars: prefixEnvVars("SUBMISSION_DEADLINE"),
+	}
+	SubmissionTimeoutFlag = &cli.DurationFlag{
+		Name:    "submission-timeout",
+		Usage:   "Maximum time spent submitting an AGG proof",
+		Value:   10 * time.Minute,
+		EnvVars: prefixEnvVars("SUBMISSION_TIMEOUT"),
+	}
+	BundleCheckpointFlag = &cli.BoolFlag{
+		Name:    "bundle-checkpoint",
+		Usage:   "Checkpoint the L1 block hash of AGG proofs in the same transaction as their proposal, through Multicall3. Requires Multicall3 to be an approved proposer, or permissionless proposing, and the witness generation timeout, proof timeout and submission deadline to add up to at most 48 minutes",
+		Value:   false,
+		EnvVars: prefixEnvVars("BUNDLE_CHECKPOINT"),
+	}
+	Multicall3AddressFlag = &cli.StringFlag{
+		Name:    "multicall3-address",
+		Usage:   "Address of the Multicall3 contract on L1",
+		Value:   predeploys.MultiCall3,
+		EnvVars: prefixEnvVars("MULTICALL3_ADDRESS"),
+	}
 	MaxBlockRangePerSpanProofFlag = &cli.Uint64Flag{
 		Name:    "max-block-range-per-span-proof",
 		Usage:   "Maximum number of blocks to include in a single span proof",
 		Value:   50,
 		EnvVars: prefixEnvVars("MAX_BLOCK_RANGE_PER_SPAN_PROOF"),
 	}
+	SpanStrategyFlag = &cli.StringFlag{
+		Name:    "span-strategy",
+		Usage:   "How to split finalized L2 blocks into span proofs: basic, safe-head, span-batch, weighted or adaptive",
+		Value:   "basic",
+		EnvVars: prefixEnvVars("SPAN_STRATEGY"),
+	}
+	L2EthRpcFlag = &cli.StringFlag{
+		Name:    "l2-eth-rpc",
+		Usage:   "HTTP provider URL for L2, used by the weighted and adaptive span strategies",
+		EnvVars: prefixEnvVars("L2_RPC"),
+	}
+	MaxGasPerSpanProofFlag = &cli.Uint64Flag{
+		Name:    "max-gas-per-span-proof",
+		Usage:   "Maximum gas used by the blocks of a span proof with the weighted span strategy. 0 means no limit.",
+		Value:   0,
+		EnvVars: prefixEnvVars("MAX_GAS_PER_SPAN_PROOF"),
+	}
+	MaxTxsPerSpanProofFlag = &cli.Uint64Flag{
+		Name:    "max-txs-per-span-proof",
+		Usage:   "Maximum number of transactions in the blocks of a span proof with the weighted span strategy. 0 means no limit.",
+		Value:   0,
+		EnvVars: prefixEnvVars("MAX_TXS_PER_SPAN_PROOF"),
+	}
+	SpanCycleBudgetFlag = &cli.Uint64Flag{
+		Name:    "span-cycle-budget",
+		Usage:   "Number of cycles a span proof should use with the adaptive span strategy",
+		Value:   0,
+		EnvVars: prefixEnvVars("SPAN_CYCLE_BUDGET"),
+	}
+	TargetSpanProofDurationFlag = &cli.DurationFlag{
+		Name:    "target-span-proof-duration",
+		Usage:   "How long span proofs should take with the adaptive span strategy. Spans shrunk after exceeding the cycle limit grow back while proofs complete faster than this. 0 disables growing.",
+		Value:   30 * time.Minute,
+		EnvVars: prefixEnvVars("TARGET_SPAN_PROOF_DURATION"),
+	}
 	// This limit is set to prevent overloading the witness generation server. Until Kona improves their native I/O API (https://github.com/anton-rs/kona/issues/553)
 	// the maximum number of concurrent witness generation requests is roughly num_cpu / 2. Set it to 5 for now to be safe.
 	MaxConcurrentWitnessGenFlag = &cli.Uint64Flag{
@@ -124,6 +246,11 @@ var (
 		Value:   20,
 		EnvVars: prefixEnvVars("MAX_CONCURRENT_PROOF_REQUESTS"),
 	}
+	ProverBackendsFlag = &cli.StringFlag{
+		Name:    "prover-backends",
+		Usage:   "Path of a JSON file configuring the prover backends to request proofs from. If unset, proofs are requested from the OP Succinct server URL.",
+		EnvVars: prefixEnvVars("PROVER_BACKENDS"),
+	}
 	TxCacheOutDirFlag = &cli.StringFlag{
 		Name:    "tx-cache-out-dir",
 		Usage:   "Cache directory for the found transactions to determine span batch boundaries",
@@ -166,13 +293,35 @@ var optionalFlags = []cli.Flag{
 	WaitNodeSyncFlag,
 	DbPathFlag,
 	UseCachedDbFlag,
+	DbUrlFlag,
+	LeaderElectionFlag,
+	ReplicaIDFlag,
 	SlackTokenFlag,
+	SlackChannelFlag,
+	NotifyWebhookUrlFlag,
+	PagerDutyRoutingKeyFlag,
+	AlertContractLagFlag,
+	AlertRangeFailuresFlag,
+	AlertMinBalanceFlag,
+	AlertServerUnreachableChecksFlag,
+	SubmissionMaxBaseFeeFlag,
+	SubmissionDeadlineFlag,
+	SubmissionTimeoutFlag,
+	BundleCheckpointFlag,
+	Multicall3AddressFlag,
 	MaxBlockRangePerSpanProofFlag,
+	SpanStrategyFlag,
+	L2EthRpcFlag,
+	MaxGasPerSpanProofFlag,
+	MaxTxsPerSpanProofFlag,
+	SpanCycleBudgetFlag,
+	TargetSpanProofDurationFlag,
 	MaxConcurrentWitnessGenFlag,
 	TxCacheOutDirFlag,
 	OPSuccinctServerUrlFlag,
 	ProofTimeoutFlag,
 	MaxConcurrentProofRequestsFlag,
+	ProverBackendsFlag,
 	BatchInboxFlag,
 	BatcherAddressFlag,
 	MockFlag,
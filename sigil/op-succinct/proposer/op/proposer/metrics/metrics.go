@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"io"
+	"time"
 
 	"github.com/ethereum/go-ethereum/log"
 
@@ -26,6 +27,8 @@ type OPSuccinctMetricer interface {
 	RecordError(label string, num uint64)
 	RecordProveFailure(reason string)
 	RecordWitnessGenFailure(reason string)
+	RecordSubmissionDeferred(reason string)
+	RecordProofStageDuration(proofType, stage, result string, blocks uint64, duration time.Duration)
 }
 
 type OPSuccinctMetrics struct {
@@ -49,9 +52,13 @@ type OPSuccinctMetrics struct {
 	HighestProvenContiguousL2Block prometheus.Gauge
 	MinBlockToProveToAgg           prometheus.Gauge
 
-	ErrorCount         *prometheus.CounterVec
-	ProveFailures      *prometheus.CounterVec
-	WitnessGenFailures *prometheus.CounterVec
+	ErrorCount          *prometheus.CounterVec
+	ProveFailures       *prometheus.CounterVec
+	WitnessGenFailures  *prometheus.CounterVec
+	SubmissionDeferrals *prometheus.CounterVec
+
+	ProofStageDuration        *prometheus.HistogramVec
+	ProofStageSecondsPerBlock *prometheus.HistogramVec
 }
 
 var _ OPSuccinctMetricer = (*OPSuccinctMetrics)(nil)
@@ -136,6 +143,23 @@ func NewMetrics(procName string) *OPSuccinctMetrics {
 			Name:      "witness_gen_failures",
 			Help:      "Number of witness generation failures by type",
 		}, []string{"reason"}),
+		SubmissionDeferrals: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "submission_deferrals",
+			Help:      "Number of times the submission of an AGG proof was deferred, by reason",
+		}, []string{"reason"}),
+		ProofStageDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "proof_stage_duration_seconds",
+			Help:      "Time spent by proof requests in each status, by the status they transitioned to",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 16),
+		}, []string{"type", "stage", "result"}),
+		ProofStageSecondsPerBlock: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "proof_stage_seconds_per_block",
+			Help:      "Time spent by proof requests in each status divided by the number of blocks in the request",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14),
+		}, []string{"type", "stage", "result"}),
 	}
 }
 
@@ -187,6 +211,20 @@ func (m *OPSuccinctMetrics) RecordWitnessGenFailure(reason string) {
 	m.WitnessGenFailures.WithLabelValues(reason).Inc()
 }
 
+// RecordSubmissionDeferred records why the submission of an AGG proof was deferred
+func (m *OPSuccinctMetrics) RecordSubmissionDeferred(reason string) {
+	m.SubmissionDeferrals.WithLabelValues(reason).Inc()
+}
+
+// RecordProofStageDuration records the time a proof request spent in a status (stage) before transitioning to another
+// status (result).
+func (m *OPSuccinctMetrics) RecordProofStageDuration(proofType, stage, result string, blocks uint64, duration time.Duration) {
+	m.ProofStageDuration.WithLabelValues(proofType, stage, result).Observe(duration.Seconds())
+	if blocks > 0 {
+		m.ProofStageSecondsPerBlock.WithLabelValues(proofType, stage, result).Observe(duration.Seconds() / float64(blocks))
+	}
+}
+
 // RecordProposerStatus sets the proposer Prometheus metrics to the given values.
 func (m *OPSuccinctMetrics) RecordProposerStatus(metrics ProposerMetrics) {
 	m.NumProving.Set(float64(metrics.NumProving))
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"io"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -24,6 +25,10 @@ func (*noopMetrics) RecordProposerStatus(metrics ProposerMetrics) {}
 func (*noopMetrics) RecordError(label string, num uint64)         {}
 func (*noopMetrics) RecordProveFailure(reason string)             {}
 func (*noopMetrics) RecordWitnessGenFailure(reason string)        {}
+func (*noopMetrics) RecordSubmissionDeferred(reason string)       {}
+
+func (*noopMetrics) RecordProofStageDuration(proofType, stage, result string, blocks uint64, duration time.Duration) {
+}
 
 func (*noopMetrics) RecordInfo(version string) {}
 func (*noopMetrics) RecordUp()                 {}
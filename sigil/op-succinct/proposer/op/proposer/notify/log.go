@@ -0,0 +1,30 @@
+package notify
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// LogNotifier writes notifications to the log.
+type LogNotifier struct {
+	log log.Logger
+}
+
+func NewLogNotifier(log log.Logger) *LogNotifier {
+	return &LogNotifier{log: log}
+}
+
+func (l *LogNotifier) Notify(_ context.Context, n Notification) error {
+	switch {
+	case n.Resolved:
+		l.log.Info("Alert resolved", "key", n.Key, "title", n.Title, "message", n.Message)
+	case n.Severity == SeverityCritical:
+		l.log.Error("Alert", "key", n.Key, "title", n.Title, "message", n.Message)
+	case n.Severity == SeverityWarning:
+		l.log.Warn("Alert", "key", n.Key, "title", n.Title, "message", n.Message)
+	default:
+		l.log.Info(n.Title, "message", n.Message)
+	}
+	return nil
+}
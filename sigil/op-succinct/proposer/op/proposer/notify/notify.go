@@ -0,0 +1,190 @@
+// Package notify sends the proposer's status reports and alerts to the operators.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout is the timeout of the HTTP requests made by the notifiers.
+const DefaultTimeout = 10 * time.Second
+
+type Severity string
+
+const (
+	// SeverityInfo is for status reports that don't need any action.
+	SeverityInfo Severity = "info"
+	// SeverityWarning is for conditions that need attention, but don't stop the proposer from making progress yet.
+	SeverityWarning Severity = "warning"
+	// SeverityCritical is for conditions that stop the proposer from making progress.
+	SeverityCritical Severity = "critical"
+)
+
+// Notification is a status report, or an alert about a condition of the proposer.
+type Notification struct {
+	// Key identifies the condition, so that repeated alerts about it can be grouped and resolved.
+	Key      string   `json:"key"`
+	Severity Severity `json:"severity"`
+	Title    string   `json:"title"`
+	Message  string   `json:"message"`
+	// Resolved is set when the condition an alert was raised for no longer holds.
+	Resolved bool `json:"resolved"`
+}
+
+// Text formats the notification for chat messages and logs.
+func (n Notification) Text() string {
+	label := string(n.Severity)
+	if n.Resolved {
+		label = "resolved"
+	}
+	if n.Severity == SeverityInfo && !n.Resolved {
+		return fmt.Sprintf("*%s*\n%s", n.Title, n.Message)
+	}
+	return fmt.Sprintf("*[%s] %s*\n%s", label, n.Title, n.Message)
+}
+
+// Notifier sends notifications to a sink.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Multi sends notifications to all of its notifiers, even if some of them fail.
+type Multi []Notifier
+
+func (m Multi) Notify(ctx context.Context, n Notification) error {
+	var errs []error
+	for _, notifier := range m {
+		if err := notifier.Notify(ctx, n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Alerter raises and resolves alerts, only notifying when the state of an alert changes. The notifiers of a Multi are
+// tracked separately, so that an alert that only some of them failed to send is retried on those only.
+type Alerter struct {
+	sinks []Notifier
+
+	mu     sync.Mutex
+	active map[string]*alert
+}
+
+// alert is an active alert, with the sinks that were sent it.
+type alert struct {
+	n        Notification
+	notified []bool
+}
+
+func NewAlerter(notifier Notifier) *Alerter {
+	sinks := []Notifier{notifier}
+	if m, ok := notifier.(Multi); ok {
+		sinks = m
+	}
+	return &Alerter{
+		sinks:  sinks,
+		active: make(map[string]*alert),
+	}
+}
+
+// Raise sends the alert if it isn't already active. The alert is active once at least one sink was sent it, and is
+// sent again on the next call to the sinks that failed.
+func (a *Alerter) Raise(ctx context.Context, n Notification) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	al, ok := a.active[n.Key]
+	if !ok {
+		al = &alert{n: n, notified: make([]bool, len(a.sinks))}
+	}
+	var errs []error
+	for i, sink := range a.sinks {
+		if al.notified[i] {
+			continue
+		}
+		if err := sink.Notify(ctx, al.n); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		al.notified[i] = true
+	}
+	if !ok && len(errs) < len(a.sinks) {
+		a.active[n.Key] = al
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to raise alert %s: %w", n.Key, errors.Join(errs...))
+	}
+	return nil
+}
+
+// Resolve sends a resolution of the alert with the given key to the sinks that were sent it, if it is active. The
+// alert stays active until every one of them was sent the resolution.
+func (a *Alerter) Resolve(ctx context.Context, key, message string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	al, ok := a.active[key]
+	if !ok {
+		return nil
+	}
+	n := al.n
+	n.Message = message
+	n.Resolved = true
+	var errs []error
+	for i, sink := range a.sinks {
+		if !al.notified[i] {
+			continue
+		}
+		if err := sink.Notify(ctx, n); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		al.notified[i] = false
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to resolve alert %s: %w", key, errors.Join(errs...))
+	}
+	delete(a.active, key)
+	return nil
+}
+
+// IsActive returns whether the alert with the given key has been raised and not resolved.
+func (a *Alerter) IsActive(key string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, ok := a.active[key]
+	return ok
+}
+
+// postJSON posts the body as JSON to the URL, and fails if the response status isn't 2xx.
+func postJSON(ctx context.Context, client *http.Client, url string, body any) error {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("received status code %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/testutils"
+)
+
+var testAlert = Notification{
+	Key:      "contract-lag",
+	Severity: SeverityCritical,
+	Title:    "Contract is behind L2 finalized",
+	Message:  "The contract is 90 minutes behind L2 finalized.",
+}
+
+func TestSlackNotifier(t *testing.T) {
+	response := `{"ok": true, "channel": "C123", "ts": "1.0"}`
+	server := testutils.NewServer(t, func(w http.ResponseWriter, _ testutils.Request) {
+		_, _ = w.Write([]byte(response))
+	})
+	notifier := NewSlackNotifier("xoxb-token", "proposer-alerts", slack.OptionAPIURL(server.URL+"/"))
+
+	require.NoError(t, notifier.Notify(context.Background(), testAlert))
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/chat.postMessage", requests[0].Path)
+	form, err := url.ParseQuery(string(requests[0].Body))
+	require.NoError(t, err)
+	require.Equal(t, "proposer-alerts", form.Get("channel"))
+	require.Equal(t, "*[critical] Contract is behind L2 finalized*\nThe contract is 90 minutes behind L2 finalized.", form.Get("text"))
+
+	response = `{"ok": false, "error": "channel_not_found"}`
+	require.ErrorContains(t, notifier.Notify(context.Background(), testAlert), "channel_not_found")
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	status := http.StatusNoContent
+	server := testutils.NewServer(t, func(w http.ResponseWriter, _ testutils.Request) {
+		w.WriteHeader(status)
+	})
+	notifier := NewWebhookNotifier(server.URL + "/hooks/proposer")
+
+	require.NoError(t, notifier.Notify(context.Background(), testAlert))
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/hooks/proposer", requests[0].Path)
+	var received Notification
+	require.NoError(t, json.Unmarshal(requests[0].Body, &received))
+	require.Equal(t, testAlert, received)
+
+	status = http.StatusInternalServerError
+	require.ErrorContains(t, notifier.Notify(context.Background(), testAlert), "500")
+}
+
+func TestPagerDutyNotifier(t *testing.T) {
+	status, response := http.StatusAccepted, `{"status": "success", "dedup_key": "contract-lag"}`
+	server := testutils.NewServer(t, func(w http.ResponseWriter, _ testutils.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	})
+	notifier := NewPagerDutyNotifier(server.URL+"/v2/enqueue", "routing-key", "proposer-10")
+
+	// Status reports don't page.
+	require.NoError(t, notifier.Notify(context.Background(), Notification{Key: "status", Severity: SeverityInfo, Title: "Status"}))
+	require.Empty(t, server.Requests())
+
+	require.NoError(t, notifier.Notify(context.Background(), testAlert))
+	resolved := testAlert
+	resolved.Resolved = true
+	require.NoError(t, notifier.Notify(context.Background(), resolved))
+
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	require.JSONEq(t, `{
+		"routing_key": "routing-key",
+		"event_action": "trigger",
+		"dedup_key": "contract-lag",
+		"payload": {
+			"summary": "Contract is behind L2 finalized: The contract is 90 minutes behind L2 finalized.",
+			"source": "proposer-10",
+			"severity": "critical"
+		}
+	}`, string(requests[0].Body))
+	require.JSONEq(t, `{"routing_key": "routing-key", "event_action": "resolve", "dedup_key": "contract-lag"}`, string(requests[1].Body))
+
+	status, response = http.StatusBadRequest, `{"status": "invalid event"}`
+	require.ErrorContains(t, notifier.Notify(context.Background(), testAlert), "invalid event")
+}
+
+// recordingNotifier records the notifications it sends, failing them while err is set.
+type recordingNotifier struct {
+	sent []Notification
+	err  error
+}
+
+func (r *recordingNotifier) Notify(_ context.Context, n Notification) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.sent = append(r.sent, n)
+	return nil
+}
+
+func TestAlerter(t *testing.T) {
+	notifier := new(recordingNotifier)
+	alerter := NewAlerter(Multi{NewLogNotifier(log.NewLogger(log.DiscardHandler())), notifier})
+	ctx := context.Background()
+
+	// Resolving an alert that wasn't raised doesn't notify.
+	require.NoError(t, alerter.Resolve(ctx, testAlert.Key, "caught up"))
+	require.Empty(t, notifier.sent)
+
+	// An alert is only sent once while it's active.
+	require.NoError(t, alerter.Raise(ctx, testAlert))
+	require.NoError(t, alerter.Raise(ctx, testAlert))
+	require.Len(t, notifier.sent, 1)
+	require.True(t, alerter.IsActive(testAlert.Key))
+
+	require.NoError(t, alerter.Resolve(ctx, testAlert.Key, "The contract caught up."))
+	require.Len(t, notifier.sent, 2)
+	require.True(t, notifier.sent[1].Resolved)
+	require.Equal(t, "The contract caught up.", notifier.sent[1].Message)
+	require.False(t, alerter.IsActive(testAlert.Key))
+
+	// An alert that failed to send is raised again on the next check.
+	single := NewAlerter(notifier)
+	notifier.err = errors.New("sink down")
+	require.Error(t, single.Raise(ctx, testAlert))
+	require.False(t, single.IsActive(testAlert.Key))
+	notifier.err = nil
+	require.NoError(t, single.Raise(ctx, testAlert))
+	require.Len(t, notifier.sent, 3)
+	require.True(t, single.IsActive(testAlert.Key))
+}
+
+func TestAlerterPartialDelivery(t *testing.T) {
+	up, down := new(recordingNotifier), new(recordingNotifier)
+	alerter := NewAlerter(Multi{up, down})
+	ctx := context.Background()
+
+	// An alert sent by one of the sinks is active, and only retried on the sink that failed.
+	down.err = errors.New("sink down")
+	require.ErrorContains(t, alerter.Raise(ctx, testAlert), "sink down")
+	require.True(t, alerter.IsActive(testAlert.Key))
+	require.ErrorContains(t, alerter.Raise(ctx, testAlert), "sink down")
+	require.Len(t, up.sent, 1)
+
+	down.err = nil
+	require.NoError(t, alerter.Raise(ctx, testAlert))
+	require.NoError(t, alerter.Raise(ctx, testAlert))
+	require.Len(t, up.sent, 1)
+	require.Len(t, down.sent, 1)
+
+	// The resolution is retried on the sinks that failed to send it.
+	up.err = errors.New("sink down")
+	require.ErrorContains(t, alerter.Resolve(ctx, testAlert.Key, "The contract caught up."), "sink down")
+	require.True(t, alerter.IsActive(testAlert.Key))
+	require.Len(t, down.sent, 2)
+	require.True(t, down.sent[1].Resolved)
+
+	up.err = nil
+	require.NoError(t, alerter.Resolve(ctx, testAlert.Key, "The contract caught up."))
+	require.False(t, alerter.IsActive(testAlert.Key))
+	require.Len(t, up.sent, 2)
+	require.True(t, up.sent[1].Resolved)
+	require.Len(t, down.sent, 2)
+
+	// A resolved alert that wasn't sent to a sink isn't resolved on it.
+	down.err = errors.New("sink down")
+	require.Error(t, alerter.Raise(ctx, testAlert))
+	require.NoError(t, alerter.Resolve(ctx, testAlert.Key, "The contract caught up."))
+	require.Len(t, up.sent, 4)
+	require.Len(t, down.sent, 2)
+}
@@ -0,0 +1,76 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PagerDutyEventsURL is the endpoint of the PagerDuty Events API v2.
+const PagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// pagerDutyMaxSummary is the maximum length of the summary of a PagerDuty event.
+const pagerDutyMaxSummary = 1024
+
+// PagerDutyNotifier triggers and resolves PagerDuty incidents with the Events API v2. Status reports aren't sent, so
+// that only alerts page the on-call.
+type PagerDutyNotifier struct {
+	url        string
+	routingKey string
+	source     string
+	client     *http.Client
+}
+
+// NewPagerDutyNotifier creates a notifier sending events to the PagerDuty service with the routing key. The source
+// identifies the proposer in the incidents.
+func NewPagerDutyNotifier(url, routingKey, source string) *PagerDutyNotifier {
+	return &PagerDutyNotifier{
+		url:        url,
+		routingKey: routingKey,
+		source:     source,
+		client:     &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key,omitempty"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary  string `json:"summary"`
+	Source   string `json:"source"`
+	Severity string `json:"severity"`
+}
+
+func (p *PagerDutyNotifier) Notify(ctx context.Context, n Notification) error {
+	if n.Severity == SeverityInfo {
+		return nil
+	}
+
+	event := pagerDutyEvent{
+		RoutingKey:  p.routingKey,
+		EventAction: "trigger",
+		DedupKey:    n.Key,
+	}
+	if n.Resolved {
+		event.EventAction = "resolve"
+	} else {
+		summary := fmt.Sprintf("%s: %s", n.Title, n.Message)
+		if len(summary) > pagerDutyMaxSummary {
+			summary = summary[:pagerDutyMaxSummary]
+		}
+		event.Payload = &pagerDutyPayload{
+			Summary:  summary,
+			Source:   p.source,
+			Severity: string(n.Severity),
+		}
+	}
+
+	if err := postJSON(ctx, p.client, p.url, event); err != nil {
+		return fmt.Errorf("error sending PagerDuty event: %w", err)
+	}
+	return nil
+}
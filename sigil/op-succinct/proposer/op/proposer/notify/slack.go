@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+)
+
+// SlackNotifier posts notifications to a Slack channel.
+type SlackNotifier struct {
+	client  *slack.Client
+	channel string
+}
+
+func NewSlackNotifier(token, channel string, options ...slack.Option) *SlackNotifier {
+	return &SlackNotifier{
+		client:  slack.New(token, options...),
+		channel: channel,
+	}
+}
+
+func (s *SlackNotifier) Notify(ctx context.Context, n Notification) error {
+	_, _, err := s.client.PostMessageContext(ctx, s.channel, slack.MsgOptionText(n.Text(), false))
+	if err != nil {
+		return fmt.Errorf("error sending Slack notification: %w", err)
+	}
+	return nil
+}
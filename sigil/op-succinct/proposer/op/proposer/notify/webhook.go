@@ -0,0 +1,27 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// WebhookNotifier posts notifications as JSON to a URL.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+func (w *WebhookNotifier) Notify(ctx context.Context, n Notification) error {
+	if err := postJSON(ctx, w.client, w.url, n); err != nil {
+		return fmt.Errorf("error sending webhook notification: %w", err)
+	}
+	return nil
+}
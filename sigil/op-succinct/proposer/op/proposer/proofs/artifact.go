@@ -0,0 +1,189 @@
+// Package proofs moves completed proofs between proposer DBs, and checks AGG proofs against an
+// OPSuccinctL2OutputOracle deployment without submitting them.
+package proofs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// FormatVersion is the version of the proof file format written by Write. Read rejects files of other versions.
+const FormatVersion = 1
+
+// File is the format proofs are exported to.
+type File struct {
+	Version  int        `json:"version"`
+	ChainID  uint64     `json:"chainId"`
+	Metadata Metadata   `json:"metadata"`
+	Proofs   []Artifact `json:"proofs"`
+}
+
+// Metadata identifies the programs and the chain the proofs were generated for. It is read from the
+// OPSuccinctL2OutputOracle the proofs were exported for, and is zero if none was given.
+type Metadata struct {
+	L2OOAddress         common.Address `json:"l2ooAddress"`
+	AggregationVkey     common.Hash    `json:"aggregationVkey"`
+	RangeVkeyCommitment common.Hash    `json:"rangeVkeyCommitment"`
+	RollupConfigHash    common.Hash    `json:"rollupConfigHash"`
+}
+
+// Artifact is a completed proof.
+type Artifact struct {
+	Type       proofrequest.Type `json:"type"`
+	StartBlock uint64            `json:"startBlock"`
+	EndBlock   uint64            `json:"endBlock"`
+	// The L1 head the AGG proof was generated against.
+	L1BlockNumber uint64        `json:"l1BlockNumber,omitempty"`
+	L1BlockHash   common.Hash   `json:"l1BlockHash,omitempty"`
+	Cycles        uint64        `json:"cycles,omitempty"`
+	GasUsed       uint64        `json:"gasUsed,omitempty"`
+	Proof         hexutil.Bytes `json:"proof"`
+}
+
+// IsZero returns whether the metadata is unknown.
+func (m Metadata) IsZero() bool {
+	return m == Metadata{}
+}
+
+// Mismatches returns the fields of the metadata that differ from other. Only the program and chain identifiers are
+// compared, since the same proofs can be checked against several deployments.
+func (m Metadata) Mismatches(other Metadata) []string {
+	var fields []string
+	if m.AggregationVkey != other.AggregationVkey {
+		fields = append(fields, fmt.Sprintf("aggregation vkey %s != %s", m.AggregationVkey, other.AggregationVkey))
+	}
+	if m.RangeVkeyCommitment != other.RangeVkeyCommitment {
+		fields = append(fields, fmt.Sprintf("range vkey commitment %s != %s", m.RangeVkeyCommitment, other.RangeVkeyCommitment))
+	}
+	if m.RollupConfigHash != other.RollupConfigHash {
+		fields = append(fields, fmt.Sprintf("rollup config hash %s != %s", m.RollupConfigHash, other.RollupConfigHash))
+	}
+	return fields
+}
+
+// ReadMetadata reads the metadata of the proofs accepted by the OPSuccinctL2OutputOracle at the address.
+func ReadMetadata(caller bind.ContractCaller, l2ooAddress common.Address) (Metadata, error) {
+	contract, err := opsuccinctbindings.NewOPSuccinctL2OutputOracleCaller(l2ooAddress, caller)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("failed to bind L2OO contract: %w", err)
+	}
+	meta := Metadata{L2OOAddress: l2ooAddress}
+	if meta.AggregationVkey, err = contract.AggregationVkey(nil); err != nil {
+		return Metadata{}, fmt.Errorf("failed to read aggregation vkey: %w", err)
+	}
+	if meta.RangeVkeyCommitment, err = contract.RangeVkeyCommitment(nil); err != nil {
+		return Metadata{}, fmt.Errorf("failed to read range vkey commitment: %w", err)
+	}
+	if meta.RollupConfigHash, err = contract.RollupConfigHash(nil); err != nil {
+		return Metadata{}, fmt.Errorf("failed to read rollup config hash: %w", err)
+	}
+	return meta, nil
+}
+
+// Export returns the COMPLETE proofs of the DB matching the filter.
+func Export(proofDB *db.ProofDB, filter db.ProofRequestFilter) ([]Artifact, error) {
+	filter.Status = proofrequest.StatusCOMPLETE
+	reqs, err := proofDB.ListProofRequests(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := make([]Artifact, 0, len(reqs))
+	for _, req := range reqs {
+		artifact := Artifact{
+			Type:       req.Type,
+			StartBlock: req.StartBlock,
+			EndBlock:   req.EndBlock,
+			Cycles:     req.Cycles,
+			GasUsed:    req.GasUsed,
+			Proof:      req.Proof,
+		}
+		if req.Type == proofrequest.TypeAGG {
+			artifact.L1BlockNumber = req.L1BlockNumber
+			artifact.L1BlockHash = common.HexToHash(req.L1BlockHash)
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	return artifacts, nil
+}
+
+// Import adds the proofs to the DB as COMPLETE proof requests. Proofs overlapping a request of the same type that
+// isn't FAILED are skipped. Returns the number of imported proofs.
+func Import(proofDB *db.ProofDB, artifacts []Artifact) (int, error) {
+	imported := 0
+	for _, a := range artifacts {
+		ok, err := proofDB.ImportProof(db.ImportedProof{
+			Type:          a.Type,
+			StartBlock:    a.StartBlock,
+			EndBlock:      a.EndBlock,
+			L1BlockNumber: a.L1BlockNumber,
+			L1BlockHash:   a.L1BlockHash.Hex(),
+			Proof:         a.Proof,
+			Cycles:        a.Cycles,
+			GasUsed:       a.GasUsed,
+		})
+		if err != nil {
+			return imported, fmt.Errorf("failed to import %s proof of blocks %d-%d: %w", a.Type, a.StartBlock, a.EndBlock, err)
+		}
+		if ok {
+			imported++
+		}
+	}
+	return imported, nil
+}
+
+// Write writes the proofs to the file at the path.
+func Write(path string, f *File) error {
+	f.Version = FormatVersion
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode proofs: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write proofs: %w", err)
+	}
+	return nil
+}
+
+// Read reads and checks the proofs of the file at the path.
+func Read(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proofs: %w", err)
+	}
+	var f File
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to decode proofs: %w", err)
+	}
+	if f.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported proof file version %d, expected %d", f.Version, FormatVersion)
+	}
+	for _, a := range f.Proofs {
+		if err := a.check(); err != nil {
+			return nil, err
+		}
+	}
+	return &f, nil
+}
+
+func (a Artifact) check() error {
+	if a.Type != proofrequest.TypeSPAN && a.Type != proofrequest.TypeAGG {
+		return fmt.Errorf("invalid proof type %q", a.Type)
+	}
+	if a.StartBlock >= a.EndBlock {
+		return fmt.Errorf("invalid block range %d-%d of %s proof", a.StartBlock, a.EndBlock, a.Type)
+	}
+	if a.Type == proofrequest.TypeAGG && (a.L1BlockNumber == 0 || a.L1BlockHash == (common.Hash{})) {
+		return fmt.Errorf("AGG proof of blocks %d-%d has no L1 head", a.StartBlock, a.EndBlock)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package proofs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/flags"
+)
+
+var (
+	fileFlag = &cli.StringFlag{
+		Name:     "file",
+		Usage:    "Path of the proof file",
+		Required: true,
+	}
+	typeFlag = &cli.StringFlag{
+		Name:  "type",
+		Usage: "Only export proofs of this type (SPAN or AGG)",
+	}
+	fromBlockFlag = &cli.Uint64Flag{
+		Name:  "from-block",
+		Usage: "Only export proofs ending after this L2 block",
+	}
+	toBlockFlag = &cli.Uint64Flag{
+		Name:  "to-block",
+		Usage: "Only export proofs starting at or before this L2 block",
+	}
+	proposerAddressFlag = &cli.StringFlag{
+		Name:     "proposer-address",
+		Usage:    "Address to simulate the proposals from. Must be an approved proposer of the L2OO, unless anyone can propose",
+		Required: true,
+	}
+	forceFlag = &cli.BoolFlag{
+		Name:  "force",
+		Usage: "Import the proofs even if their metadata differs from the L2OO",
+	}
+)
+
+// Subcommands are the commands to export, import and verify proofs.
+var Subcommands = cli.Commands{
+	{
+		Name:  "export",
+		Usage: "Exports the completed proofs of the proof DB to a file",
+		Flags: []cli.Flag{fileFlag, typeFlag, fromBlockFlag, toBlockFlag, flags.RollupRpcFlag, flags.DbPathFlag, flags.DbUrlFlag, flags.L1EthRpcFlag, flags.L2OOAddressFlag},
+		Action: func(ctx *cli.Context) error {
+			chainID, err := chainID(ctx)
+			if err != nil {
+				return err
+			}
+			proofDB, err := openDB(ctx, chainID)
+			if err != nil {
+				return err
+			}
+			defer proofDB.CloseDB()
+
+			f := &File{ChainID: chainID}
+			if ctx.IsSet(flags.L2OOAddressFlag.Name) {
+				if f.Metadata, err = l2ooMetadata(ctx); err != nil {
+					return err
+				}
+			} else {
+				fmt.Fprintln(ctx.App.ErrWriter, "No L2OO address set, exporting proofs without metadata")
+			}
+
+			f.Proofs, err = Export(proofDB, db.ProofRequestFilter{
+				Type:      proofrequest.Type(ctx.String(typeFlag.Name)),
+				FromBlock: ctx.Uint64(fromBlockFlag.Name),
+				ToBlock:   ctx.Uint64(toBlockFlag.Name),
+			})
+			if err != nil {
+				return err
+			}
+			if err := Write(ctx.String(fileFlag.Name), f); err != nil {
+				return err
+			}
+			fmt.Fprintf(ctx.App.Writer, "Exported %d proofs to %s\n", len(f.Proofs), ctx.String(fileFlag.Name))
+			return nil
+		},
+	},
+	{
+		Name:  "import",
+		Usage: "Imports the proofs of a file into the proof DB. The proposer must be stopped, or use USE_CACHED_DB or DB_URL",
+		Flags: []cli.Flag{fileFlag, forceFlag, flags.RollupRpcFlag, flags.DbPathFlag, flags.DbUrlFlag, flags.L1EthRpcFlag, flags.L2OOAddressFlag},
+		Action: func(ctx *cli.Context) error {
+			f, err := Read(ctx.String(fileFlag.Name))
+			if err != nil {
+				return err
+			}
+			chainID, err := chainID(ctx)
+			if err != nil {
+				return err
+			}
+			if f.ChainID != chainID {
+				return fmt.Errorf("proofs are for chain %d, but the rollup node is on chain %d", f.ChainID, chainID)
+			}
+
+			if ctx.IsSet(flags.L2OOAddressFlag.Name) {
+				meta, err := l2ooMetadata(ctx)
+				if err != nil {
+					return err
+				}
+				if mismatches := f.Metadata.Mismatches(meta); len(mismatches) > 0 && !ctx.Bool(forceFlag.Name) {
+					return fmt.Errorf("proof metadata differs from the L2OO: %v", mismatches)
+				}
+			}
+
+			proofDB, err := openDB(ctx, chainID)
+			if err != nil {
+				return err
+			}
+			defer proofDB.CloseDB()
+
+			imported, err := Import(proofDB, f.Proofs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(ctx.App.Writer, "Imported %d proofs, skipped %d overlapping proofs\n", imported, len(f.Proofs)-imported)
+			return nil
+		},
+	},
+	{
+		Name:  "verify",
+		Usage: "Checks the AGG proofs of a file against an L2OO deployment, by simulating their proposal",
+		Flags: []cli.Flag{fileFlag, proposerAddressFlag, flags.RollupRpcFlag, flags.L1EthRpcFlag, flags.L2OOAddressFlag},
+		Action: func(ctx *cli.Context) error {
+			f, err := Read(ctx.String(fileFlag.Name))
+			if err != nil {
+				return err
+			}
+			rollupClient, err := rollupClient(ctx)
+			if err != nil {
+				return err
+			}
+			l1Client, l2ooAddress, err := l2oo(ctx)
+			if err != nil {
+				return err
+			}
+			if !common.IsHexAddress(ctx.String(proposerAddressFlag.Name)) {
+				return fmt.Errorf("invalid proposer address %q", ctx.String(proposerAddressFlag.Name))
+			}
+			verifier, err := NewVerifier(l1Client, l2ooAddress, rollupClient, common.HexToAddress(ctx.String(proposerAddressFlag.Name)))
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+			verified := 0
+			for _, a := range f.Proofs {
+				if a.Type != proofrequest.TypeAGG {
+					continue
+				}
+				checks, err := verifier.Verify(ctx.Context, f.Metadata, a)
+				if err != nil {
+					return err
+				}
+				verified++
+				fmt.Fprintf(ctx.App.Writer, "AGG proof of blocks %d-%d:\n", a.StartBlock, a.EndBlock)
+				ok := true
+				for _, c := range checks {
+					if c.Err != nil {
+						ok = false
+						fmt.Fprintf(ctx.App.Writer, "  %s: FAIL: %v\n", c.Name, c.Err)
+					} else {
+						fmt.Fprintf(ctx.App.Writer, "  %s: OK\n", c.Name)
+					}
+				}
+				if !ok {
+					failed++
+				}
+			}
+			if verified == 0 {
+				return errors.New("no AGG proofs to verify")
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d AGG proofs would be rejected", failed, verified)
+			}
+			return nil
+		},
+	},
+}
+
+func rollupClient(ctx *cli.Context) (*sources.RollupClient, error) {
+	if !ctx.IsSet(flags.RollupRpcFlag.Name) {
+		return nil, fmt.Errorf("--%s is required", flags.RollupRpcFlag.Name)
+	}
+	return dial.DialRollupClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, nil, ctx.String(flags.RollupRpcFlag.Name))
+}
+
+// chainID returns the L2 chain ID of the rollup node, which determines the path of the SQLite proof DB.
+func chainID(ctx *cli.Context) (uint64, error) {
+	client, err := rollupClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rollupConfig, err := client.RollupConfig(ctx.Context)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch rollup config: %w", err)
+	}
+	return rollupConfig.L2ChainID.Uint64(), nil
+}
+
+// openDB opens the proof DB of the proposer of the chain. Unlike the proposer, the SQLite DB is never deleted.
+func openDB(ctx *cli.Context, chainID uint64) (*db.ProofDB, error) {
+	if dbUrl := ctx.String(flags.DbUrlFlag.Name); dbUrl != "" {
+		return db.InitPostgresDB(dbUrl)
+	}
+	dbPath := filepath.Join(ctx.String(flags.DbPathFlag.Name), fmt.Sprintf("%d", chainID), "proofs.db")
+	if _, err := os.Stat(dbPath); err != nil {
+		return nil, fmt.Errorf("failed to find proof DB: %w", err)
+	}
+	return db.InitDB(dbPath, true)
+}
+
+func l2oo(ctx *cli.Context) (*ethclient.Client, common.Address, error) {
+	if !ctx.IsSet(flags.L1EthRpcFlag.Name) || !ctx.IsSet(flags.L2OOAddressFlag.Name) {
+		return nil, common.Address{}, fmt.Errorf("--%s and --%s are required", flags.L1EthRpcFlag.Name, flags.L2OOAddressFlag.Name)
+	}
+	address := ctx.String(flags.L2OOAddressFlag.Name)
+	if !common.IsHexAddress(address) {
+		return nil, common.Address{}, fmt.Errorf("invalid L2OO address %q", address)
+	}
+	client, err := ethclient.DialContext(ctx.Context, ctx.String(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to dial L1 RPC: %w", err)
+	}
+	return client, common.HexToAddress(address), nil
+}
+
+func l2ooMetadata(ctx *cli.Context) (Metadata, error) {
+	client, address, err := l2oo(ctx)
+	if err != nil {
+		return Metadata{}, err
+	}
+	defer client.Close()
+	return ReadMetadata(client, address)
+}
@@ -0,0 +1,171 @@
+package proofs
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/testutils"
+)
+
+// completeProof adds a proof request and completes it with the proof.
+func completeProof(t *testing.T, proofDB *db.ProofDB, proofType proofrequest.Type, start, end uint64, proof []byte) {
+	ok, err := proofDB.ImportProof(db.ImportedProof{Type: proofType, StartBlock: start, EndBlock: end, L1BlockNumber: 500, L1BlockHash: common.Hash{0x01}.Hex(), Proof: proof})
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestExportImport(t *testing.T) {
+	source := testutils.NewTestDB(t)
+	completeProof(t, source, proofrequest.TypeSPAN, 100, 200, []byte{0x01})
+	completeProof(t, source, proofrequest.TypeSPAN, 200, 300, []byte{0x02})
+	completeProof(t, source, proofrequest.TypeAGG, 100, 300, []byte{0x03})
+	require.NoError(t, source.NewEntry(proofrequest.TypeSPAN, 300, 400))
+
+	artifacts, err := Export(source, db.ProofRequestFilter{})
+	require.NoError(t, err)
+	require.Len(t, artifacts, 3, "only completed proofs are exported")
+
+	path := filepath.Join(t.TempDir(), "proofs.json")
+	meta := Metadata{AggregationVkey: common.Hash{0xaa}, RangeVkeyCommitment: common.Hash{0xbb}, RollupConfigHash: common.Hash{0xcc}}
+	require.NoError(t, Write(path, &File{ChainID: 10, Metadata: meta, Proofs: artifacts}))
+	f, err := Read(path)
+	require.NoError(t, err)
+	require.Equal(t, FormatVersion, f.Version)
+	require.Equal(t, uint64(10), f.ChainID)
+	require.Equal(t, meta, f.Metadata)
+	require.Equal(t, artifacts, f.Proofs)
+	require.Equal(t, common.Hash{0x01}, f.Proofs[1].L1BlockHash, "AGG proofs keep their L1 head")
+
+	// The target DB already has a request for the first span, so only the other proofs are imported.
+	target := testutils.NewTestDB(t)
+	require.NoError(t, target.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	imported, err := Import(target, f.Proofs)
+	require.NoError(t, err)
+	require.Equal(t, 2, imported)
+
+	aggs, err := target.GetAllCompletedAggProofs(100)
+	require.NoError(t, err)
+	require.Len(t, aggs, 1)
+	require.Equal(t, []byte{0x03}, aggs[0].Proof)
+	require.Equal(t, uint64(500), aggs[0].L1BlockNumber)
+	require.Equal(t, common.Hash{0x01}.Hex(), aggs[0].L1BlockHash)
+
+	imported, err = Import(target, f.Proofs)
+	require.NoError(t, err)
+	require.Zero(t, imported, "proofs are only imported once")
+}
+
+func TestReadRejectsUnsupportedVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proofs.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 2, "proofs": []}`), 0o644))
+	_, err := Read(path)
+	require.ErrorContains(t, err, "unsupported proof file version 2")
+}
+
+// l2ooStub answers calls to the L2OO view functions from its fields, and calls to proposeL2Output with revertErr.
+type l2ooStub struct {
+	t            *testing.T
+	meta         Metadata
+	latestBlock  uint64
+	checkpointed map[uint64]common.Hash
+	revertErr    error
+	proposals    int
+}
+
+func (s *l2ooStub) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (s *l2ooStub) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	l2ooABI, err := opsuccinctbindings.OPSuccinctL2OutputOracleMetaData.GetAbi()
+	require.NoError(s.t, err)
+	method, err := l2ooABI.MethodById(call.Data)
+	require.NoError(s.t, err)
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	require.NoError(s.t, err)
+
+	switch method.Name {
+	case "aggregationVkey":
+		return method.Outputs.Pack(s.meta.AggregationVkey)
+	case "rangeVkeyCommitment":
+		return method.Outputs.Pack(s.meta.RangeVkeyCommitment)
+	case "rollupConfigHash":
+		return method.Outputs.Pack(s.meta.RollupConfigHash)
+	case "latestBlockNumber":
+		return method.Outputs.Pack(new(big.Int).SetUint64(s.latestBlock))
+	case "historicBlockHashes":
+		return method.Outputs.Pack(s.checkpointed[args[0].(*big.Int).Uint64()])
+	case "proposeL2Output":
+		s.proposals++
+		require.Equal(s.t, eth.Bytes32{0x0f}, eth.Bytes32(args[0].([32]byte)))
+		return nil, s.revertErr
+	}
+	s.t.Fatalf("unexpected call to %s", method.Name)
+	return nil, nil
+}
+
+type outputStub struct{}
+
+func (outputStub) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	return &eth.OutputResponse{OutputRoot: eth.Bytes32{0x0f}, BlockRef: eth.L2BlockRef{Number: blockNum}}, nil
+}
+
+func TestVerify(t *testing.T) {
+	meta := Metadata{AggregationVkey: common.Hash{0xaa}, RangeVkeyCommitment: common.Hash{0xbb}, RollupConfigHash: common.Hash{0xcc}}
+	proof := Artifact{Type: proofrequest.TypeAGG, StartBlock: 100, EndBlock: 300, L1BlockNumber: 500, L1BlockHash: common.Hash{0x01}, Proof: []byte{0x03}}
+	failures := func(checks []Check) map[string]string {
+		failed := make(map[string]string)
+		for _, c := range checks {
+			if c.Err != nil {
+				failed[c.Name] = c.Err.Error()
+			}
+		}
+		return failed
+	}
+
+	t.Run("Accepted", func(t *testing.T) {
+		stub := &l2ooStub{t: t, meta: meta, latestBlock: 100, checkpointed: map[uint64]common.Hash{500: {0x01}}}
+		verifier, err := NewVerifier(stub, common.Address{0x42}, outputStub{}, common.Address{0x01})
+		require.NoError(t, err)
+		checks, err := verifier.Verify(context.Background(), meta, proof)
+		require.NoError(t, err)
+		require.Len(t, checks, 4)
+		require.Empty(t, failures(checks))
+		require.Equal(t, 1, stub.proposals)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		stub := &l2ooStub{t: t, meta: meta, latestBlock: 200, revertErr: errors.New("execution reverted")}
+		stub.meta.RangeVkeyCommitment = common.Hash{0xdd}
+		verifier, err := NewVerifier(stub, common.Address{0x42}, outputStub{}, common.Address{0x01})
+		require.NoError(t, err)
+		checks, err := verifier.Verify(context.Background(), meta, proof)
+		require.NoError(t, err)
+
+		failed := failures(checks)
+		require.Len(t, failed, 4)
+		require.Contains(t, failed["metadata"], "range vkey commitment")
+		require.Contains(t, failed["start block"], "latest block on the L2OO is 200")
+		require.Contains(t, failed["l1 head"], "L1 block 500 is not checkpointed")
+		require.Contains(t, failed["proposal"], "execution reverted")
+	})
+
+	t.Run("Only AGG proofs", func(t *testing.T) {
+		verifier, err := NewVerifier(&l2ooStub{t: t}, common.Address{0x42}, outputStub{}, common.Address{0x01})
+		require.NoError(t, err)
+		_, err = verifier.Verify(context.Background(), meta, Artifact{Type: proofrequest.TypeSPAN})
+		require.Error(t, err)
+	})
+}
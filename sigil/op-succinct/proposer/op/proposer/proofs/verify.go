@@ -0,0 +1,151 @@
+package proofs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// OutputSource returns the output roots of L2 blocks, like the rollup node.
+type OutputSource interface {
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
+}
+
+// Check is the result of checking one condition for an AGG proof to be accepted by the L2OO.
+type Check struct {
+	Name string
+	Err  error
+}
+
+// Verifier checks AGG proofs against an OPSuccinctL2OutputOracle deployment, by simulating their proposal with
+// eth_call. Nothing is sent to the chain.
+type Verifier struct {
+	caller   bind.ContractCaller
+	l2oo     common.Address
+	contract *opsuccinctbindings.OPSuccinctL2OutputOracleCaller
+	l2ooABI  *abi.ABI
+	outputs  OutputSource
+	// proposer is the address the proposal is simulated from.
+	proposer common.Address
+}
+
+// NewVerifier creates a Verifier for the L2OO at the address. The proposals are simulated from the proposer address,
+// which must be an approved proposer unless the L2OO allows anyone to propose.
+func NewVerifier(caller bind.ContractCaller, l2oo common.Address, outputs OutputSource, proposer common.Address) (*Verifier, error) {
+	contract, err := opsuccinctbindings.NewOPSuccinctL2OutputOracleCaller(l2oo, caller)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind L2OO contract: %w", err)
+	}
+	parsed, err := opsuccinctbindings.OPSuccinctL2OutputOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse L2OO ABI: %w", err)
+	}
+	return &Verifier{caller: caller, l2oo: l2oo, contract: contract, l2ooABI: parsed, outputs: outputs, proposer: proposer}, nil
+}
+
+// Verify checks that the AGG proof, exported with the metadata, would be accepted by the L2OO in its current state.
+// All checks are run, so that every reason for a rejection is reported.
+func (v *Verifier) Verify(ctx context.Context, meta Metadata, a Artifact) ([]Check, error) {
+	if a.Type != proofrequest.TypeAGG {
+		return nil, fmt.Errorf("only AGG proofs can be verified, got a %s proof", a.Type)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	var checks []Check
+	metaCheck := Check{Name: "metadata"}
+	if meta.IsZero() {
+		metaCheck.Err = fmt.Errorf("the proofs were exported without metadata")
+	} else if l2ooMeta, err := ReadMetadata(v.caller, v.l2oo); err != nil {
+		metaCheck.Err = err
+	} else if mismatches := meta.Mismatches(l2ooMeta); len(mismatches) > 0 {
+		metaCheck.Err = fmt.Errorf("proof metadata differs from the L2OO: %v", mismatches)
+	}
+	checks = append(checks, metaCheck)
+
+	startCheck := Check{Name: "start block"}
+	latest, err := v.contract.LatestBlockNumber(opts)
+	if err != nil {
+		startCheck.Err = fmt.Errorf("failed to read latest block number: %w", err)
+	} else if latest.Uint64() != a.StartBlock {
+		startCheck.Err = fmt.Errorf("proof starts at block %d, but the latest block on the L2OO is %d", a.StartBlock, latest.Uint64())
+	}
+	checks = append(checks, startCheck)
+
+	l1HeadCheck := Check{Name: "l1 head"}
+	checkpointed, err := v.contract.HistoricBlockHashes(opts, new(big.Int).SetUint64(a.L1BlockNumber))
+	if err != nil {
+		l1HeadCheck.Err = fmt.Errorf("failed to read checkpointed block hash: %w", err)
+	} else if checkpointed == [32]byte{} {
+		l1HeadCheck.Err = fmt.Errorf("L1 block %d is not checkpointed on the L2OO", a.L1BlockNumber)
+	} else if common.Hash(checkpointed) != a.L1BlockHash {
+		l1HeadCheck.Err = fmt.Errorf("L1 block %d is checkpointed with hash %s, but the proof was generated against %s", a.L1BlockNumber, common.Hash(checkpointed), a.L1BlockHash)
+	}
+	checks = append(checks, l1HeadCheck)
+
+	checks = append(checks, Check{Name: "proposal", Err: v.simulateProposal(ctx, a)})
+	return checks, nil
+}
+
+// simulateProposal calls proposeL2Output with the proof and the output root of its end block, returning the revert
+// reason if the L2OO rejects it.
+func (v *Verifier) simulateProposal(ctx context.Context, a Artifact) error {
+	output, err := v.outputs.OutputAtBlock(ctx, a.EndBlock)
+	if err != nil {
+		return fmt.Errorf("failed to fetch output at block %d: %w", a.EndBlock, err)
+	}
+	data, err := v.l2ooABI.Pack(
+		"proposeL2Output",
+		output.OutputRoot,
+		new(big.Int).SetUint64(a.EndBlock),
+		new(big.Int).SetUint64(a.L1BlockNumber),
+		[]byte(a.Proof))
+	if err != nil {
+		return fmt.Errorf("failed to pack proposeL2Output call: %w", err)
+	}
+	if _, err := v.caller.CallContract(ctx, ethereum.CallMsg{From: v.proposer, To: &v.l2oo, Data: data}, nil); err != nil {
+		if reason := RevertReason(v.l2ooABI, err); reason != "" {
+			return fmt.Errorf("proposeL2Output reverted: %s", reason)
+		}
+		return fmt.Errorf("proposeL2Output reverted: %w", err)
+	}
+	return nil
+}
+
+// RevertReason decodes the revert data of a failed call, which is either an Error(string) revert or one of the custom
+// errors of the contract. Returns an empty string if there's no revert data.
+func RevertReason(contractABI *abi.ABI, err error) string {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return ""
+	}
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return ""
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil || len(data) < 4 {
+		return ""
+	}
+	if reason, unpackErr := abi.UnpackRevert(data); unpackErr == nil {
+		return reason
+	}
+	for name, e := range contractABI.Errors {
+		if bytes.Equal(e.ID[:4], data[:4]) {
+			return name
+		}
+	}
+	return hexData
+}
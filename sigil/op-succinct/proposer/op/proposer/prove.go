@@ -1,14 +1,10 @@
 package proposer
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
-	"net"
-	"net/http"
-	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -18,46 +14,132 @@ import (
 
 const PROOF_STATUS_TIMEOUT = 30 * time.Second
 
-// Process all of requests in PROVING state.
+// Process all of requests in PROVING state. Requests that have been PROVING for longer than the proof timeout are
+// moved to another prover backend.
 func (l *L2OutputSubmitter) ProcessProvingRequests() error {
 	// Get all proof requests that are currently in the PROVING state.
 	reqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusPROVING)
 	if err != nil {
 		return err
 	}
+	// Failing to get the status of a proof from one backend doesn't stop processing the proofs of the other backends.
+	var statusErrs []error
 	for _, req := range reqs {
-		proofStatus, err := l.GetProofStatus(req.ProverRequestID)
+		backend, err := l.provers.backend(req.ProverBackend)
 		if err != nil {
-			l.Log.Error("failed to get proof status for ID", "id", req.ProverRequestID, "err", err)
+			// The backend may have been removed from the config while the proof was in flight.
+			l.Log.Error("failed to get prover backend of proof request", "id", req.ID, "backend", req.ProverBackend, "err", err)
+			l.Metr.RecordError("get_proof_status", 1)
+			statusErrs = append(statusErrs, fmt.Errorf("request %d: %w", req.ID, err))
+			continue
+		}
+
+		if l.Cfg.ProofTimeout > 0 && req.ProofRequestTime+l.Cfg.ProofTimeout < uint64(time.Now().Unix()) {
+			l.Log.Warn("Proof timed out", "id", req.ID, "prover_request_id", req.ProverRequestID, "backend", backend.Name)
+			l.Metr.RecordProveFailure("Timeout")
+			if err := l.failoverRequest(req, backend); err != nil {
+				return fmt.Errorf("failed to move timed out request: %w", err)
+			}
+			continue
+		}
+
+		proofStatus, err := backend.Prover.GetProofStatus(l.ctx, req.ProverRequestID)
+		if err != nil {
+			l.Log.Error("failed to get proof status for ID", "id", req.ProverRequestID, "backend", backend.Name, "err", err)
 
 			// Record the error for the get proof status call.
 			l.Metr.RecordError("get_proof_status", 1)
-			return err
+			statusErrs = append(statusErrs, fmt.Errorf("prover backend %s: %w", backend.Name, err))
+			continue
 		}
 		if proofStatus.FulfillmentStatus == SP1FulfillmentStatusFulfilled {
 			// Update the proof in the DB and update status to COMPLETE.
 			l.Log.Info("Fulfilled Proof", "id", req.ProverRequestID)
-			err = l.db.AddFulfilledProof(req.ID, proofStatus.Proof)
+			err = l.db.AddFulfilledProof(req.ID, proofStatus.Proof, proofStatus.Cycles)
 			if err != nil {
 				l.Log.Error("failed to update completed proof status", "err", err)
 				return err
 			}
+			if err := l.ResolveRangeFailures(l.ctx, req, fmt.Sprintf("The %s proof of blocks %d-%d was fulfilled.", req.Type, req.StartBlock, req.EndBlock)); err != nil {
+				l.Log.Error("failed to resolve repeated failures alert", "id", req.ID, "err", err)
+			}
+			if l.spanSizer != nil && req.Type == proofrequest.TypeSPAN {
+				// The proof is already stored, so failing to observe it only delays learning the span size.
+				if err := l.observeSpanProof(l.ctx, req, proofStatus.Cycles); err != nil {
+					l.Log.Warn("failed to update adaptive span size", "id", req.ID, "err", err)
+				}
+			}
 			continue
 		}
 
 		if proofStatus.FulfillmentStatus == SP1FulfillmentStatusUnfulfillable {
 			// Record the failure reason.
-			l.Log.Info("Proof is unfulfillable", "id", req.ProverRequestID)
-			l.Metr.RecordProveFailure("unfulfillable")
+			l.Log.Info("Proof is unfulfillable", "id", req.ProverRequestID, "status", proofStatus)
+			if proofStatus.UnclaimDescription != nil {
+				l.Metr.RecordProveFailure(proofStatus.UnclaimDescription.String())
+			} else {
+				l.Metr.RecordProveFailure("unfulfillable")
+			}
+			if l.spanSizer != nil && req.Type == proofrequest.TypeSPAN && proofStatus.UnclaimDescription != nil && *proofStatus.UnclaimDescription == CycleLimitExceeded {
+				l.spanSizer.Shrink()
+			}
 
-			err = l.RetryRequest(req, proofStatus)
+			err = l.RetryRequest(req, proofStatus, "unfulfillable")
 			if err != nil {
 				return fmt.Errorf("failed to retry request: %w", err)
 			}
 		}
 	}
 
-	return nil
+	return errors.Join(statusErrs...)
+}
+
+// failoverRequest fails a request that timed out on the prover backend, and retries it on another backend that proves
+// it. If there is no other backend, it's retried on any backend.
+func (l *L2OutputSubmitter) failoverRequest(req *ent.ProofRequest, backend *ProverBackend) error {
+	inFlight, err := l.db.CountInFlightSpanProofsByBackend()
+	if err != nil {
+		return fmt.Errorf("failed to count in-flight proof requests: %w", err)
+	}
+
+	reason := fmt.Sprintf("proof timed out on prover backend %s", backend.Name)
+	failed, err := l.db.FailProofRequest(req.ID, reason, "")
+	if err != nil {
+		return err
+	}
+	if !failed {
+		l.Log.Info("proof request is no longer in flight, not retrying", "id", req.ID)
+		return nil
+	}
+	if err := l.checkRangeFailures(l.ctx, req, reason); err != nil {
+		l.Log.Error("failed to check repeated failures of proof request", "id", req.ID, "err", err)
+	}
+	// Cancel the proof so that the backend stops spending on it. The request is moved even if this fails.
+	if req.ProverRequestID != "" {
+		if err := backend.Prover.CancelProof(l.ctx, req.ProverRequestID); err != nil {
+			l.Log.Warn("failed to cancel timed out proof", "id", req.ID, "prover_request_id", req.ProverRequestID, "backend", backend.Name, "err", err)
+		}
+	}
+
+	target := l.provers.failover(req, backend.Name, inFlight)
+	if target == nil {
+		l.Log.Info("no other prover backend proves the request, retrying it on any backend", "id", req.ID)
+		return l.db.NewEntry(req.Type, req.StartBlock, req.EndBlock)
+	}
+	l.Log.Info("moving timed out proof request to another prover backend", "id", req.ID, "from", backend.Name, "to", target.Name)
+	return l.db.NewEntryOnBackend(req.Type, req.StartBlock, req.EndBlock, target.Name)
+}
+
+// CancelProof cancels a proof request on the prover backend it was requested from.
+func (l *L2OutputSubmitter) CancelProof(ctx context.Context, req *ent.ProofRequest) error {
+	if req.ProverRequestID == "" {
+		return nil
+	}
+	backend, err := l.provers.backend(req.ProverBackend)
+	if err != nil {
+		return err
+	}
+	return backend.Prover.CancelProof(ctx, req.ProverRequestID)
 }
 
 // Process all of requests in WITNESSGEN state.
@@ -72,40 +154,66 @@ func (l *L2OutputSubmitter) ProcessWitnessgenRequests() error {
 		// This is a catch-all in case the witness generation state update failed.
 		if req.LastUpdatedTime+uint64(l.Cfg.WitnessGenTimeout) < uint64(time.Now().Unix()) {
 			// Retry the request if it timed out.
-			l.RetryRequest(req, ProofStatusResponse{})
+			l.RetryRequest(req, ProofStatusResponse{}, "witness generation timed out")
 		}
 	}
 
 	return nil
 }
 
-// Retry a proof request. Sets the status of a proof to FAILED and retries the proof based on the optional proof status response.
+// Retry a proof request. Sets the status of a proof to FAILED, recording the reason and the optional proof status response,
+// and retries the proof based on the optional proof status response.
 // If an error response is received:
-// - Range Proof: Split in two if the block range is > 1. Retry the same request if range is 1 block.
+// - Range Proof: Split in two if the block range is > 1, or into spans sized by the span sizer with the adaptive span
+// strategy. Retry the same request if range is 1 block.
 // - Agg Proof: Retry the same request.
-func (l *L2OutputSubmitter) RetryRequest(req *ent.ProofRequest, status ProofStatusResponse) error {
-	err := l.db.UpdateProofStatus(req.ID, proofrequest.StatusFAILED)
+func (l *L2OutputSubmitter) RetryRequest(req *ent.ProofRequest, status ProofStatusResponse, reason string) error {
+	var proverResponse string
+	if status.FulfillmentStatus != SP1FulfillmentStatusUnspecified {
+		proverResponse = status.String()
+	}
+	failed, err := l.db.FailProofRequest(req.ID, reason, proverResponse)
 	if err != nil {
 		l.Log.Error("failed to update proof status", "err", err)
 		return err
 	}
-
+	if !failed {
+		// The request already failed or completed, so it has already been retried or doesn't need to be.
+		l.Log.Info("proof request is no longer in flight, not retrying", "id", req.ID)
+		return nil
+	}
 	// If there's an execution error AND the request is a SPAN proof AND the block range is > 1, split the request into two requests.
 	// This is likely caused by an SP1 OOM due to a large block range with many transactions.
 	// TODO: This solution can be removed once the embedded allocator is used, because then the programs
 	// will never OOM.
-	if req.Type == proofrequest.TypeSPAN && status.ExecutionStatus == SP1ExecutionStatusUnexecutable && req.EndBlock-req.StartBlock > 1 {
+	split := req.Type == proofrequest.TypeSPAN && status.ExecutionStatus == SP1ExecutionStatusUnexecutable && req.EndBlock-req.StartBlock > 1
+	if !split {
+		if err := l.checkRangeFailures(l.ctx, req, reason); err != nil {
+			l.Log.Error("failed to check repeated failures of proof request", "id", req.ID, "err", err)
+		}
+	}
+
+	if split {
 		// Split the request into two requests.
 		midBlock := (req.StartBlock + req.EndBlock) / 2
-		err = l.db.NewEntry(req.Type, req.StartBlock, midBlock)
-		if err != nil {
-			l.Log.Error("failed to retry first half of proof request", "err", err)
-			return err
+		spans := []Span{{Start: req.StartBlock, End: midBlock}, {Start: midBlock, End: req.EndBlock}}
+		if l.spanSizer != nil {
+			adaptiveSpans, err := l.splitSpanAdaptive(l.ctx, req)
+			if err != nil {
+				l.Log.Warn("failed to split proof request adaptively, splitting in half", "id", req.ID, "err", err)
+			} else {
+				spans = adaptiveSpans
+			}
 		}
-		err = l.db.NewEntry(req.Type, midBlock, req.EndBlock)
-		if err != nil {
-			l.Log.Error("failed to retry second half of proof request", "err", err)
-			return err
+		for _, span := range spans {
+			err = l.db.NewEntry(req.Type, span.Start, span.End)
+			if err != nil {
+				l.Log.Error("failed to retry part of proof request", "start", span.Start, "end", span.End, "err", err)
+				return err
+			}
+		}
+		if err := l.ResolveRangeFailures(l.ctx, req, fmt.Sprintf("The %s proof of blocks %d-%d was split into %d proofs.", req.Type, req.StartBlock, req.EndBlock, len(spans))); err != nil {
+			l.Log.Error("failed to resolve repeated failures alert", "id", req.ID, "err", err)
 		}
 	} else {
 		// Retry the same request.
@@ -120,7 +228,14 @@ func (l *L2OutputSubmitter) RetryRequest(req *ent.ProofRequest, status ProofStat
 }
 
 func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
-	nextProofToRequest, err := l.db.GetNextUnrequestedProof()
+	// Only the leader requests AGG proofs, since they require checkpointing an L1 block hash on the L2OO.
+	var nextProofToRequest *ent.ProofRequest
+	var err error
+	if l.IsLeader() {
+		nextProofToRequest, err = l.db.GetNextUnrequestedProof()
+	} else {
+		nextProofToRequest, err = l.db.GetNextUnrequestedSpanProof()
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get unrequested proofs: %w", err)
 	}
@@ -150,10 +265,6 @@ func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to count witnessgen proofs: %w", err)
 		}
-		provingProofs, err := l.db.GetNumberOfRequestsWithStatuses(proofrequest.StatusPROVING)
-		if err != nil {
-			return fmt.Errorf("failed to count proving proofs: %w", err)
-		}
 
 		// The number of witness generation requests is capped at MAX_CONCURRENT_WITNESS_GEN. This prevents overloading the machine with processes spawned by the witness generation server.
 		// Once https://github.com/anton-rs/kona/issues/553 is fixed, we may be able to remove this check.
@@ -161,27 +272,40 @@ func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
 			l.Log.Info("max witness generation reached, waiting for next cycle")
 			return nil
 		}
+	}
 
-		// The total number of concurrent proofs is capped at MAX_CONCURRENT_PROOF_REQUESTS.
-		if (witnessGenProofs + provingProofs) >= int(l.Cfg.MaxConcurrentProofRequests) {
-			l.Log.Info("max concurrent proof requests reached, waiting for next cycle")
-			return nil
-		}
+	// The number of concurrent span proofs on each prover backend is capped at its max concurrent proof requests.
+	inFlight, err := l.db.CountInFlightSpanProofsByBackend()
+	if err != nil {
+		return fmt.Errorf("failed to count in-flight proofs: %w", err)
+	}
+	backend := l.provers.route(nextProofToRequest, inFlight)
+	if backend == nil {
+		l.Log.Info("no prover backend has capacity for the proof, waiting for next cycle", "id", nextProofToRequest.ID, "type", nextProofToRequest.Type)
+		return nil
+	}
+
+	// Set the proof status to WITNESSGEN. Claiming the request before requesting it ensures that no other replica
+	// sharing the DB requests the same proof.
+	claimed, err := l.db.ClaimProofRequest(nextProofToRequest.ID, l.Cfg.ReplicaID, backend.Name)
+	if err != nil {
+		return fmt.Errorf("failed to claim proof request: %w", err)
 	}
+	if !claimed {
+		l.Log.Info("proof request was already claimed, waiting for next cycle", "id", nextProofToRequest.ID)
+		return nil
+	}
+	nextProofToRequest.Status = proofrequest.StatusWITNESSGEN
+
+	nextProofToRequest.ProverBackend = backend.Name
+
 	go func(p ent.ProofRequest) {
-		l.Log.Info("requesting proof from server", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID)
-		// Set the proof status to WITNESSGEN.
-		err = l.db.UpdateProofStatus(p.ID, proofrequest.StatusWITNESSGEN)
-		if err != nil {
-			l.Log.Error("failed to update proof status", "err", err)
-			return
-		}
+		l.Log.Info("requesting proof from prover backend", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID, "backend", backend.Name)
 
-		// Request the type of proof depending on the mock configuration.
-		err = l.RequestProof(p, l.Cfg.Mock)
+		err := l.RequestProof(p, backend)
 		if err != nil {
 			// If the proof fails to be requested, we should add it to the queue to be retried.
-			err = l.RetryRequest(nextProofToRequest, ProofStatusResponse{})
+			err = l.RetryRequest(&p, ProofStatusResponse{}, fmt.Sprintf("proof request failed: %v", err))
 			if err != nil {
 				l.Log.Error("failed to retry request", "err", err)
 			}
@@ -227,6 +351,9 @@ func (l *L2OutputSubmitter) prepareProofRequest(p ent.ProofRequest) ([]byte, err
 		requestBody := SpanProofRequest{
 			Start: p.StartBlock,
 			End:   p.EndBlock,
+			// Executing the range program before proving it takes a while, so the adaptive span strategy only does it
+			// for some of the span proofs.
+			Execute: l.spanSizer != nil && l.spanSizer.ShouldExecute(),
 		}
 		jsonBody, err := json.Marshal(requestBody)
 		if err != nil {
@@ -250,261 +377,45 @@ func (l *L2OutputSubmitter) prepareProofRequest(p ent.ProofRequest) ([]byte, err
 	}
 }
 
-// RequestProof handles both mock and real proof requests
-func (l *L2OutputSubmitter) RequestProof(p ent.ProofRequest, isMock bool) error {
+// RequestProof requests the proof from the prover backend. Proofs that the backend generates synchronously, like mock
+// proofs, are stored right away.
+func (l *L2OutputSubmitter) RequestProof(p ent.ProofRequest, backend *ProverBackend) error {
 	jsonBody, err := l.prepareProofRequest(p)
 	if err != nil {
 		return err
 	}
 
-	if isMock {
-		proofData, err := l.requestMockProof(p.Type, jsonBody)
-		if err != nil {
-			return fmt.Errorf("mock proof request failed: %w", err)
-		}
+	resp, err := backend.Prover.RequestProof(l.ctx, p.Type, jsonBody)
+	if err != nil {
+		return fmt.Errorf("proof request to prover backend %s failed: %w", backend.Name, err)
+	}
 
-		// For mock proofs, once the "mock proof" has been generated, set the status to PROVING. AddFulfilledProof expects the proof to be in the PROVING status.
+	if resp.Fulfilled {
+		// Once the proof has been generated, set the status to PROVING. AddFulfilledProof expects the proof to be in the PROVING status.
 		err = l.db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING)
 		if err != nil {
 			return fmt.Errorf("failed to set proof status to proving: %w", err)
 		}
-		return l.db.AddFulfilledProof(p.ID, proofData)
-	}
-
-	// Request a real proof from the witness generation server. Returns the proof ID from the network.
-	proofID, err := l.requestRealProof(p.Type, jsonBody)
-	if err != nil {
-		return fmt.Errorf("real proof request failed: %w", err)
+		return l.db.AddFulfilledProof(p.ID, resp.Proof, 0)
 	}
 
-	// Set the proof status to PROVING once the prover ID has been retrieved. Only proofs with status PROVING, SUCCESS or FAILED have a prover request ID.
-	err = l.db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING)
+	// Set the prover ID before setting the proof status to PROVING, so that the transition records it. Only proofs with
+	// status PROVING, SUCCESS or FAILED have a prover request ID.
+	err = l.db.SetProverRequestID(p.ID, resp.ProverRequestID)
 	if err != nil {
-		return fmt.Errorf("failed to set proof status to proving: %w", err)
-	}
-
-	return l.db.SetProverRequestID(p.ID, proofID)
-}
-
-func (l *L2OutputSubmitter) requestRealProof(proofType proofrequest.Type, jsonBody []byte) ([]byte, error) {
-	resp, err := l.makeProofRequest(proofType, jsonBody)
-	if err != nil {
-		return nil, err
-	}
-
-	var response WitnessGenerationResponse
-	if err := json.Unmarshal(resp, &response); err != nil {
-		return nil, fmt.Errorf("error decoding JSON response: %w", err)
-	}
-	// Format the proof ID as a hex string.
-	proofIdHex := fmt.Sprintf("%x", response.ProofID)
-	l.Log.Info("successfully submitted proof", "proofID", proofIdHex)
-	return response.ProofID, nil
-}
-
-// Request a mock proof from the witness generation server.
-func (l *L2OutputSubmitter) requestMockProof(proofType proofrequest.Type, jsonBody []byte) ([]byte, error) {
-	resp, err := l.makeProofRequest(proofType, jsonBody)
-	if err != nil {
-		return nil, err
-	}
-
-	var response ProofStatusResponse
-	if err := json.Unmarshal(resp, &response); err != nil {
-		return nil, fmt.Errorf("error decoding JSON response: %w", err)
-	}
-
-	return response.Proof, nil
-}
-
-// Make a proof request to the witness generation server for the correct proof type.
-func (l *L2OutputSubmitter) makeProofRequest(proofType proofrequest.Type, jsonBody []byte) ([]byte, error) {
-	urlPath := l.getProofEndpoint(proofType)
-	req, err := http.NewRequest("POST", l.Cfg.OPSuccinctServerUrl+"/"+urlPath, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	timeout := time.Duration(l.Cfg.WitnessGenTimeout) * time.Second
-	client := &http.Client{Timeout: timeout}
-	resp, err := client.Do(req)
-	if err != nil {
-		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-			l.Log.Error("Witness generation request timed out", "err", err)
-			l.Metr.RecordWitnessGenFailure("Timeout")
-			return nil, fmt.Errorf("request timed out after %s: %w", timeout, err)
-		}
-		l.Log.Error("Witness generation request failed", "err", err)
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			l.Log.Error("Witness generation request failed",
-				"status", resp.StatusCode,
-				"error", errResp.Error)
-		} else {
-			l.Log.Error("Witness generation request failed", 
-				"status", resp.StatusCode,
-				"body", string(body))
-		}
-		l.Metr.RecordWitnessGenFailure("Failed")
-		return nil, fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
-	}
-
-	return io.ReadAll(resp.Body)
-}
-
-func (l *L2OutputSubmitter) getProofEndpoint(proofType proofrequest.Type) string {
-	switch {
-	case proofType == proofrequest.TypeAGG && l.Cfg.Mock:
-		return "request_mock_agg_proof"
-	case proofType == proofrequest.TypeAGG:
-		return "request_agg_proof"
-	case l.Cfg.Mock:
-		return "request_mock_span_proof"
-	default:
-		return "request_span_proof"
-	}
-}
-
-// Get the status of a proof given its ID.
-func (l *L2OutputSubmitter) GetProofStatus(proofId string) (ProofStatusResponse, error) {
-	req, err := http.NewRequest("GET", l.Cfg.OPSuccinctServerUrl+"/status/"+proofId, nil)
-	if err != nil {
-		return ProofStatusResponse{}, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	client := &http.Client{
-		Timeout: PROOF_STATUS_TIMEOUT,
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		if err, ok := err.(net.Error); ok && err.Timeout() {
-			return ProofStatusResponse{}, fmt.Errorf("request timed out after %s: %w", PROOF_STATUS_TIMEOUT, err)
-		}
-		return ProofStatusResponse{}, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// If the response status code is not 200, return an error.
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			l.Log.Error("Failed to get proof status",
-				"status", resp.StatusCode,
-				"error", errResp.Error)
-		} else {
-			l.Log.Error("Failed to get unmarshal proof status error message", 
-				"status", resp.StatusCode,
-				"body", body)
-		}
-		return ProofStatusResponse{}, fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
-	}
-
-	// Read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return ProofStatusResponse{}, fmt.Errorf("error reading the response body: %v", err)
-	}
-
-	// Create a variable of the Response type
-	var proofStatus ProofStatusResponse
-
-	// Unmarshal the JSON into the response variable
-	err = json.Unmarshal(body, &proofStatus)
-	if err != nil {
-		return ProofStatusResponse{}, fmt.Errorf("error decoding JSON response: %v", err)
+		return err
 	}
 
-	return proofStatus, nil
+	return l.db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING)
 }
 
 // Validate the contract's configuration of the aggregation and range verification keys as well
-// as the rollup config hash.
+// as the rollup config hash with every prover backend.
 func (l *L2OutputSubmitter) ValidateConfig(address string) error {
-	l.Log.Info("requesting config validation", "address", address)
-	requestBody := ValidateConfigRequest{
-		Address: address,
-	}
-	jsonBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return fmt.Errorf("failed to marshal request body: %w", err)
-	}
-
-	req, err := http.NewRequest("POST", l.Cfg.OPSuccinctServerUrl+"/validate_config", bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{
-		Timeout: PROOF_STATUS_TIMEOUT,
-	}
-
-	// Attempt to validate the config up to 5 times with exponential backoff.
-	maxRetries := 5
-	backoff := 1 * time.Second
-	var resp *http.Response
-
-	for i := 0; i < maxRetries; i++ {
-		resp, err = client.Do(req)
-		if err == nil && resp.StatusCode == http.StatusOK {
-			break
-		}
-		if i == maxRetries-1 {
-			if err != nil {
-				if err, ok := err.(net.Error); ok && err.Timeout() {
-					return fmt.Errorf("request timed out after %s: %w", PROOF_STATUS_TIMEOUT, err)
-				}
-				return fmt.Errorf("failed to send request: %w", err)
-			}
-			return fmt.Errorf("server not healthy after %d retries", maxRetries)
+	for _, backend := range l.provers.backends {
+		if err := backend.Prover.ValidateConfig(l.ctx, address); err != nil {
+			return fmt.Errorf("prover backend %s: %w", backend.Name, err)
 		}
-
-		l.Log.Info("server not ready, retrying", "attempt", i+1, "backoff", backoff)
-		time.Sleep(backoff)
-		backoff *= 2
-	}
-	defer resp.Body.Close()
-
-	// Read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("error reading the response body: %v", err)
 	}
-
-	// Create a variable of the ValidateConfigResponse type
-	var response ValidateConfigResponse
-
-	// Unmarshal the JSON into the response variable
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return fmt.Errorf("error decoding JSON response: %v", err)
-	}
-
-	var invalidConfigs []string
-	if !response.RollupConfigHashValid {
-		invalidConfigs = append(invalidConfigs, "rollup config hash")
-	}
-	if !response.AggVkeyValid {
-		invalidConfigs = append(invalidConfigs, "aggregation verification key")
-	}
-	if !response.RangeVkeyValid {
-		invalidConfigs = append(invalidConfigs, "range verification key")
-	}
-	if len(invalidConfigs) > 0 {
-		return fmt.Errorf("config is invalid: %s", strings.Join(invalidConfigs, ", "))
-	}
-
 	return nil
 }
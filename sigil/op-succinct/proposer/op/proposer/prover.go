@@ -0,0 +1,533 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// DefaultProverBackend is the name of the prover backend created from the OP Succinct server URL when no prover
+// backends are configured.
+const DefaultProverBackend = "default"
+
+// Prover generates proofs for the proposer.
+type Prover interface {
+	// RequestProof requests a proof of the given type, with the JSON body of the OP Succinct server request.
+	RequestProof(ctx context.Context, proofType proofrequest.Type, body []byte) (ProverResponse, error)
+	// GetProofStatus returns the status of the proof request with the given prover request ID.
+	GetProofStatus(ctx context.Context, proverRequestID string) (ProofStatusResponse, error)
+	// ValidateConfig checks that the prover proves for the configuration of the L2OO at the address.
+	ValidateConfig(ctx context.Context, address string) error
+	// CancelProof cancels the proof request with the given prover request ID, so that the prover releases it.
+	CancelProof(ctx context.Context, proverRequestID string) error
+}
+
+// ProverResponse is the response of a prover to a proof request.
+type ProverResponse struct {
+	// ProverRequestID identifies the request with the prover, to poll its status.
+	ProverRequestID []byte
+	// Fulfilled is set if the prover generated the proof synchronously, in which case Proof is the proof.
+	Fulfilled bool
+	Proof     []byte
+}
+
+// serverProver requests proofs from an OP Succinct server, which generates the witness and proves it with the SP1
+// prover it's configured with.
+type serverProver struct {
+	url               string
+	witnessGenTimeout time.Duration
+	log               log.Logger
+	metr              opsuccinctmetrics.OPSuccinctMetricer
+}
+
+func NewServerProver(url string, witnessGenTimeout time.Duration, log log.Logger, metr opsuccinctmetrics.OPSuccinctMetricer) Prover {
+	return &serverProver{
+		url:               url,
+		witnessGenTimeout: witnessGenTimeout,
+		log:               log,
+		metr:              metr,
+	}
+}
+
+func (s *serverProver) RequestProof(ctx context.Context, proofType proofrequest.Type, body []byte) (ProverResponse, error) {
+	path := "request_span_proof"
+	if proofType == proofrequest.TypeAGG {
+		path = "request_agg_proof"
+	}
+	resp, err := s.makeProofRequest(ctx, path, body)
+	if err != nil {
+		return ProverResponse{}, err
+	}
+
+	var response WitnessGenerationResponse
+	if err := json.Unmarshal(resp, &response); err != nil {
+		return ProverResponse{}, fmt.Errorf("error decoding JSON response: %w", err)
+	}
+	// Format the proof ID as a hex string.
+	proofIdHex := fmt.Sprintf("%x", response.ProofID)
+	s.log.Info("successfully submitted proof", "proofID", proofIdHex, "server", s.url)
+	return ProverResponse{ProverRequestID: response.ProofID}, nil
+}
+
+// Make a proof request to the witness generation server at the given path.
+func (s *serverProver) makeProofRequest(ctx context.Context, path string, jsonBody []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url+"/"+path, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: s.witnessGenTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			s.log.Error("Witness generation request timed out", "err", err)
+			s.metr.RecordWitnessGenFailure("Timeout")
+			return nil, fmt.Errorf("request timed out after %s: %w", s.witnessGenTimeout, err)
+		}
+		s.log.Error("Witness generation request failed", "err", err)
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(body, &errResp); err == nil {
+			s.log.Error("Witness generation request failed",
+				"status", resp.StatusCode,
+				"error", errResp.Error)
+		} else {
+			s.log.Error("Witness generation request failed",
+				"status", resp.StatusCode,
+				"body", string(body))
+		}
+		s.metr.RecordWitnessGenFailure("Failed")
+		return nil, fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// Get the status of a proof given its ID.
+func (s *serverProver) GetProofStatus(ctx context.Context, proofId string) (ProofStatusResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", s.url+"/status/"+proofId, nil)
+	if err != nil {
+		return ProofStatusResponse{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	client := &http.Client{
+		Timeout: PROOF_STATUS_TIMEOUT,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		if err, ok := err.(net.Error); ok && err.Timeout() {
+			return ProofStatusResponse{}, fmt.Errorf("request timed out after %s: %w", PROOF_STATUS_TIMEOUT, err)
+		}
+		return ProofStatusResponse{}, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// If the response status code is not 200, return an error.
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(body, &errResp); err == nil {
+			s.log.Error("Failed to get proof status",
+				"status", resp.StatusCode,
+				"error", errResp.Error)
+		} else {
+			s.log.Error("Failed to get unmarshal proof status error message",
+				"status", resp.StatusCode,
+				"body", body)
+		}
+		return ProofStatusResponse{}, fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
+	}
+
+	// Read the response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ProofStatusResponse{}, fmt.Errorf("error reading the response body: %v", err)
+	}
+
+	// Create a variable of the Response type
+	var proofStatus ProofStatusResponse
+
+	// Unmarshal the JSON into the response variable
+	err = json.Unmarshal(body, &proofStatus)
+	if err != nil {
+		return ProofStatusResponse{}, fmt.Errorf("error decoding JSON response: %v", err)
+	}
+
+	return proofStatus, nil
+}
+
+// CancelProof cancels a proof given its ID. The server stops tracking the proof and reports it as unfulfillable.
+func (s *serverProver) CancelProof(ctx context.Context, proofId string) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url+"/cancel/"+proofId, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	client := &http.Client{
+		Timeout: PROOF_STATUS_TIMEOUT,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		s.log.Error("Failed to cancel proof", "status", resp.StatusCode, "body", string(body))
+		return fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Validate the contract's configuration of the aggregation and range verification keys as well
+// as the rollup config hash.
+func (s *serverProver) ValidateConfig(ctx context.Context, address string) error {
+	s.log.Info("requesting config validation", "address", address, "server", s.url)
+	requestBody := ValidateConfigRequest{
+		Address: address,
+	}
+	jsonBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	client := &http.Client{
+		Timeout: PROOF_STATUS_TIMEOUT,
+	}
+
+	// Attempt to validate the config up to 5 times with exponential backoff.
+	maxRetries := 5
+	backoff := 1 * time.Second
+	var resp *http.Response
+
+	for i := 0; i < maxRetries; i++ {
+		req, err := http.NewRequestWithContext(ctx, "POST", s.url+"/validate_config", bytes.NewBuffer(jsonBody))
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err = client.Do(req)
+		if err == nil && resp.StatusCode == http.StatusOK {
+			break
+		}
+		if err == nil {
+			resp.Body.Close()
+		}
+		if i == maxRetries-1 {
+			if err != nil {
+				if err, ok := err.(net.Error); ok && err.Timeout() {
+					return fmt.Errorf("request timed out after %s: %w", PROOF_STATUS_TIMEOUT, err)
+				}
+				return fmt.Errorf("failed to send request: %w", err)
+			}
+			return fmt.Errorf("server not healthy after %d retries", maxRetries)
+		}
+
+		s.log.Info("server not ready, retrying", "attempt", i+1, "backoff", backoff)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	defer resp.Body.Close()
+
+	// Read the response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading the response body: %v", err)
+	}
+
+	// Create a variable of the ValidateConfigResponse type
+	var response ValidateConfigResponse
+
+	// Unmarshal the JSON into the response variable
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return fmt.Errorf("error decoding JSON response: %v", err)
+	}
+
+	var invalidConfigs []string
+	if !response.RollupConfigHashValid {
+		invalidConfigs = append(invalidConfigs, "rollup config hash")
+	}
+	if !response.AggVkeyValid {
+		invalidConfigs = append(invalidConfigs, "aggregation verification key")
+	}
+	if !response.RangeVkeyValid {
+		invalidConfigs = append(invalidConfigs, "range verification key")
+	}
+	if len(invalidConfigs) > 0 {
+		return fmt.Errorf("config is invalid: %s", strings.Join(invalidConfigs, ", "))
+	}
+
+	return nil
+}
+
+// mockProver requests mock proofs from an OP Succinct server. Mock proofs are generated synchronously, and can only be
+// verified by an L2OO using the SP1MockVerifier.
+type mockProver struct {
+	*serverProver
+}
+
+func NewMockProver(url string, witnessGenTimeout time.Duration, log log.Logger, metr opsuccinctmetrics.OPSuccinctMetricer) Prover {
+	return &mockProver{
+		serverProver: &serverProver{
+			url:               url,
+			witnessGenTimeout: witnessGenTimeout,
+			log:               log,
+			metr:              metr,
+		},
+	}
+}
+
+func (m *mockProver) RequestProof(ctx context.Context, proofType proofrequest.Type, body []byte) (ProverResponse, error) {
+	path := "request_mock_span_proof"
+	if proofType == proofrequest.TypeAGG {
+		path = "request_mock_agg_proof"
+	}
+	resp, err := m.makeProofRequest(ctx, path, body)
+	if err != nil {
+		return ProverResponse{}, fmt.Errorf("mock proof request failed: %w", err)
+	}
+
+	var response ProofStatusResponse
+	if err := json.Unmarshal(resp, &response); err != nil {
+		return ProverResponse{}, fmt.Errorf("error decoding JSON response: %w", err)
+	}
+	return ProverResponse{Fulfilled: true, Proof: response.Proof}, nil
+}
+
+func (m *mockProver) GetProofStatus(context.Context, string) (ProofStatusResponse, error) {
+	return ProofStatusResponse{}, errors.New("mock proofs are fulfilled when requested")
+}
+
+// ProverBackendConfig configures a prover backend. The backends are read from the JSON array in the file passed to
+// --prover-backends.
+type ProverBackendConfig struct {
+	// Name identifies the backend on the proof requests routed to it.
+	Name string `json:"name"`
+	// URL is the URL of the OP Succinct server of the backend.
+	URL string `json:"url"`
+	// Mock requests mock proofs from the server.
+	Mock bool `json:"mock"`
+	// Weight is the share of the proof requests routed to the backend, relative to the other backends. Defaults to 1.
+	Weight uint64 `json:"weight"`
+	// MaxConcurrentProofRequests is the max number of SPAN proofs in flight on the backend. Defaults to
+	// --max-concurrent-proof-requests.
+	MaxConcurrentProofRequests uint64 `json:"maxConcurrentProofRequests"`
+	// MaxBlocks is the max number of blocks of the SPAN proofs routed to the backend. 0 means no limit.
+	MaxBlocks uint64 `json:"maxBlocks"`
+	// ProofTypes are the types of proofs routed to the backend. All types if empty.
+	ProofTypes []proofrequest.Type `json:"proofTypes"`
+}
+
+// LoadProverBackendConfigs reads the prover backends from the JSON file, or returns a single backend requesting proofs
+// from the OP Succinct server if the path is empty. Unset weights and concurrency limits are set to their defaults.
+func LoadProverBackendConfigs(path, serverUrl string, mock bool, maxConcurrentProofRequests uint64) ([]ProverBackendConfig, error) {
+	if path == "" {
+		return []ProverBackendConfig{{
+			Name:                       DefaultProverBackend,
+			URL:                        serverUrl,
+			Mock:                       mock,
+			Weight:                     1,
+			MaxConcurrentProofRequests: maxConcurrentProofRequests,
+		}}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prover backends: %w", err)
+	}
+	var cfgs []ProverBackendConfig
+	if err := json.Unmarshal(data, &cfgs); err != nil {
+		return nil, fmt.Errorf("failed to decode prover backends: %w", err)
+	}
+	for i := range cfgs {
+		if cfgs[i].Weight == 0 {
+			cfgs[i].Weight = 1
+		}
+		if cfgs[i].MaxConcurrentProofRequests == 0 {
+			cfgs[i].MaxConcurrentProofRequests = maxConcurrentProofRequests
+		}
+	}
+	if err := CheckProverBackendConfigs(cfgs); err != nil {
+		return nil, err
+	}
+	return cfgs, nil
+}
+
+// CheckProverBackendConfigs checks that the backends have unique names and URLs, and that every proof type is routed to
+// at least one backend.
+func CheckProverBackendConfigs(cfgs []ProverBackendConfig) error {
+	if len(cfgs) == 0 {
+		return errors.New("no prover backends configured")
+	}
+	names := make(map[string]bool)
+	proves := make(map[proofrequest.Type]bool)
+	for _, cfg := range cfgs {
+		if cfg.Name == "" {
+			return errors.New("prover backend name is required")
+		}
+		if names[cfg.Name] {
+			return fmt.Errorf("duplicate prover backend %s", cfg.Name)
+		}
+		names[cfg.Name] = true
+		if cfg.URL == "" {
+			return fmt.Errorf("prover backend %s has no URL", cfg.Name)
+		}
+		if len(cfg.ProofTypes) == 0 {
+			proves[proofrequest.TypeSPAN] = true
+			proves[proofrequest.TypeAGG] = true
+		}
+		for _, proofType := range cfg.ProofTypes {
+			if err := proofrequest.TypeValidator(proofType); err != nil {
+				return fmt.Errorf("prover backend %s: %w", cfg.Name, err)
+			}
+			proves[proofType] = true
+		}
+	}
+	for _, proofType := range []proofrequest.Type{proofrequest.TypeSPAN, proofrequest.TypeAGG} {
+		if !proves[proofType] {
+			return fmt.Errorf("no prover backend proves %s proofs", proofType)
+		}
+	}
+	return nil
+}
+
+// ProverBackend is a prover the proposer routes proof requests to.
+type ProverBackend struct {
+	ProverBackendConfig
+	Prover Prover
+}
+
+// proves returns whether requests for the proof can be routed to the backend, ignoring its concurrency limit.
+func (b *ProverBackend) proves(req *ent.ProofRequest) bool {
+	if len(b.ProofTypes) > 0 && !slices.Contains(b.ProofTypes, req.Type) {
+		return false
+	}
+	if req.Type == proofrequest.TypeSPAN && b.MaxBlocks > 0 && req.EndBlock-req.StartBlock > b.MaxBlocks {
+		return false
+	}
+	return true
+}
+
+// proverRouter routes proof requests to the prover backends.
+type proverRouter struct {
+	backends []*ProverBackend
+}
+
+// proverRouterOption configures a prover router created by newProverRouter.
+type proverRouterOption func(r *proverRouter)
+
+// withProverBackends replaces the backends created from the configs with the given ones, for instance in-process
+// provers in simulations.
+func withProverBackends(backends []*ProverBackend) proverRouterOption {
+	return func(r *proverRouter) {
+		r.backends = backends
+	}
+}
+
+func newProverRouter(cfgs []ProverBackendConfig, witnessGenTimeout time.Duration, log log.Logger, metr opsuccinctmetrics.OPSuccinctMetricer, opts ...proverRouterOption) *proverRouter {
+	r := new(proverRouter)
+	for _, cfg := range cfgs {
+		prover := NewServerProver(cfg.URL, witnessGenTimeout, log.New("prover", cfg.Name), metr)
+		if cfg.Mock {
+			prover = NewMockProver(cfg.URL, witnessGenTimeout, log.New("prover", cfg.Name), metr)
+		}
+		r.backends = append(r.backends, &ProverBackend{ProverBackendConfig: cfg, Prover: prover})
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// backend returns the backend with the given name. Requests made before prover backends were recorded have no backend,
+// and are assumed to be on the first one.
+func (r *proverRouter) backend(name string) (*ProverBackend, error) {
+	if name == "" {
+		return r.backends[0], nil
+	}
+	for _, b := range r.backends {
+		if b.Name == name {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown prover backend %s", name)
+}
+
+// route returns the backend to request the proof from, or nil if no backend that proves it has capacity. SPAN proofs
+// go to the backend with the fewest in-flight requests relative to its weight, among the backends under their
+// concurrency limit. AGG proofs aren't limited. A request pinned to a backend is only routed to that backend.
+func (r *proverRouter) route(req *ent.ProofRequest, inFlight map[string]int) *ProverBackend {
+	pinned := req.ProverBackend != "" && r.has(req.ProverBackend)
+	return r.leastLoaded(req, inFlight, true, func(b *ProverBackend) bool {
+		return !pinned || b.Name == req.ProverBackend
+	})
+}
+
+// failover returns the backend to move a proof that timed out on the given backend to, or nil if no other backend
+// proves it. The other backends are chosen from like in route. If they are all at their concurrency limit, the least
+// loaded one is chosen anyway, and the request waits for its capacity.
+func (r *proverRouter) failover(req *ent.ProofRequest, from string, inFlight map[string]int) *ProverBackend {
+	other := func(b *ProverBackend) bool { return b.Name != from }
+	if b := r.leastLoaded(req, inFlight, true, other); b != nil {
+		return b
+	}
+	return r.leastLoaded(req, inFlight, false, other)
+}
+
+// leastLoaded returns the backend with the fewest in-flight requests relative to its weight, among the backends that
+// prove the request and are accepted by the filter. If limited is set, backends at their concurrency limit are skipped
+// for SPAN proofs.
+func (r *proverRouter) leastLoaded(req *ent.ProofRequest, inFlight map[string]int, limited bool, filter func(*ProverBackend) bool) *ProverBackend {
+	var best *ProverBackend
+	var bestLoad float64
+	for _, b := range r.backends {
+		if !filter(b) || !b.proves(req) {
+			continue
+		}
+		n := inFlight[b.Name]
+		if b.Name == r.backends[0].Name {
+			n += inFlight[""]
+		}
+		if limited && req.Type == proofrequest.TypeSPAN && uint64(n) >= b.MaxConcurrentProofRequests {
+			continue
+		}
+		load := float64(n+1) / float64(b.Weight)
+		if best == nil || load < bestLoad {
+			best, bestLoad = b, load
+		}
+	}
+	return best
+}
+
+func (r *proverRouter) has(name string) bool {
+	_, err := r.backend(name)
+	return err == nil
+}
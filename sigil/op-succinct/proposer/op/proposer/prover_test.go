@@ -0,0 +1,255 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/testutils"
+)
+
+func newTestRouter(cfgs ...ProverBackendConfig) *proverRouter {
+	return newProverRouter(cfgs, time.Minute, log.NewLogger(log.DiscardHandler()), opsuccinctmetrics.NoopMetrics)
+}
+
+func TestProverRouter(t *testing.T) {
+	router := newTestRouter(
+		ProverBackendConfig{Name: "cuda", URL: "http://cuda", Weight: 3, MaxConcurrentProofRequests: 4, MaxBlocks: 100, ProofTypes: []proofrequest.Type{proofrequest.TypeSPAN}},
+		ProverBackendConfig{Name: "network", URL: "http://network", Weight: 1, MaxConcurrentProofRequests: 20},
+	)
+	span := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 0, EndBlock: 50}
+
+	t.Run("Routes by weight", func(t *testing.T) {
+		inFlight := make(map[string]int)
+		var routed []string
+		for range 8 {
+			backend := router.route(span, inFlight)
+			require.NotNil(t, backend)
+			inFlight[backend.Name]++
+			routed = append(routed, backend.Name)
+		}
+		// The CUDA prover takes 3 of every 4 requests until it's at its limit of 4.
+		require.Equal(t, []string{"cuda", "cuda", "cuda", "network", "cuda", "network", "network", "network"}, routed)
+	})
+
+	t.Run("Routes by type and capacity", func(t *testing.T) {
+		agg := &ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: 0, EndBlock: 1000}
+		require.Equal(t, "network", router.route(agg, nil).Name)
+
+		large := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 0, EndBlock: 200}
+		require.Equal(t, "network", router.route(large, nil).Name)
+
+		require.Nil(t, router.route(span, map[string]int{"cuda": 4, "network": 20}))
+	})
+
+	t.Run("Requests made before backends were recorded count against the first backend", func(t *testing.T) {
+		require.Equal(t, "network", router.route(span, map[string]int{"": 4}).Name)
+	})
+
+	t.Run("Pinned requests wait for their backend", func(t *testing.T) {
+		pinned := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 0, EndBlock: 50, ProverBackend: "network"}
+		require.Equal(t, "network", router.route(pinned, nil).Name)
+		require.Nil(t, router.route(pinned, map[string]int{"network": 20}))
+
+		pinned.ProverBackend = "removed"
+		require.Equal(t, "cuda", router.route(pinned, nil).Name, "requests pinned to a removed backend are routed normally")
+	})
+
+	t.Run("Fails over to another backend", func(t *testing.T) {
+		require.Equal(t, "network", router.failover(span, "cuda", nil).Name)
+		require.Equal(t, "cuda", router.failover(span, "network", nil).Name)
+		agg := &ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: 0, EndBlock: 1000}
+		require.Nil(t, router.failover(agg, "network", nil))
+	})
+
+	t.Run("Fails over to the least loaded backend", func(t *testing.T) {
+		router := newTestRouter(
+			ProverBackendConfig{Name: "cuda", URL: "http://cuda", Weight: 1, MaxConcurrentProofRequests: 4},
+			ProverBackendConfig{Name: "network", URL: "http://network", Weight: 3, MaxConcurrentProofRequests: 6},
+			ProverBackendConfig{Name: "cluster", URL: "http://cluster", Weight: 2, MaxConcurrentProofRequests: 4},
+		)
+		// The network has the highest weight, but is more loaded than the cluster.
+		require.Equal(t, "cluster", router.failover(span, "cuda", map[string]int{"network": 5, "cluster": 1}).Name)
+		// The network is at its limit.
+		require.Equal(t, "cluster", router.failover(span, "cuda", map[string]int{"network": 6, "cluster": 3}).Name)
+		// If every other backend is at its limit, the request waits on the least loaded one.
+		require.Equal(t, "network", router.failover(span, "cuda", map[string]int{"network": 6, "cluster": 4}).Name)
+		require.Equal(t, "network", router.failover(span, "cluster", map[string]int{"cuda": 4, "network": 6}).Name)
+	})
+
+	t.Run("Uses the given backends", func(t *testing.T) {
+		backend := &ProverBackend{ProverBackendConfig: ProverBackendConfig{Name: "local", Weight: 1, MaxConcurrentProofRequests: 1}}
+		router := newProverRouter(
+			[]ProverBackendConfig{{Name: DefaultProverBackend, URL: "http://server", Weight: 1}},
+			time.Minute, log.NewLogger(log.DiscardHandler()), opsuccinctmetrics.NoopMetrics,
+			withProverBackends([]*ProverBackend{backend}),
+		)
+		require.Equal(t, []*ProverBackend{backend}, router.backends)
+		require.Equal(t, backend, router.route(span, nil))
+	})
+}
+
+func TestLoadProverBackendConfigs(t *testing.T) {
+	cfgs, err := LoadProverBackendConfigs("", "http://server:3000", true, 10)
+	require.NoError(t, err)
+	require.Equal(t, []ProverBackendConfig{{Name: DefaultProverBackend, URL: "http://server:3000", Mock: true, Weight: 1, MaxConcurrentProofRequests: 10}}, cfgs)
+
+	path := filepath.Join(t.TempDir(), "backends.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[
+		{"name": "cuda", "url": "http://cuda:3000", "weight": 3, "maxConcurrentProofRequests": 2, "proofTypes": ["SPAN"]},
+		{"name": "network", "url": "http://network:3000"}
+	]`), 0o644))
+	cfgs, err = LoadProverBackendConfigs(path, "", false, 10)
+	require.NoError(t, err)
+	require.Equal(t, []ProverBackendConfig{
+		{Name: "cuda", URL: "http://cuda:3000", Weight: 3, MaxConcurrentProofRequests: 2, ProofTypes: []proofrequest.Type{proofrequest.TypeSPAN}},
+		{Name: "network", URL: "http://network:3000", Weight: 1, MaxConcurrentProofRequests: 10},
+	}, cfgs)
+
+	require.ErrorContains(t, CheckProverBackendConfigs([]ProverBackendConfig{
+		{Name: "cuda", URL: "http://cuda:3000", ProofTypes: []proofrequest.Type{proofrequest.TypeSPAN}},
+	}), "no prover backend proves AGG proofs")
+	require.ErrorContains(t, CheckProverBackendConfigs([]ProverBackendConfig{
+		{Name: "cuda", URL: "http://cuda:3000"},
+		{Name: "cuda", URL: "http://network:3000"},
+	}), "duplicate prover backend cuda")
+}
+
+// proverServerStub serves the proof request and status endpoints of an OP Succinct server.
+func proverServerStub(t *testing.T) *testutils.Server {
+	return testutils.NewServer(t, func(w http.ResponseWriter, r testutils.Request) {
+		switch r.Path {
+		case "/request_span_proof":
+			require.JSONEq(t, `{"start": 100, "end": 200}`, string(r.Body))
+			require.NoError(t, json.NewEncoder(w).Encode(WitnessGenerationResponse{ProofID: []byte{0xab, 0xcd}}))
+		case "/request_mock_span_proof":
+			require.NoError(t, json.NewEncoder(w).Encode(ProofStatusResponse{FulfillmentStatus: SP1FulfillmentStatusFulfilled, Proof: []byte{0x01}}))
+		case "/status/abcd":
+			require.NoError(t, json.NewEncoder(w).Encode(ProofStatusResponse{FulfillmentStatus: SP1FulfillmentStatusFulfilled, Proof: []byte{0x02}, Cycles: 1000}))
+		case "/cancel/abcd":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestServerProver(t *testing.T) {
+	server := proverServerStub(t)
+	ctx := context.Background()
+	logger := log.NewLogger(log.DiscardHandler())
+	body := []byte(`{"start": 100, "end": 200}`)
+
+	prover := NewServerProver(server.URL, time.Minute, logger, opsuccinctmetrics.NoopMetrics)
+	resp, err := prover.RequestProof(ctx, proofrequest.TypeSPAN, body)
+	require.NoError(t, err)
+	require.Equal(t, ProverResponse{ProverRequestID: []byte{0xab, 0xcd}}, resp)
+	status, err := prover.GetProofStatus(ctx, "abcd")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x02}, status.Proof)
+	require.Equal(t, uint64(1000), status.Cycles)
+
+	mock := NewMockProver(server.URL, time.Minute, logger, opsuccinctmetrics.NoopMetrics)
+	resp, err = mock.RequestProof(ctx, proofrequest.TypeSPAN, body)
+	require.NoError(t, err)
+	require.Equal(t, ProverResponse{Fulfilled: true, Proof: []byte{0x01}}, resp)
+
+	_, err = prover.RequestProof(ctx, proofrequest.TypeAGG, body)
+	require.ErrorContains(t, err, "404")
+}
+
+// requestTestProof requests a span proof of the given range from the backend, recording it on the given backend name.
+func requestTestProof(t *testing.T, l *L2OutputSubmitter, start, end uint64, backend *ProverBackend, name string) {
+	require.NoError(t, l.db.NewEntry(proofrequest.TypeSPAN, start, end))
+	req, err := l.db.GetNextUnrequestedSpanProof()
+	require.NoError(t, err)
+	claimed, err := l.db.ClaimProofRequest(req.ID, "replica-a", name)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.NoError(t, l.RequestProof(*req, backend))
+}
+
+func requestPaths(server *testutils.Server) []string {
+	var paths []string
+	for _, r := range server.Requests() {
+		paths = append(paths, r.Path)
+	}
+	return paths
+}
+
+func TestProcessProvingRequestsUnknownBackend(t *testing.T) {
+	server := proverServerStub(t)
+	l := newTestSubmitter(t, ProposerConfig{ReplicaID: "replica-a"}, nil)
+	l.provers = newTestRouter(ProverBackendConfig{Name: "cuda", URL: server.URL})
+	cuda, err := l.provers.backend("cuda")
+	require.NoError(t, err)
+
+	// The first proof was requested from a backend that has since been removed from the config.
+	requestTestProof(t, l, 100, 200, cuda, "removed")
+	requestTestProof(t, l, 100, 200, cuda, "cuda")
+
+	err = l.ProcessProvingRequests()
+	require.ErrorContains(t, err, "unknown prover backend removed")
+
+	proving, err := l.db.GetAllProofsWithStatus(proofrequest.StatusPROVING)
+	require.NoError(t, err)
+	require.Len(t, proving, 1)
+	require.Equal(t, "removed", proving[0].ProverBackend)
+	completed, err := l.db.GetAllProofsWithStatus(proofrequest.StatusCOMPLETE)
+	require.NoError(t, err)
+	require.Len(t, completed, 1, "the proofs of other backends are still polled")
+	require.Equal(t, "cuda", completed[0].ProverBackend)
+}
+
+func TestProofFailover(t *testing.T) {
+	cudaServer, networkServer := proverServerStub(t), proverServerStub(t)
+	l := newTestSubmitter(t, ProposerConfig{ReplicaID: "replica-a"}, nil)
+	l.provers = newTestRouter(
+		ProverBackendConfig{Name: "cuda", URL: cudaServer.URL, Weight: 3, MaxConcurrentProofRequests: 1},
+		ProverBackendConfig{Name: "network", URL: networkServer.URL, Weight: 1, MaxConcurrentProofRequests: 1},
+	)
+
+	// Request a proof, which is routed to the CUDA prover.
+	require.NoError(t, l.db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	req, err := l.db.GetNextUnrequestedSpanProof()
+	require.NoError(t, err)
+	backend := l.provers.route(req, nil)
+	require.Equal(t, "cuda", backend.Name)
+	claimed, err := l.db.ClaimProofRequest(req.ID, "replica-a", backend.Name)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.NoError(t, l.RequestProof(*req, backend))
+
+	proving, err := l.db.GetAllProofsWithStatus(proofrequest.StatusPROVING)
+	require.NoError(t, err)
+	require.Len(t, proving, 1)
+	require.Equal(t, "cuda", proving[0].ProverBackend)
+	require.Equal(t, "abcd", proving[0].ProverRequestID)
+
+	// The proof times out on the CUDA prover, is cancelled on it, and moves to the network.
+	require.NoError(t, l.failoverRequest(proving[0], backend))
+	require.Equal(t, []string{"/request_span_proof", "/cancel/abcd"}, requestPaths(cudaServer))
+	require.Empty(t, requestPaths(networkServer))
+	retry, err := l.db.GetNextUnrequestedSpanProof()
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), retry.StartBlock)
+	require.Equal(t, uint64(200), retry.EndBlock)
+	require.Equal(t, "network", retry.ProverBackend)
+	require.Equal(t, "network", l.provers.route(retry, nil).Name)
+
+	// Failing over a request that already failed doesn't retry it again.
+	require.NoError(t, l.failoverRequest(proving[0], backend))
+	unrequested, err := l.db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unrequested, 1)
+}
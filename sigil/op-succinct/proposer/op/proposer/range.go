@@ -3,15 +3,12 @@ package proposer
 import (
 	"context"
 	"fmt"
-	"slices"
-	"sync"
 
 	"github.com/ethereum-optimism/optimism/op-service/dial"
-	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
-	"golang.org/x/sync/errgroup"
 )
 
 type Span struct {
@@ -19,8 +16,14 @@ type Span struct {
 	End   uint64
 }
 
-// GetL1HeadForL2Block returns the L1 block from which the L2 block can be derived.
-func (l *L2OutputSubmitter) GetL1HeadForL2Block(ctx context.Context, rollupClient *sources.RollupClient, l2End uint64) (uint64, error) {
+// SafeHeadClient is the part of the rollup node API used to find the L2 safe heads at each L1 block.
+type SafeHeadClient interface {
+	RollupClient
+	SafeHeadAtL1Block(ctx context.Context, l1BlockNum uint64) (*eth.SafeHeadResponse, error)
+}
+
+// getL1HeadForL2Block returns the L1 block from which the L2 block can be derived.
+func getL1HeadForL2Block(ctx context.Context, rollupClient SafeHeadClient, l2End uint64) (uint64, error) {
 	status, err := rollupClient.SyncStatus(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get sync status: %w", err)
@@ -49,7 +52,7 @@ func (l *L2OutputSubmitter) GetL1HeadForL2Block(ctx context.Context, rollupClien
 	return 0, fmt.Errorf("could not find an L1 block with an L2 safe head greater than the L2 end block")
 }
 
-func (l *L2OutputSubmitter) IsSafeDBActivated(ctx context.Context, rollupClient *sources.RollupClient) (bool, error) {
+func (l *L2OutputSubmitter) IsSafeDBActivated(ctx context.Context, rollupClient SafeHeadClient) (bool, error) {
 	// Get the sync status of the rollup node.
 	status, err := rollupClient.SyncStatus(ctx)
 	if err != nil {
@@ -68,89 +71,18 @@ func (l *L2OutputSubmitter) IsSafeDBActivated(ctx context.Context, rollupClient
 // SplitRangeBasedOnSafeHeads splits a range into spans based on safe head boundaries.
 // This is useful when we want to ensure that each span aligns with L2 safe head boundaries.
 func (l *L2OutputSubmitter) SplitRangeBasedOnSafeHeads(ctx context.Context, l2Start, l2End uint64) ([]Span, error) {
-	spans := []Span{}
-	currentStart := l2Start
-
 	rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, l.Log, l.Cfg.RollupRpc)
 	if err != nil {
 		return nil, err
 	}
+	defer rollupClient.Close()
 
-	L1Head, err := l.GetL1HeadForL2Block(ctx, rollupClient, l2End)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get l1 head for l2 block: %w", err)
-	}
-
-	l2StartOutput, err := rollupClient.OutputAtBlock(ctx, l2Start)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get l2 start output: %w", err)
-	}
-	L2StartL1Origin := l2StartOutput.BlockRef.L1Origin.Number
-
-	safeHeads := make(map[uint64]struct{})
-	mu := sync.Mutex{}
-	g := errgroup.Group{}
-	g.SetLimit(10)
-
-	// Get all of the safe heads between the L2 start block and the L1 head. Use parallel requests to speed up the process.
-	// This is useful for when a chain is behind.
-	for currentL1Block := L2StartL1Origin; currentL1Block <= L1Head; currentL1Block++ {
-		l1Block := currentL1Block
-		g.Go(func() error {
-			safeHead, err := rollupClient.SafeHeadAtL1Block(ctx, l1Block)
-			if err != nil {
-				return fmt.Errorf("failed to get safe head at block %d: %w", l1Block, err)
-			}
-
-			mu.Lock()
-			safeHeads[safeHead.SafeHead.Number] = struct{}{}
-			mu.Unlock()
-			return nil
-		})
-	}
-
-	if err := g.Wait(); err != nil {
-		return nil, fmt.Errorf("failed while getting safe heads: %w", err)
-	}
-
-	uniqueSafeHeads := make([]uint64, 0, len(safeHeads))
-	for safeHead := range safeHeads {
-		uniqueSafeHeads = append(uniqueSafeHeads, safeHead)
-	}
-	slices.Sort(uniqueSafeHeads)
-
-	// Loop over all of the safe heads and create spans.
-	for _, safeHead := range uniqueSafeHeads {
-		if safeHead > currentStart {
-			rangeStart := currentStart
-			for rangeStart+l.Cfg.MaxBlockRangePerSpanProof < min(l2End, safeHead) {
-				spans = append(spans, Span{
-					Start: rangeStart,
-					End:   rangeStart + l.Cfg.MaxBlockRangePerSpanProof,
-				})
-				rangeStart += l.Cfg.MaxBlockRangePerSpanProof
-			}
-			spans = append(spans, Span{
-				Start: rangeStart,
-				End:   min(l2End, safeHead),
-			})
-			currentStart = safeHead
-		}
-	}
-
-	return spans, nil
+	return (&safeHeadPlanner{client: rollupClient, maxBlockRange: l.Cfg.MaxBlockRangePerSpanProof}).PlanSpans(ctx, l2Start, l2End)
 }
 
 // CreateSpans creates a list of spans of size MaxBlockRangePerSpanProof from start to end. Note: The end of span i = start of span i+1.
 func (l *L2OutputSubmitter) SplitRangeBasic(start, end uint64) []Span {
-	spans := []Span{}
-	// Create spans of size MaxBlockRangePerSpanProof from start to end.
-	// Each span starts where the previous one ended.
-	// Continue until we can't fit another full span before reaching end.
-	for i := start; i+l.Cfg.MaxBlockRangePerSpanProof <= end; i += l.Cfg.MaxBlockRangePerSpanProof {
-		spans = append(spans, Span{Start: i, End: i + l.Cfg.MaxBlockRangePerSpanProof})
-	}
-	return spans
+	return splitRangeBasic(start, end, l.Cfg.MaxBlockRangePerSpanProof)
 }
 
 func (l *L2OutputSubmitter) GetRangeProofBoundaries(ctx context.Context) error {
@@ -185,7 +117,16 @@ func (l *L2OutputSubmitter) GetRangeProofBoundaries(ctx context.Context) error {
 	// Note: Originally, this used the L1 finalized block. However, to satisfy the new API, we now use the L2 finalized block.
 	newL2EndBlock := status.FinalizedL2.Number
 
-	spans := l.SplitRangeBasic(newL2StartBlock, newL2EndBlock)
+	planner, closePlanner, err := l.newSpanPlanner(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create %s span planner: %w", l.Cfg.SpanStrategy, err)
+	}
+	defer closePlanner()
+
+	spans, err := planner.PlanSpans(ctx, newL2StartBlock, newL2EndBlock)
+	if err != nil {
+		return fmt.Errorf("failed to plan spans with %s strategy: %w", l.Cfg.SpanStrategy, err)
+	}
 
 	// Add each span to the DB. If there are no spans, we will not create any proofs.
 	for _, span := range spans {
@@ -0,0 +1,70 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// l1HeaderSource returns the headers of the canonical L1 chain.
+type l1HeaderSource interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// CheckL1Reorgs checks that the L1 blocks the pending AGG proofs commit to are still canonical. The L2OO only accepts
+// an AGG proof if its L1 block hash is checkpointed, which is impossible once the block was reorged out, so the AGG
+// proof is failed and requested again with a fresh checkpoint.
+func (l *L2OutputSubmitter) CheckL1Reorgs(ctx context.Context) error {
+	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get latest L2OO output: %w", err)
+	}
+	return l.checkL1Reorgs(ctx, l.L1Client, latest.Uint64())
+}
+
+// checkL1Reorgs replaces the AGG proofs starting at or after the given L2 block whose L1 block isn't canonical anymore.
+func (l *L2OutputSubmitter) checkL1Reorgs(ctx context.Context, headers l1HeaderSource, from uint64) error {
+	aggProofs, err := l.db.GetPendingAggProofsWithL1BlockInfo(from)
+	if err != nil {
+		return err
+	}
+
+	// AGG proofs requested in the same loop share their L1 block, so each block is only fetched once.
+	canonical := make(map[uint64]common.Hash)
+	for _, p := range aggProofs {
+		hash, ok := canonical[p.L1BlockNumber]
+		if !ok {
+			header, err := headers.HeaderByNumber(ctx, new(big.Int).SetUint64(p.L1BlockNumber))
+			if errors.Is(err, ethereum.NotFound) {
+				// Either the L1 node is behind, or L1 reorged to a shorter chain. Check again once the block exists.
+				l.Log.Warn("L1 block of AGG proof not found", "id", p.ID, "l1blocknum", p.L1BlockNumber)
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get L1 block %d: %w", p.L1BlockNumber, err)
+			}
+			hash = header.Hash()
+			canonical[p.L1BlockNumber] = hash
+		}
+		if hash == common.HexToHash(p.L1BlockHash) {
+			continue
+		}
+
+		l.Log.Warn("L1 block of AGG proof was reorged, requesting the proof again",
+			"id", p.ID, "start", p.StartBlock, "end", p.EndBlock, "status", p.Status,
+			"l1blocknum", p.L1BlockNumber, "l1blockhash", p.L1BlockHash, "canonical", hash)
+		l.Metr.RecordError("l1_reorg", 1)
+		reason := fmt.Sprintf("L1 block %d was reorged, its hash changed from %s to %s", p.L1BlockNumber, p.L1BlockHash, hash)
+		if _, err := l.db.ReplaceAggProof(p.ID, reason); err != nil {
+			return fmt.Errorf("failed to replace AGG proof %d: %w", p.ID, err)
+		}
+	}
+
+	return nil
+}
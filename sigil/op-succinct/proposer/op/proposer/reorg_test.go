@@ -0,0 +1,77 @@
+package proposer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestCheckL1Reorgs(t *testing.T) {
+	ctx := context.Background()
+	backend := simulated.NewBackend(types.GenesisAlloc{})
+	t.Cleanup(func() { require.NoError(t, backend.Close()) })
+	l1 := backend.Client()
+	for i := 0; i < 5; i++ {
+		backend.Commit()
+	}
+	blockHash := func(number uint64) string {
+		header, err := l1.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		require.NoError(t, err)
+		return header.Hash().Hex()
+	}
+
+	l := newTestSubmitter(t, ProposerConfig{}, nil)
+
+	// A proving AGG proof committing to block 4, which is reorged, and one committing to block 2, which isn't.
+	require.NoError(t, l.db.NewEntry(proofrequest.TypeAGG, 100, 200))
+	reorged, err := l.db.AddL1BlockInfoToAggRequest(100, 200, 4, blockHash(4))
+	require.NoError(t, err)
+	require.NoError(t, l.db.UpdateProofStatus(reorged.ID, proofrequest.StatusPROVING))
+	ok, err := l.db.ImportProof(db.ImportedProof{Type: proofrequest.TypeAGG, StartBlock: 200, EndBlock: 300, L1BlockNumber: 2, L1BlockHash: blockHash(2), Proof: []byte{0x01}})
+	require.NoError(t, err)
+	require.True(t, ok)
+	// An AGG proof committing to block 4 that was already submitted.
+	ok, err = l.db.ImportProof(db.ImportedProof{Type: proofrequest.TypeAGG, StartBlock: 0, EndBlock: 100, L1BlockNumber: 4, L1BlockHash: blockHash(4), Proof: []byte{0x02}})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, l.checkL1Reorgs(ctx, l1, 100))
+	pending, err := l.db.GetPendingAggProofsWithL1BlockInfo(0)
+	require.NoError(t, err)
+	require.Len(t, pending, 3, "no AGG proof is replaced while its L1 block is canonical")
+
+	// Replace block 4 with a block with another timestamp, and extend the new chain.
+	oldHash := blockHash(4)
+	parent, err := l1.HeaderByNumber(ctx, big.NewInt(3))
+	require.NoError(t, err)
+	require.NoError(t, backend.Fork(parent.Hash()))
+	require.NoError(t, backend.AdjustTime(time.Minute))
+	backend.Commit()
+	require.NotEqual(t, oldHash, blockHash(4))
+
+	require.NoError(t, l.checkL1Reorgs(ctx, l1, 100))
+	failed, err := l.db.GetAllProofsWithStatus(proofrequest.StatusFAILED)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Equal(t, reorged.ID, failed[0].ID)
+
+	unrequested, err := l.db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unrequested, 1)
+	require.Equal(t, proofrequest.TypeAGG, unrequested[0].Type)
+	require.Equal(t, uint64(100), unrequested[0].StartBlock)
+	require.Equal(t, uint64(200), unrequested[0].EndBlock)
+	require.Empty(t, unrequested[0].L1BlockHash, "the replacement is checkpointed again when it is requested")
+
+	completed, err := l.db.GetAllProofsWithStatus(proofrequest.StatusCOMPLETE)
+	require.NoError(t, err)
+	require.Len(t, completed, 2, "AGG proofs with a canonical or submitted L1 block are kept")
+}
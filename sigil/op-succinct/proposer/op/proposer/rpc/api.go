@@ -0,0 +1,230 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// ProofQueue is the view of the proof request DB that the admin API inspects and manipulates.
+type ProofQueue interface {
+	ListProofRequests(filter db.ProofRequestFilter) ([]*ent.ProofRequest, error)
+	GetProofRequestHistory(id int) ([]*ent.ProofRequest, error)
+	RequeueFailedRequest(id int) (*ent.ProofRequest, error)
+	CancelProvingRequest(id int) (*ent.ProofRequest, error)
+	SplitSpanRequest(id int, splitBlock uint64) ([]*ent.ProofRequest, error)
+}
+
+// ProofCanceller cancels proof requests on the prover backend they were requested from.
+type ProofCanceller interface {
+	CancelProof(ctx context.Context, req *ent.ProofRequest) error
+}
+
+// RangeAlerts resolves the alerts about repeated failures of the range of proof requests.
+type RangeAlerts interface {
+	ResolveRangeFailures(ctx context.Context, req *ent.ProofRequest, message string) error
+}
+
+// ProofRequest is the JSON representation of a proof request. The proof itself is omitted, only whether one is set.
+type ProofRequest struct {
+	ID               int                 `json:"id"`
+	Type             proofrequest.Type   `json:"type"`
+	StartBlock       uint64              `json:"startBlock"`
+	EndBlock         uint64              `json:"endBlock"`
+	Status           proofrequest.Status `json:"status"`
+	RequestAddedTime uint64              `json:"requestAddedTime"`
+	ProverRequestID  string              `json:"proverRequestId,omitempty"`
+	ProofRequestTime uint64              `json:"proofRequestTime,omitempty"`
+	LastUpdatedTime  uint64              `json:"lastUpdatedTime"`
+	L1BlockNumber    uint64              `json:"l1BlockNumber,omitempty"`
+	L1BlockHash      string              `json:"l1BlockHash,omitempty"`
+	ReplicaID        string              `json:"replicaId,omitempty"`
+	ProverBackend    string              `json:"proverBackend,omitempty"`
+	HasProof         bool                `json:"hasProof"`
+	Events           []ProofRequestEvent `json:"events,omitempty"`
+}
+
+// ProofRequestEvent is the JSON representation of a status transition of a proof request.
+type ProofRequestEvent struct {
+	FromStatus      string `json:"fromStatus,omitempty"`
+	ToStatus        string `json:"toStatus"`
+	Time            uint64 `json:"time"`
+	Reason          string `json:"reason,omitempty"`
+	ProverRequestID string `json:"proverRequestId,omitempty"`
+	ProverResponse  string `json:"proverResponse,omitempty"`
+}
+
+func newProofRequest(p *ent.ProofRequest) ProofRequest {
+	return ProofRequest{
+		ID:               p.ID,
+		Type:             p.Type,
+		StartBlock:       p.StartBlock,
+		EndBlock:         p.EndBlock,
+		Status:           p.Status,
+		RequestAddedTime: p.RequestAddedTime,
+		ProverRequestID:  p.ProverRequestID,
+		ProofRequestTime: p.ProofRequestTime,
+		LastUpdatedTime:  p.LastUpdatedTime,
+		L1BlockNumber:    p.L1BlockNumber,
+		L1BlockHash:      p.L1BlockHash,
+		ReplicaID:        p.ReplicaID,
+		ProverBackend:    p.ProverBackend,
+		HasProof:         len(p.Proof) > 0,
+		Events:           newProofRequestEvents(p.Edges.Events),
+	}
+}
+
+func newProofRequestEvents(es []*ent.ProofRequestEvent) []ProofRequestEvent {
+	if len(es) == 0 {
+		return nil
+	}
+	out := make([]ProofRequestEvent, 0, len(es))
+	for _, e := range es {
+		out = append(out, ProofRequestEvent{
+			FromStatus:      string(e.FromStatus),
+			ToStatus:        string(e.ToStatus),
+			Time:            e.Time,
+			Reason:          e.Reason,
+			ProverRequestID: e.ProverRequestID,
+			ProverResponse:  e.ProverResponse,
+		})
+	}
+	return out
+}
+
+func newProofRequests(ps []*ent.ProofRequest) []ProofRequest {
+	out := make([]ProofRequest, 0, len(ps))
+	for _, p := range ps {
+		out = append(out, newProofRequest(p))
+	}
+	return out
+}
+
+// ProofRequestFilter selects the proof requests returned by admin_listProofRequests. Empty fields match everything.
+type ProofRequestFilter struct {
+	Type      proofrequest.Type   `json:"type,omitempty"`
+	Status    proofrequest.Status `json:"status,omitempty"`
+	FromBlock uint64              `json:"fromBlock,omitempty"`
+	ToBlock   uint64              `json:"toBlock,omitempty"`
+	Limit     int                 `json:"limit,omitempty"`
+}
+
+func (f ProofRequestFilter) check() error {
+	if f.Type != "" {
+		if err := proofrequest.TypeValidator(f.Type); err != nil {
+			return err
+		}
+	}
+	if f.Status != "" {
+		if err := proofrequest.StatusValidator(f.Status); err != nil {
+			return err
+		}
+	}
+	if f.ToBlock != 0 && f.FromBlock > f.ToBlock {
+		return fmt.Errorf("fromBlock %d is after toBlock %d", f.FromBlock, f.ToBlock)
+	}
+	return nil
+}
+
+type adminAPI struct {
+	q        ProofQueue
+	canceler ProofCanceller
+	alerts   RangeAlerts
+	log      log.Logger
+}
+
+func NewAdminAPI(q ProofQueue, canceler ProofCanceller, alerts RangeAlerts, log log.Logger) *adminAPI {
+	return &adminAPI{
+		q:        q,
+		canceler: canceler,
+		alerts:   alerts,
+		log:      log,
+	}
+}
+
+// GetAdminAPI returns the proof queue API in the "admin" namespace. The methods are merged with any other API
+// registered under the same namespace, such as the op-proposer StartProposer/StopProposer API.
+func GetAdminAPI(api *adminAPI) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "admin",
+		Service:   api,
+	}
+}
+
+// ListProofRequests returns the proof requests matching the filter, ordered by start block.
+func (a *adminAPI) ListProofRequests(_ context.Context, filter ProofRequestFilter) ([]ProofRequest, error) {
+	if err := filter.check(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	reqs, err := a.q.ListProofRequests(db.ProofRequestFilter{
+		Type:      filter.Type,
+		Status:    filter.Status,
+		FromBlock: filter.FromBlock,
+		ToBlock:   filter.ToBlock,
+		Limit:     filter.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newProofRequests(reqs), nil
+}
+
+// GetProofRequestHistory returns every attempt at proving the range of the given request, including retries and splits,
+// along with the status transitions of each attempt.
+func (a *adminAPI) GetProofRequestHistory(_ context.Context, id int) ([]ProofRequest, error) {
+	reqs, err := a.q.GetProofRequestHistory(id)
+	if err != nil {
+		return nil, err
+	}
+	return newProofRequests(reqs), nil
+}
+
+// RequeueProofRequest queues a new request for the range of a FAILED or CANCELLED proof request.
+func (a *adminAPI) RequeueProofRequest(_ context.Context, id int) (ProofRequest, error) {
+	req, err := a.q.RequeueFailedRequest(id)
+	if err != nil {
+		return ProofRequest{}, err
+	}
+	a.log.Info("Requeued failed proof request via admin API", "id", id, "new_id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock)
+	return newProofRequest(req), nil
+}
+
+// CancelProofRequest marks a PROVING proof request as CANCELLED, so that it isn't retried, and cancels it on its prover
+// backend. The request stays cancelled if the prover backend fails to cancel it.
+func (a *adminAPI) CancelProofRequest(ctx context.Context, id int) error {
+	req, err := a.q.CancelProvingRequest(id)
+	if err != nil {
+		return err
+	}
+	a.log.Info("Cancelled proving proof request via admin API", "id", id, "prover_request_id", req.ProverRequestID, "backend", req.ProverBackend)
+	if err := a.alerts.ResolveRangeFailures(ctx, req, fmt.Sprintf("The %s proof of blocks %d-%d was cancelled.", req.Type, req.StartBlock, req.EndBlock)); err != nil {
+		a.log.Error("Failed to resolve repeated failures alert", "id", id, "err", err)
+	}
+	if err := a.canceler.CancelProof(ctx, req); err != nil {
+		return fmt.Errorf("cancelled proof request %d, but failed to cancel it on the prover backend: %w", id, err)
+	}
+	return nil
+}
+
+// SplitProofRequest splits an UNREQ, FAILED or CANCELLED SPAN request at splitBlock, or in half if splitBlock is 0.
+func (a *adminAPI) SplitProofRequest(ctx context.Context, id int, splitBlock uint64) ([]ProofRequest, error) {
+	spans, err := a.q.SplitSpanRequest(id, splitBlock)
+	if err != nil {
+		return nil, err
+	}
+	for _, span := range spans {
+		a.log.Info("Split span proof request via admin API", "id", id, "new_id", span.ID, "start", span.StartBlock, "end", span.EndBlock)
+	}
+	// The split spans cover the range of the original request.
+	orig := &ent.ProofRequest{Type: spans[0].Type, StartBlock: spans[0].StartBlock, EndBlock: spans[len(spans)-1].EndBlock}
+	if err := a.alerts.ResolveRangeFailures(ctx, orig, fmt.Sprintf("The %s proof of blocks %d-%d was split at block %d.", orig.Type, orig.StartBlock, orig.EndBlock, spans[0].EndBlock)); err != nil {
+		a.log.Error("Failed to resolve repeated failures alert", "id", id, "err", err)
+	}
+	return newProofRequests(spans), nil
+}
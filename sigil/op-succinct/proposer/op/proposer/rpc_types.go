@@ -1,8 +1,12 @@
 package proposer
 
+import "fmt"
+
 type SpanProofRequest struct {
 	Start uint64 `json:"start"`
 	End   uint64 `json:"end"`
+	// Execute asks the server to execute the range program before proving it, to report the cycles it used.
+	Execute bool `json:"execute,omitempty"`
 }
 
 type AggProofRequest struct {
@@ -36,6 +40,8 @@ const (
 	// Other is a catch-all for any other unclaim description that doesn't fit into the above categories.
 	// Typically, this is used for proofs that are forcibly unclaimed by the cluster.
 	Other
+	// Cancelled is used for proofs that were cancelled by the proposer.
+	Cancelled
 )
 
 func (d UnclaimDescription) String() string {
@@ -48,6 +54,8 @@ func (d UnclaimDescription) String() string {
 		return "CycleLimitExceeded"
 	case Other:
 		return "Other"
+	case Cancelled:
+		return "Cancelled"
 	default:
 		return "Unknown"
 	}
@@ -64,6 +72,23 @@ const (
 	SP1FulfillmentStatusUnfulfillable
 )
 
+func (s SP1FulfillmentStatus) String() string {
+	switch s {
+	case SP1FulfillmentStatusUnspecified:
+		return "Unspecified"
+	case SP1FulfillmentStatusRequested:
+		return "Requested"
+	case SP1FulfillmentStatusAssigned:
+		return "Assigned"
+	case SP1FulfillmentStatusFulfilled:
+		return "Fulfilled"
+	case SP1FulfillmentStatusUnfulfillable:
+		return "Unfulfillable"
+	default:
+		return "Unknown"
+	}
+}
+
 // SP1ExecutionStatus represents the execution status of a proof in the SP1 network.
 type SP1ExecutionStatus int
 
@@ -74,10 +99,40 @@ const (
 	SP1ExecutionStatusUnexecutable
 )
 
+func (s SP1ExecutionStatus) String() string {
+	switch s {
+	case SP1ExecutionStatusUnspecified:
+		return "Unspecified"
+	case SP1ExecutionStatusUnexecuted:
+		return "Unexecuted"
+	case SP1ExecutionStatusExecuted:
+		return "Executed"
+	case SP1ExecutionStatusUnexecutable:
+		return "Unexecutable"
+	default:
+		return "Unknown"
+	}
+}
+
 // ProofStatusResponse is the response type for the `/status/:proof_id` RPC from the op-succinct-server.
 type ProofStatusResponse struct {
 	FulfillmentStatus SP1FulfillmentStatus `json:"fulfillment_status"`
 	ExecutionStatus   SP1ExecutionStatus   `json:"execution_status"`
 	Proof             []byte               `json:"proof"`
+	// Cycles is the number of cycles used to execute the range program. Only set for span proofs.
+	Cycles uint64 `json:"cycles"`
+	// UnclaimDescription is why the proof is unfulfillable, if known.
+	UnclaimDescription *UnclaimDescription `json:"unclaim_description"`
 }
 
+// String summarizes the statuses of the response, without the proof.
+func (r ProofStatusResponse) String() string {
+	summary := fmt.Sprintf("fulfillment_status=%s execution_status=%s", r.FulfillmentStatus, r.ExecutionStatus)
+	if r.UnclaimDescription != nil {
+		summary += fmt.Sprintf(" unclaim_description=%s", r.UnclaimDescription)
+	}
+	if r.Cycles > 0 {
+		summary += fmt.Sprintf(" cycles=%d", r.Cycles)
+	}
+	return summary
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -22,8 +23,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 
 	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/notify"
+	opsuccinctrpc "github.com/succinctlabs/op-succinct-go/proposer/rpc"
 )
 
 var ErrAlreadyStopped = errors.New("already stopped")
@@ -52,22 +56,44 @@ type ProposerConfig struct {
 	WaitNodeSync bool
 
 	// Additional fields required for OP Succinct Proposer
-	DbPath                     string
-	UseCachedDb                bool
-	SlackToken                 string
-	BeaconRpc                  string
-	RollupRpc                  string
-	TxCacheOutDir              string
-	MaxBlockRangePerSpanProof  uint64
-	MaxConcurrentWitnessGen    uint64
-	WitnessGenTimeout          uint64
-	L2ChainID                  uint64
-	ProofTimeout               uint64
-	OPSuccinctServerUrl        string
-	MaxConcurrentProofRequests uint64
-	BatchInbox                 common.Address
-	BatcherAddress             common.Address
-	Mock                       bool
+	DbPath                       string
+	UseCachedDb                  bool
+	DbUrl                        string
+	LeaderElection               bool
+	ReplicaID                    string
+	SlackToken                   string
+	SlackChannel                 string
+	NotifyWebhookUrl             string
+	PagerDutyRoutingKey          string
+	AlertContractLag             time.Duration
+	AlertRangeFailures           uint64
+	AlertMinBalance              *big.Int
+	AlertServerUnreachableChecks uint64
+	SubmissionMaxBaseFee         *big.Int
+	SubmissionDeadline           time.Duration
+	SubmissionTimeout            time.Duration
+	BundleCheckpoint             bool
+	Multicall3Addr               common.Address
+	BeaconRpc                    string
+	RollupRpc                    string
+	TxCacheOutDir                string
+	MaxBlockRangePerSpanProof    uint64
+	SpanStrategy                 SpanStrategy
+	L2EthRpc                     string
+	MaxGasPerSpanProof           uint64
+	MaxTxsPerSpanProof           uint64
+	SpanCycleBudget              uint64
+	TargetSpanProofDuration      time.Duration
+	MaxConcurrentWitnessGen      uint64
+	WitnessGenTimeout            uint64
+	L2ChainID                    uint64
+	ProofTimeout                 uint64
+	OPSuccinctServerUrl          string
+	MaxConcurrentProofRequests   uint64
+	ProverBackends               []ProverBackendConfig
+	BatchInbox                   common.Address
+	BatcherAddress               common.Address
+	Mock                         bool
 }
 
 type ProposerService struct {
@@ -79,6 +105,7 @@ type ProposerService struct {
 	TxManager      txmgr.TxManager
 	L1Client       *ethclient.Client
 	RollupProvider dial.RollupProvider
+	Notifier       notify.Notifier
 
 	driver *L2OutputSubmitter
 
@@ -119,11 +146,32 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	// Additional fields required for OP Succinct Proposer
 	ps.DbPath = cfg.DbPath
 	ps.UseCachedDb = cfg.UseCachedDb
+	ps.DbUrl = cfg.DbUrl
+	ps.LeaderElection = cfg.LeaderElection
+	ps.ReplicaID = cfg.ReplicaID
 	ps.SlackToken = cfg.SlackToken
+	ps.SlackChannel = cfg.SlackChannel
+	ps.NotifyWebhookUrl = cfg.NotifyWebhookUrl
+	ps.PagerDutyRoutingKey = cfg.PagerDutyRoutingKey
+	ps.AlertContractLag = cfg.AlertContractLag
+	ps.AlertRangeFailures = cfg.AlertRangeFailures
+	ps.AlertMinBalance, _ = new(big.Float).Mul(big.NewFloat(cfg.AlertMinBalance), big.NewFloat(params.Ether)).Int(nil)
+	ps.AlertServerUnreachableChecks = cfg.AlertServerUnreachableChecks
+	ps.SubmissionMaxBaseFee, _ = new(big.Float).Mul(big.NewFloat(cfg.SubmissionMaxBaseFee), big.NewFloat(params.GWei)).Int(nil)
+	ps.SubmissionDeadline = cfg.SubmissionDeadline
+	ps.SubmissionTimeout = cfg.SubmissionTimeout
+	ps.BundleCheckpoint = cfg.BundleCheckpoint
+	ps.Multicall3Addr = common.HexToAddress(cfg.Multicall3Address)
 	ps.BeaconRpc = cfg.BeaconRpc
 	ps.RollupRpc = cfg.RollupRpc
 	ps.TxCacheOutDir = cfg.TxCacheOutDir
 	ps.MaxBlockRangePerSpanProof = cfg.MaxBlockRangePerSpanProof
+	ps.SpanStrategy = SpanStrategy(cfg.SpanStrategy)
+	ps.L2EthRpc = cfg.L2EthRpc
+	ps.MaxGasPerSpanProof = cfg.MaxGasPerSpanProof
+	ps.MaxTxsPerSpanProof = cfg.MaxTxsPerSpanProof
+	ps.SpanCycleBudget = cfg.SpanCycleBudget
+	ps.TargetSpanProofDuration = cfg.TargetSpanProofDuration
 	ps.MaxConcurrentWitnessGen = cfg.MaxConcurrentWitnessGen
 	ps.WitnessGenTimeout = cfg.WitnessGenTimeout
 	ps.OPSuccinctServerUrl = cfg.OPSuccinctServerUrl
@@ -134,7 +182,14 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	ps.BatcherAddress = common.HexToAddress(cfg.BatcherAddress)
 	ps.Mock = cfg.Mock
 
+	proverBackends, err := LoadProverBackendConfigs(cfg.ProverBackendsFile, cfg.OPSuccinctServerUrl, cfg.Mock, cfg.MaxConcurrentProofRequests)
+	if err != nil {
+		return err
+	}
+	ps.ProverBackends = proverBackends
+
 	ps.initL2ooAddress(cfg)
+	ps.initNotifier()
 
 	if err := ps.initRPCClients(ctx, cfg); err != nil {
 		return err
@@ -243,6 +298,22 @@ func (ps *ProposerService) initMetricsServer(cfg *CLIConfig) error {
 	return nil
 }
 
+// initNotifier sets up the sinks of the status reports and alerts. Notifications are always logged.
+func (ps *ProposerService) initNotifier() {
+	notifiers := notify.Multi{notify.NewLogNotifier(ps.Log)}
+	if ps.SlackToken != "" {
+		notifiers = append(notifiers, notify.NewSlackNotifier(ps.SlackToken, ps.SlackChannel))
+	}
+	if ps.NotifyWebhookUrl != "" {
+		notifiers = append(notifiers, notify.NewWebhookNotifier(ps.NotifyWebhookUrl))
+	}
+	if ps.PagerDutyRoutingKey != "" {
+		source := fmt.Sprintf("op-succinct-proposer chain %d", ps.L2ChainID)
+		notifiers = append(notifiers, notify.NewPagerDutyNotifier(notify.PagerDutyEventsURL, ps.PagerDutyRoutingKey, source))
+	}
+	ps.Notifier = notifiers
+}
+
 func (ps *ProposerService) initL2ooAddress(cfg *CLIConfig) {
 	l2ooAddress, err := opservice.ParseAddress(cfg.L2OOAddress)
 	if err != nil {
@@ -260,6 +331,7 @@ func (ps *ProposerService) initDriver() error {
 		Txmgr:          ps.TxManager,
 		L1Client:       ps.L1Client,
 		RollupProvider: ps.RollupProvider,
+		Notifier:       ps.Notifier,
 	})
 	if err != nil {
 		return err
@@ -278,6 +350,8 @@ func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
 	if cfg.RPCConfig.EnableAdmin {
 		adminAPI := rpc.NewAdminAPI(ps.driver, ps.Metrics, ps.Log)
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
+		proofQueueAPI := opsuccinctrpc.NewAdminAPI(ps.driver.ProofDB(), ps.driver, ps.driver, ps.Log)
+		server.AddAPI(opsuccinctrpc.GetAdminAPI(proofQueueAPI))
 		ps.Log.Info("Admin RPC enabled")
 	}
 	ps.Log.Info("Starting JSON-RPC server")
@@ -32,9 +32,9 @@ func TestCreateSpans(t *testing.T) {
 			start:              1000,
 			end:                3000,
 			maxBlockRange:      500,
-			expectedSpansCount: 3,
+			expectedSpansCount: 4,
 			expectedFirstSpan:  Span{Start: 1000, End: 1500},
-			expectedLastSpan:   Span{Start: 2000, End: 2500},
+			expectedLastSpan:   Span{Start: 2500, End: 3000},
 		},
 		{
 			name:               "Partial last span excluded",
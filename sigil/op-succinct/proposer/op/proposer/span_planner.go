@@ -0,0 +1,369 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
+)
+
+// SpanStrategy determines how the range of newly finalized L2 blocks is split into span proofs.
+type SpanStrategy string
+
+const (
+	// SpanStrategyBasic splits the range into spans of MaxBlockRangePerSpanProof blocks.
+	SpanStrategyBasic SpanStrategy = "basic"
+	// SpanStrategySafeHead ends spans on the L2 safe heads recorded by the rollup node's safe DB.
+	SpanStrategySafeHead SpanStrategy = "safe-head"
+	// SpanStrategySpanBatch ends spans on the last block of the span batches posted by the batcher.
+	SpanStrategySpanBatch SpanStrategy = "span-batch"
+	// SpanStrategyWeighted ends spans once they reach a maximum amount of gas used or number of transactions.
+	SpanStrategyWeighted SpanStrategy = "weighted"
+	// SpanStrategyAdaptive weighs spans like SpanStrategyWeighted, with limits learned from the cycle counts of the
+	// completed span proofs.
+	SpanStrategyAdaptive SpanStrategy = "adaptive"
+)
+
+var SpanStrategies = []SpanStrategy{SpanStrategyBasic, SpanStrategySafeHead, SpanStrategySpanBatch, SpanStrategyWeighted, SpanStrategyAdaptive}
+
+func (s SpanStrategy) Check() error {
+	if !slices.Contains(SpanStrategies, s) {
+		return fmt.Errorf("unknown span strategy %q, must be one of %v", s, SpanStrategies)
+	}
+	return nil
+}
+
+// SpanPlanner splits a range of L2 blocks into the spans to prove. Spans are contiguous, and the end of the last span
+// may be before the end of the range if the remaining blocks don't make a complete span yet.
+type SpanPlanner interface {
+	PlanSpans(ctx context.Context, start, end uint64) ([]Span, error)
+}
+
+// newSpanPlanner creates the planner for the configured span strategy, along with a function closing its clients.
+func (l *L2OutputSubmitter) newSpanPlanner(ctx context.Context) (SpanPlanner, func(), error) {
+	maxBlockRange := l.Cfg.MaxBlockRangePerSpanProof
+
+	switch l.Cfg.SpanStrategy {
+	case SpanStrategyBasic, "":
+		return &basicPlanner{maxBlockRange: maxBlockRange}, func() {}, nil
+	case SpanStrategySafeHead:
+		rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, l.Log, l.Cfg.RollupRpc)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &safeHeadPlanner{client: rollupClient, maxBlockRange: maxBlockRange}, rollupClient.Close, nil
+	case SpanStrategySpanBatch:
+		source := &batchDecoderSource{log: l.Log, cfg: l.Cfg, l1Client: l.L1Client}
+		return &spanBatchPlanner{source: source, maxBlockRange: maxBlockRange}, func() {}, nil
+	case SpanStrategyWeighted:
+		l2Client, err := ethclient.DialContext(ctx, l.Cfg.L2EthRpc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to dial L2 RPC: %w", err)
+		}
+		planner := &weightedPlanner{
+			source:        &receiptsWeightSource{client: l2Client},
+			maxBlockRange: maxBlockRange,
+			maxGas:        l.Cfg.MaxGasPerSpanProof,
+			maxTxs:        l.Cfg.MaxTxsPerSpanProof,
+		}
+		return planner, l2Client.Close, nil
+	case SpanStrategyAdaptive:
+		l2Client, err := ethclient.DialContext(ctx, l.Cfg.L2EthRpc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to dial L2 RPC: %w", err)
+		}
+		maxBlockRange, maxGas := l.spanSizer.Limits()
+		l.Log.Info("Planning adaptive spans", "max_block_range", maxBlockRange, "max_gas", maxGas)
+		planner := &weightedPlanner{
+			source:        &receiptsWeightSource{client: l2Client},
+			maxBlockRange: maxBlockRange,
+			maxGas:        maxGas,
+		}
+		return planner, l2Client.Close, nil
+	default:
+		return nil, nil, l.Cfg.SpanStrategy.Check()
+	}
+}
+
+// splitRangeBasic creates spans of size maxBlockRange from start to end. A partial span at the end is excluded.
+func splitRangeBasic(start, end, maxBlockRange uint64) []Span {
+	spans := []Span{}
+	// Create spans of size maxBlockRange from start to end.
+	// Each span starts where the previous one ended.
+	// Continue until we can't fit another full span before reaching end.
+	for i := start; i+maxBlockRange <= end; i += maxBlockRange {
+		spans = append(spans, Span{Start: i, End: i + maxBlockRange})
+	}
+	return spans
+}
+
+// alignSpans creates spans from start that end on the given boundaries, packing as many boundaries into each span as
+// fit in maxBlockRange blocks. If the next boundary is more than maxBlockRange blocks away, the span is cut at
+// maxBlockRange blocks. Blocks after the last boundary up to end are left for a later span.
+func alignSpans(start, end uint64, boundaries []uint64, maxBlockRange uint64) []Span {
+	bounds := make([]uint64, 0, len(boundaries))
+	for _, b := range boundaries {
+		if b > start && b <= end {
+			bounds = append(bounds, b)
+		}
+	}
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+
+	spans := []Span{}
+	current := start
+	for i := 0; i < len(bounds); {
+		if bounds[i]-current > maxBlockRange {
+			spans = append(spans, Span{Start: current, End: current + maxBlockRange})
+			current += maxBlockRange
+			continue
+		}
+		// Take the furthest boundary that is within reach.
+		for i+1 < len(bounds) && bounds[i+1]-current <= maxBlockRange {
+			i++
+		}
+		spans = append(spans, Span{Start: current, End: bounds[i]})
+		current = bounds[i]
+		i++
+	}
+	return spans
+}
+
+type basicPlanner struct {
+	maxBlockRange uint64
+}
+
+func (p *basicPlanner) PlanSpans(_ context.Context, start, end uint64) ([]Span, error) {
+	return splitRangeBasic(start, end, p.maxBlockRange), nil
+}
+
+// safeHeadPlanner ends spans on the L2 safe heads at each L1 block between the L1 origin of the start block and the L1
+// block from which the end block is derived. The rollup node must have its safe DB enabled.
+type safeHeadPlanner struct {
+	client        SafeHeadClient
+	maxBlockRange uint64
+}
+
+func (p *safeHeadPlanner) PlanSpans(ctx context.Context, start, end uint64) ([]Span, error) {
+	if end <= start {
+		return []Span{}, nil
+	}
+
+	l1Head, err := getL1HeadForL2Block(ctx, p.client, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get l1 head for l2 block: %w", err)
+	}
+
+	startOutput, err := p.client.OutputAtBlock(ctx, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get l2 start output: %w", err)
+	}
+	startL1Origin := startOutput.BlockRef.L1Origin.Number
+
+	// The safe head at l1Head may be past the end block, so the blocks after the last safe head before it are left for
+	// a later span.
+	var safeHeads []uint64
+	mu := sync.Mutex{}
+	g := errgroup.Group{}
+	g.SetLimit(10)
+
+	// Get all of the safe heads between the L2 start block and the L1 head. Use parallel requests to speed up the process.
+	// This is useful for when a chain is behind.
+	for currentL1Block := startL1Origin; currentL1Block <= l1Head; currentL1Block++ {
+		l1Block := currentL1Block
+		g.Go(func() error {
+			safeHead, err := p.client.SafeHeadAtL1Block(ctx, l1Block)
+			if err != nil {
+				return fmt.Errorf("failed to get safe head at block %d: %w", l1Block, err)
+			}
+
+			mu.Lock()
+			safeHeads = append(safeHeads, safeHead.SafeHead.Number)
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("failed while getting safe heads: %w", err)
+	}
+
+	return alignSpans(start, end, safeHeads, p.maxBlockRange), nil
+}
+
+// SpanBatchSource returns the block ranges of the span batches overlapping an L2 block range. The ranges are inclusive
+// and cover whole batches, so the last range may end after the requested range.
+type SpanBatchSource interface {
+	SpanBatchRanges(ctx context.Context, start, end uint64) ([]utils.SpanBatchRange, error)
+}
+
+// spanBatchPlanner ends spans on the last block of a span batch, so that the blocks proven by a span are derived from
+// whole batches. Spans stop at the last batch that ends by the end of the range.
+type spanBatchPlanner struct {
+	source        SpanBatchSource
+	maxBlockRange uint64
+}
+
+func (p *spanBatchPlanner) PlanSpans(ctx context.Context, start, end uint64) ([]Span, error) {
+	if end <= start {
+		return []Span{}, nil
+	}
+
+	// The span [start, end] proves blocks start+1 to end.
+	ranges, err := p.source.SpanBatchRanges(ctx, start+1, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get span batch ranges: %w", err)
+	}
+
+	boundaries := make([]uint64, 0, len(ranges))
+	for _, r := range ranges {
+		boundaries = append(boundaries, r.End)
+	}
+	return alignSpans(start, end, boundaries, p.maxBlockRange), nil
+}
+
+// batchDecoderSource finds span batches by fetching and decoding the batches posted to the batch inbox on L1.
+type batchDecoderSource struct {
+	log      log.Logger
+	cfg      ProposerConfig
+	l1Client *ethclient.Client
+}
+
+func (s *batchDecoderSource) SpanBatchRanges(ctx context.Context, start, end uint64) ([]utils.SpanBatchRange, error) {
+	rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, s.log, s.cfg.RollupRpc)
+	if err != nil {
+		return nil, err
+	}
+	defer rollupClient.Close()
+
+	// Use the batcher address of the rollup config if it isn't configured.
+	batchSender := s.cfg.BatcherAddress
+	if batchSender == (common.Address{}) {
+		rollupCfg, err := rollupClient.RollupConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rollup config: %w", err)
+		}
+		batchSender = rollupCfg.Genesis.SystemConfig.BatcherAddr
+	}
+
+	l1BeaconClient, err := utils.SetupBeacon(s.cfg.BeaconRpc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup beacon: %w", err)
+	}
+
+	config := utils.BatchDecoderConfig{
+		L2ChainID:    new(big.Int).SetUint64(s.cfg.L2ChainID),
+		L2Node:       rollupClient,
+		L1RPC:        *s.l1Client,
+		L1Beacon:     l1BeaconClient,
+		BatchSender:  batchSender,
+		L2StartBlock: start,
+		L2EndBlock:   end,
+		DataDir:      s.cfg.TxCacheOutDir,
+	}
+	return utils.GetAllSpanBatchesInL2BlockRange(config)
+}
+
+// BlockWeight is the cost of proving an L2 block, measured by the gas used and number of transactions in the block.
+type BlockWeight struct {
+	GasUsed uint64 `json:"gasUsed"`
+	TxCount uint64 `json:"txCount"`
+}
+
+// BlockWeightSource returns the weight of an L2 block.
+type BlockWeightSource interface {
+	BlockWeight(ctx context.Context, number uint64) (BlockWeight, error)
+}
+
+// weightedPlanner ends spans once adding the next block would exceed maxGas or maxTxs, or the span reaches
+// maxBlockRange blocks. A limit of 0 is ignored. A span always contains at least one block, even if the block alone
+// exceeds the limits.
+type weightedPlanner struct {
+	source        BlockWeightSource
+	maxBlockRange uint64
+	maxGas        uint64
+	maxTxs        uint64
+}
+
+func (p *weightedPlanner) PlanSpans(ctx context.Context, start, end uint64) ([]Span, error) {
+	if end <= start {
+		return []Span{}, nil
+	}
+
+	weights, err := fetchBlockWeights(ctx, p.source, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	spans := []Span{}
+	current := start
+	var gas, txs uint64
+	for i, w := range weights {
+		number := start + 1 + uint64(i)
+		exceedsGas := p.maxGas > 0 && gas+w.GasUsed > p.maxGas
+		exceedsTxs := p.maxTxs > 0 && txs+w.TxCount > p.maxTxs
+		if number-1 > current && (exceedsGas || exceedsTxs) {
+			spans = append(spans, Span{Start: current, End: number - 1})
+			current = number - 1
+			gas, txs = 0, 0
+		}
+		gas += w.GasUsed
+		txs += w.TxCount
+		if number-current == p.maxBlockRange {
+			spans = append(spans, Span{Start: current, End: number})
+			current = number
+			gas, txs = 0, 0
+		}
+	}
+	return spans, nil
+}
+
+// fetchBlockWeights returns the weights of the blocks proven by the span [start, end], which are blocks start+1 to end.
+func fetchBlockWeights(ctx context.Context, source BlockWeightSource, start, end uint64) ([]BlockWeight, error) {
+	weights := make([]BlockWeight, end-start)
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(10)
+	for i := range weights {
+		number := start + 1 + uint64(i)
+		g.Go(func() error {
+			w, err := source.BlockWeight(gCtx, number)
+			if err != nil {
+				return fmt.Errorf("failed to get weight of block %d: %w", number, err)
+			}
+			weights[i] = w
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return weights, nil
+}
+
+// receiptsWeightSource weighs L2 blocks using their receipts.
+type receiptsWeightSource struct {
+	client *ethclient.Client
+}
+
+func (s *receiptsWeightSource) BlockWeight(ctx context.Context, number uint64) (BlockWeight, error) {
+	receipts, err := s.client.BlockReceipts(ctx, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(number)))
+	if err != nil {
+		return BlockWeight{}, err
+	}
+
+	w := BlockWeight{TxCount: uint64(len(receipts))}
+	for _, r := range receipts {
+		w.GasUsed += r.GasUsed
+	}
+	return w, nil
+}
@@ -0,0 +1,295 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/fetch"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	derivetest "github.com/ethereum-optimism/optimism/op-node/rollup/derive/test"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
+)
+
+func loadFixture(t *testing.T, name string, v any) {
+	data, err := os.ReadFile(filepath.Join("testdata", "span_planner", name))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
+
+// requireContiguous checks that the spans are contiguous, start at start and are at most maxBlockRange blocks.
+func requireContiguous(t *testing.T, spans []Span, start, maxBlockRange uint64) {
+	current := start
+	for _, span := range spans {
+		require.Equal(t, current, span.Start, "spans should be contiguous")
+		require.Greater(t, span.End, span.Start)
+		require.LessOrEqual(t, span.End-span.Start, maxBlockRange)
+		current = span.End
+	}
+}
+
+// fixtureRollupClient serves the L1 origins and safe heads recorded in safe_heads.json.
+type fixtureRollupClient struct {
+	HeadL1    uint64            `json:"headL1"`
+	Outputs   map[string]uint64 `json:"outputs"`
+	SafeHeads map[string]uint64 `json:"safeHeads"`
+}
+
+func (c *fixtureRollupClient) SyncStatus(context.Context) (*eth.SyncStatus, error) {
+	return &eth.SyncStatus{HeadL1: eth.L1BlockRef{Number: c.HeadL1}}, nil
+}
+
+func (c *fixtureRollupClient) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	origin, ok := c.Outputs[strconv.FormatUint(blockNum, 10)]
+	if !ok {
+		return nil, fmt.Errorf("no output for block %d", blockNum)
+	}
+	return &eth.OutputResponse{BlockRef: eth.L2BlockRef{Number: blockNum, L1Origin: eth.BlockID{Number: origin}}}, nil
+}
+
+func (c *fixtureRollupClient) SafeHeadAtL1Block(_ context.Context, l1BlockNum uint64) (*eth.SafeHeadResponse, error) {
+	safeHead, ok := c.SafeHeads[strconv.FormatUint(l1BlockNum, 10)]
+	if !ok {
+		return nil, fmt.Errorf("no safe head for L1 block %d", l1BlockNum)
+	}
+	return &eth.SafeHeadResponse{
+		L1Block:  eth.BlockID{Number: l1BlockNum},
+		SafeHead: eth.BlockID{Number: safeHead},
+	}, nil
+}
+
+// batcherFramesSource finds span batches in the frames written to dir by writeBatcherFrames, through the same
+// frame-to-range path as the batch decoder.
+type batcherFramesSource struct {
+	cfg       reassemble.Config
+	rollupCfg *rollup.Config
+}
+
+func (s *batcherFramesSource) SpanBatchRanges(_ context.Context, start, end uint64) ([]utils.SpanBatchRange, error) {
+	return utils.GetSpanBatchRanges(s.cfg, s.rollupCfg, start, end, 0)
+}
+
+// writeBatcherFrames encodes the L2 blocks of each batch into a span batch channel the way the batcher does, and
+// writes the frames to dir as the batch decoder stores the batcher transactions fetched from L1. Each frame is posted
+// in its own L1 block. If incomplete is set, the last frame of the last channel is left out, like a channel the
+// batcher is still posting.
+func writeBatcherFrames(t *testing.T, dir string, rollupCfg *rollup.Config, inbox common.Address, batches [][2]uint64, incomplete bool) {
+	rng := rand.New(rand.NewSource(1234))
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	l1Block := uint64(100)
+	for i, batch := range batches {
+		co, err := derive.NewSpanChannelOut(rollupCfg.Genesis.L2Time, rollupCfg.L2ChainID, 1_000_000, derive.Zlib, rollup.NewChainSpec(rollupCfg))
+		require.NoError(t, err)
+		for n := batch[0]; n <= batch[1]; n++ {
+			blockTime := time.Unix(int64(rollupCfg.Genesis.L2Time+(n-rollupCfg.Genesis.L2.Number)*rollupCfg.BlockTime), 0)
+			block := derivetest.RandomL2BlockWithChainIdAndTime(rng, 2, rollupCfg.L2ChainID, blockTime)
+			require.NoError(t, co.AddBlock(rollupCfg, block))
+		}
+		require.NoError(t, co.Close())
+
+		var frames [][]byte
+		for {
+			// Frames are small so that channels are posted in several transactions.
+			data := bytes.NewBuffer([]byte{derive.DerivationVersion0})
+			_, err := co.OutputFrame(data, 200)
+			frames = append(frames, data.Bytes())
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+		}
+		require.Greater(t, len(frames), 1)
+		if incomplete && i == len(batches)-1 {
+			frames = frames[:len(frames)-1]
+		}
+
+		for _, data := range frames {
+			parsed, err := derive.ParseFrames(data)
+			require.NoError(t, err)
+			tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{ChainID: big.NewInt(1), To: &inbox, Data: data})
+			require.NoError(t, err)
+			txm := fetch.TransactionWithMetadata{
+				InboxAddr:   inbox,
+				BlockNumber: l1Block,
+				BlockHash:   common.Hash{byte(l1Block >> 8), byte(l1Block)},
+				BlockTime:   l1Block * 12,
+				ChainId:     1,
+				ValidSender: true,
+				Frames:      parsed,
+				ValidFrames: []bool{true},
+				Tx:          tx,
+			}
+			out, err := json.Marshal(txm)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filepath.Join(dir, fmt.Sprintf("%d.json", l1Block)), out, 0o644))
+			l1Block++
+		}
+	}
+}
+
+// fixtureWeightSource serves the block weights recorded in block_weights.json.
+type fixtureWeightSource struct {
+	Start  uint64        `json:"start"`
+	Blocks []BlockWeight `json:"blocks"`
+}
+
+func (s *fixtureWeightSource) BlockWeight(_ context.Context, number uint64) (BlockWeight, error) {
+	if number < s.Start || number >= s.Start+uint64(len(s.Blocks)) {
+		return BlockWeight{}, fmt.Errorf("no weight for block %d", number)
+	}
+	return s.Blocks[number-s.Start], nil
+}
+
+func TestAlignSpans(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end uint64
+		boundaries []uint64
+		expected   []Span
+	}{
+		{
+			name:       "Packs boundaries into spans",
+			start:      100,
+			end:        200,
+			boundaries: []uint64{110, 120, 130, 145, 160},
+			expected:   []Span{{100, 130}, {130, 160}},
+		},
+		{
+			name:       "Cuts batches larger than the max range",
+			start:      100,
+			end:        200,
+			boundaries: []uint64{175},
+			expected:   []Span{{100, 130}, {130, 160}, {160, 175}},
+		},
+		{
+			name:       "Ignores boundaries outside the range",
+			start:      100,
+			end:        150,
+			boundaries: []uint64{90, 100, 120, 120, 170},
+			expected:   []Span{{100, 120}},
+		},
+		{
+			name:     "No boundaries",
+			start:    100,
+			end:      200,
+			expected: []Span{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spans := alignSpans(tt.start, tt.end, tt.boundaries, 30)
+			require.Equal(t, tt.expected, spans)
+			requireContiguous(t, spans, tt.start, 30)
+		})
+	}
+}
+
+func TestSafeHeadPlanner(t *testing.T) {
+	client := new(fixtureRollupClient)
+	loadFixture(t, "safe_heads.json", client)
+	planner := &safeHeadPlanner{client: client, maxBlockRange: 30}
+
+	spans, err := planner.PlanSpans(context.Background(), 1000, 1100)
+	require.NoError(t, err)
+	// The safe head after 1090 is 1104, so the blocks after 1090 are left for a later span.
+	require.Equal(t, []Span{{1000, 1012}, {1012, 1031}, {1031, 1049}, {1049, 1079}, {1079, 1090}}, spans)
+	requireContiguous(t, spans, 1000, 30)
+}
+
+func TestSpanBatchPlanner(t *testing.T) {
+	inbox := common.Address{0xff, 0x01}
+	rollupCfg := &rollup.Config{
+		Genesis:   rollup.Genesis{L2: eth.BlockID{Number: 0}, L2Time: 1_700_000_000},
+		BlockTime: 2,
+		L2ChainID: big.NewInt(10),
+	}
+	cfg := reassemble.Config{
+		BatchInbox:    inbox,
+		InDirectory:   t.TempDir(),
+		L2ChainID:     rollupCfg.L2ChainID,
+		L2GenesisTime: rollupCfg.Genesis.L2Time,
+		L2BlockTime:   rollupCfg.BlockTime,
+	}
+	// The batcher is still posting the channel of blocks 1101 to 1110.
+	batches := [][2]uint64{{1001, 1012}, {1013, 1020}, {1021, 1048}, {1049, 1085}, {1086, 1094}, {1095, 1100}, {1101, 1110}}
+	writeBatcherFrames(t, cfg.InDirectory, rollupCfg, inbox, batches, true)
+	source := &batcherFramesSource{cfg: cfg, rollupCfg: rollupCfg}
+
+	planner := &spanBatchPlanner{source: source, maxBlockRange: 30}
+
+	spans, err := planner.PlanSpans(context.Background(), 1000, 1100)
+	require.NoError(t, err)
+	require.Equal(t, []Span{{1000, 1020}, {1020, 1048}, {1048, 1078}, {1078, 1100}}, spans)
+	requireContiguous(t, spans, 1000, 30)
+
+	// The batch of blocks 1086 to 1094 isn't complete at the end of the range, so the spans stop at block 1085.
+	spans, err = planner.PlanSpans(context.Background(), 1000, 1090)
+	require.NoError(t, err)
+	require.Equal(t, []Span{{1000, 1020}, {1020, 1048}, {1048, 1078}, {1078, 1085}}, spans)
+
+	spans, err = planner.PlanSpans(context.Background(), 1100, 1100)
+	require.NoError(t, err)
+	require.Empty(t, spans)
+}
+
+func TestWeightedPlanner(t *testing.T) {
+	source := new(fixtureWeightSource)
+	loadFixture(t, "block_weights.json", source)
+
+	t.Run("Max gas", func(t *testing.T) {
+		planner := &weightedPlanner{source: source, maxBlockRange: 20, maxGas: 30_000_000}
+		spans, err := planner.PlanSpans(context.Background(), 1000, 1060)
+		require.NoError(t, err)
+		// Blocks 1017 and 1042 use most of the gas limit, so they end up in short spans. The blocks after 1042 don't
+		// make a complete span yet.
+		require.Equal(t, []Span{{1000, 1016}, {1016, 1018}, {1018, 1038}, {1038, 1042}}, spans)
+		requireContiguous(t, spans, 1000, 20)
+	})
+
+	t.Run("Max transactions", func(t *testing.T) {
+		planner := &weightedPlanner{source: source, maxBlockRange: 20, maxTxs: 60}
+		spans, err := planner.PlanSpans(context.Background(), 1000, 1060)
+		require.NoError(t, err)
+		// Blocks 1017 and 1042 exceed the limit on their own, so they are proven alone.
+		require.Contains(t, spans, Span{1016, 1017})
+		require.Contains(t, spans, Span{1041, 1042})
+		requireContiguous(t, spans, 1000, 20)
+		for _, span := range spans {
+			var txs uint64
+			for n := span.Start + 1; n <= span.End; n++ {
+				w, err := source.BlockWeight(context.Background(), n)
+				require.NoError(t, err)
+				txs += w.TxCount
+			}
+			require.True(t, txs <= 60 || span.End-span.Start == 1, "span %v has %d transactions", span, txs)
+		}
+	})
+
+	t.Run("Range without complete spans", func(t *testing.T) {
+		planner := &weightedPlanner{source: source, maxBlockRange: 20, maxGas: 30_000_000}
+		spans, err := planner.PlanSpans(context.Background(), 1042, 1060)
+		require.NoError(t, err)
+		require.Empty(t, spans)
+	})
+}
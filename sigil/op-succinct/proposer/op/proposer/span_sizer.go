@@ -0,0 +1,200 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+const (
+	// spanSizerSmoothing is the weight of a new observation in the moving average of the cycles per gas.
+	spanSizerSmoothing = 0.3
+	// spanSizerShrinkFactor scales spans down after a span proof exceeds the cycle limit.
+	spanSizerShrinkFactor = 0.5
+	// spanSizerGrowFactor scales spans back up after a span proof completes within the target duration.
+	spanSizerGrowFactor = 1.25
+	// spanSizerMinScale bounds how small spans get relative to the cycle budget and max block range.
+	spanSizerMinScale = 1.0 / 16
+	// spanSizerHistory is the number of completed span proofs used to initialize the sizer on startup.
+	spanSizerHistory = 50
+	// spanSizerExecuteInterval is how often span proofs are executed to report their cycles once the sizer has an
+	// estimate of the cycles per gas, so that the estimate follows changes of the L2 workload.
+	spanSizerExecuteInterval = 5
+)
+
+// spanSizer learns the number of cycles used per unit of L2 gas from completed span proofs, and sizes new spans to
+// fit in a cycle budget. Spans are scaled down after proofs exceed the cycle limit, and back up when proofs complete
+// within the target duration.
+type spanSizer struct {
+	mu sync.Mutex
+
+	cycleBudget         uint64
+	maxBlockRange       uint64
+	targetProofDuration time.Duration
+
+	// cyclesPerGas is the moving average of the cycles per gas of the completed span proofs. 0 until the first one.
+	cyclesPerGas float64
+	// scale is the fraction of the cycle budget and max block range that new spans use, in [spanSizerMinScale, 1].
+	scale float64
+	// requested is the number of span proofs requested since the last one that was executed.
+	requested uint64
+}
+
+func newSpanSizer(cycleBudget, maxBlockRange uint64, targetProofDuration time.Duration) *spanSizer {
+	return &spanSizer{
+		cycleBudget:         cycleBudget,
+		maxBlockRange:       maxBlockRange,
+		targetProofDuration: targetProofDuration,
+		scale:               1,
+	}
+}
+
+// Observe records a completed span proof that used the given cycles to prove blocks using gasUsed L2 gas, and took
+// duration to prove. The cycles are 0 if the prover didn't execute the proof before proving it, in which case only
+// the duration is used.
+func (s *spanSizer) Observe(gasUsed, cycles uint64, duration time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if gasUsed > 0 && cycles > 0 {
+		cyclesPerGas := float64(cycles) / float64(gasUsed)
+		if s.cyclesPerGas == 0 {
+			s.cyclesPerGas = cyclesPerGas
+		} else {
+			s.cyclesPerGas = spanSizerSmoothing*cyclesPerGas + (1-spanSizerSmoothing)*s.cyclesPerGas
+		}
+	}
+
+	if s.targetProofDuration > 0 && duration < s.targetProofDuration {
+		s.scale = min(1, s.scale*spanSizerGrowFactor)
+	}
+}
+
+// ShouldExecute returns whether the next span proof should be executed to report its cycles. Every span proof is
+// executed until the sizer has an estimate of the cycles per gas, and then every spanSizerExecuteInterval span proofs.
+func (s *spanSizer) ShouldExecute() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cyclesPerGas == 0 || s.requested+1 >= spanSizerExecuteInterval {
+		s.requested = 0
+		return true
+	}
+	s.requested++
+	return false
+}
+
+// Shrink scales down new spans after a span proof exceeded the cycle limit.
+func (s *spanSizer) Shrink() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.scale = max(spanSizerMinScale, s.scale*spanSizerShrinkFactor)
+}
+
+// Limits returns the max number of blocks and the max gas of new spans. The max gas is 0, meaning no limit, until a
+// span proof has been observed.
+func (s *spanSizer) Limits() (maxBlockRange, maxGas uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maxBlockRange = max(1, uint64(float64(s.maxBlockRange)*s.scale))
+	if s.cyclesPerGas == 0 {
+		return maxBlockRange, 0
+	}
+	maxGas = max(1, uint64(float64(s.cycleBudget)*s.scale/s.cyclesPerGas))
+	return maxBlockRange, maxGas
+}
+
+// initSpanSizer creates the span sizer of the adaptive span strategy, initialized with the recently completed span
+// proofs in the DB.
+func initSpanSizer(cfg ProposerConfig, proofDB *db.ProofDB) (*spanSizer, error) {
+	sizer := newSpanSizer(cfg.SpanCycleBudget, cfg.MaxBlockRangePerSpanProof, cfg.TargetSpanProofDuration)
+
+	reqs, err := proofDB.GetRecentSpanProofStats(spanSizerHistory)
+	if err != nil {
+		return nil, err
+	}
+	for _, req := range reqs {
+		sizer.Observe(req.GasUsed, req.Cycles, proofDuration(req, req.LastUpdatedTime))
+	}
+	return sizer, nil
+}
+
+// proofDuration returns how long the prover took to prove the request, if it completed at the given time.
+func proofDuration(req *ent.ProofRequest, completedTime uint64) time.Duration {
+	if req.ProofRequestTime == 0 || completedTime < req.ProofRequestTime {
+		return 0
+	}
+	return time.Duration(completedTime-req.ProofRequestTime) * time.Second
+}
+
+// observeSpanProof updates the span sizer with a completed span proof. If the prover reported the cycles the proof
+// used, it also records the L2 gas used by the blocks of the proof.
+func (l *L2OutputSubmitter) observeSpanProof(ctx context.Context, req *ent.ProofRequest, cycles uint64) error {
+	var gasUsed uint64
+	if cycles > 0 {
+		l2Client, err := ethclient.DialContext(ctx, l.Cfg.L2EthRpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial L2 RPC: %w", err)
+		}
+		defer l2Client.Close()
+
+		weights, err := fetchBlockWeights(ctx, &receiptsWeightSource{client: l2Client}, req.StartBlock, req.EndBlock)
+		if err != nil {
+			return err
+		}
+		for _, w := range weights {
+			gasUsed += w.GasUsed
+		}
+		if err := l.db.SetProofGasUsed(req.ID, gasUsed); err != nil {
+			return err
+		}
+	}
+
+	l.spanSizer.Observe(gasUsed, cycles, proofDuration(req, uint64(time.Now().Unix())))
+	maxBlockRange, maxGas := l.spanSizer.Limits()
+	l.Log.Info("Updated adaptive span size", "id", req.ID, "cycles", cycles, "gas_used", gasUsed, "max_block_range", maxBlockRange, "max_gas", maxGas)
+	return nil
+}
+
+// splitSpanAdaptive splits the range of a span proof that exceeded the cycle limit into spans sized by the span sizer.
+// The range is split into at least two spans.
+func (l *L2OutputSubmitter) splitSpanAdaptive(ctx context.Context, req *ent.ProofRequest) ([]Span, error) {
+	l2Client, err := ethclient.DialContext(ctx, l.Cfg.L2EthRpc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial L2 RPC: %w", err)
+	}
+	defer l2Client.Close()
+
+	maxBlockRange, maxGas := l.spanSizer.Limits()
+	planner := &weightedPlanner{
+		source:        &receiptsWeightSource{client: l2Client},
+		maxBlockRange: maxBlockRange,
+		maxGas:        maxGas,
+	}
+	spans, err := planner.PlanSpans(ctx, req.StartBlock, req.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// The planner leaves the blocks after the last complete span, but the whole range has to be retried.
+	last := req.StartBlock
+	if len(spans) > 0 {
+		last = spans[len(spans)-1].End
+	}
+	if last < req.EndBlock {
+		spans = append(spans, Span{Start: last, End: req.EndBlock})
+	}
+	if len(spans) < 2 {
+		midBlock := (req.StartBlock + req.EndBlock) / 2
+		spans = []Span{{Start: req.StartBlock, End: midBlock}, {Start: midBlock, End: req.EndBlock}}
+	}
+	return spans, nil
+}
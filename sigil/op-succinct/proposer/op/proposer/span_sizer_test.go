@@ -0,0 +1,100 @@
+package proposer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpanSizer(t *testing.T) {
+	t.Run("Limits only the block range before observing proofs", func(t *testing.T) {
+		sizer := newSpanSizer(1_000_000_000, 300, time.Hour)
+		maxBlockRange, maxGas := sizer.Limits()
+		require.Equal(t, uint64(300), maxBlockRange)
+		require.Zero(t, maxGas)
+		require.True(t, sizer.ShouldExecute())
+		require.True(t, sizer.ShouldExecute(), "span proofs are executed until the first one is observed")
+
+		sizer.Observe(100_000_000, 2_000_000_000, 2*time.Hour)
+		require.False(t, sizer.ShouldExecute())
+	})
+
+	t.Run("Keeps executing span proofs to follow the workload", func(t *testing.T) {
+		sizer := newSpanSizer(1_000_000_000, 300, time.Hour)
+		var executed int
+		var maxGases []uint64
+		// The cycles per gas of the L2 workload grows from 10 to 40 over the proofs.
+		for i := range 4 * spanSizerExecuteInterval {
+			var cycles uint64
+			if sizer.ShouldExecute() {
+				executed++
+				cycles = uint64(10+10*(i/spanSizerExecuteInterval)) * 100_000_000
+			}
+			sizer.Observe(100_000_000, cycles, 2*time.Hour)
+			_, maxGas := sizer.Limits()
+			if len(maxGases) == 0 || maxGases[len(maxGases)-1] != maxGas {
+				maxGases = append(maxGases, maxGas)
+			}
+		}
+		require.Equal(t, 4, executed)
+		require.Equal(t, []uint64{100_000_000, 76_923_076, 55_248_618, 40_535_062}, maxGases)
+	})
+
+	t.Run("Sizes spans to the cycle budget", func(t *testing.T) {
+		sizer := newSpanSizer(1_000_000_000, 300, time.Hour)
+		sizer.Observe(100_000_000, 2_000_000_000, 2*time.Hour)
+		_, maxGas := sizer.Limits()
+		require.Equal(t, uint64(50_000_000), maxGas)
+
+		// The next observation moves the estimate of the cycles per gas from 20 towards 10.
+		sizer.Observe(100_000_000, 1_000_000_000, 2*time.Hour)
+		_, maxGas = sizer.Limits()
+		require.Equal(t, uint64(58_823_529), maxGas)
+
+		// Observations without cycles or gas don't change the estimate.
+		sizer.Observe(0, 1_000_000_000, 2*time.Hour)
+		sizer.Observe(100_000_000, 0, 2*time.Hour)
+		_, unchanged := sizer.Limits()
+		require.Equal(t, maxGas, unchanged)
+	})
+
+	t.Run("Shrinks after exceeding the cycle limit and grows back", func(t *testing.T) {
+		sizer := newSpanSizer(1_000_000_000, 320, 30*time.Minute)
+		sizer.Observe(100_000_000, 1_000_000_000, time.Hour)
+
+		sizer.Shrink()
+		maxBlockRange, maxGas := sizer.Limits()
+		require.Equal(t, uint64(160), maxBlockRange)
+		require.Equal(t, uint64(50_000_000), maxGas)
+
+		for range 10 {
+			sizer.Shrink()
+		}
+		maxBlockRange, _ = sizer.Limits()
+		require.Equal(t, uint64(20), maxBlockRange, "spans shrink to at most 1/16th")
+
+		// Slow proofs don't grow spans.
+		sizer.Observe(100_000_000, 1_000_000_000, time.Hour)
+		maxBlockRange, _ = sizer.Limits()
+		require.Equal(t, uint64(20), maxBlockRange)
+
+		for range 20 {
+			sizer.Observe(100_000_000, 1_000_000_000, 10*time.Minute)
+		}
+		maxBlockRange, maxGas = sizer.Limits()
+		require.Equal(t, uint64(320), maxBlockRange, "spans grow back to at most the max block range")
+		require.Equal(t, uint64(100_000_000), maxGas)
+	})
+
+	t.Run("Grows back after fast proofs without cycles", func(t *testing.T) {
+		sizer := newSpanSizer(1_000_000_000, 320, 30*time.Minute)
+		sizer.Observe(100_000_000, 1_000_000_000, time.Hour)
+		sizer.Shrink()
+
+		sizer.Observe(0, 0, 10*time.Minute)
+		maxBlockRange, maxGas := sizer.Limits()
+		require.Equal(t, uint64(200), maxBlockRange)
+		require.Equal(t, uint64(62_500_000), maxGas)
+	})
+}
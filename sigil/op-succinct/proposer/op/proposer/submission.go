@@ -0,0 +1,163 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/proofs"
+)
+
+// Reasons the submission of an AGG proof is deferred, recorded in the submission deferrals metric.
+const (
+	deferBaseFee           = "base_fee"
+	deferCheckpointExpired = "checkpoint_expired"
+	deferDryRunReverted    = "dry_run_reverted"
+)
+
+// defaultSubmissionTimeout is the maximum time spent submitting an AGG proof if no submission timeout is configured.
+const defaultSubmissionTimeout = 10 * time.Minute
+
+// bundleCheckpointMaxAge is how many blocks behind the L1 head the L1 block of an AGG proof can be for its hash to be
+// checkpointed in the proposal transaction. The BLOCKHASH opcode only returns the hashes of the last 256 blocks, and
+// the transaction may be included a few blocks after it is sent.
+const bundleCheckpointMaxAge = 240
+
+// l1BlockTime is the time between L1 blocks, used to convert the checkpoint max age to a duration.
+const l1BlockTime = 12 * time.Second
+
+// bundleCheckpointWindow is how long after its L1 block is chosen an AGG proof can still be submitted with a bundled
+// checkpoint.
+const bundleCheckpointWindow = bundleCheckpointMaxAge * l1BlockTime
+
+// multicall3ABIJSON is the ABI of the aggregate3 function of Multicall3.
+const multicall3ABIJSON = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+// multicall3Call is a call made by the aggregate3 function of Multicall3.
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// checkBaseFee returns whether the L1 base fee allows submitting an AGG proof that has been waiting since the given
+// time. Submissions are deferred while the base fee is above the cap, until the submission deadline passes.
+func (l *L2OutputSubmitter) checkBaseFee(ctx context.Context, pendingSince time.Time) (bool, error) {
+	maxBaseFee := l.Cfg.SubmissionMaxBaseFee
+	if maxBaseFee == nil || maxBaseFee.Sign() == 0 {
+		return true, nil
+	}
+
+	header, err := l.L1Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	if header.BaseFee == nil || header.BaseFee.Cmp(maxBaseFee) <= 0 {
+		return true, nil
+	}
+
+	waited := time.Since(pendingSince)
+	if l.Cfg.SubmissionDeadline > 0 && waited >= l.Cfg.SubmissionDeadline {
+		l.Log.Warn("L1 base fee is above the cap, but the submission deadline has passed",
+			"base_fee", header.BaseFee, "max_base_fee", maxBaseFee, "waited", waited)
+		return true, nil
+	}
+	l.Log.Info("Deferring AGG proof submission until the L1 base fee drops",
+		"base_fee", header.BaseFee, "max_base_fee", maxBaseFee, "waited", waited)
+	return false, nil
+}
+
+// checkBundleCheckpoint checks that the L2OO accepts the proposals bundled with their checkpoint. The bundled
+// proposeL2Output call is made by the Multicall3 contract, so Multicall3 must be an approved proposer, or proposing
+// must be permissionless.
+func (l *L2OutputSubmitter) checkBundleCheckpoint(ctx context.Context) error {
+	if !l.Cfg.BundleCheckpoint {
+		return nil
+	}
+	for _, proposer := range []common.Address{l.Cfg.Multicall3Addr, {}} {
+		approved, err := l.l2ooContract.ApprovedProposers(&bind.CallOpts{Context: ctx}, proposer)
+		if err != nil {
+			return fmt.Errorf("failed to check approved proposer %s: %w", proposer, err)
+		}
+		if approved {
+			return nil
+		}
+	}
+	return fmt.Errorf("bundled checkpoints require Multicall3 %s to be an approved proposer, or permissionless proposing", l.Cfg.Multicall3Addr)
+}
+
+// proposalTx returns the transaction proposing the output with the AGG proof. With BundleCheckpoint, if the L1 block
+// hash of the proof isn't checkpointed yet, the transaction checkpoints it and proposes the output through Multicall3.
+// Returns false if the L1 block hash can no longer be checkpointed, in which case the AGG proof can't be submitted.
+func (l *L2OutputSubmitter) proposalTx(ctx context.Context, output *eth.OutputResponse, aggProof *ent.ProofRequest) (txmgr.TxCandidate, bool, error) {
+	data, err := l.ProposeL2OutputTxData(output, aggProof.Proof, aggProof.L1BlockNumber)
+	if err != nil {
+		return txmgr.TxCandidate{}, false, err
+	}
+	candidate := txmgr.TxCandidate{TxData: data, To: l.Cfg.L2OutputOracleAddr}
+	if !l.Cfg.BundleCheckpoint {
+		return candidate, true, nil
+	}
+
+	l1BlockNumber := new(big.Int).SetUint64(aggProof.L1BlockNumber)
+	checkpointed, err := l.l2ooContract.HistoricBlockHashes(&bind.CallOpts{Context: ctx}, l1BlockNumber)
+	if err != nil {
+		return txmgr.TxCandidate{}, false, fmt.Errorf("failed to get checkpointed block hash: %w", err)
+	}
+	if checkpointed != ([32]byte{}) {
+		return candidate, true, nil
+	}
+
+	l1Head, err := l.L1Client.BlockNumber(ctx)
+	if err != nil {
+		return txmgr.TxCandidate{}, false, fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	if l1Head > aggProof.L1BlockNumber+bundleCheckpointMaxAge {
+		return txmgr.TxCandidate{}, false, nil
+	}
+
+	checkpointData, err := l.CheckpointBlockHashTxData(l1BlockNumber)
+	if err != nil {
+		return txmgr.TxCandidate{}, false, err
+	}
+	multicall3ABI, err := abi.JSON(strings.NewReader(multicall3ABIJSON))
+	if err != nil {
+		return txmgr.TxCandidate{}, false, fmt.Errorf("failed to parse Multicall3 ABI: %w", err)
+	}
+	bundle, err := multicall3ABI.Pack("aggregate3", []multicall3Call{
+		{Target: *l.Cfg.L2OutputOracleAddr, CallData: checkpointData},
+		{Target: *l.Cfg.L2OutputOracleAddr, CallData: data},
+	})
+	if err != nil {
+		return txmgr.TxCandidate{}, false, fmt.Errorf("failed to pack Multicall3 call: %w", err)
+	}
+	return txmgr.TxCandidate{TxData: bundle, To: &l.Cfg.Multicall3Addr}, true, nil
+}
+
+// dryRun simulates the transaction with eth_call from the proposer's address, and returns the revert reason if it
+// would revert.
+func (l *L2OutputSubmitter) dryRun(ctx context.Context, candidate txmgr.TxCandidate) error {
+	_, err := l.L1Client.CallContract(ctx, ethereum.CallMsg{
+		From:  l.Txmgr.From(),
+		To:    candidate.To,
+		Data:  candidate.TxData,
+		Value: candidate.Value,
+	}, nil)
+	if err != nil {
+		if reason := proofs.RevertReason(l.l2ooABI, err); reason != "" {
+			return fmt.Errorf("dry run reverted: %s", reason)
+		}
+		return fmt.Errorf("dry run reverted: %w", err)
+	}
+	return nil
+}
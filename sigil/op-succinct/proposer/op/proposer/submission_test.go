@@ -0,0 +1,361 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/testutils"
+)
+
+// l1Stub is a JSON-RPC server serving the L1 head, L2 outputs, and eth_call dry runs, which revert with revertData if
+// it is set.
+type l1Stub struct {
+	*testutils.Server
+
+	mu         sync.Mutex
+	head       uint64
+	baseFee    *big.Int
+	revertData []byte
+	calls      []map[string]any
+}
+
+func newL1Stub(t *testing.T) *l1Stub {
+	s := &l1Stub{head: 510, baseFee: big.NewInt(params.GWei)}
+	s.Server = testutils.NewRPCServer(t, func(method string, params []json.RawMessage) (any, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch method {
+		case "eth_blockNumber":
+			return hexutil.Uint64(s.head), nil
+		case "eth_getBlockByNumber":
+			return &types.Header{Number: new(big.Int).SetUint64(s.head), BaseFee: s.baseFee, Difficulty: new(big.Int)}, nil
+		case "eth_call":
+			var call map[string]any
+			require.NoError(t, json.Unmarshal(params[0], &call))
+			s.calls = append(s.calls, call)
+			if s.revertData != nil {
+				return nil, &testutils.RPCError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(s.revertData)}
+			}
+			return "0x", nil
+		case "optimism_outputAtBlock":
+			var block hexutil.Uint64
+			require.NoError(t, json.Unmarshal(params[0], &block))
+			return &eth.OutputResponse{
+				OutputRoot: eth.Bytes32{0x0f},
+				BlockRef:   eth.L2BlockRef{Number: uint64(block)},
+				Status:     &eth.SyncStatus{HeadL1: eth.L1BlockRef{Number: 100}},
+			}, nil
+		default:
+			return nil, fmt.Errorf("unexpected RPC method %s", method)
+		}
+	})
+	return s
+}
+
+func (s *l1Stub) set(f func(s *l1Stub)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s)
+}
+
+func (s *l1Stub) dryRuns() []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]map[string]any(nil), s.calls...)
+}
+
+// l2ooStub is an L2OO contract with its latest block, submission interval, checkpointed block hashes and approved
+// proposers set by the test.
+type l2ooStub struct {
+	L2OOContract
+	latest       uint64
+	interval     uint64
+	checkpointed map[uint64]common.Hash
+	approved     map[common.Address]bool
+}
+
+func (c *l2ooStub) LatestBlockNumber(*bind.CallOpts) (*big.Int, error) {
+	return new(big.Int).SetUint64(c.latest), nil
+}
+
+func (c *l2ooStub) NextBlockNumber(*bind.CallOpts) (*big.Int, error) {
+	return new(big.Int).SetUint64(c.latest + c.interval), nil
+}
+
+func (c *l2ooStub) ApprovedProposers(_ *bind.CallOpts, proposer common.Address) (bool, error) {
+	return c.approved[proposer], nil
+}
+
+func (c *l2ooStub) HistoricBlockHashes(_ *bind.CallOpts, blockNumber *big.Int) ([32]byte, error) {
+	return c.checkpointed[blockNumber.Uint64()], nil
+}
+
+// txmgrStub records the transactions sent through it.
+type txmgrStub struct {
+	txmgr.TxManager
+	mu   sync.Mutex
+	sent []txmgr.TxCandidate
+}
+
+func (m *txmgrStub) Send(_ context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, candidate)
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+}
+
+func (m *txmgrStub) From() common.Address {
+	return common.Address{0x99}
+}
+
+func (m *txmgrStub) BlockNumber(context.Context) (uint64, error) {
+	return 1000, nil
+}
+
+func (m *txmgrStub) candidates() []txmgr.TxCandidate {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]txmgr.TxCandidate(nil), m.sent...)
+}
+
+// deferralMetrics records the reasons of deferred submissions.
+type deferralMetrics struct {
+	opsuccinctmetrics.OPSuccinctMetricer
+	reasons []string
+}
+
+func (m *deferralMetrics) RecordSubmissionDeferred(reason string) {
+	m.reasons = append(m.reasons, reason)
+}
+
+var (
+	testL2OOAddr       = common.Address{0x42}
+	testMulticall3Addr = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+)
+
+// useSubmissionStubs configures the submitter to propose to the test L2OO through the L1 stub, and replaces its L2OO
+// contract, tx manager and metrics with stubs.
+func useSubmissionStubs(t *testing.T, l *L2OutputSubmitter) (*l2ooStub, *txmgrStub, *deferralMetrics) {
+	l2ooABI, err := opsuccinctbindings.OPSuccinctL2OutputOracleMetaData.GetAbi()
+	require.NoError(t, err)
+
+	l.Cfg.L2OutputOracleAddr = &testL2OOAddr
+	l.Cfg.Multicall3Addr = testMulticall3Addr
+	l.Cfg.PollInterval = time.Millisecond
+	l2oo := &l2ooStub{latest: 100, interval: 100, checkpointed: map[uint64]common.Hash{500: {0x05}}}
+	txmgr := new(txmgrStub)
+	metr := &deferralMetrics{OPSuccinctMetricer: opsuccinctmetrics.NoopMetrics}
+	l.l2ooContract = l2oo
+	l.l2ooABI = l2ooABI
+	l.Txmgr = txmgr
+	l.Metr = metr
+	return l2oo, txmgr, metr
+}
+
+// completeAggProof queues an AGG proof and proves it.
+func completeAggProof(t *testing.T, l *L2OutputSubmitter, start, end uint64) {
+	require.NoError(t, l.db.NewEntry(proofrequest.TypeAGG, start, end))
+	proveAggProof(t, l, start, end)
+}
+
+// proveAggProof proves a queued AGG proof through the same status transitions as the proposer.
+func proveAggProof(t *testing.T, l *L2OutputSubmitter, start, end uint64) {
+	req, err := l.db.AddL1BlockInfoToAggRequest(start, end, 500, common.Hash{0x05}.Hex())
+	require.NoError(t, err)
+	claimed, err := l.db.ClaimProofRequest(req.ID, "", "")
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.NoError(t, l.db.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+	require.NoError(t, l.db.AddFulfilledProof(req.ID, []byte{0x01}, 0))
+}
+
+// requireProposal checks that the transaction proposes the output of the L2 block with the AGG proof.
+func requireProposal(t *testing.T, l *L2OutputSubmitter, data []byte, l2Block uint64) {
+	method, err := l.l2ooABI.MethodById(data)
+	require.NoError(t, err)
+	require.Equal(t, "proposeL2Output", method.Name)
+	args, err := method.Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	require.Equal(t, l2Block, args[1].(*big.Int).Uint64())
+	require.Equal(t, uint64(500), args[2].(*big.Int).Uint64())
+}
+
+func TestSubmitAggProofsBaseFeeCap(t *testing.T) {
+	l1 := newL1Stub(t)
+	l := newTestSubmitter(t, ProposerConfig{
+		SubmissionMaxBaseFee: big.NewInt(20 * params.GWei),
+		SubmissionDeadline:   time.Hour,
+	}, l1.Server)
+	_, txmgr, metr := useSubmissionStubs(t, l)
+	ctx := context.Background()
+	for _, span := range [][2]uint64{{100, 200}, {200, 300}} {
+		_, err := l.db.ImportProof(db.ImportedProof{Type: proofrequest.TypeSPAN, StartBlock: span[0], EndBlock: span[1], Proof: []byte{0x01}})
+		require.NoError(t, err)
+	}
+	completeAggProof(t, l, 100, 200)
+
+	// The base fee is above the cap, so the submission is deferred, and a longer AGG proof is queued meanwhile.
+	l1.set(func(s *l1Stub) { s.baseFee = big.NewInt(50 * params.GWei) })
+	require.NoError(t, l.SubmitAggProofs(ctx))
+	require.Empty(t, txmgr.candidates())
+	require.Equal(t, []string{deferBaseFee}, metr.reasons)
+	unrequested, err := l.db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unrequested, 1)
+	require.Equal(t, uint64(300), unrequested[0].EndBlock)
+
+	// Once the longer AGG proof completes and the base fee drops, the submission skips to it.
+	proveAggProof(t, l, 100, 300)
+	l1.set(func(s *l1Stub) { s.baseFee = big.NewInt(10 * params.GWei) })
+	require.NoError(t, l.SubmitAggProofs(ctx))
+	sent := txmgr.candidates()
+	require.Len(t, sent, 1)
+	require.Equal(t, testL2OOAddr, *sent[0].To)
+	requireProposal(t, l, sent[0].TxData, 300)
+	require.Len(t, l1.dryRuns(), 1, "the proposal is dry-run before it is sent")
+}
+
+func TestSubmitAggProofsDeadline(t *testing.T) {
+	l1 := newL1Stub(t)
+	l := newTestSubmitter(t, ProposerConfig{
+		SubmissionMaxBaseFee: big.NewInt(20 * params.GWei),
+		SubmissionDeadline:   time.Nanosecond,
+	}, l1.Server)
+	_, txmgr, metr := useSubmissionStubs(t, l)
+	completeAggProof(t, l, 100, 200)
+	l1.set(func(s *l1Stub) { s.baseFee = big.NewInt(50 * params.GWei) })
+
+	require.NoError(t, l.SubmitAggProofs(context.Background()))
+	require.Len(t, txmgr.candidates(), 1, "the submission deadline overrides the base fee cap")
+	require.Empty(t, metr.reasons)
+}
+
+func TestSubmitAggProofsDryRunRevert(t *testing.T) {
+	l1 := newL1Stub(t)
+	l := newTestSubmitter(t, ProposerConfig{}, l1.Server)
+	_, txmgr, metr := useSubmissionStubs(t, l)
+	completeAggProof(t, l, 100, 200)
+	revert, err := (abi.Arguments{{Type: abi.Type{T: abi.StringTy}}}).Pack("L2OutputOracle: only approved proposers can propose new outputs")
+	require.NoError(t, err)
+	l1.set(func(s *l1Stub) { s.revertData = append(common.FromHex("0x08c379a0"), revert...) })
+
+	err = l.SubmitAggProofs(context.Background())
+	require.ErrorContains(t, err, "dry run reverted: L2OutputOracle: only approved proposers can propose new outputs")
+	require.Empty(t, txmgr.candidates())
+	require.Equal(t, []string{deferDryRunReverted}, metr.reasons)
+}
+
+func TestSubmitAggProofsBundledCheckpoint(t *testing.T) {
+	l1 := newL1Stub(t)
+	l := newTestSubmitter(t, ProposerConfig{BundleCheckpoint: true}, l1.Server)
+	l2oo, txmgr, metr := useSubmissionStubs(t, l)
+	ctx := context.Background()
+	delete(l2oo.checkpointed, 500)
+	completeAggProof(t, l, 100, 200)
+
+	// The L1 block hash isn't checkpointed, so it's checkpointed along with the proposal through Multicall3.
+	require.NoError(t, l.SubmitAggProofs(ctx))
+	sent := txmgr.candidates()
+	require.Len(t, sent, 1)
+	require.Equal(t, testMulticall3Addr, *sent[0].To)
+	multicall3ABI, err := abi.JSON(strings.NewReader(multicall3ABIJSON))
+	require.NoError(t, err)
+	args, err := multicall3ABI.Methods["aggregate3"].Inputs.Unpack(sent[0].TxData[4:])
+	require.NoError(t, err)
+	calls := args[0].([]struct {
+		Target       common.Address `json:"target"`
+		AllowFailure bool           `json:"allowFailure"`
+		CallData     []byte         `json:"callData"`
+	})
+	require.Len(t, calls, 2)
+	checkpoint, err := l.CheckpointBlockHashTxData(big.NewInt(500))
+	require.NoError(t, err)
+	require.Equal(t, testL2OOAddr, calls[0].Target)
+	require.Equal(t, checkpoint, calls[0].CallData)
+	require.Equal(t, testL2OOAddr, calls[1].Target)
+	requireProposal(t, l, calls[1].CallData, 200)
+	require.Equal(t, strings.ToLower(testMulticall3Addr.Hex()), strings.ToLower(l1.dryRuns()[0]["to"].(string)))
+
+	// Once the L1 block is too old to checkpoint, the AGG proof is dropped so that it can be derived again.
+	l1.set(func(s *l1Stub) { s.head = 500 + bundleCheckpointMaxAge + 1 })
+	require.NoError(t, l.SubmitAggProofs(ctx))
+	require.Len(t, txmgr.candidates(), 1)
+	require.Equal(t, []string{deferCheckpointExpired}, metr.reasons)
+	aggs, err := l.db.GetAllCompletedAggProofs(100)
+	require.NoError(t, err)
+	require.Empty(t, aggs)
+	failed, err := l.db.GetAllProofsWithStatus(proofrequest.StatusFAILED)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Equal(t, proofrequest.TypeAGG, failed[0].Type)
+}
+
+func TestCheckBundleCheckpointWindow(t *testing.T) {
+	cfg := &CLIConfig{
+		BundleCheckpoint:     true,
+		WitnessGenTimeout:    10 * 60,
+		ProofTimeout:         20 * 60,
+		SubmissionMaxBaseFee: 30,
+		SubmissionDeadline:   15 * time.Minute,
+	}
+	require.NoError(t, cfg.checkBundleCheckpointWindow())
+
+	// A proof that times out after the checkpoint window would be dropped when it's submitted, and derived again.
+	cfg.ProofTimeout = 40 * 60
+	require.ErrorContains(t, cfg.checkBundleCheckpointWindow(), "add up to at most 48m0s, got 1h5m0s")
+	cfg.SubmissionMaxBaseFee = 0
+	require.ErrorContains(t, cfg.checkBundleCheckpointWindow(), "got 50m0s", "the deadline only applies with a base fee cap")
+	cfg.ProofTimeout = 30 * 60
+	require.NoError(t, cfg.checkBundleCheckpointWindow())
+
+	cfg.ProofTimeout = 0
+	require.ErrorContains(t, cfg.checkBundleCheckpointWindow(), "require a proof timeout")
+	cfg.ProofTimeout = 20 * 60
+	cfg.SubmissionMaxBaseFee = 30
+	cfg.SubmissionDeadline = 0
+	require.ErrorContains(t, cfg.checkBundleCheckpointWindow(), "require a submission deadline")
+
+	cfg.BundleCheckpoint = false
+	require.NoError(t, cfg.checkBundleCheckpointWindow())
+}
+
+func TestCheckBundleCheckpoint(t *testing.T) {
+	l1 := newL1Stub(t)
+	l := newTestSubmitter(t, ProposerConfig{BundleCheckpoint: true}, l1.Server)
+	l2oo, _, _ := useSubmissionStubs(t, l)
+	ctx := context.Background()
+
+	// Only the proposer is approved, so the proposal made by Multicall3 would revert.
+	l2oo.approved = map[common.Address]bool{{0x99}: true}
+	require.ErrorContains(t, l.checkBundleCheckpoint(ctx), "bundled checkpoints require Multicall3")
+
+	l2oo.approved[testMulticall3Addr] = true
+	require.NoError(t, l.checkBundleCheckpoint(ctx))
+
+	l2oo.approved = map[common.Address]bool{{}: true}
+	require.NoError(t, l.checkBundleCheckpoint(ctx), "proposing is permissionless")
+
+	l.Cfg.BundleCheckpoint = false
+	l2oo.approved = nil
+	require.NoError(t, l.checkBundleCheckpoint(ctx))
+}
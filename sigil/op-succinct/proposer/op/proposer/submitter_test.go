@@ -0,0 +1,49 @@
+package proposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/notify"
+	"github.com/succinctlabs/op-succinct-go/proposer/testutils"
+)
+
+// newTestSubmitter creates a submitter with a SQLite proof DB, sending its alerts to the log. If rpc is set, the L1
+// client and the rollup provider of the submitter are connected to it.
+func newTestSubmitter(t *testing.T, cfg ProposerConfig, rpc *testutils.Server) *L2OutputSubmitter {
+	ctx := context.Background()
+	logger := log.NewLogger(log.DiscardHandler())
+	if cfg.NetworkTimeout == 0 {
+		cfg.NetworkTimeout = 5 * time.Second
+	}
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  logger,
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  cfg,
+		},
+		done:    make(chan struct{}),
+		ctx:     ctx,
+		db:      *testutils.NewTestDB(t),
+		alerter: notify.NewAlerter(notify.NewLogNotifier(logger)),
+	}
+	if rpc != nil {
+		l1Client, err := ethclient.DialContext(ctx, rpc.URL)
+		require.NoError(t, err)
+		t.Cleanup(l1Client.Close)
+		rollupProvider, err := dial.NewStaticL2RollupProvider(ctx, logger, rpc.URL)
+		require.NoError(t, err)
+		t.Cleanup(rollupProvider.Close)
+		l.L1Client = l1Client
+		l.RollupProvider = rollupProvider
+	}
+	return l
+}
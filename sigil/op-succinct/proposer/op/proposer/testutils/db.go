@@ -0,0 +1,18 @@
+package testutils
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+)
+
+// NewTestDB creates a SQLite proof DB in a temporary directory. The DB is closed when the test ends.
+func NewTestDB(t testing.TB) *db.ProofDB {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, proofDB.CloseDB()) })
+	return proofDB
+}
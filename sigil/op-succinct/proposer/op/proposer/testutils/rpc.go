@@ -0,0 +1,46 @@
+package testutils
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// RPCError is an error returned by an RPC handler, which the server responds with as a JSON-RPC error.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (e *RPCError) Error() string {
+	return e.Message
+}
+
+// RPCHandler returns the result of a JSON-RPC call. Errors other than an *RPCError fail the test.
+type RPCHandler func(method string, params []json.RawMessage) (any, error)
+
+// NewRPCServer starts a JSON-RPC server responding to calls with the handler. The server is closed when the test ends.
+func NewRPCServer(t testing.TB, handler RPCHandler) *Server {
+	return NewServer(t, func(w http.ResponseWriter, r Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.Unmarshal(r.Body, &req))
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		result, err := handler(req.Method, req.Params)
+		if rpcErr, ok := err.(*RPCError); ok {
+			resp["error"] = rpcErr
+		} else {
+			require.NoError(t, err, "RPC method %s", req.Method)
+			resp["result"] = result
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	})
+}
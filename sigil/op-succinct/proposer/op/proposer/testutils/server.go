@@ -0,0 +1,49 @@
+// Package testutils provides the stub servers and databases shared by the proposer tests.
+package testutils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Request is a request received by a Server.
+type Request struct {
+	Path string
+	Body []byte
+}
+
+// Server is an HTTP server that records the requests it receives, and responds to them with its handler.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []Request
+}
+
+// NewServer starts a server responding to requests with the handler. The server is closed when the test ends.
+func NewServer(t testing.TB, handler func(w http.ResponseWriter, req Request)) *Server {
+	s := new(Server)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := Request{Path: r.URL.Path, Body: body}
+		s.mu.Lock()
+		s.requests = append(s.requests, req)
+		s.mu.Unlock()
+		handler(w, req)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -228,6 +230,14 @@ func TimestampToBlock(rollupCfg *rollup.Config, l2Timestamp uint64) uint64 {
 // Get the block ranges for each span batch in the given L2 block range.
 func GetSpanBatchRanges(config reassemble.Config, rollupCfg *rollup.Config, startBlock, endBlock, maxSpanBatchDeviation uint64) ([]SpanBatchRange, error) {
 	frames := reassemble.LoadFrames(config.InDirectory, config.BatchInbox)
+	return SpanBatchRangesFromFrames(config, rollupCfg, frames, startBlock, endBlock, maxSpanBatchDeviation)
+}
+
+// SpanBatchRangesFromFrames gets the block ranges of the span batches overlapping the given L2 block range from the
+// frames posted to the batch inbox, sorted by start block. The ranges are those of the whole batches, so the first and
+// last range may extend past the requested range. Channels that aren't complete yet, such as the channel the batcher
+// is still posting near the head, are skipped.
+func SpanBatchRangesFromFrames(config reassemble.Config, rollupCfg *rollup.Config, frames []reassemble.FrameWithMetadata, startBlock, endBlock, maxSpanBatchDeviation uint64) ([]SpanBatchRange, error) {
 	framesByChannel := make(map[derive.ChannelID][]reassemble.FrameWithMetadata)
 	for _, frame := range frames {
 		framesByChannel[frame.Frame.ID] = append(framesByChannel[frame.Frame.ID], frame)
@@ -237,30 +247,32 @@ func GetSpanBatchRanges(config reassemble.Config, rollupCfg *rollup.Config, star
 
 	for id, frames := range framesByChannel {
 		ch := processFrames(config, rollupCfg, id, frames)
-		if len(ch.Batches) == 0 {
-			log.Fatalf("no span batches in channel")
+		if !ch.IsReady || len(ch.Batches) == 0 {
+			log.Printf("skipping incomplete channel %s\n", id)
+			continue
+		}
+		if ch.InvalidBatches {
+			return nil, fmt.Errorf("invalid batches in channel %s", id)
 		}
 
-		for idx, b := range ch.Batches {
+		for _, b := range ch.Batches {
 			batchStartBlock := TimestampToBlock(rollupCfg, b.GetTimestamp())
-			spanBatch, success := b.AsSpanBatch()
-			if !success {
-				// If AsSpanBatch fails, return the entire range.
-				log.Printf("couldn't convert batch %v to span batch\n", idx)
-				ranges = append(ranges, SpanBatchRange{Start: startBlock, End: endBlock})
-				return ranges, nil
+			batchEndBlock := batchStartBlock
+			// A singular batch holds a single block.
+			if spanBatch, ok := b.AsSpanBatch(); ok {
+				batchEndBlock = batchStartBlock + uint64(spanBatch.GetBlockCount()) - 1
 			}
-			blockCount := spanBatch.GetBlockCount()
-			batchEndBlock := batchStartBlock + uint64(blockCount) - 1
 
 			if batchStartBlock > endBlock || batchEndBlock < startBlock {
 				continue
-			} else {
-				ranges = append(ranges, SpanBatchRange{Start: max(startBlock, batchStartBlock), End: min(endBlock, batchEndBlock)})
 			}
+			ranges = append(ranges, SpanBatchRange{Start: batchStartBlock, End: batchEndBlock})
 		}
 	}
 
+	slices.SortFunc(ranges, func(a, b SpanBatchRange) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
 	return ranges, nil
 }
 
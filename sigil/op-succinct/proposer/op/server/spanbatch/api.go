@@ -0,0 +1,101 @@
+package spanbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/mux"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
+)
+
+// maxSpanBatchDeviation is the maximum deviation passed to the span batch decoder.
+const maxSpanBatchDeviation = 1000000
+
+// SpanBatchRequest is a request to find all span batches in a given block range.
+type SpanBatchRequest struct {
+	StartBlock uint64 `json:"startBlock"`
+	EndBlock   uint64 `json:"endBlock"`
+	// L2ChainID is optional. If it is set, it must be the chain ID of the rollup node the server is configured with.
+	L2ChainID uint64 `json:"l2ChainID,omitempty"`
+}
+
+// SpanBatchResponse is the response to a span batch request.
+type SpanBatchResponse struct {
+	Ranges []utils.SpanBatchRange `json:"ranges"`
+}
+
+// Handler returns the HTTP handler of the span batch ranges and health endpoints.
+func (s *Service) Handler() http.Handler {
+	r := mux.NewRouter()
+	r.HandleFunc("/span-batch-ranges", s.handleSpanBatchRanges).Methods("POST")
+	r.HandleFunc("/healthz", s.handleHealthz).Methods("GET")
+	return r
+}
+
+func (s *Service) handleHealthz(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&oprpc.HealthzResponse{Version: s.Version})
+}
+
+// handleSpanBatchRanges returns all of the span batches in a given L2 block range.
+func (s *Service) handleSpanBatchRanges(w http.ResponseWriter, r *http.Request) {
+	var req SpanBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.StartBlock > req.EndBlock {
+		http.Error(w, fmt.Sprintf("start block %d is after end block %d", req.StartBlock, req.EndBlock), http.StatusBadRequest)
+		return
+	}
+	if req.L2ChainID != 0 && req.L2ChainID != s.rollupCfg.L2ChainID.Uint64() {
+		http.Error(w, fmt.Sprintf("server is configured for chain %d, not %d", s.rollupCfg.L2ChainID, req.L2ChainID), http.StatusBadRequest)
+		return
+	}
+
+	start := time.Now()
+	ranges, err := s.SpanBatchRanges(r.Context(), req.StartBlock, req.EndBlock)
+	if err != nil {
+		s.Metrics.RecordRequest("error", time.Since(start))
+		s.Log.Error("Failed to get span batch ranges", "start", req.StartBlock, "end", req.EndBlock, "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.Metrics.RecordRequest("success", time.Since(start))
+	s.Log.Info("Found span batch ranges", "start", req.StartBlock, "end", req.EndBlock, "ranges", len(ranges), "duration", time.Since(start))
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(SpanBatchResponse{Ranges: ranges})
+}
+
+// SpanBatchRanges returns the ranges of the whole span batches overlapping the L2 block range, sorted by start block.
+func (s *Service) SpanBatchRanges(ctx context.Context, start, end uint64) ([]utils.SpanBatchRange, error) {
+	l1Start, l1End, err := utils.GetL1SearchBoundaries(s.RollupClient, *s.L1Client, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 search boundaries: %w", err)
+	}
+
+	// The search ends 10 minutes after the L1 origin of the end block, which may be past the L1 head.
+	head, err := s.L1Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	l1End = min(l1End, head.Number.Uint64()+1)
+	finalized, err := s.L1Client.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finalized L1 block: %w", err)
+	}
+
+	frames, err := s.frames.Frames(ctx, l1Start, l1End, finalized.Number.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load frames: %w", err)
+	}
+	return utils.SpanBatchRangesFromFrames(s.reassembler, s.rollupCfg, frames, start, end, maxSpanBatchDeviation)
+}
@@ -0,0 +1,50 @@
+package spanbatch
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	s := &Service{
+		Log:       log.NewLogger(log.DiscardHandler()),
+		Metrics:   NoopMetrics,
+		Version:   "v1.2.3",
+		rollupCfg: &rollup.Config{L2ChainID: big.NewInt(10)},
+	}
+	handler := s.Handler()
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return rec
+	}
+
+	rec := serve(http.MethodGet, "/healthz", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var health oprpc.HealthzResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&health))
+	require.Equal(t, "v1.2.3", health.Version)
+
+	rec = serve(http.MethodPost, "/span-batch-ranges", "{")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = serve(http.MethodPost, "/span-batch-ranges", `{"startBlock": 200, "endBlock": 100}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "start block 200 is after end block 100")
+
+	rec = serve(http.MethodPost, "/span-batch-ranges", `{"startBlock": 100, "endBlock": 200, "l2ChainID": 8453}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "configured for chain 10")
+
+	rec = serve(http.MethodGet, "/span-batch-ranges", "")
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
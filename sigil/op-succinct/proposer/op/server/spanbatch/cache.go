@@ -0,0 +1,116 @@
+package spanbatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// cachedBlock is the file of an L1 block in the frame cache.
+type cachedBlock struct {
+	Number uint64                         `json:"number"`
+	Hash   common.Hash                    `json:"hash"`
+	Frames []reassemble.FrameWithMetadata `json:"frames"`
+}
+
+// FrameCache is an on-disk cache of the frames posted to the batch inbox in each L1 block. Each L1 block is stored in
+// its own file, named after the block number, and blocks without frames are stored too, so the cache is indexed by the
+// L1 blocks that were scanned. Only finalized L1 blocks should be cached, since cached blocks are never refetched.
+type FrameCache struct {
+	dir string
+
+	mu     sync.RWMutex
+	blocks map[uint64]struct{}
+}
+
+// NewFrameCache opens the frame cache in the directory, creating it if it doesn't exist.
+func NewFrameCache(dir string) (*FrameCache, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create frame cache directory: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read frame cache directory: %w", err)
+	}
+
+	c := &FrameCache{dir: dir, blocks: make(map[uint64]struct{}, len(entries))}
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".json")
+		if !ok {
+			// Remove the temporary files of writes that were interrupted.
+			if strings.HasSuffix(e.Name(), ".tmp") {
+				_ = os.Remove(filepath.Join(dir, e.Name()))
+			}
+			continue
+		}
+		number, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		c.blocks[number] = struct{}{}
+	}
+	return c, nil
+}
+
+// Has returns whether the L1 block is cached.
+func (c *FrameCache) Has(number uint64) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := c.blocks[number]
+	return ok
+}
+
+// Len returns the number of cached L1 blocks.
+func (c *FrameCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.blocks)
+}
+
+// Get returns the frames posted in the L1 block, or false if the block isn't cached.
+func (c *FrameCache) Get(number uint64) ([]reassemble.FrameWithMetadata, bool, error) {
+	if !c.Has(number) {
+		return nil, false, nil
+	}
+	data, err := os.ReadFile(c.path(number))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read cached L1 block %d: %w", number, err)
+	}
+	var block cachedBlock
+	if err := json.Unmarshal(data, &block); err != nil {
+		return nil, false, fmt.Errorf("failed to decode cached L1 block %d: %w", number, err)
+	}
+	return block.Frames, true, nil
+}
+
+// Put caches the frames posted in the L1 block. The file is written atomically, so an interrupted write never leaves a
+// partial block in the cache.
+func (c *FrameCache) Put(number uint64, hash common.Hash, frames []reassemble.FrameWithMetadata) error {
+	data, err := json.Marshal(cachedBlock{Number: number, Hash: hash, Frames: frames})
+	if err != nil {
+		return fmt.Errorf("failed to encode L1 block %d: %w", number, err)
+	}
+	tmp := c.path(number) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write L1 block %d: %w", number, err)
+	}
+	if err := os.Rename(tmp, c.path(number)); err != nil {
+		return fmt.Errorf("failed to write L1 block %d: %w", number, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.blocks[number] = struct{}{}
+	return nil
+}
+
+func (c *FrameCache) path(number uint64) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%d.json", number))
+}
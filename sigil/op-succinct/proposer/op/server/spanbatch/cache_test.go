@@ -0,0 +1,63 @@
+package spanbatch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func testFrame(block uint64, channel byte, number uint16) reassemble.FrameWithMetadata {
+	return reassemble.FrameWithMetadata{
+		TxHash:         common.Hash{byte(block), channel, byte(number)},
+		InclusionBlock: block,
+		Timestamp:      block * 12,
+		BlockHash:      common.Hash{byte(block)},
+		Frame:          derive.Frame{ID: derive.ChannelID{channel}, FrameNumber: number, Data: []byte{channel, byte(number)}, IsLast: number == 1},
+	}
+}
+
+func TestFrameCacheDir(t *testing.T) {
+	inbox, batcher := common.Address{0xff, 0x01}, common.Address{0xab}
+	dir := frameCacheDir("/tmp/batch_decoder", "10", inbox, batcher)
+	require.Equal(t, "/tmp/batch_decoder/10/frames/0xff01000000000000000000000000000000000000-0xab00000000000000000000000000000000000000", dir)
+	require.NotEqual(t, dir, frameCacheDir("/tmp/batch_decoder", "10", inbox, common.Address{0xcd}), "frames of another batcher are cached apart")
+	require.NotEqual(t, dir, frameCacheDir("/tmp/batch_decoder", "10", common.Address{0xff, 0x02}, batcher), "frames of another batch inbox are cached apart")
+}
+
+func TestFrameCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "frames")
+	cache, err := NewFrameCache(dir)
+	require.NoError(t, err)
+	require.Zero(t, cache.Len())
+
+	_, ok, err := cache.Get(100)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	frames := []reassemble.FrameWithMetadata{testFrame(100, 1, 0), testFrame(100, 1, 1)}
+	require.NoError(t, cache.Put(100, common.Hash{100}, frames))
+	require.NoError(t, cache.Put(101, common.Hash{101}, nil))
+
+	got, ok, err := cache.Get(100)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, frames, got)
+	got, ok, err = cache.Get(101)
+	require.NoError(t, err)
+	require.True(t, ok, "blocks without frames are cached")
+	require.Empty(t, got)
+
+	// The index is rebuilt from the block files, and interrupted writes are removed.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "102.json.tmp"), []byte("{"), 0o644))
+	reopened, err := NewFrameCache(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, reopened.Len())
+	require.True(t, reopened.Has(100))
+	require.False(t, reopened.Has(102))
+	require.NoFileExists(t, filepath.Join(dir, "102.json.tmp"))
+}
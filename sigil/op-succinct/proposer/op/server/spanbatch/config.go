@@ -0,0 +1,85 @@
+package spanbatch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CLIConfig is a well typed config that is parsed from the CLI params.
+type CLIConfig struct {
+	// L1EthRpc is the HTTP provider URL for L1.
+	L1EthRpc string
+
+	// RollupRpc is the HTTP provider URL for the rollup node.
+	RollupRpc string
+
+	// BeaconRpc is the HTTP provider URL for the beacon node. Without it, batches posted as blobs are skipped.
+	BeaconRpc string
+
+	// BatcherAddress is the address of the batcher. If empty, the batcher address of the rollup config is used.
+	BatcherAddress string
+
+	// CacheDir is the directory of the frame cache. The frames of each chain are cached in a subdirectory named after
+	// its L2 chain ID.
+	CacheDir string
+
+	// ConcurrentRequests is the maximum number of L1 blocks fetched concurrently.
+	ConcurrentRequests uint64
+
+	// ListenAddr and ListenPort are the address and port of the HTTP server.
+	ListenAddr string
+	ListenPort int
+
+	LogConfig oplog.CLIConfig
+
+	MetricsConfig opmetrics.CLIConfig
+
+	PprofConfig oppprof.CLIConfig
+}
+
+func (c *CLIConfig) Check() error {
+	if err := c.MetricsConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.PprofConfig.Check(); err != nil {
+		return err
+	}
+
+	if c.BatcherAddress != "" && !common.IsHexAddress(c.BatcherAddress) {
+		return fmt.Errorf("invalid batcher address %q", c.BatcherAddress)
+	}
+	if c.CacheDir == "" {
+		return errors.New("a cache directory is required")
+	}
+	if c.ConcurrentRequests == 0 {
+		return errors.New("concurrent requests must be greater than 0")
+	}
+	if c.ListenPort < 0 || c.ListenPort > 65535 {
+		return fmt.Errorf("invalid HTTP port %d", c.ListenPort)
+	}
+	return nil
+}
+
+// NewConfig parses the Config from the provided flags or environment variables.
+func NewConfig(ctx *cli.Context) *CLIConfig {
+	return &CLIConfig{
+		L1EthRpc:           ctx.String(L1EthRpcFlag.Name),
+		RollupRpc:          ctx.String(RollupRpcFlag.Name),
+		BeaconRpc:          ctx.String(BeaconRpcFlag.Name),
+		BatcherAddress:     ctx.String(BatcherAddressFlag.Name),
+		CacheDir:           ctx.String(CacheDirFlag.Name),
+		ConcurrentRequests: ctx.Uint64(ConcurrentRequestsFlag.Name),
+		ListenAddr:         ctx.String(ListenAddrFlag.Name),
+		ListenPort:         ctx.Int(ListenPortFlag.Name),
+		LogConfig:          oplog.ReadCLIConfig(ctx),
+		MetricsConfig:      opmetrics.ReadCLIConfig(ctx),
+		PprofConfig:        oppprof.ReadCLIConfig(ctx),
+	}
+}
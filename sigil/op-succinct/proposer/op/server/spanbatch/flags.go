@@ -0,0 +1,102 @@
+package spanbatch
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+)
+
+const EnvVarPrefix = "SPAN_BATCH_SERVER"
+
+func prefixEnvVars(name string) []string {
+	return opservice.PrefixEnvVar(EnvVarPrefix, name)
+}
+
+var (
+	// Required Flags
+	L1EthRpcFlag = &cli.StringFlag{
+		Name:    "l1-eth-rpc",
+		Usage:   "HTTP provider URL for L1",
+		EnvVars: prefixEnvVars("L1_RPC"),
+	}
+	RollupRpcFlag = &cli.StringFlag{
+		Name:    "rollup-rpc",
+		Usage:   "HTTP provider URL for the rollup node",
+		EnvVars: prefixEnvVars("L2_NODE_RPC"),
+	}
+
+	// Optional flags
+	BeaconRpcFlag = &cli.StringFlag{
+		Name:    "beacon-rpc",
+		Usage:   "HTTP provider URL for the beacon node. Required to decode batches posted as blobs.",
+		EnvVars: prefixEnvVars("L1_BEACON_RPC"),
+	}
+	BatcherAddressFlag = &cli.StringFlag{
+		Name:    "batcher-address",
+		Usage:   "Address of the batcher. Defaults to the batcher address of the rollup config.",
+		EnvVars: prefixEnvVars("BATCHER_ADDRESS"),
+	}
+	CacheDirFlag = &cli.StringFlag{
+		Name:    "cache-dir",
+		Usage:   "Directory of the cache of the frames posted in each L1 block",
+		Value:   "/tmp/batch_decoder",
+		EnvVars: prefixEnvVars("CACHE_DIR"),
+	}
+	ConcurrentRequestsFlag = &cli.Uint64Flag{
+		Name:    "concurrent-requests",
+		Usage:   "Maximum number of L1 blocks fetched concurrently",
+		Value:   10,
+		EnvVars: prefixEnvVars("CONCURRENT_REQUESTS"),
+	}
+	ListenAddrFlag = &cli.StringFlag{
+		Name:    "http.addr",
+		Usage:   "Address of the HTTP server",
+		Value:   "0.0.0.0",
+		EnvVars: prefixEnvVars("HTTP_ADDR"),
+	}
+	ListenPortFlag = &cli.IntFlag{
+		Name:    "http.port",
+		Usage:   "Port of the HTTP server",
+		Value:   8089,
+		EnvVars: prefixEnvVars("HTTP_PORT"),
+	}
+)
+
+var requiredFlags = []cli.Flag{
+	L1EthRpcFlag,
+	RollupRpcFlag,
+}
+
+var optionalFlags = []cli.Flag{
+	BeaconRpcFlag,
+	BatcherAddressFlag,
+	CacheDirFlag,
+	ConcurrentRequestsFlag,
+	ListenAddrFlag,
+	ListenPortFlag,
+}
+
+func init() {
+	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+
+	Flags = append(requiredFlags, optionalFlags...)
+}
+
+// Flags contains the list of configuration options available to the binary.
+var Flags []cli.Flag
+
+func CheckRequired(ctx *cli.Context) error {
+	for _, f := range requiredFlags {
+		if !ctx.IsSet(f.Names()[0]) {
+			return fmt.Errorf("flag %s is required", f.Names()[0])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package spanbatch
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// FrameSource returns the frames posted to the batch inbox in an L1 block.
+type FrameSource interface {
+	BlockFrames(ctx context.Context, number uint64) (common.Hash, []reassemble.FrameWithMetadata, error)
+}
+
+// l1FrameSource reads the frames posted by the batcher from the L1 block's calldata and blobs. It is the same as the
+// fetch command of the batch decoder, but only keeps the frames of the batcher, and returns errors instead of exiting.
+type l1FrameSource struct {
+	log        log.Logger
+	l1Client   *ethclient.Client
+	beacon     *sources.L1BeaconClient
+	signer     types.Signer
+	batchInbox common.Address
+	batcher    common.Address
+}
+
+func (s *l1FrameSource) BlockFrames(ctx context.Context, number uint64) (common.Hash, []reassemble.FrameWithMetadata, error) {
+	block, err := s.l1Client.BlockByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return common.Hash{}, nil, fmt.Errorf("failed to get L1 block %d: %w", number, err)
+	}
+
+	var frames []reassemble.FrameWithMetadata
+	blobIndex := 0 // index of each blob in the block's blob sidecar
+	for _, tx := range block.Transactions() {
+		if tx.To() == nil || *tx.To() != s.batchInbox {
+			blobIndex += len(tx.BlobHashes())
+			continue
+		}
+		sender, err := s.signer.Sender(tx)
+		if err != nil {
+			return common.Hash{}, nil, fmt.Errorf("failed to get sender of transaction %s: %w", tx.Hash(), err)
+		}
+		if sender != s.batcher {
+			s.log.Debug("Skipping batch inbox transaction from another sender", "tx", tx.Hash(), "sender", sender)
+			blobIndex += len(tx.BlobHashes())
+			continue
+		}
+
+		var datas [][]byte
+		if tx.Type() != types.BlobTxType {
+			datas = append(datas, tx.Data())
+		} else {
+			if s.beacon == nil {
+				return common.Hash{}, nil, fmt.Errorf("transaction %s posts blobs, but no beacon RPC is configured", tx.Hash())
+			}
+			hashes := make([]eth.IndexedBlobHash, 0, len(tx.BlobHashes()))
+			for _, h := range tx.BlobHashes() {
+				hashes = append(hashes, eth.IndexedBlobHash{Index: uint64(blobIndex), Hash: h})
+				blobIndex++
+			}
+			blobs, err := s.beacon.GetBlobs(ctx, eth.L1BlockRef{
+				Hash:       block.Hash(),
+				Number:     block.NumberU64(),
+				ParentHash: block.ParentHash(),
+				Time:       block.Time(),
+			}, hashes)
+			if err != nil {
+				return common.Hash{}, nil, fmt.Errorf("failed to get blobs of transaction %s: %w", tx.Hash(), err)
+			}
+			for _, blob := range blobs {
+				data, err := blob.ToData()
+				if err != nil {
+					return common.Hash{}, nil, fmt.Errorf("failed to decode blob of transaction %s: %w", tx.Hash(), err)
+				}
+				datas = append(datas, data)
+			}
+		}
+
+		for _, data := range datas {
+			parsed, err := derive.ParseFrames(data)
+			if err != nil {
+				// Derivation ignores invalid batcher data, so it is skipped here too.
+				s.log.Warn("Skipping invalid batcher data", "tx", tx.Hash(), "err", err)
+				continue
+			}
+			for _, frame := range parsed {
+				frames = append(frames, reassemble.FrameWithMetadata{
+					TxHash:         tx.Hash(),
+					InclusionBlock: block.NumberU64(),
+					Timestamp:      block.Time(),
+					BlockHash:      block.Hash(),
+					Frame:          frame,
+				})
+			}
+		}
+	}
+	return block.Hash(), frames, nil
+}
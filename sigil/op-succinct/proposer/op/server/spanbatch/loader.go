@@ -0,0 +1,70 @@
+package spanbatch
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// frameLoader loads the frames posted in ranges of L1 blocks, reading the blocks from the frame cache when they are
+// cached, and fetching them from the frame source otherwise.
+type frameLoader struct {
+	log         log.Logger
+	metrics     Metricer
+	source      FrameSource
+	cache       *FrameCache
+	concurrency int
+}
+
+// Frames returns the frames posted in the L1 blocks [from, to), ordered by L1 block and transaction index. Fetched
+// blocks at or below the finalized L1 block are cached. Newer blocks may still be reorged, so they are fetched again
+// by every request.
+func (l *frameLoader) Frames(ctx context.Context, from, to, finalized uint64) ([]reassemble.FrameWithMetadata, error) {
+	if to <= from {
+		return nil, nil
+	}
+
+	blocks := make([][]reassemble.FrameWithMetadata, to-from)
+	cached, fetched := 0, 0
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(l.concurrency)
+	for number := from; number < to; number++ {
+		frames, ok, err := l.cache.Get(number)
+		if err != nil {
+			// The block is fetched again, which overwrites the unreadable file.
+			l.log.Warn("Failed to read cached L1 block", "block", number, "err", err)
+		}
+		if ok {
+			blocks[number-from] = frames
+			cached++
+			continue
+		}
+
+		fetched++
+		g.Go(func() error {
+			hash, frames, err := l.source.BlockFrames(gctx, number)
+			if err != nil {
+				return err
+			}
+			blocks[number-from] = frames
+			if number <= finalized {
+				return l.cache.Put(number, hash, frames)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	l.metrics.RecordL1Blocks(cached, fetched)
+	l.metrics.RecordCacheSize(l.cache.Len())
+	l.log.Debug("Loaded frames", "from", from, "to", to, "cached", cached, "fetched", fetched)
+
+	var frames []reassemble.FrameWithMetadata
+	for _, b := range blocks {
+		frames = append(frames, b...)
+	}
+	return frames, nil
+}
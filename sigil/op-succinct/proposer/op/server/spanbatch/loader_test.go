@@ -0,0 +1,82 @@
+package spanbatch
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// frameSourceStub posts a frame of channel 1 in every L1 block, and counts the fetches of each block.
+type frameSourceStub struct {
+	mu      sync.Mutex
+	fetches map[uint64]int
+	fail    uint64
+}
+
+func (s *frameSourceStub) BlockFrames(_ context.Context, number uint64) (common.Hash, []reassemble.FrameWithMetadata, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if number == s.fail {
+		return common.Hash{}, nil, errors.New("block not found")
+	}
+	s.fetches[number]++
+	return common.Hash{byte(number)}, []reassemble.FrameWithMetadata{testFrame(number, 1, uint16(number))}, nil
+}
+
+func newTestFrameLoader(t *testing.T) (*frameLoader, *frameSourceStub) {
+	cache, err := NewFrameCache(filepath.Join(t.TempDir(), "frames"))
+	require.NoError(t, err)
+	source := &frameSourceStub{fetches: make(map[uint64]int)}
+	return &frameLoader{
+		log:         log.NewLogger(log.DiscardHandler()),
+		metrics:     NoopMetrics,
+		source:      source,
+		cache:       cache,
+		concurrency: 4,
+	}, source
+}
+
+func TestFrameLoader(t *testing.T) {
+	ctx := context.Background()
+	loader, source := newTestFrameLoader(t)
+
+	frames, err := loader.Frames(ctx, 10, 20, 15)
+	require.NoError(t, err)
+	require.Len(t, frames, 10)
+	for i, f := range frames {
+		require.Equal(t, uint64(10+i), f.InclusionBlock, "frames are ordered by L1 block")
+	}
+	require.Equal(t, 6, loader.cache.Len(), "only finalized blocks are cached")
+
+	// An overlapping range only fetches the blocks that aren't cached.
+	frames, err = loader.Frames(ctx, 12, 25, 20)
+	require.NoError(t, err)
+	require.Len(t, frames, 13)
+	for number := uint64(10); number < 25; number++ {
+		want := 1
+		if number >= 16 && number < 20 {
+			want = 2
+		}
+		require.Equal(t, want, source.fetches[number], "fetches of block %d", number)
+	}
+	require.Equal(t, 11, loader.cache.Len())
+
+	_, err = loader.Frames(ctx, 20, 20, 20)
+	require.NoError(t, err)
+}
+
+func TestFrameLoaderError(t *testing.T) {
+	loader, source := newTestFrameLoader(t)
+	source.fail = 13
+
+	_, err := loader.Frames(context.Background(), 10, 20, 20)
+	require.ErrorContains(t, err, "block not found")
+	require.False(t, loader.cache.Has(13))
+}
@@ -0,0 +1,122 @@
+package spanbatch
+
+import (
+	"time"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const Namespace = "op_succinct_span_batch_server"
+
+// implements the Registry getter, for metrics HTTP server to hook into
+var _ opmetrics.RegistryMetricer = (*Metrics)(nil)
+
+type Metricer interface {
+	RecordInfo(version string)
+	RecordUp()
+
+	// RecordRequest records a span batch ranges request, with result "success" or "error".
+	RecordRequest(result string, duration time.Duration)
+	// RecordL1Blocks records how many L1 blocks of a request were read from the frame cache and fetched from L1.
+	RecordL1Blocks(cached, fetched int)
+	// RecordCacheSize records the number of L1 blocks in the frame cache.
+	RecordCacheSize(blocks int)
+}
+
+type Metrics struct {
+	ns       string
+	registry *prometheus.Registry
+	factory  opmetrics.Factory
+
+	info prometheus.GaugeVec
+	up   prometheus.Gauge
+
+	RequestDuration *prometheus.HistogramVec
+	L1Blocks        *prometheus.CounterVec
+	CacheBlocks     prometheus.Gauge
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+func NewMetrics(procName string) *Metrics {
+	if procName == "" {
+		procName = "default"
+	}
+	ns := Namespace + "_" + procName
+
+	registry := opmetrics.NewRegistry()
+	factory := opmetrics.With(registry)
+
+	return &Metrics{
+		ns:       ns,
+		registry: registry,
+		factory:  factory,
+
+		info: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "info",
+			Help:      "Pseudo-metric tracking version and config info",
+		}, []string{
+			"version",
+		}),
+		up: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "up",
+			Help:      "1 if the span batch server has finished starting up",
+		}),
+		RequestDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of span batch ranges requests, by result",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+		}, []string{"result"}),
+		L1Blocks: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "l1_blocks",
+			Help:      "Number of L1 blocks read by span batch ranges requests, by source (cache or l1)",
+		}, []string{"source"}),
+		CacheBlocks: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "cache_blocks",
+			Help:      "Number of L1 blocks in the frame cache",
+		}),
+	}
+}
+
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// RecordInfo sets a pseudo-metric that contains versioning and config info.
+func (m *Metrics) RecordInfo(version string) {
+	m.info.WithLabelValues(version).Set(1)
+}
+
+// RecordUp sets the up metric to 1.
+func (m *Metrics) RecordUp() {
+	m.up.Set(1)
+}
+
+func (m *Metrics) RecordRequest(result string, duration time.Duration) {
+	m.RequestDuration.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+func (m *Metrics) RecordL1Blocks(cached, fetched int) {
+	m.L1Blocks.WithLabelValues("cache").Add(float64(cached))
+	m.L1Blocks.WithLabelValues("l1").Add(float64(fetched))
+}
+
+func (m *Metrics) RecordCacheSize(blocks int) {
+	m.CacheBlocks.Set(float64(blocks))
+}
+
+type noopMetrics struct{}
+
+var NoopMetrics Metricer = new(noopMetrics)
+
+func (*noopMetrics) RecordInfo(version string)                           {}
+func (*noopMetrics) RecordUp()                                           {}
+func (*noopMetrics) RecordRequest(result string, duration time.Duration) {}
+func (*noopMetrics) RecordL1Blocks(cached, fetched int)                  {}
+func (*noopMetrics) RecordCacheSize(blocks int)                          {}
@@ -0,0 +1,282 @@
+package spanbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/httputil"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
+)
+
+var ErrAlreadyStopped = errors.New("already stopped")
+
+// Main is the entrypoint into the span batch server.
+func Main(version string) cliapp.LifecycleAction {
+	return func(cliCtx *cli.Context, _ context.CancelCauseFunc) (cliapp.Lifecycle, error) {
+		if err := CheckRequired(cliCtx); err != nil {
+			return nil, err
+		}
+		cfg := NewConfig(cliCtx)
+		if err := cfg.Check(); err != nil {
+			return nil, fmt.Errorf("invalid CLI flags: %w", err)
+		}
+
+		l := oplog.NewLogger(oplog.AppOut(cliCtx), cfg.LogConfig)
+		oplog.SetGlobalLogHandler(l.Handler())
+		opservice.ValidateEnvVars(EnvVarPrefix, Flags, l)
+
+		l.Info("Initializing span batch server")
+		return ServiceFromCLIConfig(cliCtx.Context, version, cfg, l)
+	}
+}
+
+// Service serves the ranges of the span batches posted by the batcher of a chain.
+type Service struct {
+	Log     log.Logger
+	Metrics Metricer
+
+	L1Client     *ethclient.Client
+	RollupClient *sources.RollupClient
+
+	Version string
+
+	rollupCfg   *rollup.Config
+	reassembler reassemble.Config
+	frames      *frameLoader
+
+	pprofService *oppprof.Service
+	metricsSrv   *httputil.HTTPServer
+	httpServer   *httputil.HTTPServer
+
+	stopped atomic.Bool
+}
+
+// ServiceFromCLIConfig creates a new Service from a CLIConfig. The HTTP server is started once the service is created.
+func ServiceFromCLIConfig(ctx context.Context, version string, cfg *CLIConfig, log log.Logger) (*Service, error) {
+	var s Service
+	if err := s.initFromCLIConfig(ctx, version, cfg, log); err != nil {
+		return nil, errors.Join(err, s.Stop(ctx)) // try to clean up our failed initialization attempt
+	}
+	return &s, nil
+}
+
+func (s *Service) initFromCLIConfig(ctx context.Context, version string, cfg *CLIConfig, log log.Logger) error {
+	s.Version = version
+	s.Log = log
+
+	s.initMetrics(cfg)
+	if err := s.initRPCClients(ctx, cfg); err != nil {
+		return err
+	}
+	if err := s.initFrameLoader(ctx, cfg); err != nil {
+		return err
+	}
+	if err := s.initPProf(cfg); err != nil {
+		return fmt.Errorf("failed to init profiling: %w", err)
+	}
+	if err := s.initMetricsServer(cfg); err != nil {
+		return fmt.Errorf("failed to start metrics server: %w", err)
+	}
+	if err := s.initHTTPServer(cfg); err != nil {
+		return fmt.Errorf("failed to start HTTP server: %w", err)
+	}
+	s.Metrics.RecordInfo(s.Version)
+	return nil
+}
+
+func (s *Service) initMetrics(cfg *CLIConfig) {
+	if cfg.MetricsConfig.Enabled {
+		s.Metrics = NewMetrics("default")
+	} else {
+		s.Metrics = NoopMetrics
+	}
+}
+
+func (s *Service) initRPCClients(ctx context.Context, cfg *CLIConfig) error {
+	l1Client, err := dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, s.Log, cfg.L1EthRpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial L1 RPC: %w", err)
+	}
+	s.L1Client = l1Client
+
+	rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, s.Log, cfg.RollupRpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup RPC: %w", err)
+	}
+	s.RollupClient = rollupClient
+	return nil
+}
+
+// initFrameLoader depends on the RPC clients, which provide the rollup config of the chain.
+func (s *Service) initFrameLoader(ctx context.Context, cfg *CLIConfig) error {
+	rollupCfg, err := s.RollupClient.RollupConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get rollup config: %w", err)
+	}
+	s.rollupCfg = rollupCfg
+	s.reassembler = reassemble.Config{
+		BatchInbox:    rollupCfg.BatchInboxAddress,
+		L2ChainID:     rollupCfg.L2ChainID,
+		L2GenesisTime: rollupCfg.Genesis.L2Time,
+		L2BlockTime:   rollupCfg.BlockTime,
+	}
+
+	beacon, err := utils.SetupBeacon(cfg.BeaconRpc)
+	if err != nil {
+		return fmt.Errorf("failed to setup beacon: %w", err)
+	}
+	batcher := rollupCfg.Genesis.SystemConfig.BatcherAddr
+	if cfg.BatcherAddress != "" {
+		batcher = common.HexToAddress(cfg.BatcherAddress)
+	}
+
+	cacheDir := frameCacheDir(cfg.CacheDir, rollupCfg.L2ChainID.String(), rollupCfg.BatchInboxAddress, batcher)
+	cache, err := NewFrameCache(cacheDir)
+	if err != nil {
+		return err
+	}
+	s.Log.Info("Opened frame cache", "dir", cacheDir, "blocks", cache.Len())
+	s.Metrics.RecordCacheSize(cache.Len())
+
+	s.frames = &frameLoader{
+		log:     s.Log,
+		metrics: s.Metrics,
+		source: &l1FrameSource{
+			log:        s.Log,
+			l1Client:   s.L1Client,
+			beacon:     beacon,
+			signer:     types.LatestSignerForChainID(rollupCfg.L1ChainID),
+			batchInbox: rollupCfg.BatchInboxAddress,
+			batcher:    batcher,
+		},
+		cache:       cache,
+		concurrency: int(cfg.ConcurrentRequests),
+	}
+	return nil
+}
+
+// frameCacheDir returns the directory of the frames cached for the chain. The frames of an L1 block depend on the batch
+// inbox and batcher they are filtered by, so the cache of each pair is kept apart.
+func frameCacheDir(cacheDir, l2ChainID string, batchInbox, batcher common.Address) string {
+	return filepath.Join(cacheDir, l2ChainID, "frames", strings.ToLower(batchInbox.Hex())+"-"+strings.ToLower(batcher.Hex()))
+}
+
+func (s *Service) initPProf(cfg *CLIConfig) error {
+	s.pprofService = oppprof.New(
+		cfg.PprofConfig.ListenEnabled,
+		cfg.PprofConfig.ListenAddr,
+		cfg.PprofConfig.ListenPort,
+		cfg.PprofConfig.ProfileType,
+		cfg.PprofConfig.ProfileDir,
+		cfg.PprofConfig.ProfileFilename,
+	)
+
+	if err := s.pprofService.Start(); err != nil {
+		return fmt.Errorf("failed to start pprof service: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) initMetricsServer(cfg *CLIConfig) error {
+	if !cfg.MetricsConfig.Enabled {
+		s.Log.Info("Metrics disabled")
+		return nil
+	}
+	m, ok := s.Metrics.(opmetrics.RegistryMetricer)
+	if !ok {
+		return fmt.Errorf("metrics were enabled, but metricer %T does not expose registry for metrics-server", s.Metrics)
+	}
+	s.Log.Debug("Starting metrics server", "addr", cfg.MetricsConfig.ListenAddr, "port", cfg.MetricsConfig.ListenPort)
+	metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.MetricsConfig.ListenAddr, cfg.MetricsConfig.ListenPort)
+	if err != nil {
+		return fmt.Errorf("failed to start metrics server: %w", err)
+	}
+	s.Log.Info("Started metrics server", "addr", metricsSrv.Addr())
+	s.metricsSrv = metricsSrv
+	return nil
+}
+
+func (s *Service) initHTTPServer(cfg *CLIConfig) error {
+	addr := net.JoinHostPort(cfg.ListenAddr, strconv.Itoa(cfg.ListenPort))
+	httpServer, err := httputil.StartHTTPServer(addr, s.Handler())
+	if err != nil {
+		return err
+	}
+	s.Log.Info("Started HTTP server", "addr", httpServer.Addr())
+	s.httpServer = httpServer
+	return nil
+}
+
+// Start runs once upon start of the service lifecycle. The HTTP server is already serving requests by then.
+func (s *Service) Start(_ context.Context) error {
+	s.Log.Info("Starting span batch server")
+	s.Metrics.RecordUp()
+	return nil
+}
+
+func (s *Service) Stopped() bool {
+	return s.stopped.Load()
+}
+
+// Stop stops the HTTP server and releases the resources of the service. After stopping, it cannot be restarted.
+func (s *Service) Stop(ctx context.Context) error {
+	if s.stopped.Load() {
+		return ErrAlreadyStopped
+	}
+	s.Log.Info("Stopping span batch server")
+
+	var result error
+	if s.httpServer != nil {
+		if err := s.httpServer.Stop(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop HTTP server: %w", err))
+		}
+	}
+	if s.pprofService != nil {
+		if err := s.pprofService.Stop(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop PProf server: %w", err))
+		}
+	}
+	if s.metricsSrv != nil {
+		if err := s.metricsSrv.Stop(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop metrics server: %w", err))
+		}
+	}
+
+	if s.L1Client != nil {
+		s.L1Client.Close()
+	}
+	if s.RollupClient != nil {
+		s.RollupClient.Close()
+	}
+
+	if result == nil {
+		s.stopped.Store(true)
+		s.Log.Info("Span batch server stopped")
+	}
+	return result
+}
+
+var _ cliapp.Lifecycle = (*Service)(nil)
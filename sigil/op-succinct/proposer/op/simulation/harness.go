@@ -0,0 +1,211 @@
+// Package simulation runs the OP Succinct proposer end to end against an in-process L1 and L2 started by op-e2e,
+// with a ScriptedProver in place of the OP Succinct server. It is only meant to be used in tests.
+package simulation
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	op_e2e "github.com/ethereum-optimism/optimism/op-e2e"
+	"github.com/ethereum-optimism/optimism/op-e2e/bindings"
+	"github.com/ethereum-optimism/optimism/op-e2e/config"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// acceptAllVerifierCode is the init code of a contract whose runtime code is a single STOP, so that every call to
+// verifyProof succeeds.
+var acceptAllVerifierCode = common.FromHex("0x600180600b6000396000f300")
+
+// Config configures the simulated L2OO and the proposer.
+type Config struct {
+	// SubmissionInterval is the minimum number of L2 blocks between two outputs on the L2OO.
+	SubmissionInterval uint64
+	// MaxBlockRangePerSpanProof is the number of L2 blocks of the SPAN proofs.
+	MaxBlockRangePerSpanProof uint64
+	// ProofTimeout is how long a proof can be requested before it is retried. 0 disables the timeout.
+	ProofTimeout time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		SubmissionInterval:        10,
+		MaxBlockRangePerSpanProof: 5,
+	}
+}
+
+// Harness is a running simulation.
+type Harness struct {
+	t *testing.T
+
+	Sys      *op_e2e.System
+	Prover   *ScriptedProver
+	Driver   *proposer.L2OutputSubmitter
+	L2OOAddr common.Address
+	L2OO     *opsuccinctbindings.OPSuccinctL2OutputOracleCaller
+}
+
+// SkipWithoutDevnet skips the test if the op-e2e devnet allocs and deploy config weren't found. They are generated by
+// `make devnet-allocs` in the Optimism monorepo, and passed with the -l1-allocs, -l2-allocs-dir, -l1-deployments and
+// -deploy-config test flags. In CI, the test fails instead so that the simulation can't silently stop running.
+func SkipWithoutDevnet(t *testing.T) {
+	if config.DeployConfig == nil || config.L1Allocs == nil {
+		if os.Getenv("CI") != "" {
+			t.Fatal("op-e2e devnet allocs not found in CI, pass -l1-allocs, -l2-allocs-dir, -l1-deployments and -deploy-config")
+		}
+		t.Skip("op-e2e devnet allocs not found, pass -l1-allocs, -l2-allocs-dir, -l1-deployments and -deploy-config")
+	}
+}
+
+// NewHarness starts an L1 and L2, deploys the L2OO on L1 and starts the proposer with the prover as its only prover
+// backend. Everything is stopped when the test ends.
+func NewHarness(t *testing.T, cfg Config, prover *ScriptedProver) *Harness {
+	SkipWithoutDevnet(t)
+	ctx := context.Background()
+
+	sysCfg := op_e2e.DefaultSystemConfig(t)
+	sysCfg.DisableProposer = true
+	sys, err := sysCfg.Start(t)
+	require.NoError(t, err, "failed to start system")
+
+	h := &Harness{t: t, Sys: sys, Prover: prover}
+	h.deployL2OO(ctx, cfg)
+
+	logger := testlog.Logger(t, log.LevelInfo).New("role", "proposer")
+	l1URL := sys.NodeEndpoint(op_e2e.RoleL1).RPC()
+	rollupURL := sys.RollupEndpoint(op_e2e.RoleSeq).RPC()
+
+	txCfg := txmgr.NewCLIConfig(l1URL, txmgr.DefaultBatcherFlagValues)
+	txCfg.PrivateKey = hexutil.Encode(crypto.FromECDSA(sys.Cfg.Secrets.Proposer))
+	txCfg.NumConfirmations = 1
+	txCfg.ReceiptQueryInterval = 50 * time.Millisecond
+	txMgr, err := txmgr.NewSimpleTxManager("proposer", logger, &txmetrics.NoopTxMetrics{}, txCfg)
+	require.NoError(t, err, "failed to create tx manager")
+	t.Cleanup(txMgr.Close)
+
+	rollupProvider, err := dial.NewStaticL2RollupProvider(ctx, logger, rollupURL)
+	require.NoError(t, err, "failed to dial rollup node")
+	t.Cleanup(rollupProvider.Close)
+
+	backend := &proposer.ProverBackend{
+		ProverBackendConfig: proposer.ProverBackendConfig{Name: "simulation", Weight: 1, MaxConcurrentProofRequests: 4},
+		Prover:              prover,
+	}
+	h.Driver, err = proposer.NewL2OutputSubmitter(proposer.DriverSetup{
+		Log:  logger,
+		Metr: opsuccinctmetrics.NoopMetrics,
+		Cfg: proposer.ProposerConfig{
+			PollInterval:               500 * time.Millisecond,
+			NetworkTimeout:             10 * time.Second,
+			L2OutputOracleAddr:         &h.L2OOAddr,
+			DbPath:                     filepath.Join(t.TempDir(), "proofs.db"),
+			RollupRpc:                  rollupURL,
+			SpanStrategy:               proposer.SpanStrategyBasic,
+			MaxBlockRangePerSpanProof:  cfg.MaxBlockRangePerSpanProof,
+			MaxConcurrentWitnessGen:    4,
+			MaxConcurrentProofRequests: 4,
+			WitnessGenTimeout:          60,
+			ProofTimeout:               uint64(cfg.ProofTimeout.Seconds()),
+			L2ChainID:                  sys.RollupConfig.L2ChainID.Uint64(),
+		},
+		Txmgr:          txMgr,
+		L1Client:       sys.NodeClient(op_e2e.RoleL1),
+		RollupProvider: rollupProvider,
+		Provers:        []*proposer.ProverBackend{backend},
+	})
+	require.NoError(t, err, "failed to create proposer")
+	require.NoError(t, h.Driver.StartL2OutputSubmitting(), "failed to start proposer")
+	t.Cleanup(func() {
+		require.NoError(t, h.Driver.StopL2OutputSubmittingIfRunning())
+	})
+	return h
+}
+
+// deployL2OO deploys the L2OO behind a proxy, initialized at the L2 genesis with a verifier accepting any proof.
+func (h *Harness) deployL2OO(ctx context.Context, cfg Config) {
+	t := h.t
+	l1 := h.Sys.NodeClient(op_e2e.RoleL1)
+	secrets := h.Sys.Cfg.Secrets
+	opts, err := bind.NewKeyedTransactorWithChainID(secrets.Deployer, h.Sys.Cfg.L1ChainIDBig())
+	require.NoError(t, err)
+	waitDeployed := func(tx *types.Transaction, err error) {
+		t.Helper()
+		require.NoError(t, err)
+		_, err = wait.ForReceiptOK(ctx, l1, tx.Hash())
+		require.NoError(t, err)
+	}
+
+	verifier, tx, _, err := bind.DeployContract(opts, abi.ABI{}, acceptAllVerifierCode, l1)
+	waitDeployed(tx, err)
+	impl, tx, _, err := opsuccinctbindings.DeployOPSuccinctL2OutputOracle(opts, l1)
+	waitDeployed(tx, err)
+	proxyAddr, tx, proxy, err := bindings.DeployProxy(opts, l1, secrets.Addresses().Deployer)
+	waitDeployed(tx, err)
+
+	genesis, err := h.Sys.RollupClient(op_e2e.RoleSeq).OutputAtBlock(ctx, 0)
+	require.NoError(t, err)
+	parsed, err := opsuccinctbindings.OPSuccinctL2OutputOracleMetaData.GetAbi()
+	require.NoError(t, err)
+	initialize, err := parsed.Pack("initialize", opsuccinctbindings.OPSuccinctL2OutputOracleInitParams{
+		Challenger:                common.Address{},
+		Proposer:                  secrets.Addresses().Proposer,
+		Owner:                     secrets.Addresses().Deployer,
+		FinalizationPeriodSeconds: big.NewInt(0),
+		L2BlockTime:               new(big.Int).SetUint64(h.Sys.RollupConfig.BlockTime),
+		StartingOutputRoot:        genesis.OutputRoot,
+		StartingBlockNumber:       big.NewInt(0),
+		StartingTimestamp:         new(big.Int).SetUint64(h.Sys.RollupConfig.Genesis.L2Time),
+		SubmissionInterval:        new(big.Int).SetUint64(cfg.SubmissionInterval),
+		Verifier:                  verifier,
+	})
+	require.NoError(t, err)
+	tx, err = proxy.UpgradeToAndCall(opts, impl, initialize)
+	waitDeployed(tx, err)
+
+	h.L2OOAddr = proxyAddr
+	h.L2OO, err = opsuccinctbindings.NewOPSuccinctL2OutputOracleCaller(proxyAddr, l1)
+	require.NoError(t, err)
+}
+
+// ProofDB returns the DB of the proof requests of the proposer.
+func (h *Harness) ProofDB() *db.ProofDB {
+	return h.Driver.ProofDB()
+}
+
+// WaitForL2OO waits until the latest block on the L2OO is at least the given block, and returns it.
+func (h *Harness) WaitForL2OO(block uint64, timeout time.Duration) uint64 {
+	h.t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var latest uint64
+	err := wait.For(ctx, time.Second, func() (bool, error) {
+		n, err := h.L2OO.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return false, err
+		}
+		latest = n.Uint64()
+		return latest >= block, nil
+	})
+	require.NoError(h.t, err, "L2OO didn't reach block %d, latest block is %d", block, latest)
+	return latest
+}
@@ -0,0 +1,162 @@
+package simulation
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// Outcome scripts how the ScriptedProver handles a proof request. The zero value proves the request right away.
+type Outcome struct {
+	// Delay is how long the proof stays requested before it is fulfilled or fails.
+	Delay time.Duration
+	// Unclaim makes the proof unfulfillable with the given description.
+	Unclaim *proposer.UnclaimDescription
+	// Unexecutable makes the proof unfulfillable because the program can't be executed, which splits SPAN proofs.
+	Unexecutable bool
+	// RequestErr fails the proof request itself, before the proof is requested.
+	RequestErr error
+}
+
+// Fail returns the outcome of a proof that is unclaimed with the given description.
+func Fail(description proposer.UnclaimDescription) Outcome {
+	return Outcome{Unclaim: &description}
+}
+
+// Request is a proof request received by the ScriptedProver.
+type Request struct {
+	Type proofrequest.Type
+	// Start and End are the block range of SPAN proofs.
+	Start uint64
+	End   uint64
+	// Outcome is the outcome the request was scripted with.
+	Outcome Outcome
+}
+
+type scriptedProof struct {
+	outcome   Outcome
+	readyAt   time.Time
+	cancelled bool
+}
+
+// ScriptedProver is an in-process prover whose proofs follow a script of outcomes per proof type. Requests beyond the
+// script are proven right away. The proofs it generates are only accepted by a verifier accepting any proof.
+type ScriptedProver struct {
+	mu       sync.Mutex
+	script   map[proofrequest.Type][]Outcome
+	proofs   map[string]*scriptedProof
+	requests []Request
+	nextID   uint64
+}
+
+var _ proposer.Prover = (*ScriptedProver)(nil)
+
+func NewScriptedProver() *ScriptedProver {
+	return &ScriptedProver{
+		script: make(map[proofrequest.Type][]Outcome),
+		proofs: make(map[string]*scriptedProof),
+	}
+}
+
+// Script queues the outcomes of the next requests of the proof type, after the outcomes already queued.
+func (p *ScriptedProver) Script(proofType proofrequest.Type, outcomes ...Outcome) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.script[proofType] = append(p.script[proofType], outcomes...)
+}
+
+// Requests returns the proof requests received so far, in order.
+func (p *ScriptedProver) Requests() []Request {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Request(nil), p.requests...)
+}
+
+func (p *ScriptedProver) RequestProof(_ context.Context, proofType proofrequest.Type, body []byte) (proposer.ProverResponse, error) {
+	req := Request{Type: proofType}
+	if proofType == proofrequest.TypeSPAN {
+		var span proposer.SpanProofRequest
+		if err := json.Unmarshal(body, &span); err != nil {
+			return proposer.ProverResponse{}, fmt.Errorf("failed to decode span proof request: %w", err)
+		}
+		req.Start, req.End = span.Start, span.End
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if queued := p.script[proofType]; len(queued) > 0 {
+		req.Outcome = queued[0]
+		p.script[proofType] = queued[1:]
+	}
+	p.requests = append(p.requests, req)
+	if req.Outcome.RequestErr != nil {
+		return proposer.ProverResponse{}, req.Outcome.RequestErr
+	}
+
+	p.nextID++
+	id := binary.BigEndian.AppendUint64(nil, p.nextID)
+	p.proofs[hex.EncodeToString(id)] = &scriptedProof{outcome: req.Outcome, readyAt: time.Now().Add(req.Outcome.Delay)}
+	return proposer.ProverResponse{ProverRequestID: id}, nil
+}
+
+func (p *ScriptedProver) GetProofStatus(_ context.Context, proverRequestID string) (proposer.ProofStatusResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	proof, ok := p.proofs[proverRequestID]
+	if !ok {
+		return proposer.ProofStatusResponse{}, fmt.Errorf("unknown proof request %s", proverRequestID)
+	}
+	if time.Now().Before(proof.readyAt) {
+		return proposer.ProofStatusResponse{FulfillmentStatus: proposer.SP1FulfillmentStatusRequested}, nil
+	}
+
+	if proof.cancelled {
+		cancelled := proposer.Cancelled
+		return proposer.ProofStatusResponse{
+			FulfillmentStatus:  proposer.SP1FulfillmentStatusUnfulfillable,
+			UnclaimDescription: &cancelled,
+		}, nil
+	}
+	outcome := proof.outcome
+	if outcome.Unclaim != nil || outcome.Unexecutable {
+		status := proposer.ProofStatusResponse{
+			FulfillmentStatus:  proposer.SP1FulfillmentStatusUnfulfillable,
+			UnclaimDescription: outcome.Unclaim,
+		}
+		if outcome.Unexecutable {
+			status.ExecutionStatus = proposer.SP1ExecutionStatusUnexecutable
+		}
+		return status, nil
+	}
+	return proposer.ProofStatusResponse{
+		FulfillmentStatus: proposer.SP1FulfillmentStatusFulfilled,
+		ExecutionStatus:   proposer.SP1ExecutionStatusExecuted,
+		Proof:             []byte("simulated proof " + proverRequestID),
+	}, nil
+}
+
+// ValidateConfig accepts any L2OO configuration, since the simulated proofs don't depend on it.
+func (p *ScriptedProver) ValidateConfig(context.Context, string) error {
+	return nil
+}
+
+// CancelProof makes the proof unfulfillable, unless it was already fulfilled or failed.
+func (p *ScriptedProver) CancelProof(_ context.Context, proverRequestID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	proof, ok := p.proofs[proverRequestID]
+	if !ok {
+		return fmt.Errorf("unknown proof request %s", proverRequestID)
+	}
+	if time.Now().Before(proof.readyAt) {
+		proof.cancelled = true
+	}
+	return nil
+}
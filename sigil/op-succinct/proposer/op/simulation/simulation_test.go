@@ -0,0 +1,170 @@
+package simulation
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+// simulationTimeout is how long a simulation waits for the L2OO to advance. L2 blocks are only finalized after their
+// L1 blocks are, which takes a few L1 blocks on the devnet.
+const simulationTimeout = 5 * time.Minute
+
+// failure returns the event of the proof request failing.
+func failure(t *testing.T, h *Harness, id int) *ent.ProofRequestEvent {
+	events, err := h.ProofDB().GetProofRequestEvents(id)
+	require.NoError(t, err)
+	for _, event := range events {
+		if event.ToStatus == proofrequestevent.ToStatusFAILED {
+			return event
+		}
+	}
+	t.Fatalf("proof request %d didn't fail", id)
+	return nil
+}
+
+func TestScriptedProver(t *testing.T) {
+	ctx := context.Background()
+	p := NewScriptedProver()
+	p.Script(proofrequest.TypeSPAN, Fail(proposer.CycleLimitExceeded), Outcome{Unexecutable: true}, Outcome{RequestErr: errors.New("server down")})
+	p.Script(proofrequest.TypeAGG, Outcome{Delay: time.Hour})
+	span, err := json.Marshal(proposer.SpanProofRequest{Start: 10, End: 20})
+	require.NoError(t, err)
+	status := func(resp proposer.ProverResponse) proposer.ProofStatusResponse {
+		s, err := p.GetProofStatus(ctx, hex.EncodeToString(resp.ProverRequestID))
+		require.NoError(t, err)
+		return s
+	}
+
+	resp, err := p.RequestProof(ctx, proofrequest.TypeSPAN, span)
+	require.NoError(t, err)
+	s := status(resp)
+	require.Equal(t, proposer.SP1FulfillmentStatusUnfulfillable, s.FulfillmentStatus)
+	require.Equal(t, proposer.CycleLimitExceeded, *s.UnclaimDescription)
+
+	resp, err = p.RequestProof(ctx, proofrequest.TypeSPAN, span)
+	require.NoError(t, err)
+	s = status(resp)
+	require.Equal(t, proposer.SP1FulfillmentStatusUnfulfillable, s.FulfillmentStatus)
+	require.Equal(t, proposer.SP1ExecutionStatusUnexecutable, s.ExecutionStatus)
+
+	_, err = p.RequestProof(ctx, proofrequest.TypeSPAN, span)
+	require.ErrorContains(t, err, "server down")
+
+	// The script of each proof type is separate, and requests beyond it are proven.
+	resp, err = p.RequestProof(ctx, proofrequest.TypeAGG, []byte(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, proposer.SP1FulfillmentStatusRequested, status(resp).FulfillmentStatus)
+	resp, err = p.RequestProof(ctx, proofrequest.TypeSPAN, span)
+	require.NoError(t, err)
+	s = status(resp)
+	require.Equal(t, proposer.SP1FulfillmentStatusFulfilled, s.FulfillmentStatus)
+	require.NotEmpty(t, s.Proof)
+
+	_, err = p.GetProofStatus(ctx, "ff")
+	require.ErrorContains(t, err, "unknown proof request")
+
+	requests := p.Requests()
+	require.Len(t, requests, 5)
+	require.Equal(t, Request{Type: proofrequest.TypeSPAN, Start: 10, End: 20, Outcome: Fail(proposer.CycleLimitExceeded)}, requests[0])
+	require.Equal(t, proofrequest.TypeAGG, requests[3].Type)
+}
+
+func TestSimulation(t *testing.T) {
+	cfg := DefaultConfig()
+	h := NewHarness(t, cfg, NewScriptedProver())
+
+	h.WaitForL2OO(2*cfg.SubmissionInterval, simulationTimeout)
+	for _, req := range h.Prover.Requests() {
+		if req.Type == proofrequest.TypeSPAN {
+			require.Equal(t, cfg.MaxBlockRangePerSpanProof, req.End-req.Start)
+		}
+	}
+}
+
+func TestSimulationUnclaimed(t *testing.T) {
+	descriptions := []proposer.UnclaimDescription{
+		proposer.UnexpectedProverError,
+		proposer.ProgramExecutionError,
+		proposer.CycleLimitExceeded,
+		proposer.Other,
+	}
+	for _, description := range descriptions {
+		t.Run(description.String(), func(t *testing.T) {
+			cfg := DefaultConfig()
+			prover := NewScriptedProver()
+			prover.Script(proofrequest.TypeSPAN, Fail(description), Fail(description))
+			prover.Script(proofrequest.TypeAGG, Fail(description))
+			h := NewHarness(t, cfg, prover)
+
+			h.WaitForL2OO(cfg.SubmissionInterval, simulationTimeout)
+
+			// The unclaimed proofs are retried with the same range.
+			failed, err := h.ProofDB().GetAllProofsWithStatus(proofrequest.StatusFAILED)
+			require.NoError(t, err)
+			require.Len(t, failed, 3)
+			for _, req := range failed {
+				require.Contains(t, failure(t, h, req.ID).ProverResponse, description.String())
+				if req.Type == proofrequest.TypeSPAN {
+					require.Equal(t, cfg.MaxBlockRangePerSpanProof, req.EndBlock-req.StartBlock)
+				}
+			}
+		})
+	}
+}
+
+func TestSimulationUnexecutable(t *testing.T) {
+	cfg := DefaultConfig()
+	prover := NewScriptedProver()
+	prover.Script(proofrequest.TypeSPAN, Outcome{Unexecutable: true})
+	h := NewHarness(t, cfg, prover)
+
+	h.WaitForL2OO(cfg.SubmissionInterval, simulationTimeout)
+
+	// The unexecutable span proof is split in two.
+	first := prover.Requests()[0]
+	var halves []Request
+	for _, req := range prover.Requests()[1:] {
+		if req.Type == proofrequest.TypeSPAN && req.Start >= first.Start && req.End <= first.End {
+			halves = append(halves, req)
+		}
+	}
+	mid := (first.Start + first.End) / 2
+	require.Len(t, halves, 2)
+	require.ElementsMatch(t, []uint64{first.Start, mid}, []uint64{halves[0].Start, halves[1].Start})
+	require.ElementsMatch(t, []uint64{mid, first.End}, []uint64{halves[0].End, halves[1].End})
+}
+
+func TestSimulationDelays(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ProofTimeout = 20 * time.Second
+	prover := NewScriptedProver()
+	prover.Script(proofrequest.TypeSPAN,
+		Outcome{Delay: 5 * time.Second},
+		Outcome{RequestErr: errors.New("server unavailable")},
+		// Proofs that take longer than the proof timeout are requested again.
+		Outcome{Delay: time.Hour},
+	)
+	prover.Script(proofrequest.TypeAGG, Outcome{Delay: 5 * time.Second})
+	h := NewHarness(t, cfg, prover)
+
+	h.WaitForL2OO(cfg.SubmissionInterval, simulationTimeout)
+
+	failed, err := h.ProofDB().GetAllProofsWithStatus(proofrequest.StatusFAILED)
+	require.NoError(t, err)
+	reasons := make([]string, 0, len(failed))
+	for _, req := range failed {
+		reasons = append(reasons, failure(t, h, req.ID).Reason)
+	}
+	require.Len(t, failed, 2, "failed requests: %v", reasons)
+}
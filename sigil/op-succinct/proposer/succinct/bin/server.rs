@@ -21,8 +21,9 @@ use op_succinct_host_utils::{
 };
 use op_succinct_proposer::{
     AggProofRequest, ContractConfig, ProofResponse, ProofStatus, SpanProofRequest,
-    ValidateConfigRequest, ValidateConfigResponse, ProofStore
+    UnclaimDescription, ValidateConfigRequest, ValidateConfigResponse, ProofStore
 };
+use sp1_core_executor::ExecutionError;
 use sp1_sdk::{
     network::{
         proto::network::{ExecutionStatus, FulfillmentStatus},
@@ -81,6 +82,7 @@ async fn main() -> Result<()> {
         .route("/request_mock_span_proof", post(request_mock_span_proof))
         .route("/request_mock_agg_proof", post(request_mock_agg_proof))
         .route("/status/:proof_id", get(get_proof_status))
+        .route("/cancel/:proof_id", post(cancel_proof))
         .route("/validate_config", post(validate_config))
         .layer(DefaultBodyLimit::disable())
         .layer(RequestBodyLimitLayer::new(102400 * 1024 * 1024))
@@ -193,7 +195,7 @@ async fn request_span_proof(
     // let client = ProverClient::builder().network().build();
 
 
-    let proof_id = send_proof(ProofType::Span, state.proof_store.clone(), state.prover_client.clone(), state.range_pk, sp1_stdin).await?;
+    let proof_id = send_proof(ProofType::Span, state.proof_store.clone(), state.prover_client.clone(), state.range_pk, sp1_stdin, payload.execute).await?;
 
     // TODO: are these args needed?
     // let proof_id = client
@@ -276,7 +278,7 @@ async fn request_agg_proof(
             }
         };
 
-    let proof_id = send_proof(ProofType::Agg, state.proof_store.clone(), state.prover_client.clone(), state.agg_pk, sp1_stdin).await?;
+    let proof_id = send_proof(ProofType::Agg, state.proof_store.clone(), state.prover_client.clone(), state.agg_pk, sp1_stdin, false).await?;
     // TODO: are these args needed?
     // let proof_id = match prover
     //     .prove(&state.agg_pk, &stdin)
@@ -364,6 +366,7 @@ async fn request_mock_span_proof(
             fulfillment_status: FulfillmentStatus::Fulfilled.into(),
             execution_status: ExecutionStatus::UnspecifiedExecutionStatus.into(),
             proof: proof_bytes,
+            ..Default::default()
         }),
     ))
 }
@@ -454,6 +457,7 @@ async fn request_mock_agg_proof(
             fulfillment_status: FulfillmentStatus::Fulfilled.into(),
             execution_status: ExecutionStatus::UnspecifiedExecutionStatus.into(),
             proof: proof.bytes(),
+            ..Default::default()
         }),
     ))
 }
@@ -480,15 +484,13 @@ async fn get_proof_status(
                 execution_status: proof_status.execution_status,
                 // TODO: fix this clone
                 proof: proof_status.proof.clone(),
+                cycles: proof_status.cycles,
+                unclaim_description: proof_status.unclaim_description,
             }
         }
         None => {
             warn!("proof with id {:?} not found", proof_id);
-            ProofStatus {
-                fulfillment_status: 0,
-                execution_status: 0,
-                proof: vec![],
-            }
+            ProofStatus::default()
         }
     };
 
@@ -507,6 +509,8 @@ async fn get_proof_status(
                 fulfillment_status,
                 execution_status,
                 proof: status.proof,
+                cycles: status.cycles,
+                unclaim_description: status.unclaim_description,
             }),
         ));
     // otherwise, return current status & no proof
@@ -517,12 +521,59 @@ async fn get_proof_status(
                 fulfillment_status,
                 execution_status,
                 proof: vec![],
+                cycles: status.cycles,
+                unclaim_description: status.unclaim_description,
             }),
         ));
     }
 
 }
 
+/// Cancel a proof. The proof is reported as unfulfillable from then on. A proof that is being generated can't be
+/// interrupted, so its result is discarded once it is done.
+async fn cancel_proof(
+    State(state): State<ContractConfig>,
+    Path(proof_id): Path<String>,
+) -> Result<StatusCode, AppError> {
+    info!("Received cancel proof request: {:?}", proof_id);
+    let proof_id = hex::decode(proof_id)?;
+
+    let mut proof_store = state.proof_store.write().await;
+    let Some(status) = proof_store.get_mut(&proof_id) else {
+        warn!("proof with id {:?} not found", proof_id);
+        return Ok(StatusCode::NOT_FOUND);
+    };
+    if status.fulfillment_status != FulfillmentStatus::Fulfilled as i32
+        && status.fulfillment_status != FulfillmentStatus::Unfulfillable as i32
+    {
+        *status = ProofStatus {
+            fulfillment_status: FulfillmentStatus::Unfulfillable as i32,
+            execution_status: status.execution_status,
+            unclaim_description: Some(UnclaimDescription::Cancelled),
+            ..Default::default()
+        };
+    }
+    Ok(StatusCode::OK)
+}
+
+/// Whether the proof was cancelled.
+async fn is_cancelled(proof_store: &ProofStore, proof_id: &[u8]) -> bool {
+    matches!(
+        proof_store.read().await.get(proof_id),
+        Some(ProofStatus { unclaim_description: Some(UnclaimDescription::Cancelled), .. })
+    )
+}
+
+/// Store the status of a proof, unless the proof was cancelled.
+async fn update_proof_status(proof_store: &ProofStore, proof_id: Vec<u8>, status: ProofStatus) {
+    let mut proof_store = proof_store.write().await;
+    if let Some(ProofStatus { unclaim_description: Some(UnclaimDescription::Cancelled), .. }) = proof_store.get(&proof_id) {
+        info!("discarding result of cancelled proof {:?}", proof_id);
+        return;
+    }
+    proof_store.insert(proof_id, status);
+}
+
 // spawns a process that creates a proof locally
 // runs proof in a background thread.  Only needs to be async because of 
 // proof_store.write().await.  It isn't blocked by anything else
@@ -532,6 +583,7 @@ async fn send_proof(
     prover_client: Arc<CudaProver>,
     proving_key: SP1ProvingKey,
     sp1_stdin: SP1Stdin,
+    execute: bool,
 ) -> Result<Vec<u8>, AppError> {
 
     let proof_id = uuid_to_hex_bytes(Uuid::new_v4());
@@ -540,7 +592,7 @@ async fn send_proof(
     let initial_status = ProofStatus {
         fulfillment_status: 2,
         execution_status: 1,
-        proof: Vec::new()
+        ..Default::default()
     };
 
     proof_store.write().await.insert(proof_id.clone(), initial_status);
@@ -549,6 +601,37 @@ async fn send_proof(
         let start_time = tokio::time::Instant::now();
         info!("computing {proof_type} proof with id {:?}", proof_id);
 
+        // Execute span proofs before proving them if the proposer asks for their cycle count, which it uses to size its
+        // spans. If the execution fails, the proof is unfulfillable.
+        let cycles = match proof_type {
+            ProofType::Span if execute => match prover_client.execute(RANGE_ELF, &sp1_stdin).run() {
+                Ok((_, report)) => report.total_instruction_count(),
+                Err(e) => {
+                    log::error!("error executing {proof_type} program {e}");
+                    let e: anyhow::Error = e.into();
+                    let unclaim_description = if exceeded_cycle_limit(&e) {
+                        UnclaimDescription::CycleLimitExceeded
+                    } else {
+                        UnclaimDescription::ProgramExecutionError
+                    };
+                    let failed_status = ProofStatus {
+                        fulfillment_status: 4,
+                        execution_status: 3,
+                        unclaim_description: Some(unclaim_description),
+                        ..Default::default()
+                    };
+                    update_proof_status(&proof_store, proof_id, failed_status).await;
+                    return Err(AppError(anyhow::anyhow!("error executing {proof_type} program {e}")));
+                }
+            },
+            _ => 0,
+        };
+
+        if is_cancelled(&proof_store, &proof_id).await {
+            info!("{proof_type} proof with id {:?} was cancelled, not proving it", proof_id);
+            return Ok(());
+        }
+
         let proof_res = match proof_type {
             ProofType::Span => {
                 prover_client.prove(&proving_key, &sp1_stdin).compressed().run()
@@ -598,6 +681,8 @@ async fn send_proof(
                             fulfillment_status: 3,
                             execution_status: 2,
                             proof: proof_bytes,
+                            cycles,
+                            ..Default::default()
                         }
                     }
                     SP1Proof::Groth16(_) => {
@@ -607,6 +692,8 @@ async fn send_proof(
                             fulfillment_status: 3,
                             execution_status: 2,
                             proof: proof_bytes,
+                            cycles,
+                            ..Default::default()
                         }
                     }
                     SP1Proof::Plonk(_) => {
@@ -616,6 +703,8 @@ async fn send_proof(
                             fulfillment_status: 3,
                             execution_status: 2,
                             proof: proof_bytes,
+                            cycles,
+                            ..Default::default()
                         }
                     }
                     _ => {
@@ -626,6 +715,21 @@ async fn send_proof(
             }
             Err(e) => {
                 log::error!("error proving {e}");
+                // Span proofs that weren't executed up front fail here if they exceed the cycle limit.
+                let e: anyhow::Error = e.into();
+                let unclaim_description = if exceeded_cycle_limit(&e) {
+                    UnclaimDescription::CycleLimitExceeded
+                } else {
+                    UnclaimDescription::UnexpectedProverError
+                };
+                let failed_status = ProofStatus {
+                    fulfillment_status: 4,
+                    execution_status: 2,
+                    cycles,
+                    unclaim_description: Some(unclaim_description),
+                    ..Default::default()
+                };
+                update_proof_status(&proof_store, proof_id, failed_status).await;
                 return Err(AppError(anyhow::anyhow!("error proving {e}")));
             }
         };
@@ -635,7 +739,7 @@ async fn send_proof(
         info!("Time to compute {proof_type} proof: {} minutes", minutes);
 
         // update proof store
-        proof_store.write().await.insert(proof_id, proof_status);
+        update_proof_status(&proof_store, proof_id, proof_status).await;
 
         Ok(())
     });
@@ -643,6 +747,13 @@ async fn send_proof(
     Ok(proof_id_clone)
 }
 
+/// Whether the error, or an error it wraps, is the SP1 executor exceeding the cycle limit.
+fn exceeded_cycle_limit(e: &anyhow::Error) -> bool {
+    e.chain().any(|cause| {
+        matches!(cause.downcast_ref::<ExecutionError>(), Some(ExecutionError::ExceededCycleLimit(_)))
+    })
+}
+
 pub enum ProofType {
     Span,
     Agg,
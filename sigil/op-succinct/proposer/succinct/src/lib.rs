@@ -22,6 +22,9 @@ pub struct ValidateConfigResponse {
 pub struct SpanProofRequest {
     pub start: u64,
     pub end: u64,
+    /// Execute the range program before proving it, to report its cycle count in the proof status.
+    #[serde(default)]
+    pub execute: bool,
 }
 
 #[derive(Deserialize, Serialize, Debug)]
@@ -41,7 +44,7 @@ pub struct ProofResponse {
     pub proof_id: Vec<u8>,
 }
 
-#[derive(Debug, Serialize_repr, Deserialize_repr)]
+#[derive(Debug, Clone, Copy, Serialize_repr, Deserialize_repr)]
 #[repr(i32)]
 /// The type of error that occurred when unclaiming a proof. Based off of the `unclaim_description`
 /// field in the `ProofStatus` struct.
@@ -50,6 +53,8 @@ pub enum UnclaimDescription {
     ProgramExecutionError = 1,
     CycleLimitExceeded = 2,
     Other = 3,
+    /// The proof was cancelled by the proposer.
+    Cancelled = 4,
 }
 
 /// Convert a string to an `UnclaimDescription`. These cover the common reasons why a proof might
@@ -60,6 +65,7 @@ impl From<String> for UnclaimDescription {
             "unexpected prover error" => UnclaimDescription::UnexpectedProverError,
             "program execution error" => UnclaimDescription::ProgramExecutionError,
             "cycle limit exceeded" => UnclaimDescription::CycleLimitExceeded,
+            "cancelled" => UnclaimDescription::Cancelled,
             _ => UnclaimDescription::Other,
         }
     }
@@ -70,13 +76,19 @@ pub enum ProofType {
     Agg,
 }
 
-#[derive(Serialize, Deserialize)]
+#[derive(Serialize, Deserialize, Default)]
 /// The status of a proof request.
 pub struct ProofStatus {
     // Note: Can't use `FulfillmentStatus`/`ExecutionStatus` directly because `Serialize_repr` and `Deserialize_repr` aren't derived on it.
     pub fulfillment_status: i32,
     pub execution_status: i32,
     pub proof: Vec<u8>,
+    /// The number of cycles used to execute the range program. Only set for span proofs.
+    #[serde(default)]
+    pub cycles: u64,
+    /// Why the proof could not be generated. Only set for unfulfillable proofs.
+    #[serde(default)]
+    pub unclaim_description: Option<UnclaimDescription>,
 }
 
 pub type ProofStore = Arc<RwLock<HashMap<Vec<u8>, ProofStatus>>>;
@@ -0,0 +1,77 @@
+package batcher
+
+import (
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ChannelInfo describes a channel of the channel manager.
+type ChannelInfo struct {
+	ID string
+	// Open is true for the channel that blocks are still added to. The other channels are closed, and pending until
+	// all their frames are confirmed.
+	Open bool
+	// FullReason is why the channel was closed.
+	FullReason string
+	UseBlobs   bool
+
+	Blocks         int
+	OldestL2       eth.BlockID
+	LatestL2       eth.BlockID
+	OldestL1Origin eth.BlockID
+	LatestL1Origin eth.BlockID
+	// Timeout is the L1 block by which the channel must be closed and submitted. 0 if there is no timeout yet.
+	Timeout uint64
+
+	InputBytes  int
+	OutputBytes int
+	// ComprRatio is the ratio of output to input bytes of the frames output so far.
+	ComprRatio float64
+
+	// TotalFrames is the number of frames output so far, and PendingFrames the number of these that aren't sent yet.
+	TotalFrames   int
+	PendingFrames int
+	PendingTxs    int
+	ConfirmedTxs  int
+}
+
+// TxInfo describes a batcher tx in flight.
+type TxInfo struct {
+	// ID is the txID of the tx, which lists the frames of the tx.
+	ID        string
+	ChannelID string
+	Frames    int
+	Bytes     int
+	AsBlob    bool
+}
+
+// ChannelConfigInfo describes the ChannelConfig of new channels.
+type ChannelConfigInfo struct {
+	// DAType is "blobs" or "calldata".
+	DAType                string
+	MaxFrameSize          uint64
+	TargetNumFrames       int
+	MaxChannelDuration    uint64
+	SubSafetyMargin       uint64
+	MaxBlocksPerSpanBatch int
+	BatchType             uint
+	Compressor            string
+	CompressionAlgo       string
+	ApproxComprRatio      float64
+	// Overridden is true if the configuration was set with SetChannelConfig, rather than by the batcher's
+	// configuration.
+	Overridden bool
+}
+
+// ChannelConfigUpdate changes the configuration of new channels. Fields that aren't set are unchanged, except that
+// switching the DA type resets the max frame size to the max for the DA type, and the target number of frames to 1
+// for calldata.
+type ChannelConfigUpdate struct {
+	DAType                *string
+	MaxFrameSize          *uint64
+	TargetNumFrames       *int
+	MaxChannelDuration    *uint64
+	SubSafetyMargin       *uint64
+	MaxBlocksPerSpanBatch *int
+	CompressionAlgo       *string
+	ApproxComprRatio      *float64
+}
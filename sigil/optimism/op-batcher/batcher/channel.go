@@ -50,6 +50,25 @@ func newChannel(log log.Logger, metr metrics.Metricer, cfg ChannelConfig, rollup
 	}, nil
 }
 
+// restoredChannel creates a channel of a previous batcher run from its channel builder and the transactions of it
+// that were confirmed, by txID.
+func restoredChannel(log log.Logger, metr metrics.Metricer, cfg ChannelConfig, cb *ChannelBuilder, confirmed map[string]eth.BlockID) *channel {
+	ch := &channel{
+		log:                   log,
+		metr:                  metr,
+		cfg:                   cfg,
+		channelBuilder:        cb,
+		pendingTransactions:   make(map[string]txData),
+		confirmedTransactions: confirmed,
+		confirmedTxUpdated:    true,
+	}
+	ch.updateInclusionBlocks()
+	if len(confirmed) > 0 {
+		cb.FramePublished(ch.minInclusionBlock)
+	}
+	return ch
+}
+
 // TxFailed records a transaction as failed. It will attempt to resubmit the data
 // in the failed transaction.
 func (s *channel) TxFailed(id string) {
@@ -20,6 +20,7 @@ var (
 	ErrChannelTimeoutClose   = errors.New("close to channel timeout")
 	ErrSeqWindowClose        = errors.New("close to sequencer window timeout")
 	ErrTerminated            = errors.New("channel terminated")
+	ErrRestored              = errors.New("channel restored from journal")
 )
 
 type ChannelFullError struct {
@@ -64,6 +65,8 @@ type ChannelBuilder struct {
 	fullErr error
 	// current channel
 	co derive.ChannelOut
+	// ID of the channel. It is the ID of co, unless the channel was restored from the journal.
+	id derive.ChannelID
 	// list of blocks in the channel. Saved in case the channel must be rebuilt
 	blocks []*types.Block
 	// latestL1Origin is the latest L1 origin of all the L2 blocks that have been added to the channel
@@ -95,6 +98,7 @@ func NewChannelBuilder(cfg ChannelConfig, rollupCfg *rollup.Config, latestL1Orig
 		cfg:       cfg,
 		rollupCfg: rollupCfg,
 		co:        co,
+		id:        co.ID(),
 	}
 
 	cb.updateDurationTimeout(latestL1OriginBlockNum)
@@ -102,15 +106,42 @@ func NewChannelBuilder(cfg ChannelConfig, rollupCfg *rollup.Config, latestL1Orig
 	return cb, nil
 }
 
+// restoredChannelBuilder creates a full channel builder for a channel of a previous batcher run, from its blocks
+// and the frames that weren't submitted yet. No blocks can be added to it, and its frames aren't output again.
+func restoredChannelBuilder(cfg ChannelConfig, rollupCfg *rollup.Config, id derive.ChannelID, blocks []*types.Block, frames []frameData, numFrames, outputBytes int) (*ChannelBuilder, error) {
+	cb, err := NewChannelBuilder(cfg, rollupCfg, 0)
+	if err != nil {
+		return nil, err
+	}
+	cb.id = id
+	cb.timeout, cb.timeoutReason = 0, nil
+	for _, block := range blocks {
+		_, l1info, err := derive.BlockToSingularBatch(rollupCfg, block)
+		if err != nil {
+			return nil, fmt.Errorf("converting block to batch: %w", err)
+		}
+		cb.trackBlock(block, l1info)
+	}
+	cb.frames = frames
+	cb.numFrames = numFrames
+	cb.outputBytes = outputBytes
+	cb.setFullErr(ErrRestored)
+	return cb, nil
+}
+
 // newChannelOut creates a new channel out based on the given configuration.
 func newChannelOut(cfg ChannelConfig, rollupCfg *rollup.Config) (derive.ChannelOut, error) {
 	spec := rollup.NewChainSpec(rollupCfg)
+	// zstd compressed channels can only be derived if compressed with the dictionary of the rollup config
+	dict := rollupCfg.ZstdDictionary()
 	if cfg.BatchType == derive.SpanBatchType {
 		return derive.NewSpanChannelOut(
 			cfg.CompressorConfig.TargetOutputSize, cfg.CompressorConfig.CompressionAlgo,
-			spec, derive.WithMaxBlocksPerSpanBatch(cfg.MaxBlocksPerSpanBatch))
+			spec, derive.WithMaxBlocksPerSpanBatch(cfg.MaxBlocksPerSpanBatch), derive.WithCompressionDict(dict))
 	}
-	comp, err := cfg.CompressorConfig.NewCompressor()
+	compCfg := cfg.CompressorConfig
+	compCfg.CompressionDict = dict
+	comp, err := compCfg.NewCompressor()
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +149,7 @@ func newChannelOut(cfg ChannelConfig, rollupCfg *rollup.Config) (derive.ChannelO
 }
 
 func (c *ChannelBuilder) ID() derive.ChannelID {
-	return c.co.ID()
+	return c.id
 }
 
 // InputBytes returns the total amount of input bytes added to the channel.
@@ -190,9 +221,20 @@ func (c *ChannelBuilder) AddBlock(block *types.Block) (*derive.L1BlockInfo, erro
 		return l1info, fmt.Errorf("adding block to channel out: %w", err)
 	}
 
-	c.blocks = append(c.blocks, block)
 	c.updateSwTimeout(batch)
+	c.trackBlock(block, l1info)
+
+	if err = c.co.FullErr(); err != nil {
+		c.setFullErr(err)
+		// Adding this block still worked, so don't return error, just mark as full
+	}
 
+	return l1info, nil
+}
+
+// trackBlock records the block, and updates the L1 origins and L2 blocks of the channel with it.
+func (c *ChannelBuilder) trackBlock(block *types.Block, l1info *derive.L1BlockInfo) {
+	c.blocks = append(c.blocks, block)
 	if l1info.Number > c.latestL1Origin.Number {
 		c.latestL1Origin = eth.BlockID{
 			Hash:   l1info.BlockHash,
@@ -211,13 +253,6 @@ func (c *ChannelBuilder) AddBlock(block *types.Block) (*derive.L1BlockInfo, erro
 	if c.oldestL2.Number == 0 || block.NumberU64() < c.oldestL2.Number {
 		c.oldestL2 = eth.ToBlockID(block)
 	}
-
-	if err = c.co.FullErr(); err != nil {
-		c.setFullErr(err)
-		// Adding this block still worked, so don't return error, just mark as full
-	}
-
-	return l1info, nil
 }
 
 // Timeout management
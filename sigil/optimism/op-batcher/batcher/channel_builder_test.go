@@ -434,7 +434,7 @@ func TestChannelBuilder_OutputFrames(t *testing.T) {
 func TestChannelBuilder_OutputFrames_SpanBatch(t *testing.T) {
 	for _, algo := range derive.CompressionAlgos {
 		t.Run("ChannelBuilder_OutputFrames_SpanBatch_"+algo.String(), func(t *testing.T) {
-			ChannelBuilder_OutputFrames_SpanBatch(t, algo) // to fill faster for brotli
+			ChannelBuilder_OutputFrames_SpanBatch(t, algo) // to fill faster for brotli and zstd
 		})
 	}
 }
@@ -442,7 +442,7 @@ func TestChannelBuilder_OutputFrames_SpanBatch(t *testing.T) {
 func ChannelBuilder_OutputFrames_SpanBatch(t *testing.T, algo derive.CompressionAlgo) {
 	channelConfig := defaultTestChannelConfig()
 	channelConfig.MaxFrameSize = 20 + derive.FrameV0OverHeadSize
-	if algo.IsBrotli() {
+	if algo.IsBrotli() || algo == derive.Zstd {
 		channelConfig.TargetNumFrames = 3
 	} else {
 		channelConfig.TargetNumFrames = 5
@@ -7,6 +7,9 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 )
 
+// CalldataMaxFrameSize is the max frame size of calldata channels when the batcher switches from blobs to calldata.
+const CalldataMaxFrameSize = 120_000
+
 type ChannelConfig struct {
 	// Number of epochs (L1 blocks) per sequencing window, including the epoch
 	// L1 origin block itself
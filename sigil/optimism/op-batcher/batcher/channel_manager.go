@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
@@ -37,8 +39,12 @@ type channelManager struct {
 	l1OriginLastClosedChannel eth.BlockID
 	// The default ChannelConfig to use for the next channel
 	defaultCfg ChannelConfig
+	// ChannelConfig set through the admin API, used instead of the one of cfgProvider if set
+	cfgOverride *ChannelConfig
 	// last block hash - for reorg detection
 	tip common.Hash
+	// when a DAPricer started holding back the data of the ready channel, zero if it isn't held back
+	holdSince time.Time
 
 	// channel to write new block data to
 	currentChannel *channel
@@ -47,6 +53,9 @@ type channelManager struct {
 	// used to lookup channels by tx ID upon tx success / failure
 	txChannels map[string]*channel
 
+	// records the channels and their frames, if set
+	journal *Journal
+
 	// if set to true, prevents production of any new channel frames
 	closed bool
 }
@@ -75,6 +84,8 @@ func (s *channelManager) Clear(l1OriginLastClosedChannel eth.BlockID) {
 	s.currentChannel = nil
 	s.channelQueue = nil
 	s.txChannels = make(map[string]*channel)
+	s.holdSince = time.Time{}
+	s.journal.clear()
 }
 
 // TxFailed records a transaction as failed. It will attempt to resubmit the data
@@ -134,6 +145,7 @@ func (s *channelManager) removePendingChannel(channel *channel) {
 		return
 	}
 	s.channelQueue = append(s.channelQueue[:index], s.channelQueue[index+1:]...)
+	s.journal.channelRemoved(channel.ID())
 }
 
 // nextTxData dequeues frames from the channel and returns them encoded in a transaction.
@@ -156,7 +168,8 @@ func (s *channelManager) nextTxData(channel *channel) (txData, error) {
 //
 // It will decide whether to switch DA type automatically.
 // When switching DA type, the channelManager state will be rebuilt
-// with a new ChannelConfig.
+// with a new ChannelConfig. If the ChannelConfigProvider is a DAPricer,
+// it may also hold back the data of the channel, in which case io.EOF is returned.
 func (s *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -171,7 +184,10 @@ func (s *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
 	}
 
 	// Call provider method to reassess optimal DA type
-	newCfg := s.cfgProvider.ChannelConfig()
+	newCfg, hold := s.pendingChannelConfig(channel, l1Head)
+	if hold {
+		return emptyTxData, io.EOF
+	}
 
 	// No change:
 	if newCfg.UseBlobs == s.defaultCfg.UseBlobs {
@@ -348,12 +364,17 @@ func (s *channelManager) processBlocks() error {
 
 // outputFrames generates frames for the current channel, and computes and logs the compression ratio
 func (s *channelManager) outputFrames() error {
+	numFrames := s.currentChannel.TotalFrames()
 	if err := s.currentChannel.OutputFrames(); err != nil {
 		return fmt.Errorf("creating frames with channel builder: %w", err)
 	}
+	// New frames are appended to the frames queue of the channel builder.
+	frames := s.currentChannel.channelBuilder.frames
+	s.journal.channelFrames(s.currentChannel.ID(), s.currentChannel.cfg.UseBlobs, frames[len(frames)-(s.currentChannel.TotalFrames()-numFrames):])
 	if !s.currentChannel.IsFull() {
 		return nil
 	}
+	s.journal.channelClosed(s.currentChannel.ID(), s.currentChannel.channelBuilder.Blocks())
 
 	lastClosedL1Origin := s.currentChannel.LatestL1Origin()
 	if lastClosedL1Origin.Number > s.l1OriginLastClosedChannel.Number {
@@ -475,6 +496,22 @@ func (s *channelManager) Close() error {
 	return nil
 }
 
+// Restore adds channels of a previous batcher run to the channel queue, to submit their remaining frames, and makes
+// the next block added extend the given last block of these channels. It is meant to be called after Clear, before
+// any block is added.
+func (s *channelManager) Restore(channels []*channel, tip eth.BlockID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.channelQueue = append(s.channelQueue, channels...)
+	s.tip = tip.Hash
+	for _, ch := range channels {
+		if l1Origin := ch.LatestL1Origin(); l1Origin.Number > s.l1OriginLastClosedChannel.Number {
+			s.l1OriginLastClosedChannel = l1Origin
+		}
+	}
+	s.log.Info("Restored channels", "channels", len(channels), "tip", tip, "l1OriginLastClosedChannel", s.l1OriginLastClosedChannel)
+}
+
 // Requeue rebuilds the channel manager state by
 // rewinding blocks back from the channel queue, and setting the defaultCfg.
 func (s *channelManager) Requeue(newCfg ChannelConfig) {
@@ -486,6 +523,7 @@ func (s *channelManager) Requeue(newCfg ChannelConfig) {
 			continue
 		}
 		blocksToRequeue = append(blocksToRequeue, channel.channelBuilder.Blocks()...)
+		s.journal.channelRemoved(channel.ID())
 	}
 
 	// We put the blocks back at the front of the queue:
@@ -497,3 +535,161 @@ func (s *channelManager) Requeue(newCfg ChannelConfig) {
 	// to pick up the new ChannelConfig
 	s.defaultCfg = newCfg
 }
+
+// channelConfig returns the ChannelConfig for new channels: the override if one is set, or else the one of the
+// ChannelConfigProvider.
+func (s *channelManager) channelConfig() ChannelConfig {
+	if s.cfgOverride != nil {
+		return *s.cfgOverride
+	}
+	return s.cfgProvider.ChannelConfig()
+}
+
+// pendingChannelConfig returns the ChannelConfig to submit the ready channel with, and whether to hold back its data.
+// Only a DAPricer holds back data, and never while the config is overridden.
+func (s *channelManager) pendingChannelConfig(ch *channel, l1Head eth.BlockID) (ChannelConfig, bool) {
+	pricer, ok := s.cfgProvider.(DAPricer)
+	if !ok || s.cfgOverride != nil {
+		return s.channelConfig(), false
+	}
+
+	pending := PendingChannel{L1Head: l1Head.Number, Bytes: ch.OutputBytes()}
+	if ch.cfg.SeqWindowSize > ch.cfg.SubSafetyMargin {
+		pending.Deadline = ch.OldestL1Origin().Number + ch.cfg.SeqWindowSize - ch.cfg.SubSafetyMargin
+	}
+	if !s.holdSince.IsZero() {
+		pending.Held = time.Since(s.holdSince)
+	}
+	cfg, hold := pricer.PendingChannelConfig(pending)
+	if hold {
+		if s.holdSince.IsZero() {
+			s.holdSince = time.Now()
+		}
+		return cfg, true
+	}
+	s.holdSince = time.Time{}
+	return cfg, false
+}
+
+// ObserveL1Head lets a DAPricer record the fees at a new L1 head. Fees are observed while the config is overridden
+// too, so that the DAPricer knows the recent fees once the override is removed.
+func (s *channelManager) ObserveL1Head(l1Head eth.BlockID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if pricer, ok := s.cfgProvider.(DAPricer); ok {
+		pricer.ObserveL1Head(l1Head.Number)
+	}
+}
+
+// ChannelConfig returns the ChannelConfig of the next channel, and whether it is overridden.
+func (s *channelManager) ChannelConfig() (ChannelConfig, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.defaultCfg, s.cfgOverride != nil
+}
+
+// OverrideChannelConfig makes new channels use the ChannelConfig returned by update instead of the one of the
+// ChannelConfigProvider, until ResetChannelConfig is called. update is called with the current ChannelConfig while
+// the channel manager is locked, and the config isn't changed if it returns an error. Blocks of channels that have
+// no submitted transactions are requeued into channels with the new config.
+func (s *channelManager) OverrideChannelConfig(update func(ChannelConfig) (ChannelConfig, error)) (ChannelConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cfg, err := update(s.defaultCfg)
+	if err != nil {
+		return ChannelConfig{}, err
+	}
+	s.log.Info("Overriding channel config", "use_blobs", cfg.UseBlobs, "max_frame_size", cfg.MaxFrameSize,
+		"target_num_frames", cfg.TargetNumFrames)
+	s.cfgOverride = &cfg
+	s.Requeue(cfg)
+	return cfg, nil
+}
+
+// ResetChannelConfig removes the override of the ChannelConfig, and requeues the blocks of channels that have
+// no submitted transactions into channels with the config of the ChannelConfigProvider.
+func (s *channelManager) ResetChannelConfig() ChannelConfig {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cfgOverride == nil {
+		return s.defaultCfg
+	}
+	s.cfgOverride = nil
+	cfg := s.cfgProvider.ChannelConfig()
+	s.log.Info("Resetting channel config override", "use_blobs", cfg.UseBlobs)
+	s.Requeue(cfg)
+	return cfg
+}
+
+// CloseCurrentChannel force-closes the current channel, if it isn't full yet, and outputs its remaining frames so
+// that they are returned by the next calls to TxData. It returns whether a channel was closed.
+func (s *channelManager) CloseCurrentChannel() (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.currentChannel == nil || s.currentChannel.IsFull() {
+		return false, nil
+	}
+	s.log.Info("Force-closing current channel", "id", s.currentChannel.ID())
+	s.currentChannel.Close()
+	if err := s.outputFrames(); err != nil {
+		return true, fmt.Errorf("outputting frames of closed channel: %w", err)
+	}
+	return true, nil
+}
+
+// ChannelInfos describes the channels in the channel queue, oldest first.
+func (s *channelManager) ChannelInfos() []ChannelInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	infos := make([]ChannelInfo, 0, len(s.channelQueue))
+	for _, ch := range s.channelQueue {
+		info := ChannelInfo{
+			ID:             ch.ID().String(),
+			Open:           ch == s.currentChannel && !ch.IsFull(),
+			UseBlobs:       ch.cfg.UseBlobs,
+			Blocks:         len(ch.channelBuilder.Blocks()),
+			OldestL2:       ch.OldestL2(),
+			LatestL2:       ch.LatestL2(),
+			OldestL1Origin: ch.OldestL1Origin(),
+			LatestL1Origin: ch.LatestL1Origin(),
+			Timeout:        ch.Timeout(),
+			InputBytes:     ch.InputBytes(),
+			OutputBytes:    ch.OutputBytes(),
+			TotalFrames:    ch.TotalFrames(),
+			PendingFrames:  ch.PendingFrames(),
+			PendingTxs:     len(ch.pendingTransactions),
+			ConfirmedTxs:   len(ch.confirmedTransactions),
+		}
+		if err := ch.FullErr(); err != nil {
+			info.FullReason = err.Error()
+		}
+		if info.InputBytes > 0 {
+			info.ComprRatio = float64(info.OutputBytes) / float64(info.InputBytes)
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// InFlightTxs describes the transactions that were returned by TxData, and not yet confirmed or failed, ordered by
+// channel and ID.
+func (s *channelManager) InFlightTxs() []TxInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var txs []TxInfo
+	for _, ch := range s.channelQueue {
+		chTxs := make([]TxInfo, 0, len(ch.pendingTransactions))
+		for id, data := range ch.pendingTransactions {
+			chTxs = append(chTxs, TxInfo{
+				ID:        id,
+				ChannelID: ch.ID().String(),
+				Frames:    len(data.frames),
+				Bytes:     data.Len(),
+				AsBlob:    data.asBlob,
+			})
+		}
+		sort.Slice(chTxs, func(i, j int) bool { return chTxs[i].ID < chTxs[j].ID })
+		txs = append(txs, chTxs...)
+	}
+	return txs
+}
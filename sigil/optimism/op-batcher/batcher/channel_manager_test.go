@@ -666,3 +666,177 @@ func TestChannelManager_Requeue(t *testing.T) {
 	require.Contains(t, m.channelQueue, channel0)
 	require.NotContains(t, m.blocks, blockA)
 }
+
+// TestChannelManager_OverrideChannelConfig checks that an overridden ChannelConfig
+// requeues the blocks of unsubmitted channels, and is used instead of the one of
+// the ChannelConfigProvider until it is reset.
+func TestChannelManager_OverrideChannelConfig(t *testing.T) {
+	l := testlog.Logger(t, log.LevelCrit)
+	cfg := newFakeDynamicEthChannelConfig(l, 1000)
+	m := NewChannelManager(l, metrics.NoopMetrics, cfg, defaultTestRollupConfig)
+	require.False(t, m.defaultCfg.UseBlobs)
+
+	rng := rand.New(rand.NewSource(99))
+	blockA := derivetest.RandomL2BlockWithChainId(rng, 10, defaultTestRollupConfig.L2ChainID)
+	m.blocks = []*types.Block{blockA}
+	_, err := m.TxData(eth.BlockID{})
+	require.ErrorIs(t, err, io.EOF)
+	require.NotEmpty(t, m.channelQueue)
+
+	_, err = m.OverrideChannelConfig(func(ChannelConfig) (ChannelConfig, error) { return cfg.blobConfig, nil })
+	require.NoError(t, err)
+	current, overridden := m.ChannelConfig()
+	require.True(t, overridden)
+	require.True(t, current.UseBlobs)
+	require.Empty(t, m.channelQueue)
+	require.Equal(t, []*types.Block{blockA}, m.blocks)
+
+	// The provider isn't consulted while the config is overridden.
+	assessments := cfg.assessments
+	_, err = m.TxData(eth.BlockID{})
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, assessments, cfg.assessments)
+	require.NotEmpty(t, m.channelQueue)
+	require.True(t, m.channelQueue[0].cfg.UseBlobs)
+
+	reset := m.ResetChannelConfig()
+	require.False(t, reset.UseBlobs)
+	current, overridden = m.ChannelConfig()
+	require.False(t, overridden)
+	require.False(t, current.UseBlobs)
+	require.Empty(t, m.channelQueue)
+	require.Equal(t, []*types.Block{blockA}, m.blocks)
+}
+
+// TestChannelManager_CloseCurrentChannel checks that force-closing the current
+// channel makes its frames available, and that they are reported as in flight
+// once returned by TxData.
+func TestChannelManager_CloseCurrentChannel(t *testing.T) {
+	require := require.New(t)
+	l := testlog.Logger(t, log.LevelCrit)
+	cfg := channelManagerTestConfig(120_000, derive.SingularBatchType)
+	m := NewChannelManager(l, metrics.NoopMetrics, cfg, defaultTestRollupConfig)
+	m.Clear(eth.BlockID{})
+
+	closed, err := m.CloseCurrentChannel()
+	require.NoError(err)
+	require.False(closed, "no channel to close")
+
+	rng := rand.New(rand.NewSource(99))
+	require.NoError(m.AddL2Block(derivetest.RandomL2BlockWithChainId(rng, 4, defaultTestRollupConfig.L2ChainID)))
+	_, err = m.TxData(eth.BlockID{})
+	require.ErrorIs(err, io.EOF)
+
+	infos := m.ChannelInfos()
+	require.Len(infos, 1)
+	require.True(infos[0].Open)
+	require.Equal(1, infos[0].Blocks)
+	require.Empty(infos[0].FullReason)
+
+	closed, err = m.CloseCurrentChannel()
+	require.NoError(err)
+	require.True(closed)
+	closed, err = m.CloseCurrentChannel()
+	require.NoError(err)
+	require.False(closed, "channel already closed")
+
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+
+	infos = m.ChannelInfos()
+	require.Len(infos, 1)
+	require.False(infos[0].Open)
+	require.NotEmpty(infos[0].FullReason)
+	require.Equal(1, infos[0].PendingTxs)
+	require.Zero(infos[0].PendingFrames)
+
+	txs := m.InFlightTxs()
+	require.Len(txs, 1)
+	require.Equal(txdata.ID().String(), txs[0].ID)
+	require.Equal(infos[0].ID, txs[0].ChannelID)
+	require.Equal(txdata.Len(), txs[0].Bytes)
+	require.False(txs[0].AsBlob)
+
+	m.TxConfirmed(txdata.ID(), eth.BlockID{Number: 1})
+	require.Empty(m.InFlightTxs())
+}
+
+// fakeDAPricer is a DAPricer that holds back the channel data while hold is set.
+type fakeDAPricer struct {
+	cfg      ChannelConfig
+	hold     bool
+	pending  []PendingChannel
+	observed []uint64
+}
+
+func (f *fakeDAPricer) ObserveL1Head(l1Head uint64) {
+	f.observed = append(f.observed, l1Head)
+}
+
+func (f *fakeDAPricer) ChannelConfig() ChannelConfig {
+	return f.cfg
+}
+
+func (f *fakeDAPricer) PendingChannelConfig(ch PendingChannel) (ChannelConfig, bool) {
+	f.pending = append(f.pending, ch)
+	return f.cfg, f.hold
+}
+
+// TestChannelManager_HoldBackTxData checks that the data of a ready channel isn't
+// returned while a DAPricer holds it back.
+func TestChannelManager_HoldBackTxData(t *testing.T) {
+	require := require.New(t)
+	l := testlog.Logger(t, log.LevelCrit)
+	cfg := channelManagerTestConfig(120_000, derive.SingularBatchType)
+	cfg.SeqWindowSize = 100
+	cfg.SubSafetyMargin = 10
+	pricer := &fakeDAPricer{cfg: cfg, hold: true}
+	m := NewChannelManager(l, metrics.NoopMetrics, pricer, defaultTestRollupConfig)
+	m.Clear(eth.BlockID{})
+
+	rng := rand.New(rand.NewSource(99))
+	require.NoError(m.AddL2Block(derivetest.RandomL2BlockWithChainId(rng, 4, defaultTestRollupConfig.L2ChainID)))
+	_, err := m.TxData(eth.BlockID{Number: 1})
+	require.ErrorIs(err, io.EOF)
+	require.Empty(pricer.pending, "the channel isn't ready yet")
+	_, err = m.CloseCurrentChannel()
+	require.NoError(err)
+
+	_, err = m.TxData(eth.BlockID{Number: 2})
+	require.ErrorIs(err, io.EOF)
+	_, err = m.TxData(eth.BlockID{Number: 3})
+	require.ErrorIs(err, io.EOF)
+	require.Len(pricer.pending, 2)
+	ch := m.channelQueue[0]
+	require.Equal(PendingChannel{
+		L1Head:   2,
+		Bytes:    ch.OutputBytes(),
+		Deadline: ch.OldestL1Origin().Number + 90,
+	}, pricer.pending[0])
+	require.Equal(uint64(3), pricer.pending[1].L1Head)
+	require.Positive(pricer.pending[1].Held)
+
+	pricer.hold = false
+	txdata, err := m.TxData(eth.BlockID{Number: 4})
+	require.NoError(err)
+	require.Positive(txdata.Len())
+	require.True(m.holdSince.IsZero())
+}
+
+// TestChannelManager_ObserveL1Head checks that a DAPricer observes every new L1
+// head, also while the channel config is overridden.
+func TestChannelManager_ObserveL1Head(t *testing.T) {
+	require := require.New(t)
+	l := testlog.Logger(t, log.LevelCrit)
+	cfg := channelManagerTestConfig(120_000, derive.SingularBatchType)
+	pricer := &fakeDAPricer{cfg: cfg}
+	m := NewChannelManager(l, metrics.NoopMetrics, pricer, defaultTestRollupConfig)
+	m.Clear(eth.BlockID{})
+
+	m.ObserveL1Head(eth.BlockID{Number: 1})
+	_, err := m.OverrideChannelConfig(func(cfg ChannelConfig) (ChannelConfig, error) { return cfg, nil })
+	require.NoError(err)
+	m.ObserveL1Head(eth.BlockID{Number: 2})
+	require.Equal([]uint64{1, 2}, pricer.observed)
+	require.Empty(pricer.pending)
+}
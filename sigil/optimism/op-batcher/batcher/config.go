@@ -88,13 +88,31 @@ type CLIConfig struct {
 	BatchType uint
 
 	// DataAvailabilityType is one of the values defined in op-batcher/flags/types.go and dictates
-	// the data availability type to use for posting batches, e.g. blobs vs calldata, or auto
-	// for choosing the most economic type dynamically at the start of each channel.
+	// the data availability type to use for posting batches, e.g. blobs vs calldata, auto
+	// for choosing the most economic type dynamically at the start of each channel, or priced
+	// for choosing it once a channel is ready, holding its data back while fees spike.
 	DataAvailabilityType flags.DataAvailabilityType
 
+	// DAFeeWindow is the number of recent L1 blocks whose fees are averaged by the priced
+	// data availability type.
+	DAFeeWindow uint64
+
+	// DAFeeSpikeRatio is how many times more expensive than at the average fees of the fee
+	// window submitting a channel must be for the priced data availability type to hold back
+	// its data.
+	DAFeeSpikeRatio float64
+
+	// DAMaxDelay is the maximum time the priced data availability type holds back the data
+	// of a channel. If 0, data is never held back.
+	DAMaxDelay time.Duration
+
 	// ActiveSequencerCheckDuration is the duration between checks to determine the active sequencer endpoint.
 	ActiveSequencerCheckDuration time.Duration
 
+	// JournalPath is the path of the journal of channels and their transactions, which is used to resume partly
+	// submitted channels after a restart. Journaling is disabled if empty.
+	JournalPath string
+
 	// TestUseMaxTxSizeForBlobs allows to set the blob size with MaxL1TxSize.
 	// Should only be used for testing purposes.
 	TestUseMaxTxSizeForBlobs bool
@@ -147,6 +165,14 @@ func (c *CLIConfig) Check() error {
 	if !flags.ValidDataAvailabilityType(c.DataAvailabilityType) {
 		return fmt.Errorf("unknown data availability type: %q", c.DataAvailabilityType)
 	}
+	if c.DataAvailabilityType == flags.PricedType {
+		if c.DAFeeWindow == 0 {
+			return errors.New("DAFeeWindow must be at least 1")
+		}
+		if c.DAFeeSpikeRatio < 1 {
+			return fmt.Errorf("DAFeeSpikeRatio must be at least 1: %v", c.DAFeeSpikeRatio)
+		}
+	}
 	if err := c.MetricsConfig.Check(); err != nil {
 		return err
 	}
@@ -187,6 +213,10 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		BatchType:                    ctx.Uint(flags.BatchTypeFlag.Name),
 		DataAvailabilityType:         flags.DataAvailabilityType(ctx.String(flags.DataAvailabilityTypeFlag.Name)),
 		ActiveSequencerCheckDuration: ctx.Duration(flags.ActiveSequencerCheckDurationFlag.Name),
+		JournalPath:                  ctx.String(flags.JournalPathFlag.Name),
+		DAFeeWindow:                  ctx.Uint64(flags.DAFeeWindowFlag.Name),
+		DAFeeSpikeRatio:              ctx.Float64(flags.DAFeeSpikeRatioFlag.Name),
+		DAMaxDelay:                   ctx.Duration(flags.DAMaxDelayFlag.Name),
 		TxMgrConfig:                  txmgr.ReadCLIConfig(ctx),
 		LogConfig:                    oplog.ReadCLIConfig(ctx),
 		MetricsConfig:                opmetrics.ReadCLIConfig(ctx),
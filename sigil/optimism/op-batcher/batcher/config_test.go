@@ -105,6 +105,23 @@ func TestBatcherConfig(t *testing.T) {
 			},
 			errString: "too many frames for blob transactions, max 6",
 		},
+		{
+			name: "zero DAFeeWindow for priced DA",
+			override: func(c *batcher.CLIConfig) {
+				c.DataAvailabilityType = flags.PricedType
+				c.DAFeeSpikeRatio = 1.5
+			},
+			errString: "DAFeeWindow must be at least 1",
+		},
+		{
+			name: "DAFeeSpikeRatio below 1 for priced DA",
+			override: func(c *batcher.CLIConfig) {
+				c.DataAvailabilityType = flags.PricedType
+				c.DAFeeWindow = 50
+				c.DAFeeSpikeRatio = 0.5
+			},
+			errString: "DAFeeSpikeRatio must be at least 1: 0.5",
+		},
 		{
 			name: "invalid compr ratio for ratio compressor",
 			override: func(c *batcher.CLIConfig) {
@@ -0,0 +1,299 @@
+package batcher
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+type (
+	// DAPricer is implemented by ChannelConfigProviders that choose the ChannelConfig of a channel once its data is
+	// ready to be submitted, and that can hold the data back instead.
+	DAPricer interface {
+		// PendingChannelConfig returns the ChannelConfig to submit the channel with, or whether to hold back
+		// its data until the next call.
+		PendingChannelConfig(ch PendingChannel) (cfg ChannelConfig, hold bool)
+		// ObserveL1Head records the fees at a new L1 head, so that decisions are based on the fees of every recent
+		// L1 block and not only of those at which a channel was ready.
+		ObserveL1Head(l1Head uint64)
+	}
+
+	// DAPricingPolicy decides how to submit the data of a channel to L1, given the current fees.
+	DAPricingPolicy interface {
+		// ObserveFees records the fees at the given L1 head.
+		ObserveFees(l1Head uint64, fees DAFees)
+		Decide(fees DAFees, ch PendingChannel) DADecision
+	}
+
+	// DAFees are the current L1 fees.
+	DAFees struct {
+		TipCap      *big.Int
+		BaseFee     *big.Int
+		BlobBaseFee *big.Int
+	}
+
+	// PendingChannel describes a channel whose data is ready to be submitted.
+	PendingChannel struct {
+		// L1Head is the number of the current L1 head.
+		L1Head uint64
+		// Bytes is the expected size of the channel data, which determines how full its blob or calldata
+		// transactions are.
+		Bytes int
+		// Deadline is the L1 block by which the channel data must be submitted: the end of the sequencing window
+		// of its oldest L1 origin, minus the sub-safety-margin. Data is never held back past it.
+		Deadline uint64
+		// Held is how long the data has been held back already.
+		Held time.Duration
+	}
+
+	// DADecision is the decision of a DAPricingPolicy, with the fee estimate it is based on.
+	DADecision struct {
+		UseBlobs bool
+		// Hold is whether to hold back the channel data until fees are reassessed.
+		Hold bool
+		// BlobCost and CalldataCost are the estimated costs in wei of submitting the channel as blobs or as
+		// calldata at the current fees.
+		BlobCost     *big.Int
+		CalldataCost *big.Int
+		// WindowCost is the estimated cost in wei of submitting the channel with the cheaper data availability type
+		// at the average fees of the recent L1 blocks, or nil if the policy doesn't keep a window of fees.
+		WindowCost *big.Int
+	}
+)
+
+// estimateDACosts estimates the costs in wei of submitting dataBytes of channel data as blobs with the blob config,
+// and as calldata with the calldata config. Like [DynamicEthChannelConfig], it assumes that compressed channel data
+// has few zeros, and that a calldata transaction contains exactly one frame.
+func estimateDACosts(fees DAFees, dataBytes int, blobConfig, calldataConfig ChannelConfig) (blobCost, calldataCost *big.Int) {
+	calldataPrice := new(big.Int).Add(fees.BaseFee, fees.TipCap)
+
+	numBlobs := max(1, ceilDiv(dataBytes, int(blobConfig.MaxFrameSize)))
+	numBlobTxs := ceilDiv(numBlobs, blobConfig.TargetNumFrames)
+	blobCost = new(big.Int).Mul(big.NewInt(int64(numBlobs*params.BlobTxBlobGasPerBlob)), fees.BlobBaseFee)
+	// blobs still have intrinsic calldata costs
+	blobCalldataCost := new(big.Int).Mul(big.NewInt(int64(numBlobTxs)*int64(params.TxGas)), calldataPrice)
+	blobCost.Add(blobCost, blobCalldataCost)
+
+	numFrames := max(1, ceilDiv(dataBytes, int(calldataConfig.MaxFrameSize)))
+	// + 1 version byte per frame
+	calldataGas := uint64(dataBytes+numFrames)*randomByteCalldataGas + uint64(numFrames)*params.TxGas
+	calldataCost = new(big.Int).Mul(new(big.Int).SetUint64(calldataGas), calldataPrice)
+	return blobCost, calldataCost
+}
+
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
+
+type feeSample struct {
+	l1Block     uint64
+	baseFee     *big.Int
+	blobBaseFee *big.Int
+}
+
+// WindowedDAPolicyConfig configures a WindowedDAPolicy.
+type WindowedDAPolicyConfig struct {
+	// FeeWindow is the number of recent L1 blocks whose fees are averaged.
+	FeeWindow uint64
+	// SpikeRatio is how many times more expensive than at the average fees submitting a channel must be for its
+	// data to be held back.
+	SpikeRatio float64
+	// MaxDelay is the maximum time the data of a channel is held back. If 0, data is never held back.
+	MaxDelay time.Duration
+}
+
+// WindowedDAPolicy chooses the cheaper data availability type for the expected size of a channel, and holds back the
+// channel data while submitting it is more than SpikeRatio times as expensive as at the average fees of the last
+// FeeWindow L1 blocks. Data is held back for at most MaxDelay, and never past the deadline of the channel.
+type WindowedDAPolicy struct {
+	cfg            WindowedDAPolicyConfig
+	blobConfig     ChannelConfig
+	calldataConfig ChannelConfig
+
+	// the fees of the L1 blocks in the window that were observed or at which a decision was made, oldest first
+	samples []feeSample
+}
+
+var _ DAPricingPolicy = (*WindowedDAPolicy)(nil)
+
+func NewWindowedDAPolicy(cfg WindowedDAPolicyConfig, blobConfig, calldataConfig ChannelConfig) *WindowedDAPolicy {
+	return &WindowedDAPolicy{
+		cfg:            cfg,
+		blobConfig:     blobConfig,
+		calldataConfig: calldataConfig,
+	}
+}
+
+func (p *WindowedDAPolicy) ObserveFees(l1Head uint64, fees DAFees) {
+	p.addSample(l1Head, fees)
+}
+
+func (p *WindowedDAPolicy) Decide(fees DAFees, ch PendingChannel) DADecision {
+	p.addSample(ch.L1Head, fees)
+
+	blobCost, calldataCost := estimateDACosts(fees, ch.Bytes, p.blobConfig, p.calldataConfig)
+	windowBlobCost, windowCalldataCost := estimateDACosts(p.averageFees(fees.TipCap), ch.Bytes, p.blobConfig, p.calldataConfig)
+	d := DADecision{
+		UseBlobs:     blobCost.Cmp(calldataCost) <= 0,
+		BlobCost:     blobCost,
+		CalldataCost: calldataCost,
+		WindowCost:   minBig(windowBlobCost, windowCalldataCost),
+	}
+
+	spiking := bigFloat(minBig(blobCost, calldataCost)) > bigFloat(d.WindowCost)*p.cfg.SpikeRatio
+	d.Hold = spiking && ch.Held < p.cfg.MaxDelay && ch.L1Head < ch.Deadline
+	return d
+}
+
+// addSample adds the fees at the given L1 block to the window, and drops the samples that left the window.
+func (p *WindowedDAPolicy) addSample(l1Block uint64, fees DAFees) {
+	sample := feeSample{l1Block: l1Block, baseFee: fees.BaseFee, blobBaseFee: fees.BlobBaseFee}
+	if n := len(p.samples); n > 0 && p.samples[n-1].l1Block >= l1Block {
+		// a sample at the same L1 block, or after a reorg
+		p.samples[n-1] = sample
+	} else {
+		p.samples = append(p.samples, sample)
+	}
+	i := 0
+	for i < len(p.samples) && p.samples[i].l1Block+p.cfg.FeeWindow <= l1Block {
+		i++
+	}
+	p.samples = p.samples[i:]
+}
+
+// averageFees returns the average base and blob base fees of the window, with the given tip cap.
+func (p *WindowedDAPolicy) averageFees(tipCap *big.Int) DAFees {
+	baseFee, blobBaseFee := new(big.Int), new(big.Int)
+	for _, s := range p.samples {
+		baseFee.Add(baseFee, s.baseFee)
+		blobBaseFee.Add(blobBaseFee, s.blobBaseFee)
+	}
+	n := big.NewInt(int64(len(p.samples)))
+	return DAFees{
+		TipCap:      tipCap,
+		BaseFee:     baseFee.Div(baseFee, n),
+		BlobBaseFee: blobBaseFee.Div(blobBaseFee, n),
+	}
+}
+
+func minBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) <= 0 {
+		return a
+	}
+	return b
+}
+
+// PricedChannelConfig is a ChannelConfigProvider that chooses between the blob and the calldata config with a
+// DAPricingPolicy once the data of a channel is ready, rather than when the channel is created. Every decision is
+// recorded as a metric, with the fee estimate it is based on.
+type PricedChannelConfig struct {
+	log       log.Logger
+	timeout   time.Duration // query timeout
+	gasPricer GasPricer
+	policy    DAPricingPolicy
+	metr      metrics.Metricer
+
+	blobConfig     ChannelConfig
+	calldataConfig ChannelConfig
+	lastConfig     *ChannelConfig
+}
+
+var _ DAPricer = (*PricedChannelConfig)(nil)
+
+func NewPricedChannelConfig(lgr log.Logger,
+	reqTimeout time.Duration, gasPricer GasPricer, policy DAPricingPolicy, metr metrics.Metricer,
+	blobConfig ChannelConfig, calldataConfig ChannelConfig,
+) *PricedChannelConfig {
+	pc := &PricedChannelConfig{
+		log:            lgr,
+		timeout:        reqTimeout,
+		gasPricer:      gasPricer,
+		policy:         policy,
+		metr:           metr,
+		blobConfig:     blobConfig,
+		calldataConfig: calldataConfig,
+	}
+	// start with blob config
+	pc.lastConfig = &pc.blobConfig
+	return pc
+}
+
+// ChannelConfig returns the ChannelConfig chosen by the last decision, which is used to build new channels.
+func (pc *PricedChannelConfig) ChannelConfig() ChannelConfig {
+	return *pc.lastConfig
+}
+
+// ObserveL1Head queries the current fees and records them with the policy. If the fees can't be queried, the L1 head
+// is skipped.
+func (pc *PricedChannelConfig) ObserveL1Head(l1Head uint64) {
+	ctx, cancel := context.WithTimeout(context.Background(), pc.timeout)
+	defer cancel()
+	tipCap, baseFee, blobBaseFee, err := pc.gasPricer.SuggestGasPriceCaps(ctx)
+	if err != nil {
+		pc.log.Warn("Error querying gas prices, skipping fee sample", "l1_head", l1Head, "err", err)
+		return
+	}
+	pc.policy.ObserveFees(l1Head, DAFees{TipCap: tipCap, BaseFee: baseFee, BlobBaseFee: blobBaseFee})
+}
+
+// PendingChannelConfig queries the current fees and decides with the policy how to submit the channel. If the fees
+// can't be queried, the last ChannelConfig is returned and the data isn't held back.
+func (pc *PricedChannelConfig) PendingChannelConfig(ch PendingChannel) (ChannelConfig, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), pc.timeout)
+	defer cancel()
+	tipCap, baseFee, blobBaseFee, err := pc.gasPricer.SuggestGasPriceCaps(ctx)
+	if err != nil {
+		pc.log.Warn("Error querying gas prices, returning last config", "err", err)
+		return *pc.lastConfig, false
+	}
+
+	fees := DAFees{TipCap: tipCap, BaseFee: baseFee, BlobBaseFee: blobBaseFee}
+	d := pc.policy.Decide(fees, ch)
+
+	decision := metrics.DADecisionCalldata
+	if d.Hold {
+		decision = metrics.DADecisionHold
+	} else if d.UseBlobs {
+		decision = metrics.DADecisionBlobs
+	}
+	estimate := metrics.DAFeeEstimate{
+		BaseFee:             bigFloat(baseFee),
+		BlobBaseFee:         bigFloat(blobBaseFee),
+		BlobCostPerByte:     bigFloat(d.BlobCost) / float64(max(ch.Bytes, 1)),
+		CalldataCostPerByte: bigFloat(d.CalldataCost) / float64(max(ch.Bytes, 1)),
+	}
+	if d.WindowCost != nil {
+		estimate.WindowCostPerByte = bigFloat(d.WindowCost) / float64(max(ch.Bytes, 1))
+	}
+	pc.metr.RecordDADecision(decision, estimate)
+
+	fill := float64(ch.Bytes) / float64(pc.blobConfig.MaxFrameSize*uint64(pc.blobConfig.TargetNumFrames))
+	lgr := pc.log.New("decision", decision, "base_fee", baseFee, "blob_base_fee", blobBaseFee, "tip_cap", tipCap,
+		"bytes", ch.Bytes, "fill", fill, "blob_cost", d.BlobCost, "calldata_cost", d.CalldataCost,
+		"window_cost", d.WindowCost, "l1_head", ch.L1Head, "deadline", ch.Deadline, "held", ch.Held)
+	if d.Hold {
+		if ch.Held == 0 {
+			lgr.Info("Holding back channel data while fees spike")
+		} else {
+			lgr.Debug("Still holding back channel data while fees spike")
+		}
+		return *pc.lastConfig, true
+	}
+	if d.UseBlobs {
+		lgr.Info("Using blob channel config")
+		pc.lastConfig = &pc.blobConfig
+	} else {
+		lgr.Info("Using calldata channel config")
+		pc.lastConfig = &pc.calldataConfig
+	}
+	return *pc.lastConfig, false
+}
+
+func bigFloat(x *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(x).Float64()
+	return f
+}
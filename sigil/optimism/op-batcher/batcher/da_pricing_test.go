@@ -0,0 +1,177 @@
+package batcher
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func daPricingTestConfigs() (blobCfg, calldataCfg ChannelConfig) {
+	calldataCfg = ChannelConfig{
+		MaxFrameSize:    120_000 - 1,
+		TargetNumFrames: 1,
+	}
+	blobCfg = ChannelConfig{
+		MaxFrameSize:    eth.MaxBlobDataSize - 1,
+		TargetNumFrames: 3,
+		UseBlobs:        true,
+	}
+	return blobCfg, calldataCfg
+}
+
+func daFees(baseFee, blobBaseFee int64) DAFees {
+	return DAFees{TipCap: big.NewInt(0), BaseFee: big.NewInt(baseFee), BlobBaseFee: big.NewInt(blobBaseFee)}
+}
+
+func TestWindowedDAPolicy(t *testing.T) {
+	blobCfg, calldataCfg := daPricingTestConfigs()
+	fullChannel := int(blobCfg.MaxFrameSize) * blobCfg.TargetNumFrames
+
+	t.Run("chooses by fill level", func(t *testing.T) {
+		p := NewWindowedDAPolicy(WindowedDAPolicyConfig{FeeWindow: 10, SpikeRatio: 1.5}, blobCfg, calldataCfg)
+		// A full channel is much cheaper as blobs...
+		require.True(t, p.Decide(daFees(1e9, 2e8), PendingChannel{L1Head: 100, Bytes: fullChannel}).UseBlobs)
+		// ...but a nearly empty one still pays for a whole blob.
+		require.False(t, p.Decide(daFees(1e9, 2e8), PendingChannel{L1Head: 100, Bytes: 1000}).UseBlobs)
+		require.True(t, p.Decide(daFees(1e9, 1e8), PendingChannel{L1Head: 100, Bytes: 1000}).UseBlobs)
+	})
+
+	t.Run("holds back data while fees spike", func(t *testing.T) {
+		p := NewWindowedDAPolicy(WindowedDAPolicyConfig{FeeWindow: 10, SpikeRatio: 1.5, MaxDelay: time.Minute}, blobCfg, calldataCfg)
+		// The fees are sampled at every L1 head, while no channel is ready.
+		for l1Head := uint64(100); l1Head < 105; l1Head++ {
+			p.ObserveFees(l1Head, daFees(1e9, 1e9))
+		}
+
+		ch := PendingChannel{L1Head: 105, Bytes: fullChannel, Deadline: 200}
+		p.ObserveFees(ch.L1Head, daFees(1e9, 1e10))
+		d := p.Decide(daFees(1e9, 1e10), ch)
+		require.True(t, d.Hold)
+		require.True(t, d.UseBlobs)
+		require.Equal(t, -1, d.WindowCost.Cmp(d.BlobCost))
+
+		held := ch
+		held.Held = time.Minute
+		require.False(t, p.Decide(daFees(1e9, 1e10), held).Hold, "data is held back for at most the max delay")
+		atDeadline := ch
+		atDeadline.Deadline = ch.L1Head
+		require.False(t, p.Decide(daFees(1e9, 1e10), atDeadline).Hold, "data is never held back past the deadline")
+
+		// Once the lower fees left the window, the higher fees are the new normal.
+		for l1Head := uint64(106); l1Head < 115; l1Head++ {
+			p.ObserveFees(l1Head, daFees(1e9, 1e10))
+		}
+		ch.L1Head = 115
+		require.False(t, p.Decide(daFees(1e9, 1e10), ch).Hold)
+	})
+
+	t.Run("doesn't hold back data at fees that were normal for the whole window", func(t *testing.T) {
+		p := NewWindowedDAPolicy(WindowedDAPolicyConfig{FeeWindow: 10, SpikeRatio: 1.5, MaxDelay: time.Minute}, blobCfg, calldataCfg)
+		// Without the samples of the L1 heads in between, the fees of the last decision would still set the average.
+		p.Decide(daFees(1e9, 1e9), PendingChannel{L1Head: 100, Bytes: fullChannel, Deadline: 200})
+		for l1Head := uint64(101); l1Head < 108; l1Head++ {
+			p.ObserveFees(l1Head, daFees(1e9, 1e10))
+		}
+		require.False(t, p.Decide(daFees(1e9, 1e10), PendingChannel{L1Head: 108, Bytes: fullChannel, Deadline: 200}).Hold)
+	})
+
+	t.Run("never holds back data without a max delay", func(t *testing.T) {
+		p := NewWindowedDAPolicy(WindowedDAPolicyConfig{FeeWindow: 10, SpikeRatio: 1.5}, blobCfg, calldataCfg)
+		p.Decide(daFees(1e9, 1e9), PendingChannel{L1Head: 100, Bytes: fullChannel, Deadline: 200})
+		require.False(t, p.Decide(daFees(1e9, 1e10), PendingChannel{L1Head: 101, Bytes: fullChannel, Deadline: 200}).Hold)
+	})
+}
+
+type daDecisionRecord struct {
+	decision string
+	estimate metrics.DAFeeEstimate
+}
+
+type testDAMetrics struct {
+	metrics.Metricer
+	decisions []daDecisionRecord
+}
+
+func (m *testDAMetrics) RecordDADecision(decision string, estimate metrics.DAFeeEstimate) {
+	m.decisions = append(m.decisions, daDecisionRecord{decision, estimate})
+}
+
+// fixedDAPolicy is a DAPricingPolicy that always returns the same decision.
+type fixedDAPolicy struct {
+	decision DADecision
+	observed map[uint64]DAFees
+}
+
+func (p *fixedDAPolicy) ObserveFees(l1Head uint64, fees DAFees) {
+	if p.observed == nil {
+		p.observed = make(map[uint64]DAFees)
+	}
+	p.observed[l1Head] = fees
+}
+
+func (p *fixedDAPolicy) Decide(DAFees, PendingChannel) DADecision {
+	return p.decision
+}
+
+func TestPricedChannelConfig(t *testing.T) {
+	blobCfg, calldataCfg := daPricingTestConfigs()
+	gp := &mockGasPricer{tipCap: 1, baseFee: 10, blobBaseFee: 20}
+	policy := &fixedDAPolicy{decision: DADecision{
+		UseBlobs:     false,
+		BlobCost:     big.NewInt(3000),
+		CalldataCost: big.NewInt(2000),
+		WindowCost:   big.NewInt(1000),
+	}}
+	metr := &testDAMetrics{Metricer: metrics.NoopMetrics}
+	pc := NewPricedChannelConfig(testlog.Logger(t, log.LevelCrit), time.Second, gp, policy, metr, blobCfg, calldataCfg)
+	require.True(t, pc.ChannelConfig().UseBlobs, "starts with the blob config")
+
+	cfg, hold := pc.PendingChannelConfig(PendingChannel{Bytes: 1000})
+	require.False(t, hold)
+	require.False(t, cfg.UseBlobs)
+	require.False(t, pc.ChannelConfig().UseBlobs)
+	require.Equal(t, []daDecisionRecord{{metrics.DADecisionCalldata, metrics.DAFeeEstimate{
+		BaseFee:             10,
+		BlobBaseFee:         20,
+		BlobCostPerByte:     3,
+		CalldataCostPerByte: 2,
+		WindowCostPerByte:   1,
+	}}}, metr.decisions)
+
+	// Held back data keeps the last config.
+	policy.decision.UseBlobs = true
+	policy.decision.Hold = true
+	cfg, hold = pc.PendingChannelConfig(PendingChannel{Bytes: 1000})
+	require.True(t, hold)
+	require.False(t, cfg.UseBlobs)
+	require.Equal(t, metrics.DADecisionHold, metr.decisions[1].decision)
+
+	policy.decision.Hold = false
+	cfg, hold = pc.PendingChannelConfig(PendingChannel{Bytes: 1000})
+	require.False(t, hold)
+	require.True(t, cfg.UseBlobs)
+	require.Equal(t, metrics.DADecisionBlobs, metr.decisions[2].decision)
+
+	// Without fees, the last config is used and the data isn't held back.
+	gp.err = errors.New("gp-error")
+	policy.decision.UseBlobs = false
+	policy.decision.Hold = true
+	cfg, hold = pc.PendingChannelConfig(PendingChannel{Bytes: 1000})
+	require.False(t, hold)
+	require.True(t, cfg.UseBlobs)
+	require.Len(t, metr.decisions, 3)
+
+	// New L1 heads are sampled without a decision, and skipped without fees.
+	pc.ObserveL1Head(100)
+	gp.err = nil
+	pc.ObserveL1Head(101)
+	require.Equal(t, map[uint64]DAFees{101: {TipCap: big.NewInt(1), BaseFee: big.NewInt(10), BlobBaseFee: big.NewInt(20)}}, policy.observed)
+	require.Len(t, metr.decisions, 3)
+}
@@ -11,11 +11,13 @@ import (
 	"time"
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/ethereum-optimism/optimism/op-batcher/flags"
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -63,6 +65,7 @@ func (r txRef) string(txIDStringer func(txID) string) string {
 
 type L1Client interface {
 	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
 	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
 }
 
@@ -85,6 +88,9 @@ type DriverSetup struct {
 	EndpointProvider dial.L2EndpointProvider
 	ChannelConfig    ChannelConfigProvider
 	AltDA            *altda.DAClient
+	// Journal records the channels and their transactions, so that partly submitted channels are resumed after a
+	// restart. Journaling is disabled if it is nil.
+	Journal *Journal
 }
 
 // BatchSubmitter encapsulates a service responsible for submitting L2 tx
@@ -115,9 +121,11 @@ type BatchSubmitter struct {
 
 // NewBatchSubmitter initializes the BatchSubmitter driver from a preconfigured DriverSetup
 func NewBatchSubmitter(setup DriverSetup) *BatchSubmitter {
+	state := NewChannelManager(setup.Log, setup.Metr, setup.ChannelConfig, setup.RollupConfig)
+	state.journal = setup.Journal
 	return &BatchSubmitter{
 		DriverSetup: setup,
-		state:       NewChannelManager(setup.Log, setup.Metr, setup.ChannelConfig, setup.RollupConfig),
+		state:       state,
 	}
 }
 
@@ -134,6 +142,8 @@ func (l *BatchSubmitter) StartBatchSubmitting() error {
 
 	l.shutdownCtx, l.cancelShutdownCtx = context.WithCancel(context.Background())
 	l.killCtx, l.cancelKillCtx = context.WithCancel(context.Background())
+	// Clearing the state also clears the journal, so its channels are read first.
+	journaled := l.Journal.snapshot()
 	l.clearState(l.shutdownCtx)
 	l.lastStoredBlock = eth.BlockID{}
 
@@ -144,6 +154,18 @@ func (l *BatchSubmitter) StartBatchSubmitting() error {
 		}
 	}
 
+	if len(journaled) > 0 {
+		_, err := retry.Do(l.shutdownCtx, resumeAttempts, retry.Exponential(), func() (struct{}, error) {
+			return struct{}{}, l.resumeFromJournal(l.shutdownCtx, journaled)
+		})
+		if err != nil {
+			// The journal was cleared with the state, so the blocks of its channels are submitted again.
+			l.Log.Error("Failed to resume channels from journal, starting with new channels", "err", err)
+			l.clearState(l.shutdownCtx)
+			l.lastStoredBlock = eth.BlockID{}
+		}
+	}
+
 	l.wg.Add(1)
 	go l.loop()
 
@@ -188,6 +210,131 @@ func (l *BatchSubmitter) StopBatchSubmitting(ctx context.Context) error {
 	return nil
 }
 
+// Channels returns the open and pending channels, oldest first.
+func (l *BatchSubmitter) Channels() []ChannelInfo {
+	return l.state.ChannelInfos()
+}
+
+// InFlightTxs returns the batcher txs that were sent, but aren't confirmed or failed yet.
+func (l *BatchSubmitter) InFlightTxs() []TxInfo {
+	return l.state.InFlightTxs()
+}
+
+// CloseChannel force-closes the open channel, so that its frames are submitted. It returns false if there is no
+// open channel.
+func (l *BatchSubmitter) CloseChannel() (bool, error) {
+	return l.state.CloseCurrentChannel()
+}
+
+// CurrentChannelConfig returns the configuration of the next channels.
+func (l *BatchSubmitter) CurrentChannelConfig() ChannelConfigInfo {
+	cfg, overridden := l.state.ChannelConfig()
+	return channelConfigInfo(cfg, overridden)
+}
+
+// SetChannelConfig overrides the configuration of the next channels with the update applied to the current
+// configuration. Switching the DA type also switches the max frame size to the max of the DA type.
+func (l *BatchSubmitter) SetChannelConfig(update ChannelConfigUpdate) (ChannelConfigInfo, error) {
+	cfg, err := l.state.OverrideChannelConfig(func(cfg ChannelConfig) (ChannelConfig, error) {
+		return l.updateChannelConfig(cfg, update)
+	})
+	if err != nil {
+		return ChannelConfigInfo{}, err
+	}
+	return channelConfigInfo(cfg, true), nil
+}
+
+// updateChannelConfig returns the channel configuration with the update applied.
+func (l *BatchSubmitter) updateChannelConfig(cfg ChannelConfig, update ChannelConfigUpdate) (ChannelConfig, error) {
+	now := uint64(time.Now().Unix())
+	if update.DAType != nil {
+		switch flags.DataAvailabilityType(*update.DAType) {
+		case flags.BlobsType:
+			if l.Config.UseAltDA {
+				return ChannelConfig{}, errors.New("cannot use blobs with alt DA")
+			}
+			if !l.RollupConfig.IsEcotone(now) {
+				return ChannelConfig{}, errors.New("cannot use blobs before Ecotone")
+			}
+			if !cfg.UseBlobs {
+				cfg.UseBlobs = true
+				cfg.MaxFrameSize = eth.MaxBlobDataSize - 1 // account for version byte prefix
+			}
+		case flags.CalldataType:
+			if cfg.UseBlobs {
+				cfg.UseBlobs = false
+				cfg.MaxFrameSize = CalldataMaxFrameSize
+				cfg.TargetNumFrames = 1
+			}
+		default:
+			return ChannelConfig{}, fmt.Errorf("unknown data availability type %q, must be %s or %s", *update.DAType, flags.BlobsType, flags.CalldataType)
+		}
+	}
+	if update.MaxFrameSize != nil {
+		cfg.MaxFrameSize = *update.MaxFrameSize
+	}
+	if update.TargetNumFrames != nil {
+		cfg.TargetNumFrames = *update.TargetNumFrames
+	}
+	if update.MaxChannelDuration != nil {
+		cfg.MaxChannelDuration = *update.MaxChannelDuration
+	}
+	if update.SubSafetyMargin != nil {
+		cfg.SubSafetyMargin = *update.SubSafetyMargin
+	}
+	if update.MaxBlocksPerSpanBatch != nil {
+		cfg.MaxBlocksPerSpanBatch = *update.MaxBlocksPerSpanBatch
+	}
+	if update.CompressionAlgo != nil {
+		if err := cfg.CompressorConfig.CompressionAlgo.Set(*update.CompressionAlgo); err != nil {
+			return ChannelConfig{}, err
+		}
+		if cfg.CompressorConfig.CompressionAlgo.IsBrotli() && !l.RollupConfig.IsFjord(now) {
+			return ChannelConfig{}, errors.New("cannot use brotli compression before Fjord")
+		}
+		if cfg.CompressorConfig.CompressionAlgo == derive.Zstd && !l.RollupConfig.IsZstd(now) {
+			return ChannelConfig{}, errors.New("cannot use zstd compression before it is enabled in the rollup config")
+		}
+	}
+	if update.ApproxComprRatio != nil {
+		cfg.CompressorConfig.ApproxComprRatio = *update.ApproxComprRatio
+	}
+	cfg.ReinitCompressorConfig()
+
+	if err := cfg.Check(); err != nil {
+		return ChannelConfig{}, fmt.Errorf("invalid channel configuration: %w", err)
+	}
+	if l.Config.UseAltDA && cfg.MaxFrameSize > altda.MaxInputSize {
+		return ChannelConfig{}, fmt.Errorf("max frame size %d exceeds altDA max input size %d", cfg.MaxFrameSize, altda.MaxInputSize)
+	}
+	return cfg, nil
+}
+
+// ResetChannelConfig removes the override of the channel configuration.
+func (l *BatchSubmitter) ResetChannelConfig() ChannelConfigInfo {
+	return channelConfigInfo(l.state.ResetChannelConfig(), false)
+}
+
+func channelConfigInfo(cfg ChannelConfig, overridden bool) ChannelConfigInfo {
+	daType := flags.CalldataType
+	if cfg.UseBlobs {
+		daType = flags.BlobsType
+	}
+	return ChannelConfigInfo{
+		DAType:                daType.String(),
+		MaxFrameSize:          cfg.MaxFrameSize,
+		TargetNumFrames:       cfg.TargetNumFrames,
+		MaxChannelDuration:    cfg.MaxChannelDuration,
+		SubSafetyMargin:       cfg.SubSafetyMargin,
+		MaxBlocksPerSpanBatch: cfg.MaxBlocksPerSpanBatch,
+		BatchType:             cfg.BatchType,
+		Compressor:            cfg.CompressorConfig.Kind,
+		CompressionAlgo:       cfg.CompressorConfig.CompressionAlgo.String(),
+		ApproxComprRatio:      cfg.CompressorConfig.ApproxComprRatio,
+		Overridden:            overridden,
+	}
+}
+
 // loadBlocksIntoState loads all blocks since the previous stored block
 // It does the following:
 //  1. Fetch the sync status of the sequencer
@@ -544,6 +691,7 @@ func (l *BatchSubmitter) publishTxToL1(ctx context.Context, queue *txmgr.Queue[t
 		l.Log.Error("Unable to get tx data", "err", err)
 		return err
 	}
+	l.Journal.txSent(txdata, l1tip.Number)
 
 	if err = l.sendTransaction(txdata, queue, receiptsCh, daGroup); err != nil {
 		return fmt.Errorf("BatchSubmitter.sendTransaction failed: %w", err)
@@ -715,23 +863,27 @@ func (l *BatchSubmitter) recordL1Tip(l1tip eth.L1BlockRef) {
 	}
 	l.lastL1Tip = l1tip
 	l.Metr.RecordLatestL1Block(l1tip)
+	l.state.ObserveL1Head(l1tip.ID())
 }
 
 func (l *BatchSubmitter) recordFailedDARequest(id txID, err error) {
 	if err != nil {
 		l.Log.Warn("DA request failed", logFields(id, err)...)
 	}
+	l.Journal.txFailed(id)
 	l.state.TxFailed(id)
 }
 
 func (l *BatchSubmitter) recordFailedTx(id txID, err error) {
 	l.Log.Warn("Transaction failed to send", logFields(id, err)...)
+	l.Journal.txFailed(id)
 	l.state.TxFailed(id)
 }
 
 func (l *BatchSubmitter) recordConfirmedTx(id txID, receipt *types.Receipt) {
 	l.Log.Info("Transaction confirmed", logFields(id, receipt)...)
 	l1block := eth.ReceiptBlockID(receipt)
+	l.Journal.txConfirmed(id, receipt.TxHash, l1block)
 	l.state.TxConfirmed(id, l1block)
 }
 
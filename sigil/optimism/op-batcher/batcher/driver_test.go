@@ -118,3 +118,41 @@ func TestBatchSubmitter_SafeL1Origin_FailsToResolveRollupClient(t *testing.T) {
 	_, err := bs.safeL1Origin(context.Background())
 	require.Error(t, err)
 }
+
+func TestBatchSubmitter_SetChannelConfig(t *testing.T) {
+	bs, _ := setup(t)
+	ptr := func(s string) *string { return &s }
+
+	current := bs.CurrentChannelConfig()
+	require.Equal(t, "calldata", current.DAType)
+	require.False(t, current.Overridden)
+
+	_, err := bs.SetChannelConfig(ChannelConfigUpdate{DAType: ptr("blobs")})
+	require.ErrorContains(t, err, "before Ecotone")
+	_, err = bs.SetChannelConfig(ChannelConfigUpdate{DAType: ptr("auto")})
+	require.ErrorContains(t, err, "unknown data availability type")
+	zero := 0
+	_, err = bs.SetChannelConfig(ChannelConfigUpdate{TargetNumFrames: &zero})
+	require.ErrorContains(t, err, "invalid channel configuration")
+	require.False(t, bs.CurrentChannelConfig().Overridden, "failed updates must not override the config")
+
+	rollupCfg := *bs.RollupConfig
+	rollupCfg.EcotoneTime = new(uint64)
+	bs.RollupConfig = &rollupCfg
+	numFrames := 3
+	updated, err := bs.SetChannelConfig(ChannelConfigUpdate{DAType: ptr("blobs"), TargetNumFrames: &numFrames})
+	require.NoError(t, err)
+	require.Equal(t, "blobs", updated.DAType)
+	require.Equal(t, uint64(eth.MaxBlobDataSize-1), updated.MaxFrameSize)
+	require.Equal(t, 3, updated.TargetNumFrames)
+	require.True(t, updated.Overridden)
+	require.Equal(t, updated, bs.CurrentChannelConfig())
+
+	updated, err = bs.SetChannelConfig(ChannelConfigUpdate{DAType: ptr("calldata")})
+	require.NoError(t, err)
+	require.Equal(t, uint64(CalldataMaxFrameSize), updated.MaxFrameSize)
+	require.Equal(t, 1, updated.TargetNumFrames)
+
+	require.Equal(t, current, bs.ResetChannelConfig())
+	require.Equal(t, current, bs.CurrentChannelConfig())
+}
@@ -0,0 +1,437 @@
+package batcher
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// minCompactSize is the size the journal file may grow to before it is compacted.
+const minCompactSize = 16 << 20
+
+const (
+	recordFrames    = "frames"
+	recordClosed    = "closed"
+	recordRemoved   = "removed"
+	recordChannel   = "channel"
+	recordTxSent    = "txSent"
+	recordConfirmed = "txConfirmed"
+	recordFailed    = "txFailed"
+)
+
+// journalRecord is a line of the journal file. Which fields are set depends on the type of the record.
+type journalRecord struct {
+	Type      string           `json:"type"`
+	ChannelID derive.ChannelID `json:"channelID"`
+	// Channel is the full state of a channel, written when the journal is compacted.
+	Channel  *journalChannel `json:"channel,omitempty"`
+	UseBlobs bool            `json:"useBlobs,omitempty"`
+	Frames   []journalFrame  `json:"frames,omitempty"`
+	Blocks   []eth.BlockID   `json:"blocks,omitempty"`
+	TxID     string          `json:"txID,omitempty"`
+	Tx       *journalTx      `json:"tx,omitempty"`
+}
+
+type journalFrame struct {
+	Number uint16 `json:"number"`
+	Data   []byte `json:"data"`
+}
+
+// journalChannel is a channel as recorded in the journal.
+type journalChannel struct {
+	ID       derive.ChannelID `json:"id"`
+	UseBlobs bool             `json:"useBlobs"`
+	// Closed is set once all the frames of the channel were output, and Blocks are the L2 blocks of the channel.
+	Closed bool          `json:"closed"`
+	Blocks []eth.BlockID `json:"blocks,omitempty"`
+	// Frames are the frames output so far, ordered by frame number.
+	Frames []journalFrame `json:"frames"`
+	// Txs are the transactions sent with frames of the channel, by txID, that didn't fail.
+	Txs map[string]*journalTx `json:"txs"`
+}
+
+// journalTx is a transaction sent with frames of a channel.
+type journalTx struct {
+	Frames []uint16 `json:"frames"`
+	AsBlob bool     `json:"asBlob"`
+	// SentAt is the L1 head when the transaction was handed to the tx manager.
+	SentAt uint64 `json:"sentAt"`
+	// Hash and Block are set once the transaction is confirmed.
+	Hash  *common.Hash `json:"hash,omitempty"`
+	Block *eth.BlockID `json:"block,omitempty"`
+}
+
+func (c *journalChannel) addFrames(frames []journalFrame) {
+	for _, f := range frames {
+		i := sort.Search(len(c.Frames), func(i int) bool { return c.Frames[i].Number >= f.Number })
+		if i < len(c.Frames) && c.Frames[i].Number == f.Number {
+			continue
+		}
+		c.Frames = append(c.Frames, journalFrame{})
+		copy(c.Frames[i+1:], c.Frames[i:])
+		c.Frames[i] = f
+	}
+}
+
+// frame returns the data of the frame with the given number, or nil if it isn't known.
+func (c *journalChannel) frame(number uint16) []byte {
+	i := sort.Search(len(c.Frames), func(i int) bool { return c.Frames[i].Number >= number })
+	if i < len(c.Frames) && c.Frames[i].Number == number {
+		return c.Frames[i].Data
+	}
+	return nil
+}
+
+// txData returns the txData of the transaction, or false if a frame of it isn't known.
+func (c *journalChannel) txData(tx *journalTx) (txData, bool) {
+	td := txData{asBlob: tx.AsBlob}
+	for _, n := range tx.Frames {
+		data := c.frame(n)
+		if data == nil {
+			return txData{}, false
+		}
+		td.frames = append(td.frames, frameData{id: frameID{chID: c.ID, frameNumber: n}, data: data})
+	}
+	return td, true
+}
+
+func (c *journalChannel) copy() *journalChannel {
+	cp := *c
+	cp.Blocks = append([]eth.BlockID(nil), c.Blocks...)
+	cp.Frames = append([]journalFrame(nil), c.Frames...)
+	cp.Txs = make(map[string]*journalTx, len(c.Txs))
+	for id, tx := range c.Txs {
+		txCopy := *tx
+		cp.Txs[id] = &txCopy
+	}
+	return &cp
+}
+
+// Journal is an append-only log of the channels of the batcher, their frames and the transactions they were
+// submitted with. It allows a restarted batcher to resume submitting the channels that were only partly submitted,
+// instead of submitting their blocks again in new channels.
+//
+// Once the journal file grew to twice its size after the last compaction, and to at least minCompactSize, it is
+// rewritten with the channels that are still pending, so it only grows with the frames of the pending channels.
+// Records aren't synced to disk individually: losing the last records in a crash only means that some data may be
+// submitted twice after a restart. For the same reason, writing to the journal is best-effort and errors are logged.
+//
+// All methods are safe to call on a nil Journal, and then do nothing.
+type Journal struct {
+	mu   sync.Mutex
+	log  log.Logger
+	path string
+	f    *os.File
+
+	// size is the size of the journal file, and compactedSize its size after the last compaction.
+	size, compactedSize int64
+	// compactSize is the minimum size of the journal file before it is compacted.
+	compactSize int64
+
+	// channels are the channels in the journal, in the order they were opened
+	channels []*journalChannel
+}
+
+// OpenJournal opens the journal at the given path, creating it if it doesn't exist yet, and loads the channels
+// recorded in it.
+func OpenJournal(path string, log log.Logger) (*Journal, error) {
+	j := &Journal{log: log, path: path, compactSize: minCompactSize}
+	if err := j.load(); err != nil {
+		return nil, fmt.Errorf("loading journal %s: %w", path, err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening journal %s: %w", path, err)
+	}
+	j.f = f
+	// The journal is rewritten right away, to drop a partly written last record that new records would be appended to.
+	if err := j.compact(); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("compacting journal %s: %w", path, err)
+	}
+	log.Info("Opened batcher journal", "path", path, "channels", len(j.channels))
+	return j, nil
+}
+
+func (j *Journal) load() error {
+	data, err := os.ReadFile(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for line := 1; scanner.Scan(); line++ {
+		var rec journalRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			// The last record may only be partly written if the batcher was killed while writing it.
+			j.log.Warn("Ignoring invalid journal record", "line", line, "err", err)
+			continue
+		}
+		j.apply(&rec)
+	}
+	return scanner.Err()
+}
+
+// Close closes the journal file.
+func (j *Journal) Close() error {
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.f == nil {
+		return nil
+	}
+	err := j.f.Sync()
+	if cerr := j.f.Close(); err == nil {
+		err = cerr
+	}
+	j.f = nil
+	return err
+}
+
+func (j *Journal) channel(id derive.ChannelID) *journalChannel {
+	for _, ch := range j.channels {
+		if ch.ID == id {
+			return ch
+		}
+	}
+	return nil
+}
+
+// apply updates the channels of the journal with the record.
+func (j *Journal) apply(rec *journalRecord) {
+	ch := j.channel(rec.ChannelID)
+	switch rec.Type {
+	case recordChannel:
+		if ch == nil && rec.Channel != nil {
+			if rec.Channel.Txs == nil {
+				rec.Channel.Txs = make(map[string]*journalTx)
+			}
+			j.channels = append(j.channels, rec.Channel)
+		}
+	case recordFrames:
+		if ch == nil {
+			ch = &journalChannel{ID: rec.ChannelID, UseBlobs: rec.UseBlobs, Txs: make(map[string]*journalTx)}
+			j.channels = append(j.channels, ch)
+		}
+		ch.addFrames(rec.Frames)
+	case recordClosed:
+		if ch != nil {
+			ch.Closed = true
+			ch.Blocks = rec.Blocks
+		}
+	case recordRemoved:
+		for i, c := range j.channels {
+			if c == ch {
+				j.channels = append(j.channels[:i], j.channels[i+1:]...)
+				break
+			}
+		}
+	case recordTxSent:
+		if ch != nil && rec.Tx != nil {
+			ch.Txs[rec.TxID] = rec.Tx
+		}
+	case recordConfirmed:
+		if ch == nil || rec.Tx == nil {
+			break
+		}
+		if tx, ok := ch.Txs[rec.TxID]; ok {
+			tx.Hash, tx.Block = rec.Tx.Hash, rec.Tx.Block
+		}
+	case recordFailed:
+		if ch != nil {
+			delete(ch.Txs, rec.TxID)
+		}
+	}
+}
+
+// record applies the record and appends it to the journal file.
+func (j *Journal) record(rec *journalRecord) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.recordLocked(rec)
+}
+
+func (j *Journal) recordLocked(rec *journalRecord) {
+	j.apply(rec)
+	if err := j.write(rec); err != nil {
+		j.log.Error("Failed to write batcher journal record", "type", rec.Type, "channel", rec.ChannelID, "err", err)
+		return
+	}
+	if j.size >= j.compactSize && j.size >= 2*j.compactedSize {
+		if err := j.compact(); err != nil {
+			j.log.Error("Failed to compact batcher journal", "err", err)
+		}
+	}
+}
+
+func (j *Journal) write(recs ...*journalRecord) error {
+	if j.f == nil {
+		return errors.New("journal is closed")
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, rec := range recs {
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+	}
+	n, err := j.f.Write(buf.Bytes())
+	j.size += int64(n)
+	return err
+}
+
+// compact rewrites the journal file with the current channels.
+func (j *Journal) compact() error {
+	if j.f == nil {
+		return errors.New("journal is closed")
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(j.path), filepath.Base(j.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, ch := range j.channels {
+		if err := enc.Encode(&journalRecord{Type: recordChannel, ChannelID: ch.ID, Channel: ch}); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), j.path); err != nil {
+		return err
+	}
+	if err := j.f.Close(); err != nil {
+		j.log.Warn("Failed to close previous batcher journal file", "err", err)
+	}
+	j.size, j.compactedSize = int64(buf.Len()), int64(buf.Len())
+	j.f, err = os.OpenFile(j.path, os.O_APPEND|os.O_WRONLY, 0o644)
+	return err
+}
+
+// snapshot returns a copy of the channels of the journal, in the order they were opened.
+func (j *Journal) snapshot() []*journalChannel {
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	chs := make([]*journalChannel, 0, len(j.channels))
+	for _, ch := range j.channels {
+		chs = append(chs, ch.copy())
+	}
+	return chs
+}
+
+// reset replaces the channels of the journal with the given ones.
+func (j *Journal) reset(chs []*journalChannel) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.channels = make([]*journalChannel, 0, len(chs))
+	for _, ch := range chs {
+		j.channels = append(j.channels, ch.copy())
+	}
+	if err := j.compact(); err != nil {
+		j.log.Error("Failed to rewrite batcher journal", "err", err)
+	}
+}
+
+// channelFrames records frames that were output by a channel.
+func (j *Journal) channelFrames(id derive.ChannelID, useBlobs bool, frames []frameData) {
+	if j == nil || len(frames) == 0 {
+		return
+	}
+	rec := &journalRecord{Type: recordFrames, ChannelID: id, UseBlobs: useBlobs}
+	for _, f := range frames {
+		rec.Frames = append(rec.Frames, journalFrame{Number: f.id.frameNumber, Data: f.data})
+	}
+	j.record(rec)
+}
+
+// channelClosed records that all the frames of a channel were output, and the blocks of the channel.
+func (j *Journal) channelClosed(id derive.ChannelID, blocks []*types.Block) {
+	if j == nil {
+		return
+	}
+	rec := &journalRecord{Type: recordClosed, ChannelID: id, Blocks: make([]eth.BlockID, 0, len(blocks))}
+	for _, b := range blocks {
+		rec.Blocks = append(rec.Blocks, eth.ToBlockID(b))
+	}
+	j.record(rec)
+}
+
+// channelRemoved records that a channel was removed, because it was fully submitted, timed out or dropped.
+func (j *Journal) channelRemoved(id derive.ChannelID) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.channel(id) == nil {
+		return
+	}
+	j.recordLocked(&journalRecord{Type: recordRemoved, ChannelID: id})
+}
+
+// clear removes all channels from the journal.
+func (j *Journal) clear() {
+	j.reset(nil)
+}
+
+// txSent records a transaction with the given frames, sent when the L1 head was at the given block.
+func (j *Journal) txSent(data txData, l1Head uint64) {
+	if j == nil || len(data.frames) == 0 {
+		return
+	}
+	tx := &journalTx{AsBlob: data.asBlob, SentAt: l1Head}
+	for _, f := range data.frames {
+		tx.Frames = append(tx.Frames, f.id.frameNumber)
+	}
+	j.record(&journalRecord{Type: recordTxSent, ChannelID: data.frames[0].id.chID, TxID: data.ID().String(), Tx: tx})
+}
+
+// txConfirmed records that a transaction was included in the given L1 block.
+func (j *Journal) txConfirmed(id txID, hash common.Hash, block eth.BlockID) {
+	if j == nil || len(id) == 0 {
+		return
+	}
+	j.record(&journalRecord{Type: recordConfirmed, ChannelID: id[0].chID, TxID: id.String(), Tx: &journalTx{Hash: &hash, Block: &block}})
+}
+
+// txFailed records that a transaction failed, so that its frames are pending again.
+func (j *Journal) txFailed(id txID) {
+	if j == nil || len(id) == 0 {
+		return
+	}
+	j.record(&journalRecord{Type: recordFailed, ChannelID: id[0].chID, TxID: id.String()})
+}
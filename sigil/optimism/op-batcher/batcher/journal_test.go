@@ -0,0 +1,250 @@
+package batcher
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	derivetest "github.com/ethereum-optimism/optimism/op-node/rollup/derive/test"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func testFrame(id derive.ChannelID, n uint16) frameData {
+	return frameData{id: frameID{chID: id, frameNumber: n}, data: []byte{byte(n), 0xaa, 0xbb}}
+}
+
+func openTestJournal(t *testing.T, path string) *Journal {
+	j, err := OpenJournal(path, testlog.Logger(t, log.LevelCrit))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, j.Close()) })
+	return j
+}
+
+func TestJournal_Replay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	rng := rand.New(rand.NewSource(1))
+	block := derivetest.RandomL2BlockWithChainId(rng, 1, defaultTestRollupConfig.L2ChainID)
+	chA, chB := derive.ChannelID{0xa}, derive.ChannelID{0xb}
+
+	j := openTestJournal(t, path)
+	j.channelFrames(chA, true, []frameData{testFrame(chA, 0), testFrame(chA, 1)})
+	j.channelFrames(chB, false, []frameData{testFrame(chB, 0)})
+	j.channelClosed(chA, []*types.Block{block})
+	sent0 := txData{frames: []frameData{testFrame(chA, 0)}, asBlob: true}
+	sent1 := txData{frames: []frameData{testFrame(chA, 1)}, asBlob: true}
+	j.txSent(sent0, 10)
+	j.txSent(sent1, 11)
+	j.txConfirmed(sent0.ID(), common.Hash{0x1}, eth.BlockID{Number: 12, Hash: common.Hash{0x12}})
+	j.txFailed(sent1.ID())
+	require.NoError(t, j.Close())
+
+	// A partly written last record is ignored.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"type":"txSent","chan`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	check := func(j *Journal, channels int) {
+		chs := j.snapshot()
+		require.Len(t, chs, channels)
+		a := chs[0]
+		require.Equal(t, chA, a.ID)
+		require.True(t, a.UseBlobs)
+		require.True(t, a.Closed)
+		require.Equal(t, []eth.BlockID{eth.ToBlockID(block)}, a.Blocks)
+		require.Equal(t, []journalFrame{{Number: 0, Data: testFrame(chA, 0).data}, {Number: 1, Data: testFrame(chA, 1).data}}, a.Frames)
+		require.Len(t, a.Txs, 1)
+		tx := a.Txs[sent0.ID().String()]
+		require.Equal(t, []uint16{0}, tx.Frames)
+		require.Equal(t, uint64(10), tx.SentAt)
+		require.Equal(t, common.Hash{0x1}, *tx.Hash)
+		require.Equal(t, eth.BlockID{Number: 12, Hash: common.Hash{0x12}}, *tx.Block)
+		data, ok := a.txData(tx)
+		require.True(t, ok)
+		require.Equal(t, sent0, data)
+	}
+	j = openTestJournal(t, path)
+	check(j, 2)
+
+	j.channelRemoved(chB)
+	check(j, 1)
+	require.NoError(t, j.Close())
+	check(openTestJournal(t, path), 1)
+
+	j = openTestJournal(t, path)
+	j.clear()
+	require.Empty(t, j.snapshot())
+	require.Empty(t, openTestJournal(t, path).snapshot())
+}
+
+func TestJournal_Compact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	chA := derive.ChannelID{0xa}
+
+	j := openTestJournal(t, path)
+	j.compactSize = 1024
+	fileSize := func() int64 {
+		fi, err := os.Stat(path)
+		require.NoError(t, err)
+		return fi.Size()
+	}
+	for i := 0; i < 100; i++ {
+		id := derive.ChannelID{byte(i)}
+		j.channelFrames(id, false, []frameData{testFrame(id, 0)})
+		j.channelRemoved(id)
+		// Removed channels are only dropped from the file once it grew past the compaction size.
+		require.Less(t, fileSize(), int64(1024)+512)
+	}
+	j.channelFrames(chA, false, []frameData{testFrame(chA, 0)})
+	require.NoError(t, j.Close())
+
+	chs := openTestJournal(t, path).snapshot()
+	require.Len(t, chs, 1)
+	require.Equal(t, chA, chs[0].ID)
+}
+
+func TestChannelManager_Journal(t *testing.T) {
+	require := require.New(t)
+	j := openTestJournal(t, filepath.Join(t.TempDir(), "journal"))
+	cfg := channelManagerTestConfig(100, derive.SingularBatchType)
+	m := NewChannelManager(testlog.Logger(t, log.LevelCrit), metrics.NoopMetrics, cfg, defaultTestRollupConfig)
+	m.journal = j
+	m.Clear(eth.BlockID{})
+
+	rng := rand.New(rand.NewSource(99))
+	block := derivetest.RandomL2BlockWithChainId(rng, 4, defaultTestRollupConfig.L2ChainID)
+	require.NoError(m.AddL2Block(block))
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+	_, err = m.CloseCurrentChannel()
+	require.NoError(err)
+
+	chs := j.snapshot()
+	require.Len(chs, 1)
+	require.Equal(m.currentChannel.ID(), chs[0].ID)
+	require.True(chs[0].Closed)
+	require.Equal([]eth.BlockID{eth.ToBlockID(block)}, chs[0].Blocks)
+	require.Len(chs[0].Frames, m.currentChannel.TotalFrames())
+	require.Equal(txdata.frames[0].data, chs[0].frame(0))
+
+	// Channels are removed from the journal once they are dropped.
+	m.TxFailed(txdata.ID())
+	m.Requeue(m.defaultCfg)
+	require.Empty(j.snapshot())
+}
+
+// fakeL1Client is an L1 chain of empty blocks up to a head.
+type fakeL1Client struct {
+	head uint64
+}
+
+func (c *fakeL1Client) header(n uint64) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(n), Difficulty: common.Big0}
+}
+
+func (c *fakeL1Client) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		return c.header(c.head), nil
+	}
+	return c.header(number.Uint64()), nil
+}
+
+func (c *fakeL1Client) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	h, _ := c.HeaderByNumber(ctx, number)
+	return types.NewBlockWithHeader(h), nil
+}
+
+func (c *fakeL1Client) NonceAt(context.Context, common.Address, *big.Int) (uint64, error) {
+	return 0, nil
+}
+
+func TestBatchSubmitter_ResumeFromJournal(t *testing.T) {
+	l1 := &fakeL1Client{}
+	rng := rand.New(rand.NewSource(1))
+	block := derivetest.RandomL2BlockWithChainId(rng, 1, defaultTestRollupConfig.L2ChainID)
+	chID := derive.ChannelID{0xc}
+	sent0 := txData{frames: []frameData{testFrame(chID, 0)}}
+	sent1 := txData{frames: []frameData{testFrame(chID, 1)}}
+
+	// The channel has two frames. The first one was confirmed in L1 block 100, and the second one was sent at L1
+	// block 101, but never included.
+	setupResume := func(t *testing.T, l1Head uint64) (*BatchSubmitter, *Journal) {
+		bs, ep := setup(t)
+		j := openTestJournal(t, filepath.Join(t.TempDir(), "journal"))
+		bs.Journal, bs.state.journal = j, j
+		l1.head = l1Head
+		bs.L1Client = l1
+		ep.rollupClient.ExpectSyncStatus(&eth.SyncStatus{
+			SafeL2: eth.L2BlockRef{Hash: block.ParentHash(), Number: block.NumberU64() - 1},
+		}, nil)
+		ep.ethClient.ExpectBlockByNumber(block.Number(), block, nil)
+
+		j.channelFrames(chID, false, []frameData{testFrame(chID, 0), testFrame(chID, 1)})
+		j.channelClosed(chID, []*types.Block{block})
+		j.txSent(sent0, 99)
+		j.txConfirmed(sent0.ID(), common.Hash{0x1}, eth.HeaderBlockID(l1.header(100)))
+		j.txSent(sent1, 101)
+		return bs, j
+	}
+
+	t.Run("Resumed", func(t *testing.T) {
+		bs, j := setupResume(t, 102)
+		journaled := j.snapshot()
+		bs.state.Clear(eth.BlockID{})
+		require.NoError(t, bs.resumeFromJournal(context.Background(), journaled))
+
+		require.Equal(t, eth.ToBlockID(block), bs.lastStoredBlock)
+		require.Equal(t, block.Hash(), bs.state.tip)
+		require.Len(t, bs.state.channelQueue, 1)
+		ch := bs.state.channelQueue[0]
+		require.Equal(t, chID, ch.ID())
+		require.True(t, ch.IsFull())
+		require.Equal(t, 1, ch.PendingFrames())
+		require.Equal(t, uint64(100+bs.state.defaultCfg.ChannelTimeout-bs.state.defaultCfg.SubSafetyMargin), ch.Timeout())
+
+		// Only the frame that wasn't included is submitted again.
+		txdata, err := bs.state.TxData(eth.BlockID{Number: 102})
+		require.NoError(t, err)
+		require.Equal(t, sent1.ID(), txdata.ID())
+
+		// The journal only keeps the confirmed tx.
+		chs := j.snapshot()
+		require.Len(t, chs, 1)
+		require.Len(t, chs[0].Txs, 1)
+		require.Contains(t, chs[0].Txs, sent0.ID().String())
+	})
+
+	t.Run("TimedOut", func(t *testing.T) {
+		bs, j := setupResume(t, 100+36)
+		journaled := j.snapshot()
+		bs.state.Clear(eth.BlockID{})
+		require.NoError(t, bs.resumeFromJournal(context.Background(), journaled))
+
+		require.Equal(t, eth.BlockID{}, bs.lastStoredBlock)
+		require.Empty(t, bs.state.channelQueue)
+		require.Empty(t, j.snapshot())
+	})
+
+	t.Run("NoBlocks", func(t *testing.T) {
+		bs, j := setupResume(t, 102)
+		journaled := j.snapshot()
+		journaled[0].Blocks = nil
+		bs.state.Clear(eth.BlockID{})
+		require.NoError(t, bs.resumeFromJournal(context.Background(), journaled))
+
+		require.Equal(t, eth.BlockID{}, bs.lastStoredBlock)
+		require.Empty(t, bs.state.channelQueue)
+		require.Empty(t, j.snapshot())
+	})
+}
@@ -0,0 +1,327 @@
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// resumeAttempts is how often resuming the channels of the journal is tried, before the batcher starts with new
+// channels instead.
+const resumeAttempts = 5
+
+// resumedChannels are the channels of the journal that a restarted batcher continues with.
+type resumedChannels struct {
+	// channels are the channels with frames left to submit, and journal are the same channels as recorded in the
+	// journal, with their transactions reconciled with L1.
+	channels []*channel
+	journal  []*journalChannel
+	// tip is the last L2 block of the resumed and fully submitted channels. Blocks are loaded after it.
+	tip eth.BlockID
+}
+
+// resumeCandidate is a channel of the journal whose blocks are still canonical and that may still land on L1.
+type resumeCandidate struct {
+	journal *journalChannel
+	blocks  []*types.Block
+}
+
+// resumeFromJournal restores the channels of the journal that were only partly submitted into the channel manager,
+// so that only their remaining frames are submitted, and makes the batcher load L2 blocks after them.
+// It must be called after the state was cleared. The journaled channels aren't modified, so it can be retried with
+// the same channels if it fails.
+func (l *BatchSubmitter) resumeFromJournal(ctx context.Context, journaled []*journalChannel) error {
+	chs := make([]*journalChannel, 0, len(journaled))
+	for _, jch := range journaled {
+		chs = append(chs, jch.copy())
+	}
+	resumed, err := l.reconcileJournal(ctx, chs)
+	if err != nil {
+		// Keep the journal while resuming is retried.
+		l.Journal.reset(journaled)
+		return err
+	}
+	l.Journal.reset(resumed.journal)
+	if resumed.tip == (eth.BlockID{}) {
+		l.Log.Info("No channels of the journal can be resumed")
+		return nil
+	}
+	l.state.Restore(resumed.channels, resumed.tip)
+	l.lastStoredBlock = resumed.tip
+	l.Log.Info("Resumed channels from journal", "channels", len(resumed.channels), "tip", resumed.tip)
+	return nil
+}
+
+// reconcileJournal checks the channels of the journal against L1 and L2, and returns the channels that can be
+// resumed. Channels are resumed in order, and the channels after one that can't be resumed are dropped, so that their
+// blocks are submitted again in new channels. A channel can be resumed if it was closed, its blocks extend the L2 safe
+// head or the previous channel, and its remaining frames can still land before the channel times out.
+//
+// Transactions of the journal that aren't known to be included in a canonical L1 block are looked up on L1. If they
+// aren't found, they are considered failed and their frames are submitted again.
+func (l *BatchSubmitter) reconcileJournal(ctx context.Context, journaled []*journalChannel) (*resumedChannels, error) {
+	rollupClient, err := l.EndpointProvider.RollupClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting rollup client: %w", err)
+	}
+	l2Client, err := l.EndpointProvider.EthClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting L2 client: %w", err)
+	}
+	cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+	syncStatus, err := rollupClient.SyncStatus(cCtx)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("getting sync status: %w", err)
+	}
+	l1Head, err := l.l1Tip(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg, _ := l.state.ChannelConfig()
+	if cfg.ChannelTimeout <= cfg.SubSafetyMargin {
+		l.Log.Warn("Channel timeout doesn't exceed the sub safety margin, no channels of the journal can be resumed",
+			"channel_timeout", cfg.ChannelTimeout, "sub_safety_margin", cfg.SubSafetyMargin)
+		return new(resumedChannels), nil
+	}
+	// Frames must land within this many L1 blocks of the first included frame of their channel.
+	landingWindow := cfg.ChannelTimeout - cfg.SubSafetyMargin
+
+	var candidates []*resumeCandidate
+	parent := syncStatus.SafeL2.ID()
+candidates:
+	for _, jch := range journaled {
+		log := l.Log.New("channel", jch.ID)
+		if !jch.Closed {
+			log.Warn("Channel of the journal wasn't closed, dropping it and later channels", "frames", len(jch.Frames), "txs", len(jch.Txs))
+			break
+		}
+		if len(jch.Blocks) == 0 {
+			log.Warn("Closed channel of the journal has no blocks, dropping it and later channels", "frames", len(jch.Frames))
+			break
+		}
+		last := jch.Blocks[len(jch.Blocks)-1]
+		if last.Number <= syncStatus.SafeL2.Number {
+			log.Info("Blocks of channel of the journal are already safe", "last", last, "safe", syncStatus.SafeL2)
+			continue
+		}
+		if jch.Blocks[0].Number != parent.Number+1 {
+			log.Warn("Channel of the journal doesn't extend the previous block, dropping it and later channels", "first", jch.Blocks[0], "previous", parent)
+			break
+		}
+		for i, n := 0, len(jch.Frames); i < n; i++ {
+			if jch.Frames[i].Number != uint16(i) {
+				log.Warn("Frames of channel of the journal are missing, dropping it and later channels", "missing", i)
+				break candidates
+			}
+		}
+
+		blocks := make([]*types.Block, 0, len(jch.Blocks))
+		for _, id := range jch.Blocks {
+			block, err := l.l2BlockByNumber(ctx, l2Client, id.Number)
+			if err != nil {
+				return nil, err
+			}
+			if block.Hash() != id.Hash || (len(blocks) == 0 && block.ParentHash() != parent.Hash) {
+				log.Warn("Block of channel of the journal was reorged, dropping it and later channels", "block", id, "canonical", eth.ToBlockID(block))
+				break candidates
+			}
+			blocks = append(blocks, block)
+		}
+
+		// The first frame of the channel landed no earlier than the earliest confirmed or sent transaction.
+		earliest := uint64(math.MaxUint64)
+		for _, tx := range jch.Txs {
+			if tx.Block != nil {
+				canonical, err := l.isCanonicalL1(ctx, *tx.Block)
+				if err != nil {
+					return nil, err
+				}
+				if !canonical {
+					tx.Hash, tx.Block = nil, nil
+				}
+			}
+			if tx.Block != nil {
+				earliest = min(earliest, tx.Block.Number)
+			} else {
+				earliest = min(earliest, tx.SentAt)
+			}
+		}
+		if earliest != math.MaxUint64 && l1Head.Number >= earliest+landingWindow {
+			log.Warn("Channel of the journal can't land before its timeout anymore, dropping it and later channels", "earliest_inclusion", earliest, "l1_head", l1Head)
+			break
+		}
+		candidates = append(candidates, &resumeCandidate{journal: jch, blocks: blocks})
+		parent = last
+	}
+
+	if err := l.findJournalTxs(ctx, candidates, l1Head.Number, landingWindow); err != nil {
+		return nil, err
+	}
+
+	resumed := new(resumedChannels)
+	for _, c := range candidates {
+		jch := c.journal
+		confirmed := make(map[string]eth.BlockID)
+		confirmedFrames := make(map[uint16]bool)
+		for id, tx := range jch.Txs {
+			if tx.Block == nil {
+				l.Log.Info("Transaction of the journal not found on L1, submitting its frames again", "tx", id)
+				delete(jch.Txs, id)
+				continue
+			}
+			confirmed[id] = *tx.Block
+			for _, n := range tx.Frames {
+				confirmedFrames[n] = true
+			}
+		}
+		var pending []frameData
+		var outputBytes int
+		for _, f := range jch.Frames {
+			outputBytes += len(f.Data)
+			if !confirmedFrames[f.Number] {
+				pending = append(pending, frameData{id: frameID{chID: jch.ID, frameNumber: f.Number}, data: f.Data})
+			}
+		}
+
+		chCfg := cfg
+		chCfg.UseBlobs = jch.UseBlobs
+		cb, err := restoredChannelBuilder(chCfg, l.RollupConfig, jch.ID, c.blocks, pending, len(jch.Frames), outputBytes)
+		if err != nil {
+			return nil, fmt.Errorf("restoring channel %s: %w", jch.ID, err)
+		}
+		ch := restoredChannel(l.Log, l.Metr, chCfg, cb, confirmed)
+		if len(confirmed) > 0 && ch.isTimedOut() {
+			l.Log.Warn("Channel of the journal timed out, dropping it and later channels", "channel", jch.ID,
+				"min_inclusion_block", ch.minInclusionBlock, "max_inclusion_block", ch.maxInclusionBlock)
+			break
+		}
+		resumed.tip = eth.ToBlockID(c.blocks[len(c.blocks)-1])
+		if len(pending) == 0 {
+			l.Log.Info("Channel of the journal is fully submitted", "channel", jch.ID)
+			continue
+		}
+		l.Log.Info("Resuming channel of the journal", "channel", jch.ID, "frames", len(jch.Frames), "pending_frames", len(pending),
+			"confirmed_txs", len(confirmed), "oldest_l2", ch.OldestL2(), "latest_l2", ch.LatestL2())
+		resumed.channels = append(resumed.channels, ch)
+		resumed.journal = append(resumed.journal, jch)
+	}
+	return resumed, nil
+}
+
+// findJournalTxs looks for the transactions of the candidates that aren't known to be included on L1, in the L1
+// blocks from the earliest block one of them was sent at, to the L1 head. Blocks older than the landing window aren't
+// scanned, since frames included in them can't be part of a channel that is still resumed. The transactions that are
+// found are updated with their hash and inclusion block.
+func (l *BatchSubmitter) findJournalTxs(ctx context.Context, candidates []*resumeCandidate, l1Head, landingWindow uint64) error {
+	if l.Config.UseAltDA {
+		// Transactions only contain commitments to the frames, so they can't be matched.
+		return nil
+	}
+	unresolved := make(map[string]*journalTx)
+	from := uint64(math.MaxUint64)
+	for _, c := range candidates {
+		for _, tx := range c.journal.Txs {
+			if tx.Block != nil {
+				continue
+			}
+			data, ok := c.journal.txData(tx)
+			if !ok {
+				continue
+			}
+			key, err := l.txDataKey(data)
+			if err != nil {
+				return err
+			}
+			unresolved[key] = tx
+			from = min(from, tx.SentAt)
+		}
+	}
+	if len(unresolved) == 0 {
+		return nil
+	}
+	if l1Head > landingWindow {
+		from = max(from, l1Head-landingWindow)
+	}
+
+	l.Log.Info("Looking for transactions of the journal on L1", "txs", len(unresolved), "from", from, "to", l1Head)
+	for n := from; n <= l1Head && len(unresolved) > 0; n++ {
+		cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+		block, err := l.L1Client.BlockByNumber(cCtx, new(big.Int).SetUint64(n))
+		cancel()
+		if err != nil {
+			return fmt.Errorf("getting L1 block %d: %w", n, err)
+		}
+		for _, tx := range block.Transactions() {
+			if tx.To() == nil || *tx.To() != l.RollupConfig.BatchInboxAddress {
+				continue
+			}
+			if sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx); err != nil || sender != l.Txmgr.From() {
+				continue
+			}
+			key := string(tx.Data())
+			if tx.Type() == types.BlobTxType {
+				key = blobHashesKey(tx.BlobHashes())
+			}
+			if jtx, ok := unresolved[key]; ok {
+				hash := tx.Hash()
+				jtx.Hash, jtx.Block = &hash, &eth.BlockID{Hash: block.Hash(), Number: n}
+				delete(unresolved, key)
+			}
+		}
+	}
+	return nil
+}
+
+// txDataKey returns the key that a transaction with the tx data is matched with: its calldata, or its blob hashes.
+func (l *BatchSubmitter) txDataKey(data txData) (string, error) {
+	if !data.asBlob {
+		return string(data.CallData()), nil
+	}
+	blobs, err := data.Blobs()
+	if err != nil {
+		return "", fmt.Errorf("generating blobs for tx data: %w", err)
+	}
+	hashes := make([]common.Hash, 0, len(blobs))
+	for _, blob := range blobs {
+		commitment, err := blob.ComputeKZGCommitment()
+		if err != nil {
+			return "", fmt.Errorf("computing blob commitment: %w", err)
+		}
+		hashes = append(hashes, eth.KZGToVersionedHash(commitment))
+	}
+	return blobHashesKey(hashes), nil
+}
+
+func blobHashesKey(hashes []common.Hash) string {
+	key := make([]byte, 0, len(hashes)*common.HashLength)
+	for _, h := range hashes {
+		key = append(key, h[:]...)
+	}
+	return string(key)
+}
+
+func (l *BatchSubmitter) isCanonicalL1(ctx context.Context, id eth.BlockID) (bool, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+	defer cancel()
+	header, err := l.L1Client.HeaderByNumber(cCtx, new(big.Int).SetUint64(id.Number))
+	if err != nil {
+		return false, fmt.Errorf("getting L1 header %d: %w", id.Number, err)
+	}
+	return header.Hash() == id.Hash, nil
+}
+
+func (l *BatchSubmitter) l2BlockByNumber(ctx context.Context, l2Client L2Client, number uint64) (*types.Block, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+	defer cancel()
+	block, err := l2Client.BlockByNumber(cCtx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, fmt.Errorf("getting L2 block %d: %w", number, err)
+	}
+	return block, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/params"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -55,6 +56,7 @@ type BatcherService struct {
 	EndpointProvider dial.L2EndpointProvider
 	TxManager        txmgr.TxManager
 	AltDA            *altda.DAClient
+	Journal          *Journal
 
 	BatcherConfig
 
@@ -115,6 +117,9 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	if err := bs.initChannelConfig(cfg); err != nil {
 		return fmt.Errorf("failed to init channel config: %w", err)
 	}
+	if err := bs.initJournal(cfg); err != nil {
+		return fmt.Errorf("failed to init journal: %w", err)
+	}
 	bs.initBalanceMonitor(cfg)
 	if err := bs.initMetricsServer(cfg); err != nil {
 		return fmt.Errorf("failed to start metrics server: %w", err)
@@ -207,7 +212,7 @@ func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 	}
 
 	switch cfg.DataAvailabilityType {
-	case flags.BlobsType, flags.AutoType:
+	case flags.BlobsType, flags.AutoType, flags.PricedType:
 		if !cfg.TestUseMaxTxSizeForBlobs {
 			// account for version byte prefix
 			cc.MaxFrameSize = eth.MaxBlobDataSize - 1
@@ -235,6 +240,9 @@ func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 	if cc.CompressorConfig.CompressionAlgo.IsBrotli() && !bs.RollupConfig.IsFjord(uint64(time.Now().Unix())) {
 		return errors.New("cannot use brotli compression before Fjord")
 	}
+	if cc.CompressorConfig.CompressionAlgo == derive.Zstd && !bs.RollupConfig.IsZstd(uint64(time.Now().Unix())) {
+		return errors.New("cannot use zstd compression before it is enabled in the rollup config")
+	}
 
 	if err := cc.Check(); err != nil {
 		return fmt.Errorf("invalid channel configuration: %w", err)
@@ -254,16 +262,28 @@ func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 		bs.Log.Warn("Alt-DA Mode is a Beta feature of the MIT licensed OP Stack.  While it has received initial review from core contributors, it is still undergoing testing, and may have bugs or other issues.")
 	}
 
-	if cfg.DataAvailabilityType == flags.AutoType {
+	switch cfg.DataAvailabilityType {
+	case flags.AutoType, flags.PricedType:
 		// copy blobs config and use hardcoded calldata fallback config for now
 		calldataCC := cc
 		calldataCC.TargetNumFrames = 1
-		calldataCC.MaxFrameSize = 120_000
+		calldataCC.MaxFrameSize = CalldataMaxFrameSize
 		calldataCC.UseBlobs = false
 		calldataCC.ReinitCompressorConfig()
 
-		bs.ChannelConfig = NewDynamicEthChannelConfig(bs.Log, 10*time.Second, bs.TxManager, cc, calldataCC)
-	} else {
+		if cfg.DataAvailabilityType == flags.AutoType {
+			bs.ChannelConfig = NewDynamicEthChannelConfig(bs.Log, 10*time.Second, bs.TxManager, cc, calldataCC)
+			break
+		}
+		policy := NewWindowedDAPolicy(WindowedDAPolicyConfig{
+			FeeWindow:  cfg.DAFeeWindow,
+			SpikeRatio: cfg.DAFeeSpikeRatio,
+			MaxDelay:   cfg.DAMaxDelay,
+		}, cc, calldataCC)
+		bs.ChannelConfig = NewPricedChannelConfig(bs.Log, 10*time.Second, bs.TxManager, policy, bs.Metrics, cc, calldataCC)
+		bs.Log.Info("Initialized DA pricing policy", "fee_window", cfg.DAFeeWindow,
+			"spike_ratio", cfg.DAFeeSpikeRatio, "max_delay", cfg.DAMaxDelay)
+	default:
 		bs.ChannelConfig = cc
 	}
 
@@ -315,6 +335,18 @@ func (bs *BatcherService) initMetricsServer(cfg *CLIConfig) error {
 	return nil
 }
 
+func (bs *BatcherService) initJournal(cfg *CLIConfig) error {
+	if cfg.JournalPath == "" {
+		return nil
+	}
+	journal, err := OpenJournal(cfg.JournalPath, bs.Log)
+	if err != nil {
+		return err
+	}
+	bs.Journal = journal
+	return nil
+}
+
 func (bs *BatcherService) initDriver() {
 	bs.driver = NewBatchSubmitter(DriverSetup{
 		Log:              bs.Log,
@@ -326,6 +358,7 @@ func (bs *BatcherService) initDriver() {
 		EndpointProvider: bs.EndpointProvider,
 		ChannelConfig:    bs.ChannelConfig,
 		AltDA:            bs.AltDA,
+		Journal:          bs.Journal,
 	})
 }
 
@@ -337,7 +370,7 @@ func (bs *BatcherService) initRPCServer(cfg *CLIConfig) error {
 		oprpc.WithLogger(bs.Log),
 	)
 	if cfg.RPC.EnableAdmin {
-		adminAPI := rpc.NewAdminAPI(bs.driver, bs.Metrics, bs.Log)
+		adminAPI := rpc.NewAdminAPI(adminDriver{bs.driver}, bs.Metrics, bs.Log)
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
 		server.AddAPI(bs.TxManager.API())
 		bs.Log.Info("Admin RPC enabled")
@@ -350,6 +383,44 @@ func (bs *BatcherService) initRPCServer(cfg *CLIConfig) error {
 	return nil
 }
 
+// adminDriver converts the types of the BatchSubmitter to the ones of the admin RPC.
+type adminDriver struct {
+	*BatchSubmitter
+}
+
+var _ rpc.BatcherDriver = adminDriver{}
+
+func (d adminDriver) Channels() []rpc.ChannelInfo {
+	chs := d.BatchSubmitter.Channels()
+	infos := make([]rpc.ChannelInfo, 0, len(chs))
+	for _, ch := range chs {
+		infos = append(infos, rpc.ChannelInfo(ch))
+	}
+	return infos
+}
+
+func (d adminDriver) InFlightTxs() []rpc.TxInfo {
+	txs := d.BatchSubmitter.InFlightTxs()
+	infos := make([]rpc.TxInfo, 0, len(txs))
+	for _, tx := range txs {
+		infos = append(infos, rpc.TxInfo(tx))
+	}
+	return infos
+}
+
+func (d adminDriver) CurrentChannelConfig() rpc.ChannelConfig {
+	return rpc.ChannelConfig(d.BatchSubmitter.CurrentChannelConfig())
+}
+
+func (d adminDriver) SetChannelConfig(update rpc.ChannelConfigUpdate) (rpc.ChannelConfig, error) {
+	cfg, err := d.BatchSubmitter.SetChannelConfig(ChannelConfigUpdate(update))
+	return rpc.ChannelConfig(cfg), err
+}
+
+func (d adminDriver) ResetChannelConfig() rpc.ChannelConfig {
+	return rpc.ChannelConfig(d.BatchSubmitter.ResetChannelConfig())
+}
+
 func (bs *BatcherService) initAltDA(cfg *CLIConfig) error {
 	config := cfg.AltDA
 	if err := config.Check(); err != nil {
@@ -428,6 +499,10 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 		}
 	}
 
+	if err := bs.Journal.Close(); err != nil {
+		result = errors.Join(result, fmt.Errorf("failed to close journal: %w", err))
+	}
+
 	if bs.L1Client != nil {
 		bs.L1Client.Close()
 	}
@@ -17,8 +17,11 @@ type Config struct {
 	// will default to RatioKind.
 	Kind string
 
-	// Type of compression algorithm to use. Must be one of [zlib, brotli-(9|10|11)]
+	// Type of compression algorithm to use. Must be one of [zlib, brotli-(9|10|11), zstd]
 	CompressionAlgo derive.CompressionAlgo
+	// CompressionDict is the dictionary to compress with. It is only used by zstd, and must be
+	// the zstd dictionary of the rollup config.
+	CompressionDict []byte
 }
 
 func (c Config) NewCompressor() (derive.Compressor, error) {
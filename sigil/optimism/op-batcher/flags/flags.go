@@ -156,6 +156,33 @@ var (
 		Value:   false,
 		EnvVars: prefixEnvVars("WAIT_NODE_SYNC"),
 	}
+	JournalPathFlag = &cli.StringFlag{
+		Name: "journal-path",
+		Usage: "Path of a journal file of the pending channels and their transactions. On restart, partly submitted " +
+			"channels are resumed from it, instead of submitting their blocks again. Journaling is disabled if empty.",
+		EnvVars: prefixEnvVars("JOURNAL_PATH"),
+	}
+	DAFeeWindowFlag = &cli.Uint64Flag{
+		Name: "da-fee-window",
+		Usage: "The number of recent L1 blocks whose base and blob base fees are averaged by the priced data " +
+			"availability type, to tell fee spikes apart.",
+		Value:   50,
+		EnvVars: prefixEnvVars("DA_FEE_WINDOW"),
+	}
+	DAFeeSpikeRatioFlag = &cli.Float64Flag{
+		Name: "da-fee-spike-ratio",
+		Usage: "How many times more expensive than at the average fees of the fee window submitting a channel must " +
+			"be for the priced data availability type to hold its data back.",
+		Value:   1.5,
+		EnvVars: prefixEnvVars("DA_FEE_SPIKE_RATIO"),
+	}
+	DAMaxDelayFlag = &cli.DurationFlag{
+		Name: "da-max-delay",
+		Usage: "The maximum time the priced data availability type holds back the data of a channel while fees " +
+			"spike. Data is never held back past the sequencing window minus the sub-safety-margin. 0 disables holding back data.",
+		Value:   time.Minute,
+		EnvVars: prefixEnvVars("DA_MAX_DELAY"),
+	}
 	// Legacy Flags
 	SequencerHDPathFlag = txmgr.SequencerHDPathFlag
 )
@@ -184,6 +211,10 @@ var optionalFlags = []cli.Flag{
 	DataAvailabilityTypeFlag,
 	ActiveSequencerCheckDurationFlag,
 	CompressionAlgoFlag,
+	JournalPathFlag,
+	DAFeeWindowFlag,
+	DAFeeSpikeRatioFlag,
+	DAMaxDelayFlag,
 }
 
 func init() {
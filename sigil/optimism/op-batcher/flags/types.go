@@ -9,12 +9,14 @@ const (
 	CalldataType DataAvailabilityType = "calldata"
 	BlobsType    DataAvailabilityType = "blobs"
 	AutoType     DataAvailabilityType = "auto"
+	PricedType   DataAvailabilityType = "priced"
 )
 
 var DataAvailabilityTypes = []DataAvailabilityType{
 	CalldataType,
 	BlobsType,
 	AutoType,
+	PricedType,
 }
 
 func (kind DataAvailabilityType) String() string {
@@ -48,6 +48,8 @@ type Metricer interface {
 
 	RecordBlobUsedBytes(num int)
 
+	RecordDADecision(decision string, estimate DAFeeEstimate)
+
 	Document() []opmetrics.DocumentedMetric
 }
 
@@ -83,6 +85,23 @@ type Metrics struct {
 	batcherTxEvs opmetrics.EventVec
 
 	blobUsedBytes prometheus.Histogram
+
+	// label by blobs, calldata, hold
+	daDecisionEvs opmetrics.EventVec
+	daFeeEstimate prometheus.GaugeVec
+}
+
+// DAFeeEstimate is the fee estimate, in wei, behind a data availability decision.
+type DAFeeEstimate struct {
+	BaseFee     float64
+	BlobBaseFee float64
+	// BlobCostPerByte and CalldataCostPerByte are the estimated costs per byte of submitting the channel as blobs
+	// or as calldata at the current fees.
+	BlobCostPerByte     float64
+	CalldataCostPerByte float64
+	// WindowCostPerByte is the estimated cost per byte of submitting the channel with the cheaper data
+	// availability type at the average fees of the fee window.
+	WindowCostPerByte float64
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -193,6 +212,13 @@ func NewMetrics(procName string) *Metrics {
 		}),
 
 		batcherTxEvs: opmetrics.NewEventVec(factory, ns, "", "batcher_tx", "BatcherTx", []string{"stage"}),
+
+		daDecisionEvs: opmetrics.NewEventVec(factory, ns, "", "da_decision", "DA decision", []string{"decision"}),
+		daFeeEstimate: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "da_fee_estimate",
+			Help:      "Fee estimate in wei behind the last data availability decision.",
+		}, []string{"estimate"}),
 	}
 }
 
@@ -231,6 +257,10 @@ const (
 	TxStageSubmitted = "submitted"
 	TxStageSuccess   = "success"
 	TxStageFailed    = "failed"
+
+	DADecisionBlobs    = "blobs"
+	DADecisionCalldata = "calldata"
+	DADecisionHold     = "hold"
 )
 
 func (m *Metrics) RecordLatestL1Block(l1ref eth.L1BlockRef) {
@@ -318,6 +348,16 @@ func (m *Metrics) RecordBlobUsedBytes(num int) {
 	m.blobUsedBytes.Observe(float64(num))
 }
 
+// RecordDADecision records a decision on how to submit a channel, and the fee estimate it is based on.
+func (m *Metrics) RecordDADecision(decision string, estimate DAFeeEstimate) {
+	m.daDecisionEvs.Record(decision)
+	m.daFeeEstimate.WithLabelValues("base_fee").Set(estimate.BaseFee)
+	m.daFeeEstimate.WithLabelValues("blob_base_fee").Set(estimate.BlobBaseFee)
+	m.daFeeEstimate.WithLabelValues("blob_cost_per_byte").Set(estimate.BlobCostPerByte)
+	m.daFeeEstimate.WithLabelValues("calldata_cost_per_byte").Set(estimate.CalldataCostPerByte)
+	m.daFeeEstimate.WithLabelValues("window_cost_per_byte").Set(estimate.WindowCostPerByte)
+}
+
 // estimateBatchSize estimates the size of the batch
 func estimateBatchSize(block *types.Block) uint64 {
 	size := uint64(70) // estimated overhead of batch metadata
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/rpc"
 )
@@ -13,6 +14,93 @@ import (
 type BatcherDriver interface {
 	StartBatchSubmitting() error
 	StopBatchSubmitting(ctx context.Context) error
+	// Channels returns the open and pending channels, oldest first.
+	Channels() []ChannelInfo
+	// InFlightTxs returns the batcher txs that were sent, but aren't confirmed or failed yet.
+	InFlightTxs() []TxInfo
+	// CloseChannel force-closes the open channel, so that its frames are submitted. It returns false if there is no
+	// open channel.
+	CloseChannel() (bool, error)
+	// CurrentChannelConfig returns the configuration of the next channels.
+	CurrentChannelConfig() ChannelConfig
+	// SetChannelConfig overrides the configuration of the next channels. The blocks of the channels that have no
+	// submitted txs yet are requeued into channels with the new configuration.
+	SetChannelConfig(update ChannelConfigUpdate) (ChannelConfig, error)
+	// ResetChannelConfig removes the override of the channel configuration.
+	ResetChannelConfig() ChannelConfig
+}
+
+// ChannelInfo describes a channel of the batcher.
+type ChannelInfo struct {
+	ID string `json:"id"`
+	// Open is true for the channel that blocks are still added to. The other channels are closed, and pending until
+	// all their frames are confirmed.
+	Open bool `json:"open"`
+	// FullReason is why the channel was closed.
+	FullReason string `json:"fullReason,omitempty"`
+	// UseBlobs is whether the frames of the channel are sent in blobs.
+	UseBlobs bool `json:"useBlobs"`
+
+	Blocks         int         `json:"blocks"`
+	OldestL2       eth.BlockID `json:"oldestL2"`
+	LatestL2       eth.BlockID `json:"latestL2"`
+	OldestL1Origin eth.BlockID `json:"oldestL1Origin"`
+	LatestL1Origin eth.BlockID `json:"latestL1Origin"`
+	// Timeout is the L1 block by which the channel must be closed and submitted. 0 if there is no timeout yet.
+	Timeout uint64 `json:"timeout"`
+
+	InputBytes  int `json:"inputBytes"`
+	OutputBytes int `json:"outputBytes"`
+	// ComprRatio is the ratio of output to input bytes of the frames output so far.
+	ComprRatio float64 `json:"comprRatio"`
+
+	// TotalFrames is the number of frames output so far, and PendingFrames the number of these that aren't sent yet.
+	TotalFrames   int `json:"totalFrames"`
+	PendingFrames int `json:"pendingFrames"`
+	PendingTxs    int `json:"pendingTxs"`
+	ConfirmedTxs  int `json:"confirmedTxs"`
+}
+
+// TxInfo describes a batcher tx in flight.
+type TxInfo struct {
+	// ID is the txID of the tx, which lists the frames of the tx.
+	ID        string `json:"id"`
+	ChannelID string `json:"channelID"`
+	Frames    int    `json:"frames"`
+	Bytes     int    `json:"bytes"`
+	AsBlob    bool   `json:"asBlob"`
+}
+
+// ChannelConfig is the configuration of new channels.
+type ChannelConfig struct {
+	// DAType is "blobs" or "calldata".
+	DAType                string  `json:"daType"`
+	MaxFrameSize          uint64  `json:"maxFrameSize"`
+	TargetNumFrames       int     `json:"targetNumFrames"`
+	MaxChannelDuration    uint64  `json:"maxChannelDuration"`
+	SubSafetyMargin       uint64  `json:"subSafetyMargin"`
+	MaxBlocksPerSpanBatch int     `json:"maxBlocksPerSpanBatch"`
+	BatchType             uint    `json:"batchType"`
+	Compressor            string  `json:"compressor"`
+	CompressionAlgo       string  `json:"compressionAlgo"`
+	ApproxComprRatio      float64 `json:"approxComprRatio"`
+	// Overridden is true if the configuration was set with admin_setChannelConfig, rather than by the batcher's
+	// configuration.
+	Overridden bool `json:"overridden"`
+}
+
+// ChannelConfigUpdate changes the configuration of new channels. Fields that aren't set are unchanged, except that
+// switching the DA type resets the max frame size to the max for the DA type, and the target number of frames to 1
+// for calldata.
+type ChannelConfigUpdate struct {
+	DAType                *string  `json:"daType,omitempty"`
+	MaxFrameSize          *uint64  `json:"maxFrameSize,omitempty"`
+	TargetNumFrames       *int     `json:"targetNumFrames,omitempty"`
+	MaxChannelDuration    *uint64  `json:"maxChannelDuration,omitempty"`
+	SubSafetyMargin       *uint64  `json:"subSafetyMargin,omitempty"`
+	MaxBlocksPerSpanBatch *int     `json:"maxBlocksPerSpanBatch,omitempty"`
+	CompressionAlgo       *string  `json:"compressionAlgo,omitempty"`
+	ApproxComprRatio      *float64 `json:"approxComprRatio,omitempty"`
 }
 
 type adminAPI struct {
@@ -41,3 +129,27 @@ func (a *adminAPI) StartBatcher(_ context.Context) error {
 func (a *adminAPI) StopBatcher(ctx context.Context) error {
 	return a.b.StopBatchSubmitting(ctx)
 }
+
+func (a *adminAPI) Channels(_ context.Context) ([]ChannelInfo, error) {
+	return a.b.Channels(), nil
+}
+
+func (a *adminAPI) InFlightTxs(_ context.Context) ([]TxInfo, error) {
+	return a.b.InFlightTxs(), nil
+}
+
+func (a *adminAPI) CloseChannel(_ context.Context) (bool, error) {
+	return a.b.CloseChannel()
+}
+
+func (a *adminAPI) ChannelConfig(_ context.Context) (ChannelConfig, error) {
+	return a.b.CurrentChannelConfig(), nil
+}
+
+func (a *adminAPI) SetChannelConfig(_ context.Context, update ChannelConfigUpdate) (ChannelConfig, error) {
+	return a.b.SetChannelConfig(update)
+}
+
+func (a *adminAPI) ResetChannelConfig(_ context.Context) (ChannelConfig, error) {
+	return a.b.ResetChannelConfig(), nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/urfave/cli/v2"
 
+	"github.com/ethereum-optimism/optimism/op-conductor/cmd/raft"
 	"github.com/ethereum-optimism/optimism/op-conductor/conductor"
 	"github.com/ethereum-optimism/optimism/op-conductor/flags"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
@@ -32,7 +33,13 @@ func main() {
 	app.Usage = "Optimism Sequencer Conductor Service"
 	app.Description = "op-conductor help sequencer to run in highly available mode"
 	app.Action = cliapp.LifecycleCmd(OpConductorMain)
-	app.Commands = []*cli.Command{}
+	app.Commands = []*cli.Command{
+		{
+			Name:        "raft",
+			Usage:       "Inspect and recover the raft state of a stopped op-conductor",
+			Subcommands: raft.Subcommands,
+		},
+	}
 
 	ctx := ctxinterrupt.WithSignalWaiterMain(context.Background())
 	err := app.RunContext(ctx, os.Args)
@@ -0,0 +1,184 @@
+package raft
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/flags"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+)
+
+var (
+	storageDirFlag = &cli.PathFlag{
+		Name:     flags.RaftStorageDir.Name,
+		Usage:    "Directory of the raft data. The op-conductor using it must be stopped",
+		EnvVars:  flags.RaftStorageDir.EnvVars,
+		Required: true,
+	}
+	serverIDFlag = &cli.StringFlag{
+		Name:     flags.RaftServerID.Name,
+		Usage:    "Raft server ID of the op-conductor",
+		EnvVars:  flags.RaftServerID.EnvVars,
+		Required: true,
+	}
+	serverAddrFlag = &cli.StringFlag{
+		Name:     "raft.server.addr",
+		Usage:    "Raft address (host:port) of the op-conductor, as advertised to the other servers of the cluster",
+		Required: true,
+	}
+	snapshotOutFlag = &cli.PathFlag{
+		Name:  "snapshot-out",
+		Usage: "Path to write the latest unsafe payload to, in the format expected by the bootstrap command",
+	}
+	snapshotFlag = &cli.PathFlag{
+		Name:     "snapshot",
+		Usage:    "Path of the unsafe payload to bootstrap from, as written by the dump command",
+		Required: true,
+	}
+	voterFlag = &cli.StringSliceFlag{
+		Name:  "voter",
+		Usage: "Voter of the new cluster membership, as <server ID>=<raft address>. Can be repeated",
+	}
+	nonvoterFlag = &cli.StringSliceFlag{
+		Name:  "nonvoter",
+		Usage: "Nonvoter of the new cluster membership, as <server ID>=<raft address>. Can be repeated",
+	}
+)
+
+var Subcommands = cli.Commands{
+	{
+		Name:  "dump",
+		Usage: "Prints the raft state of a stopped op-conductor as JSON",
+		Description: "Prints the current term, the latest snapshot and the log entries after it, and the latest " +
+			"unsafe payload. The latest unsafe payload can be written to a file to bootstrap a new cluster from.",
+		Flags:  []cli.Flag{storageDirFlag, serverIDFlag, snapshotOutFlag},
+		Action: dump,
+	},
+	{
+		Name:  "bootstrap",
+		Usage: "Bootstraps a new single-server cluster from an unsafe payload",
+		Description: "Writes the raft state of a new cluster, with the op-conductor as its only voter and the unsafe " +
+			"payload as the latest. The op-conductor must not have any raft state. Start it without raft bootstrap, " +
+			"then add the other servers to the cluster.",
+		Flags:  []cli.Flag{storageDirFlag, serverIDFlag, serverAddrFlag, snapshotFlag},
+		Action: bootstrap,
+	},
+	{
+		Name:  "force-membership",
+		Usage: "Overwrites the cluster membership in the raft state of a stopped op-conductor",
+		Description: "Recovers a cluster that lost the majority of its voters, and cannot elect a leader. " +
+			"Run it with the same membership on every remaining server before restarting them. " +
+			"Log entries that were not committed by the old cluster may be committed by the new one.",
+		Flags:  []cli.Flag{storageDirFlag, serverIDFlag, voterFlag, nonvoterFlag},
+		Action: forceMembership,
+	},
+}
+
+func dump(ctx *cli.Context) error {
+	state, err := consensus.DumpRaftState(ctx.Path(storageDirFlag.Name), ctx.String(serverIDFlag.Name))
+	if err != nil {
+		return err
+	}
+	if path := ctx.Path(snapshotOutFlag.Name); path != "" {
+		if state.UnsafeHead == nil {
+			return fmt.Errorf("no unsafe payload to write to %s", path)
+		}
+		if err := writePayload(path, state.UnsafeHead); err != nil {
+			return err
+		}
+	}
+	return jsonutil.WriteJSON(state, ioutil.ToStdOut())
+}
+
+func bootstrap(ctx *cli.Context) error {
+	logger := oplog.NewLogger(oplog.AppOut(ctx), oplog.DefaultCLIConfig())
+	head, err := readPayload(ctx.Path(snapshotFlag.Name))
+	if err != nil {
+		return err
+	}
+	storageDir, serverID, serverAddr := ctx.Path(storageDirFlag.Name), ctx.String(serverIDFlag.Name), ctx.String(serverAddrFlag.Name)
+	if err := consensus.BootstrapFromSnapshot(storageDir, serverID, serverAddr, head); err != nil {
+		return err
+	}
+	logger.Info("Bootstrapped raft cluster", "server_id", serverID, "server_addr", serverAddr, "unsafe_head", head.ExecutionPayload.ID())
+	return nil
+}
+
+func forceMembership(ctx *cli.Context) error {
+	logger := oplog.NewLogger(oplog.AppOut(ctx), oplog.DefaultCLIConfig())
+	voters, err := parseServers(ctx.StringSlice(voterFlag.Name), consensus.Voter)
+	if err != nil {
+		return err
+	}
+	if len(voters) == 0 {
+		return fmt.Errorf("missing voters")
+	}
+	nonvoters, err := parseServers(ctx.StringSlice(nonvoterFlag.Name), consensus.Nonvoter)
+	if err != nil {
+		return err
+	}
+	servers := append(voters, nonvoters...)
+	seen := make(map[string]bool, len(servers))
+	for _, srv := range servers {
+		if seen[srv.ID] {
+			return fmt.Errorf("duplicate server %s", srv.ID)
+		}
+		seen[srv.ID] = true
+	}
+
+	serverID := ctx.String(serverIDFlag.Name)
+	if err := consensus.ForceMembership(ctx.Path(storageDirFlag.Name), serverID, servers); err != nil {
+		return err
+	}
+	logger.Info("Forced cluster membership", "server_id", serverID, "servers", servers)
+	return nil
+}
+
+// parseServers parses servers in the <server ID>=<raft address> format.
+func parseServers(values []string, suffrage consensus.ServerSuffrage) ([]consensus.ServerInfo, error) {
+	servers := make([]consensus.ServerInfo, 0, len(values))
+	for _, v := range values {
+		id, addr, ok := strings.Cut(v, "=")
+		if !ok || id == "" || addr == "" {
+			return nil, fmt.Errorf("invalid server %q, expected <server ID>=<raft address>", v)
+		}
+		servers = append(servers, consensus.ServerInfo{ID: id, Addr: addr, Suffrage: suffrage})
+	}
+	return servers, nil
+}
+
+func writePayload(path string, payload *eth.ExecutionPayloadEnvelope) error {
+	var buf bytes.Buffer
+	if _, err := payload.MarshalSSZ(&buf); err != nil {
+		return fmt.Errorf("failed to encode unsafe payload: %w", err)
+	}
+	out, err := ioutil.NewAtomicWriter(path, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if _, err := out.Write(buf.Bytes()); err != nil {
+		_ = out.Abort()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return out.Close()
+}
+
+func readPayload(path string) (*eth.ExecutionPayloadEnvelope, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	payload := &eth.ExecutionPayloadEnvelope{}
+	if err := payload.UnmarshalSSZ(uint32(len(data)), bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed to decode unsafe payload from %s: %w", path, err)
+	}
+	return payload, nil
+}
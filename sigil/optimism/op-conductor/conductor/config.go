@@ -3,6 +3,7 @@ package conductor
 import (
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -10,6 +11,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/flags"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	opnode "github.com/ethereum-optimism/optimism/op-node"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
@@ -43,6 +45,9 @@ type Config struct {
 	// RaftTrailingLogs is the number of logs to keep after a snapshot.
 	RaftTrailingLogs uint64
 
+	// RaftPayloadHistory is the number of latest committed unsafe payloads to keep in memory.
+	RaftPayloadHistory uint64
+
 	// NodeRPC is the HTTP provider URL for op-node.
 	NodeRPC string
 
@@ -55,12 +60,19 @@ type Config struct {
 	// HealthCheck is the health check configuration.
 	HealthCheck HealthCheckConfig
 
+	// PeerRPCs are the conductor RPC URLs of the other servers in the cluster, by server ID.
+	// They are used to transfer leadership to the follower with the best health score.
+	PeerRPCs map[string]string
+
 	// RollupCfg is the rollup config.
 	RollupCfg rollup.Config
 
 	// RPCEnableProxy is true if the sequencer RPC proxy should be enabled.
 	RPCEnableProxy bool
 
+	// RPCEnableUnsafePayloads is true if the committed unsafe payloads should be served over RPC.
+	RPCEnableUnsafePayloads bool
+
 	LogConfig     oplog.CLIConfig
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
@@ -90,6 +102,14 @@ func (c *Config) Check() error {
 	if err := c.HealthCheck.Check(); err != nil {
 		return errors.Wrap(err, "invalid health check config")
 	}
+	for id, url := range c.PeerRPCs {
+		if id == c.RaftServerID {
+			return fmt.Errorf("peer RPC configured for this server %s", id)
+		}
+		if url == "" {
+			return fmt.Errorf("missing peer RPC URL of server %s", id)
+		}
+	}
 	if err := c.RollupCfg.Check(); err != nil {
 		return errors.Wrap(err, "invalid rollup config")
 	}
@@ -116,6 +136,11 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*Config, error) {
 		return nil, errors.Wrap(err, "failed to load rollup config")
 	}
 
+	peerRPCs, err := parsePeerRPCs(ctx.StringSlice(flags.HealthCheckPeerRPCs.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid peer RPCs")
+	}
+
 	return &Config{
 		ConsensusAddr:         ctx.String(flags.ConsensusAddr.Name),
 		ConsensusPort:         ctx.Int(flags.ConsensusPort.Name),
@@ -125,6 +150,7 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*Config, error) {
 		RaftSnapshotInterval:  ctx.Duration(flags.RaftSnapshotInterval.Name),
 		RaftSnapshotThreshold: ctx.Uint64(flags.RaftSnapshotThreshold.Name),
 		RaftTrailingLogs:      ctx.Uint64(flags.RaftTrailingLogs.Name),
+		RaftPayloadHistory:    ctx.Uint64(flags.RaftPayloadHistory.Name),
 		NodeRPC:               ctx.String(flags.NodeRPC.Name),
 		ExecutionRPC:          ctx.String(flags.ExecutionRPC.Name),
 		Paused:                ctx.Bool(flags.Paused.Name),
@@ -134,13 +160,30 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*Config, error) {
 			SafeEnabled:    ctx.Bool(flags.HealthCheckSafeEnabled.Name),
 			SafeInterval:   ctx.Uint64(flags.HealthCheckSafeInterval.Name),
 			MinPeerCount:   ctx.Uint64(flags.HealthCheckMinPeerCount.Name),
+			MinScore:       ctx.Uint64(flags.HealthCheckMinScore.Name),
+
+			ExecutionLatencyWeight: ctx.Uint64(flags.HealthCheckExecutionLatencyWeight.Name),
+			ExecutionMaxLatency:    ctx.Duration(flags.HealthCheckExecutionMaxLatency.Name),
+			EngineErrorsWeight:     ctx.Uint64(flags.HealthCheckEngineErrorsWeight.Name),
+			EngineRPC:              ctx.String(flags.HealthCheckEngineRPC.Name),
+			EngineJWTSecret:        ctx.String(flags.HealthCheckEngineJWTSecret.Name),
+			EngineMaxErrors:        ctx.Uint64(flags.HealthCheckEngineMaxErrors.Name),
+			TxPoolWeight:           ctx.Uint64(flags.HealthCheckTxPoolWeight.Name),
+			TxPoolMaxTxs:           ctx.Uint64(flags.HealthCheckTxPoolMaxTxs.Name),
+			L1Weight:               ctx.Uint64(flags.HealthCheckL1Weight.Name),
+			L1RPC:                  ctx.String(flags.HealthCheckL1RPC.Name),
+			DiskUsageWeight:        ctx.Uint64(flags.HealthCheckDiskUsageWeight.Name),
+			DiskUsageDatadir:       ctx.String(flags.HealthCheckDiskUsageDatadir.Name),
+			DiskUsageMax:           ctx.Uint64(flags.HealthCheckDiskUsageMax.Name),
 		},
-		RollupCfg:      *rollupCfg,
-		RPCEnableProxy: ctx.Bool(flags.RPCEnableProxy.Name),
-		LogConfig:      oplog.ReadCLIConfig(ctx),
-		MetricsConfig:  opmetrics.ReadCLIConfig(ctx),
-		PprofConfig:    oppprof.ReadCLIConfig(ctx),
-		RPC:            oprpc.ReadCLIConfig(ctx),
+		PeerRPCs:                peerRPCs,
+		RollupCfg:               *rollupCfg,
+		RPCEnableProxy:          ctx.Bool(flags.RPCEnableProxy.Name),
+		RPCEnableUnsafePayloads: ctx.Bool(flags.RPCEnableUnsafePayloads.Name),
+		LogConfig:               oplog.ReadCLIConfig(ctx),
+		MetricsConfig:           opmetrics.ReadCLIConfig(ctx),
+		PprofConfig:             oppprof.ReadCLIConfig(ctx),
+		RPC:                     oprpc.ReadCLIConfig(ctx),
 	}, nil
 }
 
@@ -160,8 +203,53 @@ type HealthCheckConfig struct {
 
 	// MinPeerCount is the minimum number of peers required for the sequencer to be healthy.
 	MinPeerCount uint64
+
+	// MinScore is the minimum health score from the weighted health rules required for the sequencer to be healthy.
+	MinScore uint64
+
+	// ExecutionLatencyWeight is the weight of the execution client RPC latency rule, 0 disables the rule.
+	ExecutionLatencyWeight uint64
+
+	// ExecutionMaxLatency is the maximum RPC latency of the execution client.
+	ExecutionMaxLatency time.Duration
+
+	// EngineErrorsWeight is the weight of the engine API errors rule, 0 disables the rule.
+	EngineErrorsWeight uint64
+
+	// EngineRPC is the engine API provider URL of the execution client.
+	EngineRPC string
+
+	// EngineJWTSecret is the path to the JWT secret file of the engine API.
+	EngineJWTSecret string
+
+	// EngineMaxErrors is the maximum number of engine API errors within the last EngineErrorsWindow health checks.
+	EngineMaxErrors uint64
+
+	// TxPoolWeight is the weight of the txpool saturation rule, 0 disables the rule.
+	TxPoolWeight uint64
+
+	// TxPoolMaxTxs is the maximum number of transactions in the txpool of the execution client.
+	TxPoolMaxTxs uint64
+
+	// L1Weight is the weight of the L1 RPC reachability rule, 0 disables the rule.
+	L1Weight uint64
+
+	// L1RPC is the HTTP provider URL for L1.
+	L1RPC string
+
+	// DiskUsageWeight is the weight of the datadir disk usage rule, 0 disables the rule.
+	DiskUsageWeight uint64
+
+	// DiskUsageDatadir is the datadir of the execution client.
+	DiskUsageDatadir string
+
+	// DiskUsageMax is the maximum disk usage of the datadir in percent.
+	DiskUsageMax uint64
 }
 
+// EngineErrorsWindow is the number of latest health checks over which engine API errors are counted.
+const EngineErrorsWindow = 10
+
 func (c *HealthCheckConfig) Check() error {
 	if c.Interval == 0 {
 		return fmt.Errorf("missing health check interval")
@@ -172,5 +260,46 @@ func (c *HealthCheckConfig) Check() error {
 	if c.MinPeerCount == 0 {
 		return fmt.Errorf("missing minimum peer count")
 	}
+	if c.MinScore > health.MaxHealthScore {
+		return fmt.Errorf("minimum score %d exceeds maximum health score %d", c.MinScore, health.MaxHealthScore)
+	}
+	if c.ExecutionLatencyWeight > 0 && c.ExecutionMaxLatency == 0 {
+		return fmt.Errorf("missing execution max latency")
+	}
+	if c.EngineErrorsWeight > 0 {
+		if c.EngineRPC == "" {
+			return fmt.Errorf("missing engine RPC")
+		}
+		if c.EngineJWTSecret == "" {
+			return fmt.Errorf("missing engine JWT secret")
+		}
+	}
+	if c.L1Weight > 0 && c.L1RPC == "" {
+		return fmt.Errorf("missing L1 RPC")
+	}
+	if c.DiskUsageWeight > 0 {
+		if c.DiskUsageDatadir == "" {
+			return fmt.Errorf("missing disk usage datadir")
+		}
+		if c.DiskUsageMax > 100 {
+			return fmt.Errorf("invalid disk usage max %d%%", c.DiskUsageMax)
+		}
+	}
 	return nil
 }
+
+// parsePeerRPCs parses peer RPCs in the <server ID>=<URL> format.
+func parsePeerRPCs(values []string) (map[string]string, error) {
+	peers := make(map[string]string, len(values))
+	for _, v := range values {
+		id, url, ok := strings.Cut(v, "=")
+		if !ok || id == "" || url == "" {
+			return nil, fmt.Errorf("invalid peer RPC %q, expected <server ID>=<URL>", v)
+		}
+		if _, ok := peers[id]; ok {
+			return nil, fmt.Errorf("duplicate peer RPC for server %s", id)
+		}
+		peers[id] = url
+	}
+	return peers, nil
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"os"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
+	gn "github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/raft"
@@ -39,6 +43,9 @@ var (
 	ErrNoUnsafeHead       = errors.New("no unsafe head")
 )
 
+// peerHealthScoreTimeout is the timeout to get the health score of a peer when transferring leadership.
+const peerHealthScoreTimeout = 2 * time.Second
+
 // New creates a new OpConductor instance.
 func New(ctx context.Context, cfg *Config, log log.Logger, version string) (*OpConductor, error) {
 	return NewOpConductor(ctx, cfg, log, metrics.NewMetrics(), version, nil, nil, nil)
@@ -111,6 +118,9 @@ func (c *OpConductor) init(ctx context.Context) error {
 	if err := c.initHealthMonitor(ctx); err != nil {
 		return errors.Wrap(err, "failed to initialize health monitor")
 	}
+	if err := c.initPeers(ctx); err != nil {
+		return errors.Wrap(err, "failed to initialize peers")
+	}
 	if err := c.initRPCServer(ctx); err != nil {
 		return errors.Wrap(err, "failed to initialize rpc server")
 	}
@@ -158,6 +168,7 @@ func (c *OpConductor) initConsensus(ctx context.Context) error {
 		SnapshotInterval:  c.cfg.RaftSnapshotInterval,
 		SnapshotThreshold: c.cfg.RaftSnapshotThreshold,
 		TrailingLogs:      c.cfg.RaftTrailingLogs,
+		PayloadHistory:    c.cfg.RaftPayloadHistory,
 	}
 	cons, err := consensus.NewRaftConsensus(c.log, raftConsensusConfig)
 	if err != nil {
@@ -185,6 +196,11 @@ func (c *OpConductor) initHealthMonitor(ctx context.Context) error {
 	}
 	p2p := opp2p.NewClient(pc)
 
+	rules, err := c.initHealthRules(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize health rules")
+	}
+
 	c.hmon = health.NewSequencerHealthMonitor(
 		c.log,
 		c.metrics,
@@ -196,18 +212,113 @@ func (c *OpConductor) initHealthMonitor(ctx context.Context) error {
 		&c.cfg.RollupCfg,
 		node,
 		p2p,
+		rules,
+		c.cfg.HealthCheck.MinScore,
 	)
 	c.healthUpdateCh = c.hmon.Subscribe()
 
 	return nil
 }
 
+// initHealthRules creates the health rules with a non-zero weight.
+func (c *OpConductor) initHealthRules(ctx context.Context) ([]health.HealthRule, error) {
+	hc := c.cfg.HealthCheck
+	var rules []health.HealthRule
+
+	if hc.ExecutionLatencyWeight > 0 || hc.TxPoolWeight > 0 {
+		ec, err := opclient.NewRPC(ctx, c.log, c.cfg.ExecutionRPC)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create geth rpc client")
+		}
+		if hc.ExecutionLatencyWeight > 0 {
+			rules = append(rules, health.NewExecutionLatencyRule(hc.ExecutionLatencyWeight, hc.ExecutionMaxLatency, ec))
+		}
+		if hc.TxPoolWeight > 0 {
+			rules = append(rules, health.NewTxPoolSaturationRule(hc.TxPoolWeight, hc.TxPoolMaxTxs, ec))
+		}
+	}
+
+	if hc.EngineErrorsWeight > 0 {
+		secret, err := readJWTSecret(hc.EngineJWTSecret)
+		if err != nil {
+			return nil, err
+		}
+		engine, err := opclient.NewRPC(ctx, c.log, hc.EngineRPC, opclient.WithGethRPCOptions(rpc.WithHTTPAuth(gn.NewJWTAuth(secret))))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create engine rpc client")
+		}
+		rules = append(rules, health.NewEngineErrorsRule(hc.EngineErrorsWeight, int(hc.EngineMaxErrors), EngineErrorsWindow, engine))
+	}
+
+	if hc.L1Weight > 0 {
+		l1RPC, err := opclient.NewRPC(ctx, c.log, hc.L1RPC)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create l1 rpc client")
+		}
+		l1, err := sources.NewL1Client(l1RPC, c.log, nil, sources.L1ClientDefaultConfig(&c.cfg.RollupCfg, true, sources.RPCKindStandard))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create l1 client")
+		}
+		rules = append(rules, health.NewL1ReachabilityRule(hc.L1Weight, l1))
+	}
+
+	if hc.DiskUsageWeight > 0 {
+		rules = append(rules, health.NewDiskUsageRule(hc.DiskUsageWeight, hc.DiskUsageMax, hc.DiskUsageDatadir))
+	}
+
+	return rules, nil
+}
+
+// readJWTSecret reads a hex-encoded 32 bytes JWT secret from the given file.
+func readJWTSecret(path string) ([32]byte, error) {
+	var secret [32]byte
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return secret, errors.Wrap(err, "failed to read jwt secret")
+	}
+	jwtSecret := common.FromHex(strings.TrimSpace(string(data)))
+	if len(jwtSecret) != 32 {
+		return secret, fmt.Errorf("invalid jwt secret in path %s, not 32 hex-formatted bytes", path)
+	}
+	copy(secret[:], jwtSecret)
+	return secret, nil
+}
+
+// initPeers creates the clients of the conductors of the peers, to get their health scores when transferring leadership.
+func (c *OpConductor) initPeers(ctx context.Context) error {
+	if c.healthScoreFn != nil || len(c.cfg.PeerRPCs) == 0 {
+		return nil
+	}
+
+	c.peers = make(map[string]*conductorrpc.APIClient, len(c.cfg.PeerRPCs))
+	for id, url := range c.cfg.PeerRPCs {
+		pc, err := rpc.DialContext(ctx, url)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create peer rpc client of server %s", id)
+		}
+		c.peers[id] = conductorrpc.NewAPIClient(pc)
+	}
+	c.healthScoreFn = func(ctx context.Context, id string) (uint64, error) {
+		peer, ok := c.peers[id]
+		if !ok {
+			return 0, fmt.Errorf("no peer rpc configured for server %s", id)
+		}
+		return peer.SequencerHealthScore(ctx)
+	}
+	return nil
+}
+
 func (oc *OpConductor) initRPCServer(ctx context.Context) error {
+	opts := []oprpc.ServerOption{oprpc.WithLogger(oc.log)}
+	if oc.cfg.RPCEnableUnsafePayloads {
+		// unsafe payload subscriptions require websockets
+		opts = append(opts, oprpc.WithWebsocketEnabled())
+	}
 	server := oprpc.NewServer(
 		oc.cfg.RPC.ListenAddr,
 		oc.cfg.RPC.ListenPort,
 		oc.version,
-		oprpc.WithLogger(oc.log),
+		opts...,
 	)
 	api := conductorrpc.NewAPIBackend(oc.log, oc)
 	server.AddAPI(rpc.API{
@@ -244,6 +355,13 @@ func (oc *OpConductor) initRPCServer(ctx context.Context) error {
 		})
 	}
 
+	if oc.cfg.RPCEnableUnsafePayloads {
+		server.AddAPI(rpc.API{
+			Namespace: conductorrpc.UnsafePayloadsRPCNamespace,
+			Service:   conductorrpc.NewUnsafePayloadsBackend(oc.log, oc),
+		})
+	}
+
 	oc.rpcServer = server
 	return nil
 }
@@ -277,6 +395,9 @@ type OpConductor struct {
 	leaderUpdateCh <-chan bool
 	loopActionFn   func() // loopActionFn defines the logic to be executed inside control loop.
 
+	peers         map[string]*conductorrpc.APIClient
+	healthScoreFn func(ctx context.Context, id string) (uint64, error) // healthScoreFn returns the health score of a peer, nil if no peers are configured.
+
 	wg             sync.WaitGroup
 	pauseCh        chan struct{}
 	pauseDoneCh    chan struct{}
@@ -384,6 +505,10 @@ func (oc *OpConductor) Stop(ctx context.Context) error {
 		}
 	}
 
+	for _, peer := range oc.peers {
+		peer.Close()
+	}
+
 	if oc.cons != nil {
 		if err := oc.cons.Shutdown(); err != nil {
 			result = multierror.Append(result, errors.Wrap(err, "failed to shutdown consensus"))
@@ -497,6 +622,11 @@ func (oc *OpConductor) SequencerHealthy(_ context.Context) bool {
 	return oc.healthy.Load()
 }
 
+// SequencerHealthScore returns the health score of the sequencer.
+func (oc *OpConductor) SequencerHealthScore(_ context.Context) uint64 {
+	return oc.hmon.Score()
+}
+
 // ClusterMembership returns current cluster's membership information.
 func (oc *OpConductor) ClusterMembership(_ context.Context) (*consensus.ClusterMembership, error) {
 	return oc.cons.ClusterMembership()
@@ -507,6 +637,16 @@ func (oc *OpConductor) LatestUnsafePayload(_ context.Context) (*eth.ExecutionPay
 	return oc.cons.LatestUnsafePayload()
 }
 
+// UnsafePayloadByNumber returns a committed unsafe payload by block number, if it is still in the payload history.
+func (oc *OpConductor) UnsafePayloadByNumber(_ context.Context, num uint64) (*eth.ExecutionPayloadEnvelope, error) {
+	return oc.cons.UnsafePayloadByNumber(num)
+}
+
+// SubscribeUnsafePayloads subscribes to the unsafe payloads committed to the cluster.
+func (oc *OpConductor) SubscribeUnsafePayloads(ch chan<- *eth.ExecutionPayloadEnvelope) event.Subscription {
+	return oc.cons.SubscribeUnsafePayloads(ch)
+}
+
 func (oc *OpConductor) loop() {
 	defer oc.wg.Done()
 
@@ -673,11 +813,17 @@ func (oc *OpConductor) action() {
 	}
 }
 
-// transferLeader tries to transfer leadership to another server.
+// transferLeader tries to transfer leadership to another server, the healthiest follower if their health scores are known.
 func (oc *OpConductor) transferLeader() error {
-	// TransferLeader here will do round robin to try to transfer leadership to the next healthy node.
-	oc.log.Info("transferring leadership", "server", oc.cons.ServerID())
-	err := oc.cons.TransferLeader()
+	var err error
+	if target := oc.healthiestFollower(); target != nil {
+		oc.log.Info("transferring leadership to healthiest follower", "server", oc.cons.ServerID(), "target", target.ID, "target_addr", target.Addr)
+		err = oc.TransferLeaderToServer(oc.shutdownCtx, target.ID, target.Addr)
+	} else {
+		// TransferLeader here will do round robin to try to transfer leadership to the next healthy node.
+		oc.log.Info("transferring leadership", "server", oc.cons.ServerID())
+		err = oc.cons.TransferLeader()
+	}
 	oc.metrics.RecordLeaderTransfer(err == nil)
 	if err == nil {
 		oc.leader.Store(false)
@@ -695,6 +841,42 @@ func (oc *OpConductor) transferLeader() error {
 	}
 }
 
+// healthiestFollower returns the voter with the best health score other than the current server,
+// or nil if there are no peers configured, or none of them reported a non-zero health score.
+func (oc *OpConductor) healthiestFollower() *consensus.ServerInfo {
+	if oc.healthScoreFn == nil {
+		return nil
+	}
+
+	membership, err := oc.cons.ClusterMembership()
+	if err != nil {
+		oc.log.Warn("failed to get cluster membership, cannot pick healthiest follower", "err", err)
+		return nil
+	}
+
+	var (
+		best      *consensus.ServerInfo
+		bestScore uint64
+	)
+	for i, server := range membership.Servers {
+		if server.Suffrage != consensus.Voter || server.ID == oc.cons.ServerID() {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(oc.shutdownCtx, peerHealthScoreTimeout)
+		score, err := oc.healthScoreFn(ctx, server.ID)
+		cancel()
+		if err != nil {
+			oc.log.Warn("failed to get health score of follower", "server", server.ID, "err", err)
+			continue
+		}
+		oc.log.Debug("follower health score", "server", server.ID, "score", score)
+		if score > bestScore {
+			best, bestScore = &membership.Servers[i], score
+		}
+	}
+	return best
+}
+
 func (oc *OpConductor) stopSequencer() error {
 	oc.log.Info(
 		"stopping sequencer",
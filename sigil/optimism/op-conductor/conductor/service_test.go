@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	clientmocks "github.com/ethereum-optimism/optimism/op-conductor/client/mocks"
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
 	consensusmocks "github.com/ethereum-optimism/optimism/op-conductor/consensus/mocks"
 	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	healthmocks "github.com/ethereum-optimism/optimism/op-conductor/health/mocks"
@@ -532,6 +533,82 @@ func (s *OpConductorTestSuite) TestScenario7() {
 	s.cons.AssertCalled(s.T(), "TransferLeader")
 }
 
+// This test is the same as Scenario 7, except that the health scores of the peers are known,
+// so we expect leadership to be transferred to the voter with the best health score.
+func (s *OpConductorTestSuite) TestScenario7HealthiestFollower() {
+	s.enableSynchronization()
+
+	// set initial state
+	s.conductor.leader.Store(true)
+	s.conductor.healthy.Store(true)
+	s.conductor.seqActive.Store(true)
+
+	scores := map[string]uint64{
+		"SequencerB": 50,
+		"SequencerC": 100,
+		"SequencerD": 100,
+	}
+	s.conductor.healthScoreFn = func(_ context.Context, id string) (uint64, error) {
+		if id == "SequencerE" {
+			return 0, s.err
+		}
+		return scores[id], nil
+	}
+	s.cons.EXPECT().ClusterMembership().Return(&consensus.ClusterMembership{
+		Servers: []consensus.ServerInfo{
+			{ID: "SequencerA", Addr: "127.0.0.1:50050", Suffrage: consensus.Voter},
+			{ID: "SequencerB", Addr: "127.0.0.1:50051", Suffrage: consensus.Voter},
+			{ID: "SequencerC", Addr: "127.0.0.1:50052", Suffrage: consensus.Voter},
+			{ID: "SequencerD", Addr: "127.0.0.1:50053", Suffrage: consensus.Nonvoter},
+			{ID: "SequencerE", Addr: "127.0.0.1:50054", Suffrage: consensus.Voter},
+		},
+	}, nil).Times(1)
+	s.cons.EXPECT().TransferLeaderTo("SequencerC", "127.0.0.1:50052").Return(nil).Times(1)
+	s.ctrl.EXPECT().StopSequencer(mock.Anything).Return(common.Hash{}, nil).Times(1)
+
+	// become unhealthy
+	s.updateHealthStatusAndExecuteAction(health.ErrSequencerNotHealthy)
+
+	// expect to step down as leader in favor of the healthiest voter, and stop sequencing
+	s.False(s.conductor.leader.Load())
+	s.False(s.conductor.healthy.Load())
+	s.False(s.conductor.seqActive.Load())
+	s.ctrl.AssertCalled(s.T(), "StopSequencer", mock.Anything)
+	s.cons.AssertCalled(s.T(), "TransferLeaderTo", "SequencerC", "127.0.0.1:50052")
+	s.cons.AssertNotCalled(s.T(), "TransferLeader")
+}
+
+// This test is the same as Scenario 7, except that none of the peers reports a non-zero health score,
+// so we expect leadership to be transferred to any voter.
+func (s *OpConductorTestSuite) TestScenario7NoHealthyFollower() {
+	s.enableSynchronization()
+
+	// set initial state
+	s.conductor.leader.Store(true)
+	s.conductor.healthy.Store(true)
+	s.conductor.seqActive.Store(true)
+
+	s.conductor.healthScoreFn = func(_ context.Context, id string) (uint64, error) {
+		return 0, nil
+	}
+	s.cons.EXPECT().ClusterMembership().Return(&consensus.ClusterMembership{
+		Servers: []consensus.ServerInfo{
+			{ID: "SequencerA", Addr: "127.0.0.1:50050", Suffrage: consensus.Voter},
+			{ID: "SequencerB", Addr: "127.0.0.1:50051", Suffrage: consensus.Voter},
+		},
+	}, nil).Times(1)
+	s.cons.EXPECT().TransferLeader().Return(nil).Times(1)
+	s.ctrl.EXPECT().StopSequencer(mock.Anything).Return(common.Hash{}, nil).Times(1)
+
+	// become unhealthy
+	s.updateHealthStatusAndExecuteAction(health.ErrSequencerNotHealthy)
+
+	s.False(s.conductor.leader.Load())
+	s.False(s.conductor.seqActive.Load())
+	s.cons.AssertCalled(s.T(), "TransferLeader")
+	s.cons.AssertNotCalled(s.T(), "TransferLeaderTo", mock.Anything, mock.Anything)
+}
+
 // In this test, we have a leader that is healthy and sequencing, we send a unhealthy update to it and expect it to stop sequencing and transfer leadership.
 // However, the action we needed to take failed temporarily, so we expect it to retry until it succeeds.
 // 1. [leader, healthy, sequencing] -- become unhealthy -->
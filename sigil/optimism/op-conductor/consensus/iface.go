@@ -1,9 +1,16 @@
 package consensus
 
 import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/event"
+
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
+// ErrUnsafePayloadNotFound is returned when an unsafe payload is not in the payload history.
+var ErrUnsafePayloadNotFound = errors.New("unsafe payload not found")
+
 // ServerSuffrage determines whether a Server in a Configuration gets a vote.
 type ServerSuffrage int
 
@@ -73,6 +80,11 @@ type Consensus interface {
 	CommitUnsafePayload(payload *eth.ExecutionPayloadEnvelope) error
 	// LatestUnsafeBlock returns the latest unsafe payload from FSM in a strongly consistent fashion.
 	LatestUnsafePayload() (*eth.ExecutionPayloadEnvelope, error)
+	// UnsafePayloadByNumber returns a committed unsafe payload by block number, from the latest payloads retained locally.
+	UnsafePayloadByNumber(num uint64) (*eth.ExecutionPayloadEnvelope, error)
+	// SubscribeUnsafePayloads subscribes to the unsafe payloads committed to the FSM, on leaders and followers alike.
+	// Payloads are sent without blocking, the subscription ends with ErrSubscriptionLagging if the channel is full.
+	SubscribeUnsafePayloads(ch chan<- *eth.ExecutionPayloadEnvelope) event.Subscription
 
 	// Shutdown shuts down the consensus protocol client.
 	Shutdown() error
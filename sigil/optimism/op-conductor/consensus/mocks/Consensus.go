@@ -6,6 +6,8 @@ import (
 	consensus "github.com/ethereum-optimism/optimism/op-conductor/consensus"
 	eth "github.com/ethereum-optimism/optimism/op-service/eth"
 
+	event "github.com/ethereum/go-ethereum/event"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -601,6 +603,54 @@ func (_c *Consensus_Shutdown_Call) RunAndReturn(run func() error) *Consensus_Shu
 	return _c
 }
 
+// SubscribeUnsafePayloads provides a mock function with given fields: ch
+func (_m *Consensus) SubscribeUnsafePayloads(ch chan<- *eth.ExecutionPayloadEnvelope) event.Subscription {
+	ret := _m.Called(ch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeUnsafePayloads")
+	}
+
+	var r0 event.Subscription
+	if rf, ok := ret.Get(0).(func(chan<- *eth.ExecutionPayloadEnvelope) event.Subscription); ok {
+		r0 = rf(ch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(event.Subscription)
+		}
+	}
+
+	return r0
+}
+
+// Consensus_SubscribeUnsafePayloads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeUnsafePayloads'
+type Consensus_SubscribeUnsafePayloads_Call struct {
+	*mock.Call
+}
+
+// SubscribeUnsafePayloads is a helper method to define mock.On call
+//   - ch chan<- *eth.ExecutionPayloadEnvelope
+func (_e *Consensus_Expecter) SubscribeUnsafePayloads(ch interface{}) *Consensus_SubscribeUnsafePayloads_Call {
+	return &Consensus_SubscribeUnsafePayloads_Call{Call: _e.mock.On("SubscribeUnsafePayloads", ch)}
+}
+
+func (_c *Consensus_SubscribeUnsafePayloads_Call) Run(run func(ch chan<- *eth.ExecutionPayloadEnvelope)) *Consensus_SubscribeUnsafePayloads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(chan<- *eth.ExecutionPayloadEnvelope))
+	})
+	return _c
+}
+
+func (_c *Consensus_SubscribeUnsafePayloads_Call) Return(_a0 event.Subscription) *Consensus_SubscribeUnsafePayloads_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Consensus_SubscribeUnsafePayloads_Call) RunAndReturn(run func(chan<- *eth.ExecutionPayloadEnvelope) event.Subscription) *Consensus_SubscribeUnsafePayloads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransferLeader provides a mock function with given fields:
 func (_m *Consensus) TransferLeader() error {
 	ret := _m.Called()
@@ -693,6 +743,64 @@ func (_c *Consensus_TransferLeaderTo_Call) RunAndReturn(run func(string, string)
 	return _c
 }
 
+// UnsafePayloadByNumber provides a mock function with given fields: num
+func (_m *Consensus) UnsafePayloadByNumber(num uint64) (*eth.ExecutionPayloadEnvelope, error) {
+	ret := _m.Called(num)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsafePayloadByNumber")
+	}
+
+	var r0 *eth.ExecutionPayloadEnvelope
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*eth.ExecutionPayloadEnvelope, error)); ok {
+		return rf(num)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *eth.ExecutionPayloadEnvelope); ok {
+		r0 = rf(num)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eth.ExecutionPayloadEnvelope)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(num)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Consensus_UnsafePayloadByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsafePayloadByNumber'
+type Consensus_UnsafePayloadByNumber_Call struct {
+	*mock.Call
+}
+
+// UnsafePayloadByNumber is a helper method to define mock.On call
+//   - num uint64
+func (_e *Consensus_Expecter) UnsafePayloadByNumber(num interface{}) *Consensus_UnsafePayloadByNumber_Call {
+	return &Consensus_UnsafePayloadByNumber_Call{Call: _e.mock.On("UnsafePayloadByNumber", num)}
+}
+
+func (_c *Consensus_UnsafePayloadByNumber_Call) Run(run func(num uint64)) *Consensus_UnsafePayloadByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *Consensus_UnsafePayloadByNumber_Call) Return(_a0 *eth.ExecutionPayloadEnvelope, _a1 error) *Consensus_UnsafePayloadByNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Consensus_UnsafePayloadByNumber_Call) RunAndReturn(run func(uint64) (*eth.ExecutionPayloadEnvelope, error)) *Consensus_UnsafePayloadByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewConsensus creates a new instance of Consensus. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewConsensus(t interface {
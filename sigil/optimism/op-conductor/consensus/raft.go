@@ -4,13 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"net"
-	"os"
-	"path/filepath"
 	"time"
 
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/hashicorp/raft"
-	boltdb "github.com/hashicorp/raft-boltdb/v2"
 	"github.com/pkg/errors"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
@@ -28,6 +26,7 @@ type RaftConsensus struct {
 
 	serverID raft.ServerID
 	r        *raft.Raft
+	storage  *raftStorage
 
 	unsafeTracker *unsafeHeadTracker
 }
@@ -41,6 +40,7 @@ type RaftConsensusConfig struct {
 	SnapshotInterval  time.Duration
 	SnapshotThreshold uint64
 	TrailingLogs      uint64
+	PayloadHistory    uint64
 }
 
 // checkTCPPortOpen attempts to connect to the specified address and returns an error if the connection fails.
@@ -61,33 +61,14 @@ func NewRaftConsensus(log log.Logger, cfg *RaftConsensusConfig) (*RaftConsensus,
 	rc.SnapshotThreshold = cfg.SnapshotThreshold
 	rc.LocalID = raft.ServerID(cfg.ServerID)
 
-	baseDir := filepath.Join(cfg.StorageDir, cfg.ServerID)
-	if _, err := os.Stat(baseDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(baseDir, 0o755); err != nil {
-			return nil, fmt.Errorf("error creating storage dir: %w", err)
-		}
-	}
-
-	var err error
-	logStorePath := filepath.Join(baseDir, "raft-log.db")
-	logStore, err := boltdb.NewBoltStore(logStorePath)
-	if err != nil {
-		return nil, fmt.Errorf(`boltdb.NewBoltStore(%q): %w`, logStorePath, err)
-	}
-
-	stableStorePath := filepath.Join(baseDir, "raft-stable.db")
-	stableStore, err := boltdb.NewBoltStore(stableStorePath)
-	if err != nil {
-		return nil, fmt.Errorf(`boltdb.NewBoltStore(%q): %w`, stableStorePath, err)
-	}
-
-	snapshotStore, err := raft.NewFileSnapshotStoreWithLogger(baseDir, 1, rc.Logger)
+	storage, err := openRaftStorage(cfg.StorageDir, cfg.ServerID, rc.Logger, nil)
 	if err != nil {
-		return nil, fmt.Errorf(`raft.NewFileSnapshotStore(%q): %w`, baseDir, err)
+		return nil, err
 	}
 
 	addr, err := net.ResolveTCPAddr("tcp", cfg.ServerAddr)
 	if err != nil {
+		storage.Close()
 		return nil, errors.Wrap(err, "failed to resolve tcp address")
 	}
 
@@ -96,14 +77,17 @@ func NewRaftConsensus(log log.Logger, cfg *RaftConsensusConfig) (*RaftConsensus,
 	bindAddr := fmt.Sprintf("0.0.0.0:%d", addr.Port)
 	transport, err := raft.NewTCPTransportWithLogger(bindAddr, addr, maxConnPool, timeout, rc.Logger)
 	if err != nil {
+		storage.Close()
 		return nil, errors.Wrap(err, "failed to create raft tcp transport")
 	}
 
-	fsm := NewUnsafeHeadTracker(log)
+	fsm := NewUnsafeHeadTracker(log, cfg.PayloadHistory)
 
-	r, err := raft.NewRaft(rc, fsm, logStore, stableStore, snapshotStore, transport)
+	r, err := raft.NewRaft(rc, fsm, storage.logs, storage.stable, storage.snapshots, transport)
 	if err != nil {
 		log.Error("failed to create raft", "err", err)
+		transport.Close()
+		storage.Close()
 		return nil, errors.Wrap(err, "failed to create raft")
 	}
 
@@ -129,6 +113,7 @@ func NewRaftConsensus(log log.Logger, cfg *RaftConsensusConfig) (*RaftConsensus,
 	return &RaftConsensus{
 		log:           log,
 		r:             r,
+		storage:       storage,
 		serverID:      raft.ServerID(cfg.ServerID),
 		unsafeTracker: fsm,
 		rollupCfg:     cfg.RollupCfg,
@@ -227,12 +212,16 @@ func (rc *RaftConsensus) TransferLeaderTo(id string, addr string) error {
 	return nil
 }
 
-// Shutdown implements Consensus, it shuts down the consensus protocol client.
+// Shutdown implements Consensus, it shuts down the consensus protocol client, and closes its storage.
 func (rc *RaftConsensus) Shutdown() error {
 	if err := rc.r.Shutdown().Error(); err != nil {
 		rc.log.Error("failed to shutdown raft", "err", err)
 		return err
 	}
+	if err := rc.storage.Close(); err != nil {
+		rc.log.Error("failed to close raft storage", "err", err)
+		return err
+	}
 	return nil
 }
 
@@ -263,6 +252,20 @@ func (rc *RaftConsensus) LatestUnsafePayload() (*eth.ExecutionPayloadEnvelope, e
 	return rc.unsafeTracker.UnsafeHead(), nil
 }
 
+// UnsafePayloadByNumber implements Consensus, it returns a committed unsafe payload by block number from the payload history of the FSM.
+func (rc *RaftConsensus) UnsafePayloadByNumber(num uint64) (*eth.ExecutionPayloadEnvelope, error) {
+	payload := rc.unsafeTracker.UnsafePayloadByNumber(num)
+	if payload == nil {
+		return nil, ErrUnsafePayloadNotFound
+	}
+	return payload, nil
+}
+
+// SubscribeUnsafePayloads implements Consensus, it subscribes to the unsafe payloads committed to the FSM.
+func (rc *RaftConsensus) SubscribeUnsafePayloads(ch chan<- *eth.ExecutionPayloadEnvelope) event.Subscription {
+	return rc.unsafeTracker.Subscribe(ch)
+}
+
 // ClusterMembership implements Consensus, it returns the current cluster membership configuration.
 func (rc *RaftConsensus) ClusterMembership() (*ClusterMembership, error) {
 	var future raft.ConfigurationFuture
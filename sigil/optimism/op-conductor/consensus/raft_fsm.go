@@ -2,28 +2,42 @@ package consensus
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/hashicorp/raft"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
+// ErrSubscriptionLagging is returned by the error channel of an unsafe payloads subscription when the subscriber
+// does not keep up with the committed payloads. The subscription is ended, so that the FSM never blocks on it.
+var ErrSubscriptionLagging = errors.New("unsafe payloads subscriber is lagging")
+
 var _ raft.FSM = (*unsafeHeadTracker)(nil)
 
 // unsafeHeadTracker implements raft.FSM for storing unsafe head payload into raft consensus layer.
+// It also retains the latest historySize unsafe head payloads, and notifies subscribers of new unsafe heads.
 type unsafeHeadTracker struct {
-	log        log.Logger
-	mtx        sync.RWMutex
-	unsafeHead *eth.ExecutionPayloadEnvelope
+	log         log.Logger
+	mtx         sync.RWMutex
+	unsafeHead  *eth.ExecutionPayloadEnvelope
+	historySize uint64
+	history     []*eth.ExecutionPayloadEnvelope // ordered by block number
+
+	subsMtx sync.Mutex
+	subs    map[*payloadSubscription]struct{}
 }
 
-func NewUnsafeHeadTracker(log log.Logger) *unsafeHeadTracker {
+func NewUnsafeHeadTracker(log log.Logger, historySize uint64) *unsafeHeadTracker {
 	return &unsafeHeadTracker{
-		log: log,
+		log:         log,
+		historySize: historySize,
 	}
 }
 
@@ -39,15 +53,32 @@ func (t *unsafeHeadTracker) Apply(l *raft.Log) interface{} {
 	}
 
 	t.mtx.Lock()
-	defer t.mtx.Unlock()
 	t.log.Debug("applying new unsafe head", "number", uint64(data.ExecutionPayload.BlockNumber), "hash", data.ExecutionPayload.BlockHash.Hex())
-	if t.unsafeHead == nil || t.unsafeHead.ExecutionPayload.BlockNumber < data.ExecutionPayload.BlockNumber {
+	advanced := t.unsafeHead == nil || t.unsafeHead.ExecutionPayload.BlockNumber < data.ExecutionPayload.BlockNumber
+	if advanced {
 		t.unsafeHead = data
+		t.record(data)
 	}
+	t.mtx.Unlock()
 
+	if advanced {
+		t.notify(data)
+	}
 	return nil
 }
 
+// record adds a new unsafe head to the history, and drops the oldest payloads beyond the history size.
+// It must be called with the lock held.
+func (t *unsafeHeadTracker) record(payload *eth.ExecutionPayloadEnvelope) {
+	if t.historySize == 0 {
+		return
+	}
+	t.history = append(t.history, payload)
+	if uint64(len(t.history)) > t.historySize {
+		t.history = t.history[uint64(len(t.history))-t.historySize:]
+	}
+}
+
 // Restore implements raft.FSM, it restores state from snapshot.
 func (t *unsafeHeadTracker) Restore(snapshot io.ReadCloser) error {
 	var buf bytes.Buffer
@@ -63,8 +94,13 @@ func (t *unsafeHeadTracker) Restore(snapshot io.ReadCloser) error {
 	}
 
 	t.mtx.Lock()
-	defer t.mtx.Unlock()
 	t.unsafeHead = data
+	// The restored state may not follow the history, e.g. when a lagging follower installs a snapshot of the leader.
+	t.history = nil
+	t.record(data)
+	t.mtx.Unlock()
+
+	t.notify(data)
 	return nil
 }
 
@@ -74,6 +110,7 @@ func (t *unsafeHeadTracker) Snapshot() (raft.FSMSnapshot, error) {
 	defer t.mtx.RUnlock()
 
 	return &snapshot{
+		log:        t.log,
 		unsafeHead: t.unsafeHead,
 	}, nil
 }
@@ -86,6 +123,84 @@ func (t *unsafeHeadTracker) UnsafeHead() *eth.ExecutionPayloadEnvelope {
 	return t.unsafeHead
 }
 
+// UnsafePayloadByNumber returns the unsafe head payload of the given block number from the history,
+// nil if the history does not hold it.
+func (t *unsafeHeadTracker) UnsafePayloadByNumber(num uint64) *eth.ExecutionPayloadEnvelope {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	i := sort.Search(len(t.history), func(i int) bool {
+		return uint64(t.history[i].ExecutionPayload.BlockNumber) >= num
+	})
+	if i < len(t.history) && uint64(t.history[i].ExecutionPayload.BlockNumber) == num {
+		return t.history[i]
+	}
+	return nil
+}
+
+// Subscribe subscribes to new unsafe head payloads. The payloads are sent to the channel without blocking:
+// if the channel is full, the subscription ends with ErrSubscriptionLagging.
+func (t *unsafeHeadTracker) Subscribe(ch chan<- *eth.ExecutionPayloadEnvelope) event.Subscription {
+	sub := &payloadSubscription{
+		tracker: t,
+		ch:      ch,
+		err:     make(chan error, 1),
+	}
+	t.subsMtx.Lock()
+	defer t.subsMtx.Unlock()
+	if t.subs == nil {
+		t.subs = make(map[*payloadSubscription]struct{})
+	}
+	t.subs[sub] = struct{}{}
+	return sub
+}
+
+func (t *unsafeHeadTracker) notify(payload *eth.ExecutionPayloadEnvelope) {
+	t.subsMtx.Lock()
+	defer t.subsMtx.Unlock()
+	for sub := range t.subs {
+		select {
+		case sub.ch <- payload:
+		default:
+			t.log.Warn("ending lagging unsafe payloads subscription", "number", uint64(payload.ExecutionPayload.BlockNumber))
+			delete(t.subs, sub)
+			sub.end(ErrSubscriptionLagging)
+		}
+	}
+}
+
+var _ event.Subscription = (*payloadSubscription)(nil)
+
+// payloadSubscription is a subscription to the unsafe head payloads of an unsafeHeadTracker.
+type payloadSubscription struct {
+	tracker *unsafeHeadTracker
+	ch      chan<- *eth.ExecutionPayloadEnvelope
+	err     chan error
+	once    sync.Once
+}
+
+// Unsubscribe implements event.Subscription.
+func (s *payloadSubscription) Unsubscribe() {
+	s.tracker.subsMtx.Lock()
+	delete(s.tracker.subs, s)
+	s.tracker.subsMtx.Unlock()
+	s.end(nil)
+}
+
+// Err implements event.Subscription.
+func (s *payloadSubscription) Err() <-chan error {
+	return s.err
+}
+
+func (s *payloadSubscription) end(err error) {
+	s.once.Do(func() {
+		if err != nil {
+			s.err <- err
+		}
+		close(s.err)
+	})
+}
+
 var _ raft.FSMSnapshot = (*snapshot)(nil)
 
 type snapshot struct {
@@ -71,6 +71,67 @@ func TestUnsafeHeadTracker(t *testing.T) {
 	})
 }
 
+func applyPayload(t *testing.T, tracker *unsafeHeadTracker, blockNum uint64) {
+	var buf bytes.Buffer
+	_, err := createPayloadEnvelope(blockNum).MarshalSSZ(&buf)
+	require.NoError(t, err)
+	require.Nil(t, tracker.Apply(&raft.Log{Data: buf.Bytes()}))
+}
+
+func TestUnsafeHeadTrackerHistory(t *testing.T) {
+	tracker := NewUnsafeHeadTracker(testlog.Logger(t, log.LevelDebug), 3)
+	for num := uint64(1); num <= 5; num++ {
+		applyPayload(t, tracker, num)
+	}
+	require.Nil(t, tracker.UnsafePayloadByNumber(2), "pruned from history")
+	for num := uint64(3); num <= 5; num++ {
+		require.Equal(t, hexutil.Uint64(num), tracker.UnsafePayloadByNumber(num).ExecutionPayload.BlockNumber)
+	}
+	require.Nil(t, tracker.UnsafePayloadByNumber(6))
+
+	applyPayload(t, tracker, 4)
+	require.Equal(t, hexutil.Uint64(5), tracker.UnsafeHead().ExecutionPayload.BlockNumber, "older payloads are not recorded")
+
+	mrc, err := NewMockReadCloser(createPayloadEnvelope(10))
+	require.NoError(t, err)
+	require.NoError(t, tracker.Restore(mrc))
+	require.Nil(t, tracker.UnsafePayloadByNumber(5), "history is reset on restore")
+	require.NotNil(t, tracker.UnsafePayloadByNumber(10))
+}
+
+func TestUnsafeHeadTrackerSubscribe(t *testing.T) {
+	tracker := NewUnsafeHeadTracker(testlog.Logger(t, log.LevelDebug), 0)
+
+	ch := make(chan *eth.ExecutionPayloadEnvelope, 2)
+	sub := tracker.Subscribe(ch)
+	applyPayload(t, tracker, 1)
+	applyPayload(t, tracker, 1)
+	applyPayload(t, tracker, 2)
+	require.Equal(t, hexutil.Uint64(1), (<-ch).ExecutionPayload.BlockNumber)
+	require.Equal(t, hexutil.Uint64(2), (<-ch).ExecutionPayload.BlockNumber, "only new unsafe heads are sent")
+
+	t.Run("lagging", func(t *testing.T) {
+		applyPayload(t, tracker, 3)
+		applyPayload(t, tracker, 4)
+		applyPayload(t, tracker, 5)
+		require.ErrorIs(t, <-sub.Err(), ErrSubscriptionLagging)
+		_, ok := <-sub.Err()
+		require.False(t, ok)
+		require.Empty(t, tracker.subs)
+		sub.Unsubscribe()
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		ch := make(chan *eth.ExecutionPayloadEnvelope, 1)
+		sub := tracker.Subscribe(ch)
+		sub.Unsubscribe()
+		_, ok := <-sub.Err()
+		require.False(t, ok)
+		applyPayload(t, tracker, 6)
+		require.Empty(t, ch)
+	})
+}
+
 type mockReadCloser struct {
 	currentPosition int
 	data            *eth.ExecutionPayloadEnvelope
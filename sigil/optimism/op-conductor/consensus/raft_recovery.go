@@ -0,0 +1,294 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/raft"
+	boltdb "github.com/hashicorp/raft-boltdb/v2"
+	"go.etcd.io/bbolt"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// storageLockTimeout is how long the offline raft tools wait for the lock on the raft storage, which is held by a running op-conductor.
+const storageLockTimeout = time.Second
+
+// keyCurrentTerm is the key of the current term in the raft stable store.
+var keyCurrentTerm = []byte("CurrentTerm")
+
+// raftStorage holds the raft stores of a server.
+type raftStorage struct {
+	logs      *boltdb.BoltStore
+	stable    *boltdb.BoltStore
+	snapshots *raft.FileSnapshotStore
+}
+
+// openRaftStorage opens the raft stores of the given server in the storage directory, creating them if they do not exist,
+// unless the bolt options are read-only.
+func openRaftStorage(storageDir, serverID string, logger hclog.Logger, boltOpts *bbolt.Options) (*raftStorage, error) {
+	baseDir := filepath.Join(storageDir, serverID)
+	readOnly := boltOpts != nil && boltOpts.ReadOnly
+	if _, err := os.Stat(baseDir); os.IsNotExist(err) {
+		if readOnly {
+			return nil, fmt.Errorf("no raft storage found at %s", baseDir)
+		}
+		if err := os.MkdirAll(baseDir, 0o755); err != nil {
+			return nil, fmt.Errorf("error creating storage dir: %w", err)
+		}
+	}
+
+	logStorePath := filepath.Join(baseDir, "raft-log.db")
+	logStore, err := boltdb.New(boltdb.Options{Path: logStorePath, BoltOptions: boltOpts})
+	if err != nil {
+		return nil, fmt.Errorf(`boltdb.NewBoltStore(%q): %w`, logStorePath, err)
+	}
+
+	stableStorePath := filepath.Join(baseDir, "raft-stable.db")
+	stableStore, err := boltdb.New(boltdb.Options{Path: stableStorePath, BoltOptions: boltOpts})
+	if err != nil {
+		logStore.Close()
+		return nil, fmt.Errorf(`boltdb.NewBoltStore(%q): %w`, stableStorePath, err)
+	}
+
+	snapshotStore, err := raft.NewFileSnapshotStoreWithLogger(baseDir, 1, logger)
+	if err != nil {
+		logStore.Close()
+		stableStore.Close()
+		return nil, fmt.Errorf(`raft.NewFileSnapshotStore(%q): %w`, baseDir, err)
+	}
+
+	return &raftStorage{
+		logs:      logStore,
+		stable:    stableStore,
+		snapshots: snapshotStore,
+	}, nil
+}
+
+// openOfflineRaftStorage opens the raft stores of a server that is not running, failing if an op-conductor holds them.
+func openOfflineRaftStorage(storageDir, serverID string, readOnly bool) (*raftStorage, error) {
+	storage, err := openRaftStorage(storageDir, serverID, hclog.NewNullLogger(), &bbolt.Options{Timeout: storageLockTimeout, ReadOnly: readOnly})
+	if errors.Is(err, bbolt.ErrTimeout) {
+		return nil, fmt.Errorf("raft storage of %s is locked, stop its op-conductor first: %w", serverID, err)
+	}
+	return storage, err
+}
+
+// Close closes the raft stores.
+func (s *raftStorage) Close() error {
+	return errors.Join(s.logs.Close(), s.stable.Close())
+}
+
+// RaftStateDump is the raft state of a server, as stored on disk.
+type RaftStateDump struct {
+	// CurrentTerm is the latest raft term known to the server.
+	CurrentTerm uint64 `json:"currentTerm"`
+	// Snapshot is the latest FSM snapshot, nil if there is none.
+	Snapshot *SnapshotDump `json:"snapshot"`
+	// Logs are the raft log entries after the snapshot.
+	Logs []LogDump `json:"logs"`
+	// UnsafeHead is the latest unsafe payload of the server, from the snapshot or the log entries after it.
+	// Note that the latest log entries may not have been committed by the cluster.
+	UnsafeHead *eth.ExecutionPayloadEnvelope `json:"unsafeHead"`
+}
+
+// SnapshotDump is a raft FSM snapshot.
+type SnapshotDump struct {
+	ID         string                        `json:"id"`
+	Index      uint64                        `json:"index"`
+	Term       uint64                        `json:"term"`
+	Membership ClusterMembership             `json:"membership"`
+	UnsafeHead *eth.ExecutionPayloadEnvelope `json:"unsafeHead"`
+}
+
+// LogDump is a raft log entry. Commands hold the unsafe payload that was committed, and
+// configuration changes the new cluster membership.
+type LogDump struct {
+	Index      uint64             `json:"index"`
+	Term       uint64             `json:"term"`
+	Type       string             `json:"type"`
+	Block      *eth.BlockID       `json:"block,omitempty"`
+	Membership *ClusterMembership `json:"membership,omitempty"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// DumpRaftState reads the raft state of a server that is not running from the storage directory.
+func DumpRaftState(storageDir, serverID string) (*RaftStateDump, error) {
+	storage, err := openOfflineRaftStorage(storageDir, serverID, true)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Close()
+
+	dump := &RaftStateDump{Logs: []LogDump{}}
+	if dump.CurrentTerm, err = storage.stable.GetUint64(keyCurrentTerm); err != nil && !errors.Is(err, boltdb.ErrKeyNotFound) {
+		return nil, fmt.Errorf("failed to read current term: %w", err)
+	}
+
+	snapshots, err := storage.snapshots.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	var snapshotIndex uint64
+	if len(snapshots) > 0 {
+		meta, source, err := storage.snapshots.Open(snapshots[0].ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open snapshot %s: %w", snapshots[0].ID, err)
+		}
+		tracker := NewUnsafeHeadTracker(log.Root(), 0)
+		if err := tracker.Restore(source); err != nil {
+			return nil, fmt.Errorf("failed to restore snapshot %s: %w", meta.ID, err)
+		}
+		dump.Snapshot = &SnapshotDump{
+			ID:         meta.ID,
+			Index:      meta.Index,
+			Term:       meta.Term,
+			Membership: membership(meta.Configuration, meta.ConfigurationIndex),
+			UnsafeHead: tracker.UnsafeHead(),
+		}
+		dump.UnsafeHead = tracker.UnsafeHead()
+		snapshotIndex = meta.Index
+	}
+
+	first, err := storage.logs.FirstIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read first log index: %w", err)
+	}
+	last, err := storage.logs.LastIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read last log index: %w", err)
+	}
+	for index := max(first, snapshotIndex+1); first > 0 && index <= last; index++ {
+		var entry raft.Log
+		if err := storage.logs.GetLog(index, &entry); err != nil {
+			return nil, fmt.Errorf("failed to read log %d: %w", index, err)
+		}
+		logDump := LogDump{
+			Index: entry.Index,
+			Term:  entry.Term,
+			Type:  entry.Type.String(),
+		}
+		switch entry.Type {
+		case raft.LogCommand:
+			payload := &eth.ExecutionPayloadEnvelope{}
+			if err := payload.UnmarshalSSZ(uint32(len(entry.Data)), bytes.NewReader(entry.Data)); err != nil {
+				logDump.Error = err.Error()
+				break
+			}
+			block := payload.ExecutionPayload.ID()
+			logDump.Block = &block
+			if dump.UnsafeHead == nil || dump.UnsafeHead.ExecutionPayload.BlockNumber < payload.ExecutionPayload.BlockNumber {
+				dump.UnsafeHead = payload
+			}
+		case raft.LogConfiguration:
+			m := membership(raft.DecodeConfiguration(entry.Data), entry.Index)
+			logDump.Membership = &m
+		}
+		dump.Logs = append(dump.Logs, logDump)
+	}
+	return dump, nil
+}
+
+// BootstrapFromSnapshot bootstraps a new single-node cluster of the given server from an unsafe payload, to rebuild
+// a cluster from a backup. The server must not have any raft state yet. Once its op-conductor is started (without
+// raft bootstrap), the server elects itself as leader, with the given unsafe payload as the latest, and other servers
+// can join the cluster.
+func BootstrapFromSnapshot(storageDir, serverID, serverAddr string, unsafeHead *eth.ExecutionPayloadEnvelope) error {
+	if unsafeHead == nil {
+		return errors.New("missing unsafe head")
+	}
+	storage, err := openOfflineRaftStorage(storageDir, serverID, false)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	hasState, err := raft.HasExistingState(storage.logs, storage.stable, storage.snapshots)
+	if err != nil {
+		return fmt.Errorf("failed to check for existing state: %w", err)
+	}
+	if hasState {
+		return fmt.Errorf("refusing to bootstrap server %s with existing raft state", serverID)
+	}
+
+	// Like raft bootstrapping, place the configuration at index 1 of term 1, but in a snapshot along with the FSM.
+	configuration := raft.Configuration{
+		Servers: []raft.Server{{
+			ID:       raft.ServerID(serverID),
+			Address:  raft.ServerAddress(serverAddr),
+			Suffrage: raft.Voter,
+		}},
+	}
+	_, trans := raft.NewInmemTransport(raft.ServerAddress(serverAddr))
+	defer trans.Close()
+	sink, err := storage.snapshots.Create(1, 1, 1, configuration, 1, trans)
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot: %w", err)
+	}
+	snap := &snapshot{log: log.Root(), unsafeHead: unsafeHead}
+	if err := snap.Persist(sink); err != nil {
+		return fmt.Errorf("failed to persist snapshot: %w", err)
+	}
+	if err := storage.stable.SetUint64(keyCurrentTerm, 1); err != nil {
+		return fmt.Errorf("failed to set current term: %w", err)
+	}
+	return nil
+}
+
+// ForceMembership overwrites the cluster membership in the raft state of a server that is not running, to recover a
+// cluster that lost the majority of its voters. The FSM state and log entries are compacted into a new snapshot with
+// the given membership. Every server of the new membership must be forced to the same membership before it is started.
+func ForceMembership(storageDir, serverID string, servers []ServerInfo) error {
+	configuration := raft.Configuration{}
+	for _, srv := range servers {
+		configuration.Servers = append(configuration.Servers, raft.Server{
+			ID:       raft.ServerID(srv.ID),
+			Address:  raft.ServerAddress(srv.Addr),
+			Suffrage: raft.ServerSuffrage(srv.Suffrage),
+		})
+	}
+
+	// The FSM cannot be snapshotted without an unsafe head
+	dump, err := DumpRaftState(storageDir, serverID)
+	if err != nil {
+		return err
+	}
+	if dump.UnsafeHead == nil {
+		return fmt.Errorf("no unsafe head in raft state of server %s", serverID)
+	}
+
+	storage, err := openOfflineRaftStorage(storageDir, serverID, false)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	conf := raft.DefaultConfig()
+	conf.LocalID = raft.ServerID(serverID)
+	conf.Logger = hclog.NewNullLogger()
+	_, trans := raft.NewInmemTransport("")
+	defer trans.Close()
+	fsm := NewUnsafeHeadTracker(log.Root(), 0)
+	if err := raft.RecoverCluster(conf, fsm, storage.logs, storage.stable, storage.snapshots, trans, configuration); err != nil {
+		return fmt.Errorf("failed to recover cluster: %w", err)
+	}
+	return nil
+}
+
+func membership(configuration raft.Configuration, index uint64) ClusterMembership {
+	m := ClusterMembership{Servers: []ServerInfo{}, Version: index}
+	for _, srv := range configuration.Servers {
+		m.Servers = append(m.Servers, ServerInfo{
+			ID:       string(srv.ID),
+			Addr:     string(srv.Address),
+			Suffrage: ServerSuffrage(srv.Suffrage),
+		})
+	}
+	return m
+}
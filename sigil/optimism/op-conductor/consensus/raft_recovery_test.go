@@ -0,0 +1,209 @@
+package consensus
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type testServer struct {
+	id   string
+	addr string
+	dir  string
+	cons *RaftConsensus
+}
+
+func newTestServer(t *testing.T, id, dir string) *testServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+	return &testServer{id: id, addr: addr, dir: dir}
+}
+
+func (s *testServer) start(t *testing.T, bootstrap bool) {
+	now := uint64(time.Now().Unix())
+	cons, err := NewRaftConsensus(testlog.Logger(t, log.LevelInfo).New("server", s.id), &RaftConsensusConfig{
+		ServerID:          s.id,
+		ServerAddr:        s.addr,
+		StorageDir:        s.dir,
+		Bootstrap:         bootstrap,
+		RollupCfg:         &rollup.Config{CanyonTime: &now},
+		SnapshotInterval:  120 * time.Second,
+		SnapshotThreshold: 10240,
+		TrailingLogs:      8192,
+		PayloadHistory:    16,
+	})
+	require.NoError(t, err)
+	s.cons = cons
+}
+
+func (s *testServer) stop(t *testing.T) {
+	require.NoError(t, s.cons.Shutdown())
+	s.cons = nil
+}
+
+// requireUnsafeHead waits until the server applied the unsafe payload of the given block number.
+func requireUnsafeHead(t *testing.T, s *testServer, num uint64) {
+	require.Eventually(t, func() bool {
+		head := s.cons.unsafeTracker.UnsafeHead()
+		return head != nil && uint64(head.ExecutionPayload.BlockNumber) == num
+	}, 10*time.Second, 50*time.Millisecond, "server %s did not apply block %d", s.id, num)
+}
+
+// requireLeader waits until one of the servers is the leader, and returns it.
+func requireLeader(t *testing.T, servers ...*testServer) *testServer {
+	var leader *testServer
+	require.Eventually(t, func() bool {
+		for _, s := range servers {
+			if s.cons.Leader() {
+				leader = s
+				return true
+			}
+		}
+		return false
+	}, 10*time.Second, 50*time.Millisecond, "no leader elected")
+	return leader
+}
+
+func commitPayloads(t *testing.T, s *testServer, from, to uint64) {
+	for num := from; num <= to; num++ {
+		require.NoError(t, s.cons.CommitUnsafePayload(createPayloadEnvelope(num)))
+	}
+}
+
+func requireMembership(t *testing.T, m ClusterMembership, servers ...*testServer) {
+	expected := make([]ServerInfo, 0, len(servers))
+	for _, s := range servers {
+		expected = append(expected, ServerInfo{ID: s.id, Addr: s.addr, Suffrage: Voter})
+	}
+	require.ElementsMatch(t, expected, m.Servers)
+}
+
+func TestRaftRecovery(t *testing.T) {
+	dir := t.TempDir()
+	a, b, c := newTestServer(t, "a", dir), newTestServer(t, "b", dir), newTestServer(t, "c", dir)
+	a.start(t, true)
+	b.start(t, false)
+	c.start(t, false)
+	requireLeader(t, a)
+	require.NoError(t, a.cons.AddVoter(b.id, b.addr, 0))
+	require.NoError(t, a.cons.AddVoter(c.id, c.addr, 0))
+
+	commitPayloads(t, a, 1, 3)
+	require.NoError(t, a.cons.r.Snapshot().Error())
+	commitPayloads(t, a, 4, 5)
+	requireUnsafeHead(t, b, 5)
+	requireUnsafeHead(t, c, 5)
+
+	_, err := DumpRaftState(dir, a.id)
+	require.ErrorContains(t, err, "stop its op-conductor first", "storage of a running server is locked")
+
+	a.stop(t)
+	b.stop(t)
+	c.stop(t)
+
+	t.Run("dump", func(t *testing.T) {
+		dump, err := DumpRaftState(dir, a.id)
+		require.NoError(t, err)
+		require.NotZero(t, dump.CurrentTerm)
+
+		require.NotNil(t, dump.Snapshot)
+		require.Equal(t, uint64(3), uint64(dump.Snapshot.UnsafeHead.ExecutionPayload.BlockNumber))
+		requireMembership(t, dump.Snapshot.Membership, a, b, c)
+
+		var blocks []uint64
+		for _, l := range dump.Logs {
+			require.Greater(t, l.Index, dump.Snapshot.Index)
+			require.Empty(t, l.Error)
+			if l.Type == raft.LogCommand.String() {
+				blocks = append(blocks, l.Block.Number)
+			}
+		}
+		require.Equal(t, []uint64{4, 5}, blocks)
+		require.Equal(t, createPayloadEnvelope(5).ExecutionPayload.ID(), dump.UnsafeHead.ExecutionPayload.ID())
+
+		_, err = DumpRaftState(dir, "unknown")
+		require.ErrorContains(t, err, "no raft storage found")
+	})
+
+	t.Run("bootstrap", func(t *testing.T) {
+		dump, err := DumpRaftState(dir, b.id)
+		require.NoError(t, err)
+
+		bootstrapDir := t.TempDir()
+		d, e := newTestServer(t, "d", bootstrapDir), newTestServer(t, "e", bootstrapDir)
+		require.NoError(t, BootstrapFromSnapshot(bootstrapDir, d.id, d.addr, dump.UnsafeHead))
+		require.ErrorContains(t, BootstrapFromSnapshot(bootstrapDir, d.id, d.addr, dump.UnsafeHead), "existing raft state")
+		require.ErrorContains(t, BootstrapFromSnapshot(dir, a.id, a.addr, dump.UnsafeHead), "existing raft state")
+
+		d.start(t, false)
+		defer d.stop(t)
+		requireLeader(t, d)
+		head, err := d.cons.LatestUnsafePayload()
+		require.NoError(t, err)
+		require.Equal(t, dump.UnsafeHead.ExecutionPayload.ID(), head.ExecutionPayload.ID())
+
+		e.start(t, false)
+		defer e.stop(t)
+		require.NoError(t, d.cons.AddVoter(e.id, e.addr, 0))
+		commitPayloads(t, d, 6, 6)
+		requireUnsafeHead(t, e, 6)
+		membership, err := d.cons.ClusterMembership()
+		require.NoError(t, err)
+		requireMembership(t, *membership, d, e)
+	})
+
+	t.Run("force-membership", func(t *testing.T) {
+		servers := []ServerInfo{{ID: a.id, Addr: a.addr, Suffrage: Voter}, {ID: b.id, Addr: b.addr, Suffrage: Voter}}
+		require.NoError(t, ForceMembership(dir, a.id, servers))
+		require.NoError(t, ForceMembership(dir, b.id, servers))
+		require.ErrorContains(t, ForceMembership(t.TempDir(), a.id, servers), "no raft storage found")
+
+		dump, err := DumpRaftState(dir, a.id)
+		require.NoError(t, err)
+		require.Empty(t, dump.Logs, "logs are compacted into the snapshot")
+		requireMembership(t, dump.Snapshot.Membership, a, b)
+		require.Equal(t, uint64(5), uint64(dump.Snapshot.UnsafeHead.ExecutionPayload.BlockNumber))
+
+		// c is gone, but a and b can elect a leader without it
+		a.start(t, false)
+		defer a.stop(t)
+		b.start(t, false)
+		defer b.stop(t)
+		leader := requireLeader(t, a, b)
+		membership, err := leader.cons.ClusterMembership()
+		require.NoError(t, err)
+		requireMembership(t, *membership, a, b)
+
+		head, err := leader.cons.LatestUnsafePayload()
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), uint64(head.ExecutionPayload.BlockNumber))
+		commitPayloads(t, leader, 6, 6)
+		requireUnsafeHead(t, a, 6)
+		requireUnsafeHead(t, b, 6)
+	})
+}
+
+func TestBootstrapFromSnapshotMissingHead(t *testing.T) {
+	require.ErrorContains(t, BootstrapFromSnapshot(t.TempDir(), "a", "127.0.0.1:0", nil), "missing unsafe head")
+}
+
+func TestForceMembershipWithoutUnsafeHead(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestServer(t, "a", dir)
+	s.start(t, true)
+	requireLeader(t, s)
+	s.stop(t)
+
+	err := ForceMembership(dir, s.id, []ServerInfo{{ID: s.id, Addr: s.addr, Suffrage: Voter}})
+	require.ErrorContains(t, err, fmt.Sprintf("no unsafe head in raft state of server %s", s.id))
+}
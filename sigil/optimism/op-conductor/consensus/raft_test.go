@@ -81,3 +81,28 @@ func TestCommitAndRead(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, payload, unsafeHead)
 }
+
+func TestFollowerUnsafePayloads(t *testing.T) {
+	dir := t.TempDir()
+	leader, follower := newTestServer(t, "leader", dir), newTestServer(t, "follower", dir)
+	leader.start(t, true)
+	defer leader.stop(t)
+	follower.start(t, false)
+	defer follower.stop(t)
+	requireLeader(t, leader)
+	require.NoError(t, leader.cons.AddVoter(follower.id, follower.addr, 0))
+
+	ch := make(chan *eth.ExecutionPayloadEnvelope, 10)
+	sub := follower.cons.SubscribeUnsafePayloads(ch)
+	defer sub.Unsubscribe()
+	commitPayloads(t, leader, 1, 3)
+	for num := uint64(1); num <= 3; num++ {
+		require.Equal(t, hexutil.Uint64(num), (<-ch).ExecutionPayload.BlockNumber)
+	}
+
+	payload, err := follower.cons.UnsafePayloadByNumber(2)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(2), payload.ExecutionPayload.BlockNumber)
+	_, err = follower.cons.UnsafePayloadByNumber(4)
+	require.ErrorIs(t, err, ErrUnsafePayloadNotFound)
+}
@@ -63,6 +63,12 @@ var (
 		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "RAFT_TRAILING_LOGS"),
 		Value:   10240,
 	}
+	RaftPayloadHistory = &cli.Uint64Flag{
+		Name:    "raft.payload-history",
+		Usage:   "Number of latest committed unsafe payloads to keep in memory, to serve them by block number",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "RAFT_PAYLOAD_HISTORY"),
+		Value:   128,
+	}
 	NodeRPC = &cli.StringFlag{
 		Name:    "node.rpc",
 		Usage:   "HTTP provider URL for op-node",
@@ -100,6 +106,92 @@ var (
 		Usage:   "Minimum number of peers required to be considered healthy",
 		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_MIN_PEER_COUNT"),
 	}
+	HealthCheckMinScore = &cli.Uint64Flag{
+		Name:    "healthcheck.min-score",
+		Usage:   "Minimum health score (0-100) from the weighted health rules required to be considered healthy",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_MIN_SCORE"),
+		Value:   0,
+	}
+	HealthCheckExecutionLatencyWeight = &cli.Uint64Flag{
+		Name:    "healthcheck.execution-latency.weight",
+		Usage:   "Weight of the execution client RPC latency rule in the health score, 0 to disable the rule",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_EXECUTION_LATENCY_WEIGHT"),
+		Value:   0,
+	}
+	HealthCheckExecutionMaxLatency = &cli.DurationFlag{
+		Name:    "healthcheck.execution-latency.max",
+		Usage:   "Maximum RPC latency of the execution client",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_EXECUTION_LATENCY_MAX"),
+		Value:   time.Second,
+	}
+	HealthCheckEngineErrorsWeight = &cli.Uint64Flag{
+		Name:    "healthcheck.engine-errors.weight",
+		Usage:   "Weight of the engine API errors rule in the health score, 0 to disable the rule",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_ENGINE_ERRORS_WEIGHT"),
+		Value:   0,
+	}
+	HealthCheckEngineRPC = &cli.StringFlag{
+		Name:    "healthcheck.engine-errors.rpc",
+		Usage:   "Engine API provider URL of the execution layer",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_ENGINE_ERRORS_RPC"),
+	}
+	HealthCheckEngineJWTSecret = &cli.StringFlag{
+		Name:      "healthcheck.engine-errors.jwt-secret",
+		Usage:     "Path to the JWT secret file used to authenticate to the engine API",
+		EnvVars:   opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_ENGINE_ERRORS_JWT_SECRET"),
+		TakesFile: true,
+	}
+	HealthCheckEngineMaxErrors = &cli.Uint64Flag{
+		Name:    "healthcheck.engine-errors.max",
+		Usage:   "Maximum number of engine API errors within the last 10 health checks",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_ENGINE_ERRORS_MAX"),
+		Value:   3,
+	}
+	HealthCheckTxPoolWeight = &cli.Uint64Flag{
+		Name:    "healthcheck.txpool.weight",
+		Usage:   "Weight of the txpool saturation rule in the health score, 0 to disable the rule",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_TXPOOL_WEIGHT"),
+		Value:   0,
+	}
+	HealthCheckTxPoolMaxTxs = &cli.Uint64Flag{
+		Name:    "healthcheck.txpool.max-txs",
+		Usage:   "Maximum number of pending and queued transactions in the txpool of the execution layer",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_TXPOOL_MAX_TXS"),
+		Value:   5120,
+	}
+	HealthCheckL1Weight = &cli.Uint64Flag{
+		Name:    "healthcheck.l1.weight",
+		Usage:   "Weight of the L1 RPC reachability rule in the health score, 0 to disable the rule",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_L1_WEIGHT"),
+		Value:   0,
+	}
+	HealthCheckL1RPC = &cli.StringFlag{
+		Name:    "healthcheck.l1.rpc",
+		Usage:   "HTTP provider URL for L1, as used by op-node",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_L1_RPC"),
+	}
+	HealthCheckDiskUsageWeight = &cli.Uint64Flag{
+		Name:    "healthcheck.disk-usage.weight",
+		Usage:   "Weight of the execution layer datadir disk usage rule in the health score, 0 to disable the rule",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_DISK_USAGE_WEIGHT"),
+		Value:   0,
+	}
+	HealthCheckDiskUsageDatadir = &cli.StringFlag{
+		Name:    "healthcheck.disk-usage.datadir",
+		Usage:   "Datadir of the execution layer",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_DISK_USAGE_DATADIR"),
+	}
+	HealthCheckDiskUsageMax = &cli.Uint64Flag{
+		Name:    "healthcheck.disk-usage.max",
+		Usage:   "Maximum disk usage of the execution layer datadir in percent",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_DISK_USAGE_MAX"),
+		Value:   90,
+	}
+	HealthCheckPeerRPCs = &cli.StringSliceFlag{
+		Name:    "healthcheck.peer-rpcs",
+		Usage:   "Conductor RPC URLs of the other servers in the cluster, as <server ID>=<URL>. Leadership is transferred to the follower with the best health score among them, instead of to any voter",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_PEER_RPCS"),
+	}
 	Paused = &cli.BoolFlag{
 		Name:    "paused",
 		Usage:   "Whether the conductor is paused",
@@ -112,6 +204,12 @@ var (
 		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "RPC_ENABLE_PROXY"),
 		Value:   true,
 	}
+	RPCEnableUnsafePayloads = &cli.BoolFlag{
+		Name:    "rpc.enable-unsafe-payloads",
+		Usage:   "Enable the RPC API serving committed unsafe payloads by block number and over a websocket subscription",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "RPC_ENABLE_UNSAFE_PAYLOADS"),
+		Value:   false,
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -132,9 +230,26 @@ var optionalFlags = []cli.Flag{
 	RaftBootstrap,
 	HealthCheckSafeEnabled,
 	HealthCheckSafeInterval,
+	HealthCheckMinScore,
+	HealthCheckExecutionLatencyWeight,
+	HealthCheckExecutionMaxLatency,
+	HealthCheckEngineErrorsWeight,
+	HealthCheckEngineRPC,
+	HealthCheckEngineJWTSecret,
+	HealthCheckEngineMaxErrors,
+	HealthCheckTxPoolWeight,
+	HealthCheckTxPoolMaxTxs,
+	HealthCheckL1Weight,
+	HealthCheckL1RPC,
+	HealthCheckDiskUsageWeight,
+	HealthCheckDiskUsageDatadir,
+	HealthCheckDiskUsageMax,
+	HealthCheckPeerRPCs,
 	RaftSnapshotInterval,
 	RaftSnapshotThreshold,
 	RaftTrailingLogs,
+	RaftPayloadHistory,
+	RPCEnableUnsafePayloads,
 }
 
 func init() {
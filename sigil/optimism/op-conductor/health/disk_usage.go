@@ -0,0 +1,30 @@
+//go:build !windows && !openbsd
+
+package health
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+func diskUsage(path string) (uint64, uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, 0, fmt.Errorf("failed to call Statfs: %w", err)
+	}
+	bavail := stat.Bavail
+	// nolint:staticcheck
+	if stat.Bavail < 0 {
+		// FreeBSD can have a negative number of blocks available because of the grace limit.
+		bavail = 0
+	}
+	//nolint:unconvert
+	total := uint64(stat.Blocks) * uint64(stat.Bsize)
+	//nolint:unconvert
+	free := uint64(bavail) * uint64(stat.Bsize)
+	if free > total {
+		free = total
+	}
+	return total - free, total, nil
+}
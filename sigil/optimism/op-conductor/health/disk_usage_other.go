@@ -0,0 +1,7 @@
+//go:build windows || openbsd
+
+package health
+
+func diskUsage(path string) (uint64, uint64, error) {
+	return 0, 0, ErrDiskUsageUnsupported
+}
@@ -21,6 +21,51 @@ func (_m *HealthMonitor) EXPECT() *HealthMonitor_Expecter {
 	return &HealthMonitor_Expecter{mock: &_m.Mock}
 }
 
+// Score provides a mock function with given fields:
+func (_m *HealthMonitor) Score() uint64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Score")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// HealthMonitor_Score_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Score'
+type HealthMonitor_Score_Call struct {
+	*mock.Call
+}
+
+// Score is a helper method to define mock.On call
+func (_e *HealthMonitor_Expecter) Score() *HealthMonitor_Score_Call {
+	return &HealthMonitor_Score_Call{Call: _e.mock.On("Score")}
+}
+
+func (_c *HealthMonitor_Score_Call) Run(run func()) *HealthMonitor_Score_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthMonitor_Score_Call) Return(_a0 uint64) *HealthMonitor_Score_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthMonitor_Score_Call) RunAndReturn(run func() uint64) *HealthMonitor_Score_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *HealthMonitor) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -29,13 +30,17 @@ type HealthMonitor interface {
 	Start(ctx context.Context) error
 	// Stop stops the health check.
 	Stop() error
+	// Score returns the health score of the latest health check, from 0 to MaxHealthScore.
+	Score() uint64
 }
 
 // NewSequencerHealthMonitor creates a new sequencer health monitor.
 // interval is the interval between health checks measured in seconds.
 // safeInterval is the interval between safe head progress measured in seconds.
 // minPeerCount is the minimum number of peers required for the sequencer to be healthy.
-func NewSequencerHealthMonitor(log log.Logger, metrics metrics.Metricer, interval, unsafeInterval, safeInterval, minPeerCount uint64, safeEnabled bool, rollupCfg *rollup.Config, node dial.RollupClientInterface, p2p p2p.API) HealthMonitor {
+// rules are the weighted health rules that make up the health score, and minScore is the minimum
+// health score required for the sequencer to be healthy.
+func NewSequencerHealthMonitor(log log.Logger, metrics metrics.Metricer, interval, unsafeInterval, safeInterval, minPeerCount uint64, safeEnabled bool, rollupCfg *rollup.Config, node dial.RollupClientInterface, p2p p2p.API, rules []HealthRule, minScore uint64) HealthMonitor {
 	return &SequencerHealthMonitor{
 		log:            log,
 		metrics:        metrics,
@@ -49,6 +54,8 @@ func NewSequencerHealthMonitor(log log.Logger, metrics metrics.Metricer, interva
 		timeProviderFn: currentTimeProvicer,
 		node:           node,
 		p2p:            p2p,
+		rules:          rules,
+		minScore:       minScore,
 	}
 }
 
@@ -73,6 +80,10 @@ type SequencerHealthMonitor struct {
 
 	node dial.RollupClientInterface
 	p2p  p2p.API
+
+	rules    []HealthRule
+	minScore uint64
+	score    atomic.Uint64
 }
 
 var _ HealthMonitor = (*SequencerHealthMonitor)(nil)
@@ -105,6 +116,11 @@ func (hm *SequencerHealthMonitor) Subscribe() <-chan error {
 	return hm.healthUpdateCh
 }
 
+// Score implements HealthMonitor.
+func (hm *SequencerHealthMonitor) Score() uint64 {
+	return hm.score.Load()
+}
+
 func (hm *SequencerHealthMonitor) loop(ctx context.Context) {
 	defer hm.wg.Done()
 
@@ -130,12 +146,56 @@ func (hm *SequencerHealthMonitor) loop(ctx context.Context) {
 	}
 }
 
-// healthCheck checks the health of the sequencer by 3 criteria:
+// healthCheck checks the health of the sequencer, and updates its health score.
+// The sequencer is not healthy if it fails the fixed checks of checkSequencer, which sets its score to 0,
+// or if the weighted health rules put its score below the configured minimum.
+func (hm *SequencerHealthMonitor) healthCheck(ctx context.Context) error {
+	if err := hm.checkSequencer(ctx); err != nil {
+		hm.score.Store(0)
+		hm.metrics.RecordHealthScore(0)
+		return err
+	}
+
+	score := hm.checkRules(ctx)
+	hm.score.Store(score)
+	hm.metrics.RecordHealthScore(score)
+	if score < hm.minScore {
+		hm.log.Error("health score is below minimum", "score", score, "min_score", hm.minScore)
+		return ErrSequencerNotHealthy
+	}
+
+	hm.log.Info("sequencer is healthy", "score", score)
+	return nil
+}
+
+// checkRules runs the health rules, and returns the health score: the share of the total weight
+// of the rules that passed, scaled to MaxHealthScore.
+func (hm *SequencerHealthMonitor) checkRules(ctx context.Context) uint64 {
+	var total, passed uint64
+	for _, rule := range hm.rules {
+		total += rule.Weight()
+		ruleCtx, cancel := context.WithTimeout(ctx, time.Duration(hm.interval)*time.Second)
+		err := rule.Check(ruleCtx)
+		cancel()
+		hm.metrics.RecordHealthRule(rule.Name(), err == nil)
+		if err != nil {
+			hm.log.Warn("health rule failed", "rule", rule.Name(), "weight", rule.Weight(), "err", err)
+			continue
+		}
+		passed += rule.Weight()
+	}
+	if total == 0 {
+		return MaxHealthScore
+	}
+	return passed * MaxHealthScore / total
+}
+
+// checkSequencer checks the health of the sequencer by 4 criteria:
 // 1. unsafe head is progressing per block time
 // 2. unsafe head is not too far behind now (measured by unsafeInterval)
 // 3. safe head is progressing every configured batch submission interval
 // 4. peer count is above the configured minimum
-func (hm *SequencerHealthMonitor) healthCheck(ctx context.Context) error {
+func (hm *SequencerHealthMonitor) checkSequencer(ctx context.Context) error {
 	status, err := hm.node.SyncStatus(ctx)
 	if err != nil {
 		hm.log.Error("health monitor failed to get sync status", "err", err)
@@ -209,7 +269,6 @@ func (hm *SequencerHealthMonitor) healthCheck(ctx context.Context) error {
 		return ErrSequencerNotHealthy
 	}
 
-	hm.log.Info("sequencer is healthy")
 	return nil
 }
 
@@ -205,6 +205,66 @@ func (s *HealthMonitorTestSuite) TestHealthyWithUnsafeLag() {
 	s.NoError(monitor.Stop())
 }
 
+func (s *HealthMonitorTestSuite) TestHealthScore() {
+	s.T().Parallel()
+	now := uint64(time.Now().Unix())
+
+	rc := &testutils.MockRollupClient{}
+	pc := &p2pMocks.API{}
+	pc.EXPECT().PeerStats(mock.Anything).Return(&p2p.PeerStats{Connected: healthyPeerCount}, nil)
+
+	l1 := &testutils.MockEthClient{}
+	disk := NewDiskUsageRule(1, 90, "/data")
+	disk.diskUsageFn = func(string) (uint64, uint64, error) { return 50, 100, nil }
+	monitor := &SequencerHealthMonitor{
+		log:            s.log,
+		interval:       s.interval,
+		metrics:        &metrics.NoopMetricsImpl{},
+		rollupCfg:      s.rollupCfg,
+		unsafeInterval: 60,
+		safeInterval:   60,
+		minPeerCount:   s.minPeerCount,
+		timeProviderFn: (&timeProvider{now: now}).Now,
+		node:           rc,
+		p2p:            pc,
+		rules:          []HealthRule{NewL1ReachabilityRule(1, l1), disk},
+		minScore:       75,
+	}
+
+	// all rules pass
+	rc.ExpectSyncStatus(mockSyncStatus(now, 1, now, 1), nil)
+	l1.ExpectInfoByLabel(eth.Unsafe, &testutils.MockBlockInfo{}, nil)
+	s.NoError(monitor.healthCheck(context.Background()))
+	s.Equal(uint64(MaxHealthScore), monitor.Score())
+
+	// the disk usage rule fails, half of the total weight
+	disk.diskUsageFn = func(string) (uint64, uint64, error) { return 95, 100, nil }
+	rc.ExpectSyncStatus(mockSyncStatus(now, 1, now, 1), nil)
+	l1.ExpectInfoByLabel(eth.Unsafe, &testutils.MockBlockInfo{}, nil)
+	s.Equal(ErrSequencerNotHealthy, monitor.healthCheck(context.Background()))
+	s.Equal(uint64(50), monitor.Score())
+
+	// a heavier disk usage rule failing alone is still below the minimum score
+	disk.weight = 3
+	rc.ExpectSyncStatus(mockSyncStatus(now, 1, now, 1), nil)
+	l1.ExpectInfoByLabel(eth.Unsafe, &testutils.MockBlockInfo{}, nil)
+	s.Equal(ErrSequencerNotHealthy, monitor.healthCheck(context.Background()))
+	s.Equal(uint64(25), monitor.Score())
+
+	// the l1 rule failing alone is not
+	disk.diskUsageFn = func(string) (uint64, uint64, error) { return 50, 100, nil }
+	rc.ExpectSyncStatus(mockSyncStatus(now, 1, now, 1), nil)
+	l1.ExpectInfoByLabel(eth.Unsafe, nil, errRule)
+	s.NoError(monitor.healthCheck(context.Background()))
+	s.Equal(uint64(75), monitor.Score())
+
+	// failing the fixed checks resets the score
+	rc.ExpectSyncStatus(nil, errRule)
+	s.Equal(ErrSequencerConnectionDown, monitor.healthCheck(context.Background()))
+	s.Zero(monitor.Score())
+	l1.AssertExpectations(s.T())
+}
+
 func mockSyncStatus(unsafeTime, unsafeNum, safeTime, safeNum uint64) *eth.SyncStatus {
 	return &eth.SyncStatus{
 		UnsafeL2: eth.L2BlockRef{
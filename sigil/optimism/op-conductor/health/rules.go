@@ -0,0 +1,218 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const (
+	ExecutionLatencyRuleName = "execution-latency"
+	EngineErrorsRuleName     = "engine-errors"
+	TxPoolSaturationRuleName = "txpool-saturation"
+	L1ReachabilityRuleName   = "l1-reachability"
+	DiskUsageRuleName        = "disk-usage"
+)
+
+// MaxHealthScore is the health score of a sequencer that passes all of its health checks.
+const MaxHealthScore = 100
+
+// HealthRule is a weighted health check of the sequencer, on top of the fixed checks of the health monitor.
+// A failing rule lowers the health score of the sequencer by its share of the total weight of the rules.
+type HealthRule interface {
+	// Name returns the name of the rule.
+	Name() string
+	// Weight returns the weight of the rule in the health score.
+	Weight() uint64
+	// Check returns an error if the sequencer does not pass the rule.
+	Check(ctx context.Context) error
+}
+
+// L1Client is the subset of the L1 client used to check that L1 is reachable.
+type L1Client interface {
+	InfoByLabel(ctx context.Context, label eth.BlockLabel) (eth.BlockInfo, error)
+}
+
+// ExecutionLatencyRule checks that the execution client answers a simple RPC call within the max latency.
+type ExecutionLatencyRule struct {
+	weight     uint64
+	maxLatency time.Duration
+	rpc        client.RPC
+}
+
+// NewExecutionLatencyRule creates a new rule that checks the RPC latency of the execution client.
+func NewExecutionLatencyRule(weight uint64, maxLatency time.Duration, rpc client.RPC) *ExecutionLatencyRule {
+	return &ExecutionLatencyRule{
+		weight:     weight,
+		maxLatency: maxLatency,
+		rpc:        rpc,
+	}
+}
+
+func (r *ExecutionLatencyRule) Name() string   { return ExecutionLatencyRuleName }
+func (r *ExecutionLatencyRule) Weight() uint64 { return r.weight }
+
+func (r *ExecutionLatencyRule) Check(ctx context.Context) error {
+	start := time.Now()
+	var num hexutil.Uint64
+	if err := r.rpc.CallContext(ctx, &num, "eth_blockNumber"); err != nil {
+		return fmt.Errorf("failed to get block number: %w", err)
+	}
+	if latency := time.Since(start); latency > r.maxLatency {
+		return fmt.Errorf("execution rpc latency %v exceeds max latency %v", latency, r.maxLatency)
+	}
+	return nil
+}
+
+// EngineErrorsRule checks that the engine API of the execution client does not fail more than
+// maxErrors times within the last window checks.
+type EngineErrorsRule struct {
+	weight    uint64
+	maxErrors int
+	engine    client.RPC
+
+	mu      sync.Mutex
+	results []bool // Whether each of the last window checks failed, oldest first
+	window  int
+}
+
+// NewEngineErrorsRule creates a new rule that checks the error rate of the engine API.
+// The engine RPC must be authenticated with the JWT secret of the execution client.
+func NewEngineErrorsRule(weight uint64, maxErrors, window int, engine client.RPC) *EngineErrorsRule {
+	return &EngineErrorsRule{
+		weight:    weight,
+		maxErrors: maxErrors,
+		engine:    engine,
+		window:    window,
+	}
+}
+
+func (r *EngineErrorsRule) Name() string   { return EngineErrorsRuleName }
+func (r *EngineErrorsRule) Weight() uint64 { return r.weight }
+
+func (r *EngineErrorsRule) Check(ctx context.Context) error {
+	var capabilities []string
+	callErr := r.engine.CallContext(ctx, &capabilities, "engine_exchangeCapabilities", []string{})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, callErr != nil)
+	if len(r.results) > r.window {
+		r.results = r.results[len(r.results)-r.window:]
+	}
+	errs := 0
+	for _, failed := range r.results {
+		if failed {
+			errs++
+		}
+	}
+	if errs > r.maxErrors {
+		return fmt.Errorf("%d engine api errors in the last %d checks, latest: %w", errs, len(r.results), callErr)
+	}
+	return nil
+}
+
+// TxPoolSaturationRule checks that the txpool of the execution client holds no more than maxTxs transactions.
+type TxPoolSaturationRule struct {
+	weight uint64
+	maxTxs uint64
+	rpc    client.RPC
+}
+
+// NewTxPoolSaturationRule creates a new rule that checks the number of transactions in the txpool.
+func NewTxPoolSaturationRule(weight, maxTxs uint64, rpc client.RPC) *TxPoolSaturationRule {
+	return &TxPoolSaturationRule{
+		weight: weight,
+		maxTxs: maxTxs,
+		rpc:    rpc,
+	}
+}
+
+func (r *TxPoolSaturationRule) Name() string   { return TxPoolSaturationRuleName }
+func (r *TxPoolSaturationRule) Weight() uint64 { return r.weight }
+
+// TxPoolStatus is the result of the txpool_status RPC.
+type TxPoolStatus struct {
+	Pending hexutil.Uint64 `json:"pending"`
+	Queued  hexutil.Uint64 `json:"queued"`
+}
+
+func (r *TxPoolSaturationRule) Check(ctx context.Context) error {
+	var status TxPoolStatus
+	if err := r.rpc.CallContext(ctx, &status, "txpool_status"); err != nil {
+		return fmt.Errorf("failed to get txpool status: %w", err)
+	}
+	if txs := uint64(status.Pending) + uint64(status.Queued); txs > r.maxTxs {
+		return fmt.Errorf("txpool holds %d transactions, more than %d", txs, r.maxTxs)
+	}
+	return nil
+}
+
+// L1ReachabilityRule checks that the L1 RPC is reachable.
+type L1ReachabilityRule struct {
+	weight uint64
+	l1     L1Client
+}
+
+// NewL1ReachabilityRule creates a new rule that checks the reachability of the L1 RPC.
+func NewL1ReachabilityRule(weight uint64, l1 L1Client) *L1ReachabilityRule {
+	return &L1ReachabilityRule{
+		weight: weight,
+		l1:     l1,
+	}
+}
+
+func (r *L1ReachabilityRule) Name() string   { return L1ReachabilityRuleName }
+func (r *L1ReachabilityRule) Weight() uint64 { return r.weight }
+
+func (r *L1ReachabilityRule) Check(ctx context.Context) error {
+	if _, err := r.l1.InfoByLabel(ctx, eth.Unsafe); err != nil {
+		return fmt.Errorf("failed to get l1 head: %w", err)
+	}
+	return nil
+}
+
+var ErrDiskUsageUnsupported = errors.New("disk usage is not supported on this platform")
+
+// DiskUsageRule checks that the disk usage of the execution client datadir is at most maxUsage percent.
+type DiskUsageRule struct {
+	weight   uint64
+	maxUsage uint64
+	datadir  string
+
+	diskUsageFn func(path string) (used, total uint64, err error)
+}
+
+// NewDiskUsageRule creates a new rule that checks the disk usage of the execution client datadir.
+func NewDiskUsageRule(weight, maxUsage uint64, datadir string) *DiskUsageRule {
+	return &DiskUsageRule{
+		weight:      weight,
+		maxUsage:    maxUsage,
+		datadir:     datadir,
+		diskUsageFn: diskUsage,
+	}
+}
+
+func (r *DiskUsageRule) Name() string   { return DiskUsageRuleName }
+func (r *DiskUsageRule) Weight() uint64 { return r.weight }
+
+func (r *DiskUsageRule) Check(_ context.Context) error {
+	used, total, err := r.diskUsageFn(r.datadir)
+	if err != nil {
+		return fmt.Errorf("failed to get disk usage of %s: %w", r.datadir, err)
+	}
+	if total == 0 {
+		return fmt.Errorf("disk of %s has no capacity", r.datadir)
+	}
+	if usage := used * 100 / total; usage > r.maxUsage {
+		return fmt.Errorf("disk usage of %s is %d%%, more than %d%%", r.datadir, usage, r.maxUsage)
+	}
+	return nil
+}
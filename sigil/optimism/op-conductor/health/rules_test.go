@@ -0,0 +1,118 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+var errRule = errors.New("rule error")
+
+func TestExecutionLatencyRule(t *testing.T) {
+	rpc := &testutils.MockRPC{}
+	rule := NewExecutionLatencyRule(2, 100*time.Millisecond, rpc)
+	require.Equal(t, ExecutionLatencyRuleName, rule.Name())
+	require.Equal(t, uint64(2), rule.Weight())
+
+	rpc.ExpectCallContext(new(hexutil.Uint64), "eth_blockNumber", nil, nil)
+	require.NoError(t, rule.Check(context.Background()))
+
+	rpc.Mock.On("CallContext", mock.Anything, new(hexutil.Uint64), "eth_blockNumber", []any(nil)).Once().After(200 * time.Millisecond).Return(nil)
+	require.ErrorContains(t, rule.Check(context.Background()), "exceeds max latency")
+
+	rpc.ExpectCallContext(new(hexutil.Uint64), "eth_blockNumber", nil, errRule)
+	require.ErrorIs(t, rule.Check(context.Background()), errRule)
+	rpc.AssertExpectations(t)
+}
+
+func TestEngineErrorsRule(t *testing.T) {
+	rpc := &testutils.MockRPC{}
+	rule := NewEngineErrorsRule(1, 1, 3, rpc)
+	require.Equal(t, EngineErrorsRuleName, rule.Name())
+
+	expect := func(err error) {
+		rpc.ExpectCallContext(new([]string), "engine_exchangeCapabilities", []any{[]string{}}, err)
+	}
+	expect(nil)
+	require.NoError(t, rule.Check(context.Background()))
+	expect(errRule)
+	require.NoError(t, rule.Check(context.Background()), "a single error is allowed")
+	expect(errRule)
+	require.ErrorIs(t, rule.Check(context.Background()), errRule)
+	expect(nil)
+	require.Error(t, rule.Check(context.Background()), "two errors left in the window")
+	expect(nil)
+	require.NoError(t, rule.Check(context.Background()), "first error dropped out of the window")
+	rpc.AssertExpectations(t)
+}
+
+func TestTxPoolSaturationRule(t *testing.T) {
+	rpc := &testutils.MockRPC{}
+	rule := NewTxPoolSaturationRule(1, 100, rpc)
+	require.Equal(t, TxPoolSaturationRuleName, rule.Name())
+
+	expect := func(status TxPoolStatus) {
+		rpc.Mock.On("CallContext", mock.Anything, mock.Anything, "txpool_status", []any(nil)).Once().Run(func(args mock.Arguments) {
+			*args.Get(1).(*TxPoolStatus) = status
+		}).Return(nil)
+	}
+	expect(TxPoolStatus{Pending: 60, Queued: 40})
+	require.NoError(t, rule.Check(context.Background()))
+	expect(TxPoolStatus{Pending: 60, Queued: 41})
+	require.ErrorContains(t, rule.Check(context.Background()), "txpool holds 101 transactions")
+
+	rpc.ExpectCallContext(new(TxPoolStatus), "txpool_status", nil, errRule)
+	require.ErrorIs(t, rule.Check(context.Background()), errRule)
+	rpc.AssertExpectations(t)
+}
+
+func TestL1ReachabilityRule(t *testing.T) {
+	l1 := &testutils.MockEthClient{}
+	rule := NewL1ReachabilityRule(1, l1)
+	require.Equal(t, L1ReachabilityRuleName, rule.Name())
+
+	l1.ExpectInfoByLabel(eth.Unsafe, &testutils.MockBlockInfo{InfoNum: 1}, nil)
+	require.NoError(t, rule.Check(context.Background()))
+
+	l1.ExpectInfoByLabel(eth.Unsafe, nil, errRule)
+	require.ErrorIs(t, rule.Check(context.Background()), errRule)
+	l1.AssertExpectations(t)
+}
+
+func TestDiskUsageRule(t *testing.T) {
+	rule := NewDiskUsageRule(1, 80, "/data")
+	require.Equal(t, DiskUsageRuleName, rule.Name())
+
+	var used, total uint64
+	var err error
+	rule.diskUsageFn = func(path string) (uint64, uint64, error) {
+		require.Equal(t, "/data", path)
+		return used, total, err
+	}
+
+	used, total = 80, 100
+	require.NoError(t, rule.Check(context.Background()))
+	used = 81
+	require.ErrorContains(t, rule.Check(context.Background()), "disk usage of /data is 81%")
+	total = 0
+	require.Error(t, rule.Check(context.Background()))
+	err = errRule
+	require.ErrorIs(t, rule.Check(context.Background()), errRule)
+
+	// The disk usage of an existing directory can be read on supported platforms
+	used, total, err = diskUsage(t.TempDir())
+	if errors.Is(err, ErrDiskUsageUnsupported) {
+		t.Skip("disk usage not supported")
+	}
+	require.NoError(t, err)
+	require.NotZero(t, total)
+	require.LessOrEqual(t, used, total)
+}
@@ -18,6 +18,8 @@ type Metricer interface {
 	RecordStartSequencer(success bool)
 	RecordStopSequencer(success bool)
 	RecordHealthCheck(success bool, err error)
+	RecordHealthScore(score uint64)
+	RecordHealthRule(rule string, success bool)
 	RecordLoopExecutionTime(duration float64)
 }
 
@@ -33,6 +35,8 @@ type Metrics struct {
 	up   prometheus.Gauge
 
 	healthChecks    *prometheus.CounterVec
+	healthScore     prometheus.Gauge
+	healthRules     *prometheus.CounterVec
 	leaderTransfers *prometheus.CounterVec
 	sequencerStarts *prometheus.CounterVec
 	sequencerStops  *prometheus.CounterVec
@@ -73,6 +77,16 @@ func NewMetrics() *Metrics {
 			Name:      "healthchecks_count",
 			Help:      "Number of healthchecks",
 		}, []string{"success", "error"}),
+		healthScore: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "health_score",
+			Help:      "Health score of the sequencer from the latest healthcheck",
+		}),
+		healthRules: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "health_rules_count",
+			Help:      "Number of health rule checks",
+		}, []string{"rule", "success"}),
 		leaderTransfers: factory.NewCounterVec(prometheus.CounterOpts{
 			Namespace: Namespace,
 			Name:      "leader_transfers_count",
@@ -131,6 +145,16 @@ func (m *Metrics) RecordHealthCheck(success bool, err error) {
 	m.healthChecks.WithLabelValues(strconv.FormatBool(success), errStr).Inc()
 }
 
+// RecordHealthScore sets the healthScore gauge.
+func (m *Metrics) RecordHealthScore(score uint64) {
+	m.healthScore.Set(float64(score))
+}
+
+// RecordHealthRule increments the healthRules counter.
+func (m *Metrics) RecordHealthRule(rule string, success bool) {
+	m.healthRules.WithLabelValues(rule, strconv.FormatBool(success)).Inc()
+}
+
 // RecordLeaderTransfer increments the leaderTransfers counter.
 func (m *Metrics) RecordLeaderTransfer(success bool) {
 	m.leaderTransfers.WithLabelValues(strconv.FormatBool(success)).Inc()
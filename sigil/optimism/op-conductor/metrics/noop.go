@@ -11,4 +11,6 @@ func (*NoopMetricsImpl) RecordLeaderTransfer(success bool)
 func (*NoopMetricsImpl) RecordStartSequencer(success bool)                        {}
 func (*NoopMetricsImpl) RecordStopSequencer(success bool)                         {}
 func (*NoopMetricsImpl) RecordHealthCheck(success bool, err error)                {}
+func (*NoopMetricsImpl) RecordHealthScore(score uint64)                           {}
+func (*NoopMetricsImpl) RecordHealthRule(rule string, success bool)               {}
 func (*NoopMetricsImpl) RecordLoopExecutionTime(duration float64)                 {}
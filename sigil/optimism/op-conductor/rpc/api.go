@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
@@ -31,6 +32,8 @@ type API interface {
 	Stopped(ctx context.Context) (bool, error)
 	// SequencerHealthy returns true if the sequencer is healthy.
 	SequencerHealthy(ctx context.Context) (bool, error)
+	// SequencerHealthScore returns the health score of the sequencer, from 0 to 100.
+	SequencerHealthScore(ctx context.Context) (uint64, error)
 
 	// Consensus related APIs
 	// Leader returns true if the server is the leader.
@@ -71,6 +74,16 @@ type NodeProxyAPI interface {
 	RollupConfig(ctx context.Context) (*rollup.Config, error)
 }
 
+// UnsafePayloadsAPI defines the methods serving the unsafe payloads committed to the cluster,
+// to op-nodes and indexers following the sequencer.
+type UnsafePayloadsAPI interface {
+	// UnsafePayloadByNumber returns a committed unsafe payload by block number, if it is still in the payload history.
+	UnsafePayloadByNumber(ctx context.Context, number hexutil.Uint64) (*eth.ExecutionPayloadEnvelope, error)
+	// UnsafePayloads subscribes to the committed unsafe payloads, in block number order.
+	// It is a conductor_subscribe subscription, over websockets.
+	UnsafePayloads(ctx context.Context) (*rpc.Subscription, error)
+}
+
 // NodeProxyAPI defines the methods proxied to the node rpc backend
 // This should include all methods that are called by op-batcher or op-proposer
 type NodeAdminProxyAPI interface {
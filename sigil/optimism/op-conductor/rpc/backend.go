@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync/atomic"
 
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
@@ -17,6 +18,7 @@ type conductor interface {
 	Paused() bool
 	Stopped() bool
 	SequencerHealthy(ctx context.Context) bool
+	SequencerHealthScore(ctx context.Context) uint64
 
 	Leader(ctx context.Context) bool
 	LeaderWithID(ctx context.Context) *consensus.ServerInfo
@@ -27,6 +29,8 @@ type conductor interface {
 	TransferLeaderToServer(ctx context.Context, id string, addr string) error
 	CommitUnsafePayload(ctx context.Context, payload *eth.ExecutionPayloadEnvelope) error
 	ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error)
+	UnsafePayloadByNumber(ctx context.Context, num uint64) (*eth.ExecutionPayloadEnvelope, error)
+	SubscribeUnsafePayloads(ch chan<- *eth.ExecutionPayloadEnvelope) event.Subscription
 }
 
 // APIBackend is the backend implementation of the API.
@@ -138,6 +142,11 @@ func (api *APIBackend) SequencerHealthy(ctx context.Context) (bool, error) {
 	return api.con.SequencerHealthy(ctx), nil
 }
 
+// SequencerHealthScore implements API.
+func (api *APIBackend) SequencerHealthScore(ctx context.Context) (uint64, error) {
+	return api.con.SequencerHealthScore(ctx), nil
+}
+
 // ClusterMembership implements API.
 func (api *APIBackend) ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error) {
 	return api.con.ClusterMembership(ctx)
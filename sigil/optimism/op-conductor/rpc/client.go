@@ -3,6 +3,8 @@ package rpc
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
@@ -124,9 +126,28 @@ func (c *APIClient) SequencerHealthy(ctx context.Context) (bool, error) {
 	return healthy, err
 }
 
+// SequencerHealthScore implements API.
+func (c *APIClient) SequencerHealthScore(ctx context.Context) (uint64, error) {
+	var score uint64
+	err := c.c.CallContext(ctx, &score, prefixRPC("sequencerHealthScore"))
+	return score, err
+}
+
 // ClusterMembership implements API.
 func (c *APIClient) ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error) {
 	var clusterMembership consensus.ClusterMembership
 	err := c.c.CallContext(ctx, &clusterMembership, prefixRPC("clusterMembership"))
 	return &clusterMembership, err
 }
+
+// UnsafePayloadByNumber returns a committed unsafe payload by block number.
+func (c *APIClient) UnsafePayloadByNumber(ctx context.Context, num uint64) (*eth.ExecutionPayloadEnvelope, error) {
+	var payload *eth.ExecutionPayloadEnvelope
+	err := c.c.CallContext(ctx, &payload, prefixRPC("unsafePayloadByNumber"), hexutil.Uint64(num))
+	return payload, err
+}
+
+// SubscribeUnsafePayloads subscribes to the committed unsafe payloads. It requires a websocket connection.
+func (c *APIClient) SubscribeUnsafePayloads(ctx context.Context, ch chan<- *eth.ExecutionPayloadEnvelope) (ethereum.Subscription, error) {
+	return c.c.Subscribe(ctx, UnsafePayloadsRPCNamespace, ch, "unsafePayloads")
+}
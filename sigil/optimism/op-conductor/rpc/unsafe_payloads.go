@@ -0,0 +1,143 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+var UnsafePayloadsRPCNamespace = "conductor"
+
+var ErrStopped = errors.New("refusing to serve unsafe payloads of stopped conductor")
+
+// unsafePayloadsBufferSize is the number of committed unsafe payloads buffered for each subscriber.
+const unsafePayloadsBufferSize = 64
+
+// UnsafePayloadsBackend serves the unsafe payloads committed to the cluster, with a check that the conductor still
+// participates in consensus before each call. Leaders and followers serve the same payloads, as they are only
+// applied once committed by the cluster.
+type UnsafePayloadsBackend struct {
+	log log.Logger
+	con conductor
+}
+
+var _ UnsafePayloadsAPI = (*UnsafePayloadsBackend)(nil)
+
+// NewUnsafePayloadsBackend creates a new UnsafePayloadsBackend instance.
+func NewUnsafePayloadsBackend(log log.Logger, con conductor) *UnsafePayloadsBackend {
+	return &UnsafePayloadsBackend{
+		log: log,
+		con: con,
+	}
+}
+
+// UnsafePayloadByNumber implements UnsafePayloadsAPI.
+func (api *UnsafePayloadsBackend) UnsafePayloadByNumber(ctx context.Context, number hexutil.Uint64) (*eth.ExecutionPayloadEnvelope, error) {
+	payload, err := api.con.UnsafePayloadByNumber(ctx, uint64(number))
+	if err != nil {
+		return nil, err
+	}
+	if api.con.Stopped() {
+		return nil, ErrStopped
+	}
+	return payload, nil
+}
+
+// UnsafePayloads implements UnsafePayloadsAPI.
+func (api *UnsafePayloadsBackend) UnsafePayloads(ctx context.Context) (*rpc.Subscription, error) {
+	if api.con.Stopped() {
+		return nil, ErrStopped
+	}
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+	go api.streamUnsafePayloads(notifier, rpcSub)
+	return rpcSub, nil
+}
+
+// streamUnsafePayloads notifies the subscriber of the committed unsafe payloads, in block number order.
+// If the subscriber lags behind the cluster, its consensus subscription ends: it is resubscribed, and
+// the payloads it missed are filled in from the payload history.
+func (api *UnsafePayloadsBackend) streamUnsafePayloads(notifier *rpc.Notifier, rpcSub *rpc.Subscription) {
+	payloads := make(chan *eth.ExecutionPayloadEnvelope, unsafePayloadsBufferSize)
+	sub := api.con.SubscribeUnsafePayloads(payloads)
+	defer func() {
+		sub.Unsubscribe()
+	}()
+
+	var last uint64
+	notify := func(payload *eth.ExecutionPayloadEnvelope) error {
+		if api.con.Stopped() {
+			return ErrStopped
+		}
+		if err := notifier.Notify(rpcSub.ID, payload); err != nil {
+			return err
+		}
+		last = uint64(payload.ExecutionPayload.BlockNumber)
+		return nil
+	}
+	// backfill notifies the subscriber of the payloads of the history after the last one, and before the given block number.
+	// Without a block number, it stops at the first payload that is not in the history.
+	backfill := func(before uint64) error {
+		var missing uint64
+		for next := last + 1; before == 0 || next < before; next++ {
+			payload, err := api.con.UnsafePayloadByNumber(context.Background(), next)
+			if errors.Is(err, consensus.ErrUnsafePayloadNotFound) {
+				if before == 0 {
+					return nil
+				}
+				missing++
+				continue
+			} else if err != nil {
+				return err
+			}
+			if err := notify(payload); err != nil {
+				return err
+			}
+		}
+		if missing > 0 {
+			api.log.Warn("unsafe payloads missed by subscriber are no longer in the payload history", "id", rpcSub.ID, "missing", missing, "before", before)
+		}
+		return nil
+	}
+
+	for {
+		var err error
+		select {
+		case payload := <-payloads:
+			num := uint64(payload.ExecutionPayload.BlockNumber)
+			if last == 0 {
+				err = notify(payload)
+			} else if num > last {
+				if err = backfill(num); err == nil {
+					err = notify(payload)
+				}
+			}
+		case subErr := <-sub.Err():
+			if subErr == nil {
+				return
+			}
+			api.log.Warn("unsafe payloads subscriber is lagging, resubscribing", "id", rpcSub.ID, "err", subErr)
+			sub = api.con.SubscribeUnsafePayloads(payloads)
+			// Catch up with the payloads committed since the subscriber started lagging. Payloads that are
+			// still buffered, or that are sent again by the new subscription, are skipped.
+			if last != 0 {
+				err = backfill(0)
+			}
+		case <-rpcSub.Err():
+			return
+		}
+		if err != nil {
+			api.log.Info("ending unsafe payloads subscription", "id", rpcSub.ID, "err", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type payloadTracker interface {
+	raft.FSM
+	UnsafePayloadByNumber(num uint64) *eth.ExecutionPayloadEnvelope
+	Subscribe(ch chan<- *eth.ExecutionPayloadEnvelope) event.Subscription
+}
+
+// payloadsConductor serves the unsafe payloads of a raft FSM. Once gated, Stopped blocks until the gate is closed.
+type payloadsConductor struct {
+	conductor
+	fsm     payloadTracker
+	stopped atomic.Bool
+	gate    atomic.Pointer[chan struct{}]
+}
+
+func (c *payloadsConductor) Stopped() bool {
+	if gate := c.gate.Load(); gate != nil {
+		<-*gate
+	}
+	return c.stopped.Load()
+}
+
+func (c *payloadsConductor) UnsafePayloadByNumber(_ context.Context, num uint64) (*eth.ExecutionPayloadEnvelope, error) {
+	if payload := c.fsm.UnsafePayloadByNumber(num); payload != nil {
+		return payload, nil
+	}
+	return nil, consensus.ErrUnsafePayloadNotFound
+}
+
+func (c *payloadsConductor) SubscribeUnsafePayloads(ch chan<- *eth.ExecutionPayloadEnvelope) event.Subscription {
+	return c.fsm.Subscribe(ch)
+}
+
+func (c *payloadsConductor) commit(t *testing.T, from, to uint64) {
+	for num := from; num <= to; num++ {
+		blobGas := hexutil.Uint64(0)
+		payload := &eth.ExecutionPayloadEnvelope{
+			ParentBeaconBlockRoot: &common.Hash{},
+			ExecutionPayload: &eth.ExecutionPayload{
+				BlockNumber:   eth.Uint64Quantity(num),
+				BlockHash:     common.Hash{byte(num)},
+				Withdrawals:   &types.Withdrawals{},
+				ExcessBlobGas: &blobGas,
+				BlobGasUsed:   &blobGas,
+			},
+		}
+		var buf bytes.Buffer
+		_, err := payload.MarshalSSZ(&buf)
+		require.NoError(t, err)
+		require.Nil(t, c.fsm.Apply(&raft.Log{Data: buf.Bytes()}))
+	}
+}
+
+func setupUnsafePayloads(t *testing.T) (*payloadsConductor, *APIClient) {
+	logger := testlog.Logger(t, log.LevelDebug)
+	con := &payloadsConductor{fsm: consensus.NewUnsafeHeadTracker(logger, 256)}
+	server := oprpc.NewServer("127.0.0.1", 0, "test", oprpc.WithWebsocketEnabled())
+	server.AddAPI(rpc.API{
+		Namespace: UnsafePayloadsRPCNamespace,
+		Service:   NewUnsafePayloadsBackend(logger, con),
+	})
+	require.NoError(t, server.Start())
+	t.Cleanup(func() {
+		_ = server.Stop()
+	})
+
+	rpcClient, err := rpc.Dial(fmt.Sprintf("ws://%s", server.Endpoint()))
+	require.NoError(t, err)
+	client := NewAPIClient(rpcClient)
+	t.Cleanup(client.Close)
+	return con, client
+}
+
+func requireUnsafePayloads(t *testing.T, ch <-chan *eth.ExecutionPayloadEnvelope, from, to uint64) {
+	for num := from; num <= to; num++ {
+		select {
+		case payload := <-ch:
+			require.Equal(t, num, uint64(payload.ExecutionPayload.BlockNumber))
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for unsafe payload %d", num)
+		}
+	}
+}
+
+func TestUnsafePayloadByNumber(t *testing.T) {
+	con, client := setupUnsafePayloads(t)
+	con.commit(t, 1, 3)
+
+	payload, err := client.UnsafePayloadByNumber(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), uint64(payload.ExecutionPayload.BlockNumber))
+	require.Equal(t, common.Hash{2}, payload.ExecutionPayload.BlockHash)
+
+	_, err = client.UnsafePayloadByNumber(context.Background(), 4)
+	require.ErrorContains(t, err, consensus.ErrUnsafePayloadNotFound.Error())
+
+	con.stopped.Store(true)
+	_, err = client.UnsafePayloadByNumber(context.Background(), 2)
+	require.ErrorContains(t, err, ErrStopped.Error())
+}
+
+func TestSubscribeUnsafePayloads(t *testing.T) {
+	con, client := setupUnsafePayloads(t)
+	ch := make(chan *eth.ExecutionPayloadEnvelope, 1000)
+	sub, err := client.SubscribeUnsafePayloads(context.Background(), ch)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	con.commit(t, 1, 3)
+	requireUnsafePayloads(t, ch, 1, 3)
+
+	// Hold the stream while committing more payloads than the subscriber buffers, so that it lags behind.
+	gate := make(chan struct{})
+	con.gate.Store(&gate)
+	con.commit(t, 4, 4+2*unsafePayloadsBufferSize)
+	con.gate.Store(nil)
+	close(gate)
+	requireUnsafePayloads(t, ch, 4, 4+2*unsafePayloadsBufferSize)
+
+	con.commit(t, 5+2*unsafePayloadsBufferSize, 10+2*unsafePayloadsBufferSize)
+	requireUnsafePayloads(t, ch, 5+2*unsafePayloadsBufferSize, 10+2*unsafePayloadsBufferSize)
+	select {
+	case payload := <-ch:
+		t.Fatalf("unexpected unsafe payload %d", payload.ExecutionPayload.BlockNumber)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	t.Run("stopped", func(t *testing.T) {
+		con.stopped.Store(true)
+		_, err := client.SubscribeUnsafePayloads(context.Background(), make(chan *eth.ExecutionPayloadEnvelope))
+		require.ErrorContains(t, err, ErrStopped.Error())
+	})
+}
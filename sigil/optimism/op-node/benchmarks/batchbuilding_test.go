@@ -45,6 +45,7 @@ var (
 		derive.Brotli,
 		derive.Brotli9,
 		derive.Brotli11,
+		derive.Zstd,
 	}
 
 	// compressors used in the benchmark
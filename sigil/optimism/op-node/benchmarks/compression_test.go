@@ -0,0 +1,116 @@
+package benchmarks
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/traindict"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// sigilBatchesDir is where fetch-sigil-batches.sh records the batches posted by the Sigil batcher.
+	sigilBatchesDir = "testdata/sigil"
+	// sigilRollupConfig is the rollup config of Sigil, to decode the recorded batches with.
+	sigilRollupConfig = "../../rollup.json"
+)
+
+// compressionSamples returns the span batches to compare the compression algos on, the zstd dictionary to use, and
+// where the span batches come from. The span batches are read from the channels of a recording if
+// RECORDED_BATCHES_DIR, the transactions cache of `batch_decoder fetch`, and RECORDED_ROLLUP_CONFIG, the rollup config
+// file to decode them with, are set. Otherwise, they are read from the Sigil batches recorded by
+// fetch-sigil-batches.sh if there are any, and only then from the synthetic span batch fixture.
+// The zstd dictionary is read from RECORDED_ZSTD_DICT if set. Otherwise, it is trained on the first half of the
+// span batches, and only the second half is returned.
+func compressionSamples(t testing.TB) (samples [][]byte, zstdDict []byte, source string) {
+	dir, cfgPath := os.Getenv("RECORDED_BATCHES_DIR"), os.Getenv("RECORDED_ROLLUP_CONFIG")
+	source = "recorded"
+	if dir == "" && cfgPath == "" {
+		if _, err := os.Stat(sigilBatchesDir); err == nil {
+			dir, cfgPath, source = sigilBatchesDir, sigilRollupConfig, "sigil"
+		}
+	}
+	if dir != "" && cfgPath != "" {
+		rollupCfg, err := jsonutil.LoadJSON[rollup.Config](cfgPath)
+		require.NoError(t, err)
+		samples = traindict.SpanBatchSamples(reassemble.LoadFrames(dir, common.Address{}), rollupCfg)
+	} else {
+		samples, source = loadSpanBatchFixture(t), "synthetic"
+	}
+	require.NotEmpty(t, samples, "no %s span batches", source)
+
+	if dictPath := os.Getenv("RECORDED_ZSTD_DICT"); dictPath != "" {
+		zstdDict, err := os.ReadFile(dictPath)
+		require.NoError(t, err)
+		return samples, zstdDict, source
+	}
+	require.Greater(t, len(samples), 1, "not enough span batches to train a dictionary on")
+	zstdDict, err := traindict.Train(samples[:len(samples)/2], 64<<10, 1)
+	require.NoError(t, err)
+	return samples[len(samples)/2:], zstdDict, source
+}
+
+// compressSamples compresses each sample into its own channel, and returns the total compressed size.
+func compressSamples(t testing.TB, samples [][]byte, algo derive.CompressionAlgo, dict []byte) int {
+	var compressed int
+	for _, s := range samples {
+		c, err := derive.NewChannelCompressorWithDict(algo, dict)
+		require.NoError(t, err)
+		_, err = c.Write(s)
+		require.NoError(t, err)
+		require.NoError(t, c.Close())
+		compressed += c.Len()
+	}
+	return compressed
+}
+
+func TestZstdDictCompression(t *testing.T) {
+	samples, zstdDict, source := compressionSamples(t)
+	plain := compressSamples(t, samples, derive.Zstd, nil)
+	withDict := compressSamples(t, samples, derive.Zstd, zstdDict)
+	brotli := compressSamples(t, samples, derive.Brotli10, nil)
+	t.Logf("compressed sizes of %d %s span batches: zstd %d, zstd with dictionary %d, brotli-10 %d",
+		len(samples), source, plain, withDict, brotli)
+	require.Less(t, withDict, plain, "dictionary should improve zstd compression")
+}
+
+// BenchmarkSpanBatchCompression compresses span batches with every compression algo, each into its own channel,
+// and reports the compression ratio next to the time.
+// The name of each benchmark includes where the span batches come from, as the synthetic fixture only approximates
+// real batches. See compressionSamples for how to run it on recorded batches instead.
+// Hint: use -benchtime=1x, as a single iteration compresses all span batches
+func BenchmarkSpanBatchCompression(b *testing.B) {
+	samples, zstdDict, source := compressionSamples(b)
+	var uncompressed int
+	for _, s := range samples {
+		uncompressed += len(s)
+	}
+	tcs := []struct {
+		algo derive.CompressionAlgo
+		dict []byte
+	}{
+		{derive.Zlib, nil},
+		{derive.Brotli10, nil},
+		{derive.Brotli11, nil},
+		{derive.Zstd, nil},
+		{derive.Zstd, zstdDict},
+	}
+	for _, tc := range tcs {
+		name := fmt.Sprintf("Source=%s, Algo=%s, Dict=%d, Batches=%d, Bytes=%d", source, tc.algo, len(tc.dict), len(samples), uncompressed)
+		b.Run(name, func(b *testing.B) {
+			var compressed int
+			for bn := 0; bn < b.N; bn++ {
+				compressed = compressSamples(b, samples, tc.algo, tc.dict)
+			}
+			b.ReportMetric(float64(compressed)/float64(uncompressed), "ratio")
+			b.ReportMetric(float64(compressed), "compressed-bytes")
+		})
+	}
+}
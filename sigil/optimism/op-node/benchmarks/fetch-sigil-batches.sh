@@ -0,0 +1,38 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+# Records the batches the Sigil batcher posted to L1 in an L1 block range, so that the span batch compression
+# benchmarks run on real Sigil span batches instead of the synthetic fixture. The batch inbox and batcher address are
+# read from the Sigil rollup config. The transactions are written to testdata/sigil, which the benchmarks use
+# when it exists.
+
+usage() {
+    echo "Usage: $0 <start> <end>"
+    echo "  <start> : First L1 block (inclusive) to fetch batches from."
+    echo "  <end>   : Last L1 block (exclusive) to fetch batches from."
+    echo "L1_RPC and L1_BEACON must be set to a Holesky execution and beacon node."
+}
+
+if [ "$#" -ne 2 ]; then
+    usage
+    exit 1
+fi
+
+: "${L1_RPC:?L1_RPC must be set}"
+: "${L1_BEACON:?L1_BEACON must be set}"
+
+script_dir=$(cd "$(dirname "$0")" && pwd)
+rollup_config="$script_dir/../../rollup.json"
+out="$script_dir/testdata/sigil"
+
+inbox=$(jq -r '.batch_inbox_address' "$rollup_config")
+sender=$(jq -r '.genesis.system_config.batcherAddr' "$rollup_config")
+
+mkdir -p "$out"
+cd "$script_dir/.."
+go run ./cmd/batch_decoder fetch \
+    --start "$1" --end "$2" \
+    --inbox "$inbox" --sender "$sender" \
+    --l1 "$L1_RPC" --l1.beacon "$L1_BEACON" \
+    --out "$out"
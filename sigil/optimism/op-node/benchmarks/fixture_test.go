@@ -0,0 +1,142 @@
+package benchmarks
+
+import (
+	"compress/gzip"
+	"crypto/ecdsa"
+	"flag"
+	"math/big"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/traindict"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// spanBatchFixture holds span batches, as they are encoded in a channel before compression, that were built from a
+// synthetic chain of ERC20 transfers by TestGenerateSpanBatchFixture. Unlike random batches, they have the
+// repetitive structure of real batches that a dictionary can be trained on, but they aren't Sigil batches: run
+// fetch-sigil-batches.sh to benchmark on those instead.
+const spanBatchFixture = "testdata/synthetic_span_batches.rlp.gz"
+
+var updateFixture = flag.Bool("update-fixture", false, "regenerate "+spanBatchFixture)
+
+func loadSpanBatchFixture(t testing.TB) [][]byte {
+	f, err := os.Open(spanBatchFixture)
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	var samples [][]byte
+	require.NoError(t, rlp.Decode(r, &samples))
+	return samples
+}
+
+// TestGenerateSpanBatchFixture regenerates the span batch fixture when run with -update-fixture.
+func TestGenerateSpanBatchFixture(t *testing.T) {
+	if !*updateFixture {
+		t.Skip("run with -update-fixture to regenerate " + spanBatchFixture)
+	}
+	const blocks, blocksPerBatch = 480, 16
+	rollupCfg := &rollup.Config{
+		Genesis:   rollup.Genesis{L2Time: 1_700_000_000},
+		BlockTime: 2,
+		L2ChainID: big.NewInt(333),
+	}
+	db := syntheticChain(t, rand.New(rand.NewSource(0x5161)), rollupCfg, blocks)
+	samples, err := traindict.DataDirSamples(db, rollupCfg, 1, blocks+1, blocksPerBatch)
+	require.NoError(t, err)
+
+	f, err := os.Create(spanBatchFixture)
+	require.NoError(t, err)
+	defer f.Close()
+	w := gzip.NewWriter(f)
+	require.NoError(t, rlp.Encode(w, samples))
+	require.NoError(t, w.Close())
+}
+
+// syntheticChain writes a chain of L2 blocks with ERC20 transfers between a small set of accounts to a database.
+func syntheticChain(t *testing.T, rng *rand.Rand, rollupCfg *rollup.Config, blocks int) ethdb.Database {
+	var (
+		keys       []*ecdsa.PrivateKey
+		nonces     = make(map[int]uint64)
+		tokens     []common.Address
+		recipients []common.Address
+	)
+	for i := 0; i < 12; i++ {
+		key, err := crypto.ToECDSA(crypto.Keccak256([]byte{byte(i)}))
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+	for i := 0; i < 6; i++ {
+		tokens = append(tokens, common.BytesToAddress(crypto.Keccak256([]byte("token"), []byte{byte(i)})))
+	}
+	for i := 0; i < 40; i++ {
+		recipients = append(recipients, common.BytesToAddress(crypto.Keccak256([]byte("recipient"), []byte{byte(i)})))
+	}
+	// transfer(address,uint256)
+	selector := crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+	signer := types.LatestSignerForChainID(rollupCfg.L2ChainID)
+
+	db := rawdb.NewMemoryDatabase()
+	parent := common.Hash{}
+	var l1Block *types.Block
+	for n := 1; n <= blocks; n++ {
+		l2Time := rollupCfg.Genesis.L2Time + uint64(n)*rollupCfg.BlockTime
+		seqNumber := uint64(n % 6)
+		if seqNumber == 0 || l1Block == nil {
+			l1Block = types.NewBlockWithHeader(&types.Header{
+				Number:     big.NewInt(int64(1000 + n/6)),
+				Time:       l2Time,
+				BaseFee:    big.NewInt(int64(7 + rng.Intn(20))),
+				Difficulty: common.Big0,
+			})
+		}
+		l1Info, err := derive.L1InfoDeposit(rollupCfg, eth.SystemConfig{}, seqNumber, eth.BlockToInfo(l1Block), l2Time)
+		require.NoError(t, err)
+		txs := types.Transactions{types.NewTx(l1Info)}
+		for i, count := 0, 2+rng.Intn(4); i < count; i++ {
+			sender := rng.Intn(len(keys))
+			data := make([]byte, 0, 68)
+			data = append(data, selector...)
+			data = append(data, common.LeftPadBytes(recipients[rng.Intn(len(recipients))].Bytes(), 32)...)
+			data = append(data, common.LeftPadBytes(big.NewInt(rng.Int63n(1_000_000)*1e12).Bytes(), 32)...)
+			tx, err := types.SignNewTx(keys[sender], signer, &types.DynamicFeeTx{
+				ChainID:   rollupCfg.L2ChainID,
+				Nonce:     nonces[sender],
+				GasTipCap: big.NewInt(1_000_000),
+				GasFeeCap: big.NewInt(1_000_000_000),
+				Gas:       uint64(52_000 + rng.Intn(8_000)),
+				To:        &tokens[rng.Intn(len(tokens))],
+				Data:      data,
+			})
+			require.NoError(t, err)
+			nonces[sender]++
+			txs = append(txs, tx)
+		}
+		header := &types.Header{
+			ParentHash: parent,
+			Number:     big.NewInt(int64(n)),
+			Time:       l2Time,
+			GasLimit:   30_000_000,
+			BaseFee:    big.NewInt(1_000_000),
+			Difficulty: common.Big0,
+		}
+		block := types.NewBlock(header, &types.Body{Transactions: txs}, nil, trie.NewStackTrie(nil))
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		parent = block.Hash()
+	}
+	return db
+}
@@ -10,9 +10,11 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/fetch"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/traindict"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -186,6 +188,71 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "train-dict",
+			Usage: "Trains a zstd dictionary on span batches of an op-geth datadir, or of fetched batch transactions",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "datadir",
+					Usage: "Chaindata directory of an op-geth node to build span batches from. The node must not be running",
+				},
+				&cli.Uint64Flag{
+					Name:  "start",
+					Usage: "First L2 block (inclusive) of the datadir to build span batches from",
+				},
+				&cli.Uint64Flag{
+					Name:  "end",
+					Usage: "Last L2 block (exclusive) of the datadir to build span batches from",
+				},
+				&cli.Uint64Flag{
+					Name:  "blocks-per-batch",
+					Value: 100,
+					Usage: "Number of L2 blocks per span batch built from the datadir",
+				},
+				&cli.StringFlag{
+					Name:  "in",
+					Value: "/tmp/batch_decoder/transactions_cache",
+					Usage: "Cache directory for the found transactions. Used if no datadir is set",
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: "/tmp/batch_decoder/zstd.dict",
+					Usage: "File to write the dictionary to",
+				},
+				&cli.StringFlag{
+					Name:     "rollup-config",
+					Required: true,
+					Usage:    "Rollup config JSON file of the chain",
+				},
+				&cli.IntFlag{
+					Name:  "max-size",
+					Value: 64 << 10,
+					Usage: "Maximum size of the dictionary in bytes",
+				},
+				&cli.UintFlag{
+					Name:  "id",
+					Usage: "ID of the dictionary. A random ID is used if unset",
+				},
+			},
+			Action: func(cliCtx *cli.Context) error {
+				rollupCfg, err := jsonutil.LoadJSON[rollup.Config](cliCtx.String("rollup-config"))
+				if err != nil {
+					return fmt.Errorf("failed to load rollup config: %w", err)
+				}
+				config := traindict.Config{
+					DataDir:        cliCtx.String("datadir"),
+					Start:          cliCtx.Uint64("start"),
+					End:            cliCtx.Uint64("end"),
+					BlocksPerBatch: cliCtx.Uint64("blocks-per-batch"),
+					BatchInbox:     rollupCfg.BatchInboxAddress,
+					InDirectory:    cliCtx.String("in"),
+					OutFile:        cliCtx.String("out"),
+					MaxDictSize:    cliCtx.Int("max-size"),
+					DictID:         uint32(cliCtx.Uint("id")),
+				}
+				return traindict.Dictionary(config, rollupCfg)
+			},
+		},
 		{
 			Name:  "force-close",
 			Usage: "Create the tx data which will force close a channel",
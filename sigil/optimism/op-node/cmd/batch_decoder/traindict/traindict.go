@@ -0,0 +1,197 @@
+package traindict
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/dict"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+type Config struct {
+	// DataDir is the chaindata directory of an op-geth node of the chain. If set, span batches are built from the
+	// L2 blocks in [Start, End), BlocksPerBatch at a time, like the batcher does.
+	DataDir        string
+	Start, End     uint64
+	BlocksPerBatch uint64
+	// InDirectory is the transactions cache of `batch_decoder fetch`. It is used if DataDir isn't set, to train on
+	// the span batches that were actually submitted to the batch inbox.
+	BatchInbox  common.Address
+	InDirectory string
+
+	OutFile string
+	// MaxDictSize is the maximum size of the dictionary.
+	MaxDictSize int
+	// DictID is the ID that is written into the dictionary and every frame compressed with it.
+	// A random ID is used if it is zero.
+	DictID uint32
+}
+
+// Dictionary trains a zstd dictionary on span batches of the chain, and writes it to the output file.
+func Dictionary(config Config, rollupCfg *rollup.Config) error {
+	var samples [][]byte
+	if config.DataDir != "" {
+		db, err := rawdb.Open(rawdb.OpenOptions{
+			Directory:         config.DataDir,
+			AncientsDirectory: filepath.Join(config.DataDir, "ancient"),
+			Cache:             256,
+			Handles:           64,
+			ReadOnly:          true,
+		})
+		if err != nil {
+			return fmt.Errorf("opening chain database: %w", err)
+		}
+		defer db.Close()
+		samples, err = DataDirSamples(db, rollupCfg, config.Start, config.End, config.BlocksPerBatch)
+		if err != nil {
+			return err
+		}
+	} else {
+		frames := reassemble.LoadFrames(config.InDirectory, config.BatchInbox)
+		samples = SpanBatchSamples(frames, rollupCfg)
+	}
+	if len(samples) == 0 {
+		return errors.New("no span batches found")
+	}
+	var size int
+	for _, s := range samples {
+		size += len(s)
+	}
+	fmt.Printf("Training zstd dictionary on %d span batch samples with %d bytes\n", len(samples), size)
+	zstdDict, err := Train(samples, config.MaxDictSize, config.DictID)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(config.OutFile, zstdDict, 0o644); err != nil {
+		return fmt.Errorf("writing dictionary: %w", err)
+	}
+	fmt.Printf("Wrote %d byte dictionary to %v\n", len(zstdDict), config.OutFile)
+	return nil
+}
+
+// Train builds a zstd dictionary of at most maxSize bytes from the samples.
+func Train(samples [][]byte, maxSize int, id uint32) ([]byte, error) {
+	zstdDict, err := dict.BuildZstdDict(samples, dict.Options{
+		MaxDictSize: maxSize,
+		HashBytes:   6,
+		ZstdDictID:  id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building dictionary: %w", err)
+	}
+	return zstdDict, nil
+}
+
+// DataDirSamples builds span batches from the canonical L2 blocks in [start, end) of the chain database, of
+// blocksPerBatch blocks each, and returns them as they are encoded in a channel before compression.
+func DataDirSamples(db ethdb.Reader, rollupCfg *rollup.Config, start, end, blocksPerBatch uint64) ([][]byte, error) {
+	if blocksPerBatch == 0 {
+		return nil, errors.New("blocks per batch must be positive")
+	}
+	var samples [][]byte
+	spanBatch := derive.NewSpanBatch(rollupCfg.Genesis.L2Time, rollupCfg.L2ChainID)
+	flush := func() error {
+		if spanBatch.GetBlockCount() == 0 {
+			return nil
+		}
+		raw, err := spanBatch.ToRawSpanBatch()
+		if err != nil {
+			return fmt.Errorf("encoding span batch: %w", err)
+		}
+		var sample bytes.Buffer
+		if err := derive.NewBatchData(raw).EncodeRLP(&sample); err != nil {
+			return fmt.Errorf("encoding span batch: %w", err)
+		}
+		samples = append(samples, sample.Bytes())
+		spanBatch = derive.NewSpanBatch(rollupCfg.Genesis.L2Time, rollupCfg.L2ChainID)
+		return nil
+	}
+	for n := start; n < end; n++ {
+		hash := rawdb.ReadCanonicalHash(db, n)
+		if hash == (common.Hash{}) {
+			return nil, fmt.Errorf("L2 block %d not found", n)
+		}
+		block := rawdb.ReadBlock(db, hash, n)
+		if block == nil {
+			return nil, fmt.Errorf("L2 block %d (%s) not found", n, hash)
+		}
+		batch, _, err := derive.BlockToSingularBatch(rollupCfg, block)
+		if err != nil {
+			return nil, fmt.Errorf("converting L2 block %d to batch: %w", n, err)
+		}
+		if err := spanBatch.AppendSingularBatch(batch, 0); err != nil {
+			return nil, fmt.Errorf("adding L2 block %d to span batch: %w", n, err)
+		}
+		if uint64(spanBatch.GetBlockCount()) == blocksPerBatch {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+// SpanBatchSamples returns the span batches of every ready channel of the frames, as they are encoded in the
+// channel before compression. The span batches of a channel are concatenated into a single sample.
+// Channels that can't be decoded, or that don't contain span batches, are skipped.
+func SpanBatchSamples(frames []reassemble.FrameWithMetadata, rollupCfg *rollup.Config) [][]byte {
+	spec := rollup.NewChainSpec(rollupCfg)
+	channels := make(map[derive.ChannelID]*derive.Channel)
+	var samples [][]byte
+	for _, frame := range frames {
+		id := frame.Frame.ID
+		ch, ok := channels[id]
+		if !ok {
+			ch = derive.NewChannel(id, eth.L1BlockRef{Number: frame.InclusionBlock})
+			channels[id] = ch
+		}
+		if ch.IsReady() {
+			continue
+		}
+		if err := ch.AddFrame(frame.Frame, eth.L1BlockRef{Number: frame.InclusionBlock, Time: frame.Timestamp}); err != nil {
+			continue
+		}
+		if !ch.IsReady() {
+			continue
+		}
+		origin := ch.HighestBlock().Time
+		br, err := derive.BatchReader(ch.Reader(), spec.MaxRLPBytesPerChannel(origin), rollupCfg.IsFjord(origin),
+			derive.BatchReaderOptions(rollupCfg, origin)...)
+		if err != nil {
+			fmt.Printf("Error creating batch reader for channel %v. Err: %v\n", id.String(), err)
+			continue
+		}
+		var sample bytes.Buffer
+		for batchData, err := br(); err != io.EOF; batchData, err = br() {
+			if err != nil {
+				fmt.Printf("Error reading batchData for channel %v. Err: %v\n", id.String(), err)
+				break
+			}
+			if batchData.GetBatchType() != derive.SpanBatchType {
+				continue
+			}
+			if err := batchData.EncodeRLP(&sample); err != nil {
+				fmt.Printf("Error encoding batchData for channel %v. Err: %v\n", id.String(), err)
+				break
+			}
+		}
+		if sample.Len() > 0 {
+			samples = append(samples, sample.Bytes())
+		}
+	}
+	return samples
+}
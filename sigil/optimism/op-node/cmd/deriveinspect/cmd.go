@@ -0,0 +1,168 @@
+package deriveinspect
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	opnode "github.com/ethereum-optimism/optimism/op-node"
+	"github.com/ethereum-optimism/optimism/op-node/flags"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	opflags "github.com/ethereum-optimism/optimism/op-service/flags"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+var (
+	fixtureFlag = &cli.PathFlag{
+		Name:     "fixture",
+		Usage:    "Path of the fixture file with the chain data of the L1 range, gzipped if the path ends with .gz",
+		Required: true,
+	}
+	l1RPCFlag = &cli.StringFlag{
+		Name:     "l1",
+		Usage:    "Address of the L1 RPC to record the fixture from",
+		Required: true,
+	}
+	l1BeaconFlag = &cli.StringFlag{
+		Name:  "l1.beacon",
+		Usage: "Address of the L1 beacon node HTTP endpoint to record blobs from",
+	}
+	l2RPCFlag = &cli.StringFlag{
+		Name:     "l2",
+		Usage:    "Address of the L2 RPC to record the fixture from",
+		Required: true,
+	}
+	l1StartFlag = &cli.Uint64Flag{
+		Name:     "l1.start",
+		Usage:    "First L1 block of the range to derive. Derivation starts from the last L2 block with an earlier L1 origin",
+		Required: true,
+	}
+	l1EndFlag = &cli.Uint64Flag{
+		Name:     "l1.end",
+		Usage:    "Last L1 block of the range to derive",
+		Required: true,
+	}
+	pipelineLogLevelFlag = &cli.GenericFlag{
+		Name:  "pipeline.log.level",
+		Usage: "The lowest log level of the derivation pipeline internals. Options: trace, debug, info, warn, error, crit",
+		Value: oplog.NewLevelFlagValue(log.LevelWarn),
+	}
+
+	recordFlags = append([]cli.Flag{
+		l1RPCFlag,
+		l1BeaconFlag,
+		l2RPCFlag,
+		l1StartFlag,
+		l1EndFlag,
+		fixtureFlag,
+		opflags.CLINetworkFlag(flags.EnvVarPrefix, ""),
+		opflags.CLIRollupConfigFlag(flags.EnvVarPrefix, ""),
+		pipelineLogLevelFlag,
+	}, oplog.CLIFlags(flags.EnvVarPrefix)...)
+
+	replayFlags = append([]cli.Flag{
+		fixtureFlag,
+		pipelineLogLevelFlag,
+	}, oplog.CLIFlags(flags.EnvVarPrefix)...)
+)
+
+var Subcommands = cli.Commands{
+	{
+		Name:  "record",
+		Usage: "Derives an L1 block range from RPC, and records the chain data that derivation reads to a fixture",
+		Description: "Runs the derivation pipeline over the L1 block range, and reports every frame, channel and batch " +
+			"that it reads, why batches are dropped, and the attributes that it derives. " +
+			"The L1 blocks, receipts and blobs and the L2 blocks that derivation reads are recorded to the fixture, " +
+			"which is written even if derivation fails, so that the derivation can be replayed offline.",
+		Flags:  recordFlags,
+		Action: record,
+	},
+	{
+		Name:  "replay",
+		Usage: "Derives the L1 block range of a fixture offline",
+		Description: "Runs the derivation pipeline over the L1 block range of a fixture, and reports every frame, " +
+			"channel and batch that it reads, why batches are dropped, and the attributes that it derives.",
+		Flags:  replayFlags,
+		Action: replay,
+	},
+}
+
+// newLoggers returns the logger of the report, and the logger of the derivation pipeline internals, which has its own
+// log level.
+func newLoggers(ctx *cli.Context) (logger log.Logger, pipelineLog log.Logger) {
+	logCfg := oplog.ReadCLIConfig(ctx)
+	logger = oplog.NewLogger(oplog.AppOut(ctx), logCfg)
+	logCfg.Level = ctx.Generic(pipelineLogLevelFlag.Name).(*oplog.LevelFlagValue).Level()
+	pipelineLog = oplog.NewLogger(oplog.AppOut(ctx), logCfg).New("module", "pipeline")
+	return logger, pipelineLog
+}
+
+func record(ctx *cli.Context) error {
+	logger, pipelineLog := newLoggers(ctx)
+	rollupCfg, err := opnode.NewRollupConfig(logger, ctx.String(opflags.NetworkFlagName), ctx.String(opflags.RollupConfigFlagName))
+	if err != nil {
+		return err
+	}
+	l1Start, l1End := ctx.Uint64(l1StartFlag.Name), ctx.Uint64(l1EndFlag.Name)
+	if l1End < l1Start {
+		return fmt.Errorf("L1 range end %d is before its start %d", l1End, l1Start)
+	}
+
+	l1RPC, err := client.NewRPC(ctx.Context, logger, ctx.String(l1RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to setup L1 RPC: %w", err)
+	}
+	l1Cl, err := sources.NewL1Client(l1RPC, logger, nil, sources.L1ClientDefaultConfig(rollupCfg, false, sources.RPCKindStandard))
+	if err != nil {
+		return fmt.Errorf("failed to create L1 client: %w", err)
+	}
+	l2RPC, err := client.NewRPC(ctx.Context, logger, ctx.String(l2RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to setup L2 RPC: %w", err)
+	}
+	l2Cl, err := sources.NewL2Client(l2RPC, logger, nil, sources.L2ClientDefaultConfig(rollupCfg, true))
+	if err != nil {
+		return fmt.Errorf("failed to create L2 client: %w", err)
+	}
+	var l1Blobs derive.L1BlobsFetcher
+	if addr := ctx.String(l1BeaconFlag.Name); addr != "" {
+		l1Beacon := sources.NewBeaconHTTPClient(client.NewBasicHTTPClient(addr, logger))
+		l1Blobs = sources.NewL1BeaconClient(l1Beacon, sources.L1BeaconClientConfig{})
+	}
+
+	l1StartRef, err := l1Cl.L1BlockRefByNumber(ctx.Context, l1Start)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 block %d: %w", l1Start, err)
+	}
+	l2Start, err := findL2Start(ctx.Context, rollupCfg, l2Cl, l1StartRef)
+	if err != nil {
+		return err
+	}
+	logger.Info("Recording derivation", "l1_start", l1StartRef, "l1_end", l1End, "l2_start", l2Start)
+
+	fixture := NewFixture(rollupCfg, l2Start.Hash, l1End)
+	src := newChainSource(rollupCfg, &recorder{fixture: fixture, l1: l1Cl, l1Blobs: l1Blobs, l2: l2Cl}, l1End)
+	err = inspect(ctx.Context, logger, pipelineLog, rollupCfg, src, fixture.L2Start)
+	// The fixture is written even if derivation fails, so that the failure can be replayed.
+	path := ctx.Path(fixtureFlag.Name)
+	if writeErr := fixture.Write(path); writeErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to write fixture: %w", writeErr))
+	}
+	logger.Info("Wrote fixture", "path", path)
+	return err
+}
+
+func replay(ctx *cli.Context) error {
+	logger, pipelineLog := newLoggers(ctx)
+	fixture, err := LoadFixture(ctx.Path(fixtureFlag.Name))
+	if err != nil {
+		return err
+	}
+	logger.Info("Replaying derivation", "l1_end", fixture.L1End, "l2_start", fixture.L2Start)
+	src := newChainSource(fixture.Rollup, fixture, fixture.L1End)
+	return inspect(ctx.Context, logger, pipelineLog, fixture.Rollup, src, fixture.L2Start)
+}
@@ -0,0 +1,325 @@
+package deriveinspect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+// ErrNotInFixture is returned for chain data that is missing from a fixture. It's not ethereum.NotFound, which
+// derivation takes as the end of the L1 chain.
+var ErrNotInFixture = errors.New("not in the fixture")
+
+// L1Block is an L1 block with its transactions and their receipts, as the derivation pipeline reads it.
+type L1Block struct {
+	Header   *types.Header
+	Txs      types.Transactions
+	Receipts types.Receipts
+}
+
+type l1BlockJSON struct {
+	Header   hexutil.Bytes   `json:"header"`
+	Txs      []hexutil.Bytes `json:"txs"`
+	Receipts types.Receipts  `json:"receipts"`
+}
+
+func (b *L1Block) MarshalJSON() ([]byte, error) {
+	header, err := rlp.EncodeToBytes(b.Header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode header: %w", err)
+	}
+	txs := make([]hexutil.Bytes, len(b.Txs))
+	for i, tx := range b.Txs {
+		if txs[i], err = tx.MarshalBinary(); err != nil {
+			return nil, fmt.Errorf("failed to encode tx %d: %w", i, err)
+		}
+	}
+	return json.Marshal(l1BlockJSON{Header: header, Txs: txs, Receipts: b.Receipts})
+}
+
+func (b *L1Block) UnmarshalJSON(data []byte) error {
+	var dec l1BlockJSON
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	var header types.Header
+	if err := rlp.DecodeBytes(dec.Header, &header); err != nil {
+		return fmt.Errorf("failed to decode header: %w", err)
+	}
+	txs := make(types.Transactions, len(dec.Txs))
+	for i, data := range dec.Txs {
+		txs[i] = new(types.Transaction)
+		if err := txs[i].UnmarshalBinary(data); err != nil {
+			return fmt.Errorf("failed to decode tx %d: %w", i, err)
+		}
+	}
+	*b = L1Block{Header: &header, Txs: txs, Receipts: dec.Receipts}
+	return nil
+}
+
+func (b *L1Block) Info() eth.BlockInfo {
+	return eth.HeaderBlockInfo(b.Header)
+}
+
+// Fixture holds the L1 and L2 chain data that the derivation pipeline reads while deriving an L1 block range, so that
+// the derivation can be replayed offline.
+type Fixture struct {
+	Rollup *rollup.Config
+	// L2Start is the L2 block that derivation starts from as the safe head.
+	L2Start common.Hash
+	// L1End is the last L1 block of the range. Blocks after it are reported as not found.
+	L1End uint64
+
+	l1         map[common.Hash]*L1Block
+	l1ByNumber map[uint64]common.Hash
+	l2         map[common.Hash]*eth.ExecutionPayloadEnvelope
+	l2ByNumber map[uint64]common.Hash
+	blobs      map[common.Hash]*eth.Blob
+}
+
+type fixtureJSON struct {
+	Rollup  *rollup.Config                  `json:"rollup"`
+	L2Start common.Hash                     `json:"l2Start"`
+	L1End   uint64                          `json:"l1End"`
+	L1      []*L1Block                      `json:"l1"`
+	L2      []*eth.ExecutionPayloadEnvelope `json:"l2"`
+	Blobs   map[common.Hash]*eth.Blob       `json:"blobs"`
+}
+
+func NewFixture(cfg *rollup.Config, l2Start common.Hash, l1End uint64) *Fixture {
+	return &Fixture{
+		Rollup:     cfg,
+		L2Start:    l2Start,
+		L1End:      l1End,
+		l1:         make(map[common.Hash]*L1Block),
+		l1ByNumber: make(map[uint64]common.Hash),
+		l2:         make(map[common.Hash]*eth.ExecutionPayloadEnvelope),
+		l2ByNumber: make(map[uint64]common.Hash),
+		blobs:      make(map[common.Hash]*eth.Blob),
+	}
+}
+
+// LoadFixture reads a fixture from a JSON file, which may be gzipped.
+func LoadFixture(path string) (*Fixture, error) {
+	dec, err := jsonutil.LoadJSON[fixtureJSON](path)
+	if err != nil {
+		return nil, err
+	}
+	if dec.Rollup == nil {
+		return nil, fmt.Errorf("fixture %q has no rollup config", path)
+	}
+	f := NewFixture(dec.Rollup, dec.L2Start, dec.L1End)
+	for _, block := range dec.L1 {
+		f.AddL1Block(block)
+	}
+	for _, envelope := range dec.L2 {
+		f.AddPayload(envelope)
+	}
+	for hash, blob := range dec.Blobs {
+		f.AddBlob(hash, blob)
+	}
+	return f, nil
+}
+
+// Write writes the fixture as JSON to the file at path, which is gzipped if the path ends with .gz.
+func (f *Fixture) Write(path string) error {
+	enc := fixtureJSON{
+		Rollup:  f.Rollup,
+		L2Start: f.L2Start,
+		L1End:   f.L1End,
+		L1:      make([]*L1Block, 0, len(f.l1)),
+		L2:      make([]*eth.ExecutionPayloadEnvelope, 0, len(f.l2)),
+		Blobs:   f.blobs,
+	}
+	for _, block := range f.l1 {
+		enc.L1 = append(enc.L1, block)
+	}
+	sort.Slice(enc.L1, func(i, j int) bool { return enc.L1[i].Header.Number.Cmp(enc.L1[j].Header.Number) < 0 })
+	for _, envelope := range f.l2 {
+		enc.L2 = append(enc.L2, envelope)
+	}
+	sort.Slice(enc.L2, func(i, j int) bool {
+		return enc.L2[i].ExecutionPayload.BlockNumber < enc.L2[j].ExecutionPayload.BlockNumber
+	})
+	return jsonutil.WriteJSON(enc, ioutil.ToAtomicFile(path, 0o644))
+}
+
+func (f *Fixture) AddL1Block(block *L1Block) {
+	hash := block.Header.Hash()
+	f.l1[hash] = block
+	f.l1ByNumber[block.Header.Number.Uint64()] = hash
+}
+
+func (f *Fixture) AddPayload(envelope *eth.ExecutionPayloadEnvelope) {
+	payload := envelope.ExecutionPayload
+	f.l2[payload.BlockHash] = envelope
+	f.l2ByNumber[uint64(payload.BlockNumber)] = payload.BlockHash
+}
+
+// AddBlob adds a blob by its versioned hash.
+func (f *Fixture) AddBlob(hash common.Hash, blob *eth.Blob) {
+	f.blobs[hash] = blob
+}
+
+func (f *Fixture) L1BlockByHash(_ context.Context, hash common.Hash) (*L1Block, error) {
+	block, ok := f.l1[hash]
+	if !ok {
+		return nil, fmt.Errorf("L1 block %s: %w", hash, ErrNotInFixture)
+	}
+	return block, nil
+}
+
+func (f *Fixture) L1BlockByNumber(ctx context.Context, num uint64) (*L1Block, error) {
+	hash, ok := f.l1ByNumber[num]
+	if !ok {
+		return nil, fmt.Errorf("L1 block %d: %w", num, ErrNotInFixture)
+	}
+	return f.L1BlockByHash(ctx, hash)
+}
+
+func (f *Fixture) GetBlobs(_ context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	blobs := make([]*eth.Blob, len(hashes))
+	for i, h := range hashes {
+		blob, ok := f.blobs[h.Hash]
+		if !ok {
+			return nil, fmt.Errorf("blob %s of L1 block %s: %w", h.Hash, ref, ErrNotInFixture)
+		}
+		blobs[i] = blob
+	}
+	return blobs, nil
+}
+
+func (f *Fixture) PayloadByHash(_ context.Context, hash common.Hash) (*eth.ExecutionPayloadEnvelope, error) {
+	envelope, ok := f.l2[hash]
+	if !ok {
+		return nil, fmt.Errorf("L2 block %s: %w", hash, ErrNotInFixture)
+	}
+	return envelope, nil
+}
+
+func (f *Fixture) PayloadByNumber(ctx context.Context, num uint64) (*eth.ExecutionPayloadEnvelope, error) {
+	hash, ok := f.l2ByNumber[num]
+	if !ok {
+		return nil, fmt.Errorf("L2 block %d: %w", num, ErrNotInFixture)
+	}
+	return f.PayloadByHash(ctx, hash)
+}
+
+// chainData provides the L1 and L2 chain data that derivation reads, either from a fixture, or from RPC while
+// recording a fixture.
+type chainData interface {
+	L1BlockByHash(ctx context.Context, hash common.Hash) (*L1Block, error)
+	L1BlockByNumber(ctx context.Context, num uint64) (*L1Block, error)
+	GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error)
+	PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayloadEnvelope, error)
+	PayloadByNumber(ctx context.Context, num uint64) (*eth.ExecutionPayloadEnvelope, error)
+}
+
+// chainSource serves the L1 and L2 sources of the derivation pipeline from chain data. L1 blocks after the end of the
+// L1 range are not found, so that derivation stops at the end of the range.
+type chainSource struct {
+	chainData
+	cfg   *rollup.Config
+	l1End uint64
+}
+
+var (
+	_ derive.L1Fetcher      = (*chainSource)(nil)
+	_ derive.L1BlobsFetcher = (*chainSource)(nil)
+	_ derive.L2Source       = (*chainSource)(nil)
+)
+
+func newChainSource(cfg *rollup.Config, data chainData, l1End uint64) *chainSource {
+	return &chainSource{chainData: data, cfg: cfg, l1End: l1End}
+}
+
+// L1BlockRefByLabel returns the end of the L1 range for every label.
+func (s *chainSource) L1BlockRefByLabel(ctx context.Context, _ eth.BlockLabel) (eth.L1BlockRef, error) {
+	return s.L1BlockRefByNumber(ctx, s.l1End)
+}
+
+func (s *chainSource) L1BlockRefByNumber(ctx context.Context, num uint64) (eth.L1BlockRef, error) {
+	if num > s.l1End {
+		return eth.L1BlockRef{}, fmt.Errorf("%w: L1 block %d is after the end of the L1 range", ethereum.NotFound, num)
+	}
+	block, err := s.L1BlockByNumber(ctx, num)
+	if err != nil {
+		return eth.L1BlockRef{}, err
+	}
+	return eth.InfoToL1BlockRef(block.Info()), nil
+}
+
+func (s *chainSource) L1BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L1BlockRef, error) {
+	block, err := s.L1BlockByHash(ctx, hash)
+	if err != nil {
+		return eth.L1BlockRef{}, err
+	}
+	return eth.InfoToL1BlockRef(block.Info()), nil
+}
+
+func (s *chainSource) InfoByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, error) {
+	block, err := s.L1BlockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return block.Info(), nil
+}
+
+func (s *chainSource) InfoAndTxsByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error) {
+	block, err := s.L1BlockByHash(ctx, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return block.Info(), block.Txs, nil
+}
+
+func (s *chainSource) FetchReceipts(ctx context.Context, hash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	block, err := s.L1BlockByHash(ctx, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return block.Info(), block.Receipts, nil
+}
+
+// L2BlockRefByLabel is not supported, derivation only reads L2 blocks by hash and number.
+func (s *chainSource) L2BlockRefByLabel(_ context.Context, label eth.BlockLabel) (eth.L2BlockRef, error) {
+	return eth.L2BlockRef{}, fmt.Errorf("cannot read L2 block by label %q", label)
+}
+
+func (s *chainSource) L2BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L2BlockRef, error) {
+	envelope, err := s.PayloadByHash(ctx, hash)
+	if err != nil {
+		return eth.L2BlockRef{}, err
+	}
+	return derive.PayloadToBlockRef(s.cfg, envelope.ExecutionPayload)
+}
+
+func (s *chainSource) L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error) {
+	envelope, err := s.PayloadByNumber(ctx, num)
+	if err != nil {
+		return eth.L2BlockRef{}, err
+	}
+	return derive.PayloadToBlockRef(s.cfg, envelope.ExecutionPayload)
+}
+
+func (s *chainSource) SystemConfigByL2Hash(ctx context.Context, hash common.Hash) (eth.SystemConfig, error) {
+	envelope, err := s.PayloadByHash(ctx, hash)
+	if err != nil {
+		return eth.SystemConfig{}, err
+	}
+	return derive.PayloadToSystemConfig(s.cfg, envelope.ExecutionPayload)
+}
@@ -0,0 +1,432 @@
+package deriveinspect
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/attributes"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// maxTemporaryErrors is the number of consecutive temporary errors after which the inspector gives up. Temporary
+// errors are retried, like the node does, but chain data that is missing from a fixture stays missing.
+const maxTemporaryErrors = 10
+
+// inspect derives the L1 range of the chain source, starting at the given L2 block as the safe head.
+func inspect(ctx context.Context, logger log.Logger, pipelineLog log.Logger, cfg *rollup.Config, src *chainSource, l2Start common.Hash) error {
+	safeHead, err := src.L2BlockRefByHash(ctx, l2Start)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the L2 start block %s: %w", l2Start, err)
+	}
+	return newInspector(logger, pipelineLog, cfg, src, safeHead).Run(ctx)
+}
+
+// pendingBatch is a batch that can't be accepted or dropped yet.
+type pendingBatch struct {
+	*derive.BatchWithL1InclusionBlock
+	// validity is the last reported validity of the batch, if it was checked already
+	validity derive.BatchValidity
+	checked  bool
+}
+
+// inspector runs the derivation pipeline, and reports the frames, channels and batches that it reads from every L1
+// block, why batches are dropped, and the attributes that it derives.
+//
+// The pipeline doesn't expose the data that it reads, so the inspector traces every L1 block that the pipeline
+// advances to with its own channel bank, and checks the decoded batches against the safe head with derive.CheckBatch,
+// like the batch queue does. The safe head advances along the L2 blocks of the chain source, which the derived
+// attributes are compared with.
+type inspector struct {
+	log         log.Logger
+	pipelineLog log.Logger
+	cfg         *rollup.Config
+	spec        *rollup.ChainSpec
+	src         *chainSource
+	pipeline    *derive.DerivationPipeline
+
+	safeHead eth.L2BlockRef
+
+	// traced is the last L1 block that was traced
+	traced  eth.L1BlockRef
+	sysCfg  eth.SystemConfig
+	dataSrc *derive.DataSourceFactory
+	frames  *originFrames
+	bank    *derive.ChannelBank
+	batches []*pendingBatch
+	// acceptedUntil is the timestamp of the last block of the last accepted batch. Like the batch queue, no next
+	// batch is accepted until the safe head reaches it.
+	acceptedUntil uint64
+
+	derived    int
+	mismatches int
+}
+
+func newInspector(logger log.Logger, pipelineLog log.Logger, cfg *rollup.Config, src *chainSource, safeHead eth.L2BlockRef) *inspector {
+	frames := new(originFrames)
+	return &inspector{
+		log:         logger,
+		pipelineLog: pipelineLog,
+		cfg:         cfg,
+		spec:        rollup.NewChainSpec(cfg),
+		src:         src,
+		pipeline:    derive.NewDerivationPipeline(pipelineLog, cfg, src, src, altda.Disabled, src, metrics.NoopMetrics),
+		safeHead:    safeHead,
+		dataSrc:     derive.NewDataSourceFactory(pipelineLog, cfg, src, src, altda.Disabled),
+		frames:      frames,
+		bank:        derive.NewChannelBank(logger, cfg, frames, metrics.NoopMetrics),
+	}
+}
+
+// Run steps the pipeline until it reaches the end of the L1 range.
+func (in *inspector) Run(ctx context.Context) error {
+	in.pipeline.ConfirmEngineReset()
+	temporaryErrs := 0
+	for {
+		attrs, err := in.pipeline.Step(ctx, in.safeHead)
+		if traceErr := in.traceOrigin(ctx); traceErr != nil {
+			return traceErr
+		}
+		if errors.Is(err, io.EOF) {
+			in.log.Info("Derived the L1 range", "origin", in.pipeline.Origin(), "safe_head", in.safeHead,
+				"attributes", in.derived, "mismatches", in.mismatches)
+			return nil
+		} else if errors.Is(err, derive.NotEnoughData) {
+			continue
+		} else if errors.Is(err, derive.ErrTemporary) {
+			if temporaryErrs++; temporaryErrs > maxTemporaryErrors {
+				return fmt.Errorf("derivation failed at L1 origin %s after %d temporary errors: %w", in.pipeline.Origin(), maxTemporaryErrors, err)
+			}
+			in.log.Warn("Temporary derivation error", "origin", in.pipeline.Origin(), "err", err)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("derivation failed at L1 origin %s: %w", in.pipeline.Origin(), err)
+		}
+		temporaryErrs = 0
+		if attrs != nil {
+			if err := in.applyAttributes(ctx, attrs); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// applyAttributes compares the attributes with the next L2 block of the chain source, and makes it the safe head.
+func (in *inspector) applyAttributes(ctx context.Context, attrs *derive.AttributesWithParent) error {
+	in.derived++
+	envelope, err := in.src.PayloadByNumber(ctx, in.safeHead.Number+1)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the L2 block after %s to compare the derived attributes with: %w", in.safeHead, err)
+	}
+	next, err := derive.PayloadToBlockRef(in.cfg, envelope.ExecutionPayload)
+	if err != nil {
+		return fmt.Errorf("failed to read L2 block %s: %w", envelope.ExecutionPayload.ID(), err)
+	}
+	lgr := in.log.New("parent", attrs.Parent.ID(), "timestamp", uint64(attrs.Attributes.Timestamp),
+		"txs", len(attrs.Attributes.Transactions), "no_tx_pool", attrs.Attributes.NoTxPool,
+		"is_last_in_span", attrs.IsLastInSpan, "derived_from", attrs.DerivedFrom.ID(), "block", next)
+	if err := attributes.AttributesMatchBlock(in.cfg, attrs.Attributes, in.safeHead.Hash, envelope, in.pipelineLog); err != nil {
+		in.mismatches++
+		lgr.Warn("Derived attributes do not match the L2 block", "err", err)
+	} else {
+		lgr.Info("Derived attributes")
+	}
+	in.safeHead = next
+	return in.nextBatch(ctx)
+}
+
+// traceOrigin traces the L1 blocks up to the origin of the pipeline.
+func (in *inspector) traceOrigin(ctx context.Context) error {
+	origin := in.pipeline.Origin()
+	if origin == (eth.L1BlockRef{}) || origin == in.traced {
+		return nil
+	}
+	if in.traced == (eth.L1BlockRef{}) {
+		// The pipeline was reset, and starts reading from its origin
+		sysCfg, err := in.resetSystemConfig(ctx, origin)
+		if err != nil {
+			return err
+		}
+		in.sysCfg = sysCfg
+		in.log.Info("Reset derivation", "origin", origin, "safe_head", in.safeHead, "batcher", sysCfg.BatcherAddr)
+		return in.traceBlock(ctx, origin)
+	}
+	for num := in.traced.Number + 1; num <= origin.Number; num++ {
+		ref, err := in.src.L1BlockRefByNumber(ctx, num)
+		if err != nil {
+			return fmt.Errorf("failed to fetch L1 block %d: %w", num, err)
+		}
+		_, receipts, err := in.src.FetchReceipts(ctx, ref.Hash)
+		if err != nil {
+			return fmt.Errorf("failed to fetch receipts of L1 block %s: %w", ref, err)
+		}
+		if err := derive.UpdateSystemConfigWithL1Receipts(&in.sysCfg, receipts, in.cfg, ref.Time); err != nil {
+			return fmt.Errorf("failed to update the system config with L1 block %s: %w", ref, err)
+		}
+		if err := in.traceBlock(ctx, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resetSystemConfig returns the system config that the pipeline was reset with, which is the one of the L2 blocks
+// with the origin of the pipeline as their L1 origin.
+func (in *inspector) resetSystemConfig(ctx context.Context, origin eth.L1BlockRef) (eth.SystemConfig, error) {
+	ref := in.safeHead
+	for ref.L1Origin.Number > origin.Number && ref.Number > in.cfg.Genesis.L2.Number {
+		parent := ref.ParentID()
+		var err error
+		if ref, err = in.src.L2BlockRefByHash(ctx, parent.Hash); err != nil {
+			return eth.SystemConfig{}, fmt.Errorf("failed to fetch L2 block %s: %w", parent, err)
+		}
+	}
+	sysCfg, err := in.src.SystemConfigByL2Hash(ctx, ref.Hash)
+	if err != nil {
+		return eth.SystemConfig{}, fmt.Errorf("failed to fetch the system config of L2 block %s: %w", ref, err)
+	}
+	return sysCfg, nil
+}
+
+// traceBlock reads the frames of the batcher transactions of an L1 block into the channel bank, and decodes the
+// batches of the channels that it completes.
+func (in *inspector) traceBlock(ctx context.Context, ref eth.L1BlockRef) error {
+	in.traced = ref
+	in.frames.origin = ref
+	in.log.Info("Reading L1 block", "block", ref)
+	// channels can time out with the new origin
+	if err := in.readChannels(ctx, ref); err != nil {
+		return err
+	}
+
+	data, err := in.dataSrc.OpenData(ctx, ref, in.sysCfg.BatcherAddr)
+	if err != nil {
+		return fmt.Errorf("failed to open data of L1 block %s: %w", ref, err)
+	}
+	for {
+		d, err := data.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read data of L1 block %s: %w", ref, err)
+		}
+		frames, err := derive.ParseFrames(d)
+		if err != nil {
+			in.log.Warn("Dropped invalid frame data", "origin", ref.ID(), "length", len(d), "err", err)
+			continue
+		}
+		for _, f := range frames {
+			in.log.Info("Read frame", "origin", ref.ID(), "channel", f.ID, "frame_number", f.FrameNumber,
+				"length", len(f.Data), "is_last", f.IsLast)
+			in.bank.IngestFrame(f)
+			if err := in.readChannels(ctx, ref); err != nil {
+				return err
+			}
+		}
+	}
+	return in.nextBatch(ctx)
+}
+
+// readChannels reads the batches of every channel that is ready in the channel bank. The channel bank reports the
+// channels that it reads and the ones that time out.
+func (in *inspector) readChannels(ctx context.Context, origin eth.L1BlockRef) error {
+	for {
+		data, err := in.bank.Read()
+		if err != nil {
+			return nil
+		} else if data == nil {
+			// a channel timed out
+			continue
+		}
+		if err := in.readBatches(ctx, origin, data); err != nil {
+			return err
+		}
+	}
+}
+
+func (in *inspector) readBatches(ctx context.Context, origin eth.L1BlockRef, data []byte) error {
+	next, err := derive.BatchReader(bytes.NewReader(data), in.spec.MaxRLPBytesPerChannel(origin.Time),
+		in.cfg.IsFjord(origin.Time), derive.BatchReaderOptions(in.cfg, origin.Time)...)
+	if err != nil {
+		in.log.Warn("Dropped channel, failed to create batch reader", "origin", origin.ID(), "err", err)
+		return nil
+	}
+	for {
+		batchData, err := next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			in.log.Warn("Dropped rest of channel, failed to read batch", "origin", origin.ID(), "err", err)
+			return nil
+		}
+		var batch derive.Batch
+		switch typ := batchData.GetBatchType(); typ {
+		case derive.SingularBatchType:
+			batch, err = derive.GetSingularBatch(batchData)
+		case derive.SpanBatchType:
+			if !in.cfg.IsDelta(origin.Time) {
+				in.log.Warn("Dropped span batch before Delta", "origin", origin.ID())
+				continue
+			}
+			batch, err = derive.DeriveSpanBatch(batchData, in.cfg.BlockTime, in.cfg.Genesis.L2Time, in.cfg.L2ChainID)
+		default:
+			in.log.Warn("Dropped batch of unknown type", "origin", origin.ID(), "type", typ)
+			continue
+		}
+		if err != nil {
+			in.log.Warn("Dropped invalid batch", "origin", origin.ID(), "err", err)
+			continue
+		}
+		lgr := batch.LogContext(in.log).New("origin", origin.ID())
+		if origin.Number < in.safeHead.L1Origin.Number {
+			// The batch queue skips the batches that it reads before it reaches the L1 origin of the safe head.
+			lgr.Info("Skipped batch before the L1 origin of the safe head", "safe_head", in.safeHead)
+			continue
+		}
+		lgr.Info("Read batch")
+		if err := in.addBatch(ctx, &derive.BatchWithL1InclusionBlock{Batch: batch, L1InclusionBlock: origin}); err != nil {
+			return err
+		}
+	}
+}
+
+// addBatch drops the batch if it's invalid on top of the safe head, like the batch queue does when it adds a batch.
+func (in *inspector) addBatch(ctx context.Context, batch *derive.BatchWithL1InclusionBlock) error {
+	l1Blocks, err := in.l1Blocks(ctx)
+	if err != nil {
+		return err
+	}
+	p := &pendingBatch{BatchWithL1InclusionBlock: batch}
+	if in.checkBatch(ctx, l1Blocks, p) != derive.BatchDrop {
+		in.batches = append(in.batches, p)
+	}
+	return nil
+}
+
+// nextBatch checks the pending batches in order of inclusion, like the batch queue does, until it can accept one.
+func (in *inspector) nextBatch(ctx context.Context) error {
+	if len(in.batches) == 0 || in.safeHead.Time < in.acceptedUntil {
+		return nil
+	}
+	l1Blocks, err := in.l1Blocks(ctx)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(in.batches); {
+		p := in.batches[i]
+		switch in.checkBatch(ctx, l1Blocks, p) {
+		case derive.BatchAccept:
+			in.batches = slices.Delete(in.batches, i, i+1)
+			if span, ok := p.AsSpanBatch(); ok {
+				in.acceptedUntil = span.GetBlockTimestamp(span.GetBlockCount() - 1)
+			} else {
+				in.acceptedUntil = p.GetTimestamp()
+			}
+			return nil
+		case derive.BatchDrop:
+			in.batches = slices.Delete(in.batches, i, i+1)
+		case derive.BatchUndecided:
+			return nil
+		default:
+			i++
+		}
+	}
+	return nil
+}
+
+// checkBatch checks the batch on top of the safe head, and reports its validity if it changed.
+func (in *inspector) checkBatch(ctx context.Context, l1Blocks []eth.L1BlockRef, p *pendingBatch) derive.BatchValidity {
+	reason := new(reasonHandler)
+	validity := derive.CheckBatch(ctx, in.cfg, log.NewLogger(reason), l1Blocks, in.safeHead, p.BatchWithL1InclusionBlock, in.src)
+	if p.checked && validity == p.validity {
+		return validity
+	}
+	p.validity, p.checked = validity, true
+	lgr := p.LogContext(in.log).New("inclusion_block", p.L1InclusionBlock.ID(), "safe_head", in.safeHead.ID())
+	switch validity {
+	case derive.BatchAccept:
+		lgr.Info("Accepted batch")
+	case derive.BatchDrop:
+		lgr.Warn("Dropped batch", reason.context()...)
+	case derive.BatchFuture:
+		lgr.Info("Batch is for the future", reason.context()...)
+	case derive.BatchUndecided:
+		lgr.Info("Batch is undecided", reason.context()...)
+	}
+	return validity
+}
+
+// l1Blocks returns the L1 blocks from the L1 origin of the safe head up to the last traced L1 block.
+func (in *inspector) l1Blocks(ctx context.Context) ([]eth.L1BlockRef, error) {
+	var blocks []eth.L1BlockRef
+	for num := in.safeHead.L1Origin.Number; num <= in.traced.Number; num++ {
+		ref, err := in.src.L1BlockRefByNumber(ctx, num)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch L1 block %d: %w", num, err)
+		}
+		blocks = append(blocks, ref)
+	}
+	return blocks, nil
+}
+
+// originFrames is the previous stage of the channel bank of the inspector. The inspector ingests frames into the
+// channel bank itself, so it only provides the L1 origin.
+type originFrames struct {
+	origin eth.L1BlockRef
+}
+
+func (o *originFrames) NextFrame(context.Context) (derive.Frame, error) {
+	return derive.Frame{}, io.EOF
+}
+
+func (o *originFrames) Origin() eth.L1BlockRef {
+	return o.origin
+}
+
+// reasonHandler is a log handler that keeps the last record, which derive.CheckBatch logs with the reason for the
+// validity of a batch. The context of the logger is dropped, it's the batch that is reported anyway.
+type reasonHandler struct {
+	last *slog.Record
+}
+
+func (h *reasonHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *reasonHandler) Handle(_ context.Context, r slog.Record) error {
+	r = r.Clone()
+	h.last = &r
+	return nil
+}
+
+func (h *reasonHandler) WithAttrs([]slog.Attr) slog.Handler {
+	return h
+}
+
+func (h *reasonHandler) WithGroup(string) slog.Handler {
+	return h
+}
+
+// context returns the reason and its attributes as log context.
+func (h *reasonHandler) context() []any {
+	if h.last == nil {
+		return nil
+	}
+	ctx := []any{"reason", h.last.Message}
+	h.last.Attrs(func(a slog.Attr) bool {
+		ctx = append(ctx, a.Key, a.Value.Any())
+		return true
+	})
+	return ctx
+}
@@ -0,0 +1,205 @@
+package deriveinspect
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"io"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// testCompressor adapts a channel compressor to a channel out compressor that is never full.
+type testCompressor struct {
+	derive.ChannelCompressor
+}
+
+func (c testCompressor) FullErr() error {
+	return nil
+}
+
+// batcherTx returns a signed batcher transaction with a single frame of a channel with the batches.
+func batcherTx(t *testing.T, cfg *rollup.Config, key *ecdsa.PrivateKey, batches ...*derive.SingularBatch) *types.Transaction {
+	compressor, err := derive.NewChannelCompressor(derive.Zlib)
+	require.NoError(t, err)
+	co, err := derive.NewSingularChannelOut(testCompressor{compressor}, rollup.NewChainSpec(cfg))
+	require.NoError(t, err)
+	for _, batch := range batches {
+		require.NoError(t, co.AddSingularBatch(batch, 0))
+	}
+	require.NoError(t, co.Close())
+	data := bytes.NewBuffer([]byte{derive.DerivationVersion0})
+	_, err = co.OutputFrame(data, 100_000)
+	require.ErrorIs(t, err, io.EOF, "the channel must fit in a single frame")
+
+	return types.MustSignNewTx(key, types.LatestSignerForChainID(cfg.L1ChainID), &types.DynamicFeeTx{
+		ChainID:   cfg.L1ChainID,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       100_000,
+		To:        &cfg.BatchInboxAddress,
+		Data:      data.Bytes(),
+	})
+}
+
+// testFixture returns a fixture of 3 L1 blocks and 2 L2 blocks. The second L1 block includes a channel with a batch
+// of the second L2 block, and a batch of a third L2 block with a wrong parent.
+func testFixture(t *testing.T) *Fixture {
+	batcherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	l2Genesis := &eth.ExecutionPayloadEnvelope{ExecutionPayload: &eth.ExecutionPayload{
+		BlockHash: common.Hash{0x02},
+		Timestamp: 1000,
+		GasLimit:  30_000_000,
+	}}
+	var l1 []*L1Block
+	for i := int64(0); i < 3; i++ {
+		header := &types.Header{
+			Number:     big.NewInt(i),
+			Time:       uint64(1000 + 12*i),
+			Difficulty: common.Big0,
+			GasLimit:   30_000_000,
+			BaseFee:    big.NewInt(7),
+			MixDigest:  common.Hash{byte(i)},
+		}
+		if i > 0 {
+			header.ParentHash = l1[i-1].Header.Hash()
+		}
+		l1 = append(l1, &L1Block{Header: header, Txs: types.Transactions{}, Receipts: types.Receipts{}})
+	}
+	cfg := &rollup.Config{
+		Genesis: rollup.Genesis{
+			L1:     eth.BlockID{Hash: l1[0].Header.Hash(), Number: 0},
+			L2:     eth.BlockID{Hash: l2Genesis.ExecutionPayload.BlockHash, Number: 0},
+			L2Time: 1000,
+			SystemConfig: eth.SystemConfig{
+				BatcherAddr: crypto.PubkeyToAddress(batcherKey.PublicKey),
+				GasLimit:    30_000_000,
+			},
+		},
+		BlockTime:              2,
+		MaxSequencerDrift:      600,
+		SeqWindowSize:          4,
+		ChannelTimeoutBedrock:  10,
+		L1ChainID:              big.NewInt(900),
+		L2ChainID:              big.NewInt(901),
+		BatchInboxAddress:      common.Address{0xff},
+		DepositContractAddress: common.Address{0xdd},
+		L1SystemConfigAddress:  common.Address{0xcc},
+	}
+
+	tx := batcherTx(t, cfg, batcherKey,
+		&derive.SingularBatch{ParentHash: l2Genesis.ExecutionPayload.BlockHash, EpochHash: l1[0].Header.Hash(), Timestamp: 1002},
+		&derive.SingularBatch{ParentHash: common.Hash{0xba, 0xd0}, EpochHash: l1[0].Header.Hash(), Timestamp: 1004},
+	)
+	l1[1].Txs = types.Transactions{tx}
+	l1[1].Receipts = types.Receipts{{
+		Type:              tx.Type(),
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 50_000,
+		GasUsed:           50_000,
+		TxHash:            tx.Hash(),
+		Logs:              []*types.Log{},
+	}}
+
+	f := NewFixture(cfg, l2Genesis.ExecutionPayload.BlockHash, 2)
+	for _, block := range l1 {
+		f.AddL1Block(block)
+	}
+	f.AddPayload(l2Genesis)
+
+	// The second L2 block is built from the attributes of the first batch, which has no transactions.
+	src := newChainSource(cfg, f, f.L1End)
+	genesisRef, err := src.L2BlockRefByHash(context.Background(), cfg.Genesis.L2.Hash)
+	require.NoError(t, err)
+	attrs, err := derive.NewFetchingAttributesBuilder(cfg, src, src).PreparePayloadAttributes(context.Background(), genesisRef, cfg.Genesis.L1)
+	require.NoError(t, err)
+	f.AddPayload(&eth.ExecutionPayloadEnvelope{ExecutionPayload: &eth.ExecutionPayload{
+		ParentHash:   genesisRef.Hash,
+		FeeRecipient: attrs.SuggestedFeeRecipient,
+		PrevRandao:   attrs.PrevRandao,
+		BlockNumber:  1,
+		GasLimit:     *attrs.GasLimit,
+		Timestamp:    attrs.Timestamp,
+		BlockHash:    common.Hash{0x03},
+		Transactions: attrs.Transactions,
+	}})
+	return f
+}
+
+func replayFixture(t *testing.T, f *Fixture) (*testlog.CapturingHandler, error) {
+	logger, logs := testlog.CaptureLogger(t, log.LevelInfo)
+	src := newChainSource(f.Rollup, f, f.L1End)
+	return logs, inspect(context.Background(), logger, testlog.Logger(t, log.LevelWarn), f.Rollup, src, f.L2Start)
+}
+
+func TestReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixture.json.gz")
+	require.NoError(t, testFixture(t).Write(path))
+	f, err := LoadFixture(path)
+	require.NoError(t, err)
+
+	logs, err := replayFixture(t, f)
+	require.NoError(t, err)
+
+	require.Len(t, logs.FindLogs(testlog.NewMessageFilter("Read frame")), 1)
+	require.NotNil(t, logs.FindLog(testlog.NewMessageFilter("Reading channel")))
+	require.Len(t, logs.FindLogs(testlog.NewMessageFilter("Read batch")), 2)
+	accepted := logs.FindLog(testlog.NewMessageFilter("Accepted batch"))
+	require.NotNil(t, accepted)
+	require.EqualValues(t, 1002, accepted.AttrValue("batch_timestamp"))
+
+	future := logs.FindLog(testlog.NewMessageFilter("Batch is for the future"))
+	require.NotNil(t, future)
+	require.EqualValues(t, 1004, future.AttrValue("batch_timestamp"))
+	require.Equal(t, "received out-of-order batch for future processing after next batch", future.AttrValue("reason"))
+
+	dropped := logs.FindLog(testlog.NewMessageFilter("Dropped batch"))
+	require.NotNil(t, dropped)
+	require.EqualValues(t, 1004, dropped.AttrValue("batch_timestamp"))
+	require.Equal(t, "ignoring batch with mismatching parent hash", dropped.AttrValue("reason"))
+
+	derived := logs.FindLogs(testlog.NewMessageFilter("Derived attributes"))
+	require.Len(t, derived, 1)
+	require.Equal(t, f.Rollup.Genesis.L2, derived[0].AttrValue("parent"))
+
+	done := logs.FindLog(testlog.NewMessageFilter("Derived the L1 range"))
+	require.NotNil(t, done)
+	require.EqualValues(t, 1, done.AttrValue("attributes"))
+	require.EqualValues(t, 0, done.AttrValue("mismatches"))
+}
+
+func TestReplayMismatch(t *testing.T) {
+	f := testFixture(t)
+	block, err := f.PayloadByNumber(context.Background(), 1)
+	require.NoError(t, err)
+	block.ExecutionPayload.Transactions = append(block.ExecutionPayload.Transactions, hexutil.Bytes{0x01})
+
+	logs, err := replayFixture(t, f)
+	require.NoError(t, err)
+	mismatch := logs.FindLog(testlog.NewMessageFilter("Derived attributes do not match the L2 block"))
+	require.NotNil(t, mismatch)
+	require.ErrorContains(t, mismatch.AttrValue("err").(error), "transaction count does not match")
+	require.EqualValues(t, 1, logs.FindLog(testlog.NewMessageFilter("Derived the L1 range")).AttrValue("mismatches"))
+}
+
+func TestReplayMissingData(t *testing.T) {
+	f := testFixture(t)
+	delete(f.l1, f.l1ByNumber[2])
+
+	_, err := replayFixture(t, f)
+	require.ErrorIs(t, err, ErrNotInFixture, "missing data must not be taken as the end of the L1 chain")
+}
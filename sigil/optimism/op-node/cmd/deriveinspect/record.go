@@ -0,0 +1,142 @@
+package deriveinspect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// rpcL1Source is the L1 RPC that fixtures are recorded from.
+type rpcL1Source interface {
+	InfoByNumber(ctx context.Context, number uint64) (eth.BlockInfo, error)
+	InfoAndTxsByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error)
+	FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error)
+}
+
+// rpcL2Source is the L2 RPC that fixtures are recorded from.
+type rpcL2Source interface {
+	PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayloadEnvelope, error)
+	PayloadByNumber(ctx context.Context, number uint64) (*eth.ExecutionPayloadEnvelope, error)
+	L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error)
+	L2BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L2BlockRef, error)
+}
+
+// recorder serves chain data from RPC, and adds everything that it serves to a fixture.
+type recorder struct {
+	fixture *Fixture
+	l1      rpcL1Source
+	// l1Blobs is nil if no beacon endpoint is configured
+	l1Blobs derive.L1BlobsFetcher
+	l2      rpcL2Source
+}
+
+var _ chainData = (*recorder)(nil)
+
+func (r *recorder) L1BlockByHash(ctx context.Context, hash common.Hash) (*L1Block, error) {
+	if block, err := r.fixture.L1BlockByHash(ctx, hash); err == nil {
+		return block, nil
+	}
+	info, txs, err := r.l1.InfoAndTxsByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	_, receipts, err := r.l1.FetchReceipts(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	headerRLP, err := info.HeaderRLP()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode header of L1 block %s: %w", hash, err)
+	}
+	var header types.Header
+	if err := rlp.DecodeBytes(headerRLP, &header); err != nil {
+		return nil, fmt.Errorf("failed to decode header of L1 block %s: %w", hash, err)
+	}
+	block := &L1Block{Header: &header, Txs: txs, Receipts: receipts}
+	r.fixture.AddL1Block(block)
+	return block, nil
+}
+
+func (r *recorder) L1BlockByNumber(ctx context.Context, num uint64) (*L1Block, error) {
+	if block, err := r.fixture.L1BlockByNumber(ctx, num); err == nil {
+		return block, nil
+	}
+	info, err := r.l1.InfoByNumber(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+	return r.L1BlockByHash(ctx, info.Hash())
+}
+
+func (r *recorder) GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	if blobs, err := r.fixture.GetBlobs(ctx, ref, hashes); err == nil {
+		return blobs, nil
+	}
+	if r.l1Blobs == nil {
+		return nil, errors.New("cannot fetch blobs without an L1 beacon endpoint")
+	}
+	blobs, err := r.l1Blobs.GetBlobs(ctx, ref, hashes)
+	if err != nil {
+		return nil, err
+	}
+	for i, h := range hashes {
+		r.fixture.AddBlob(h.Hash, blobs[i])
+	}
+	return blobs, nil
+}
+
+func (r *recorder) PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayloadEnvelope, error) {
+	if envelope, err := r.fixture.PayloadByHash(ctx, hash); err == nil {
+		return envelope, nil
+	}
+	envelope, err := r.l2.PayloadByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	r.fixture.AddPayload(envelope)
+	return envelope, nil
+}
+
+func (r *recorder) PayloadByNumber(ctx context.Context, num uint64) (*eth.ExecutionPayloadEnvelope, error) {
+	if envelope, err := r.fixture.PayloadByNumber(ctx, num); err == nil {
+		return envelope, nil
+	}
+	envelope, err := r.l2.PayloadByNumber(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+	r.fixture.AddPayload(envelope)
+	return envelope, nil
+}
+
+// findL2Start returns an L2 block with an L1 origin before the given L1 block, to derive the L1 blocks from the given
+// one onwards from. It starts at the L2 block with the time of the L1 block, which has an L1 origin at or before the
+// L1 block, and walks back until the L1 origin is before it.
+func findL2Start(ctx context.Context, cfg *rollup.Config, l2 rpcL2Source, l1Start eth.L1BlockRef) (eth.L2BlockRef, error) {
+	num, err := cfg.TargetBlockNumber(l1Start.Time)
+	if err != nil {
+		return eth.L2BlockRef{}, fmt.Errorf("no L2 block at the time of L1 block %s: %w", l1Start, err)
+	}
+	ref, err := l2.L2BlockRefByNumber(ctx, num)
+	if err != nil {
+		return eth.L2BlockRef{}, fmt.Errorf("failed to fetch L2 block %d: %w", num, err)
+	}
+	for ref.L1Origin.Number >= l1Start.Number {
+		if ref.Number <= cfg.Genesis.L2.Number {
+			return eth.L2BlockRef{}, fmt.Errorf("no L2 block with an L1 origin before L1 block %s", l1Start)
+		}
+		parent := ref.ParentID()
+		if ref, err = l2.L2BlockRefByHash(ctx, parent.Hash); err != nil {
+			return eth.L2BlockRef{}, fmt.Errorf("failed to fetch L2 block %s: %w", parent, err)
+		}
+	}
+	return ref, nil
+}
@@ -0,0 +1,143 @@
+package eventtrace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+)
+
+var (
+	outDirFlag = &cli.PathFlag{
+		Name:  "out",
+		Usage: "Directory to write the sequence.html and timing.html renderings to",
+		Value: ".",
+	}
+	fromFlag = &cli.TimestampFlag{
+		Name:   "from",
+		Usage:  "Only render entries traced at or after this time, in RFC 3339 format",
+		Layout: time.RFC3339,
+	}
+	toFlag = &cli.TimestampFlag{
+		Name:   "to",
+		Usage:  "Only render entries traced before this time, in RFC 3339 format",
+		Layout: time.RFC3339,
+	}
+	durationsFlag = &cli.BoolFlag{
+		Name:  "durations",
+		Usage: "Show the duration of each derivation in the sequence diagram",
+	}
+)
+
+var Subcommands = cli.Commands{
+	{
+		Name:      "render",
+		Usage:     "Renders the sequence diagram and timing breakdown of an event trace",
+		ArgsUsage: "<trace.jsonl>...",
+		Description: "Reads event traces in the JSONL format written by the op-node --event.trace.file flag, and renders " +
+			"them as an HTML sequence diagram and timing breakdown. Rotated trace files can be passed together, " +
+			"oldest first. Long traces render slowly, narrow them down with --from and --to.",
+		Flags:  []cli.Flag{outDirFlag, fromFlag, toFlag, durationsFlag},
+		Action: render,
+	},
+}
+
+func render(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return fmt.Errorf("no trace files specified")
+	}
+	var entries []event.TraceEntry
+	for _, path := range ctx.Args().Slice() {
+		fileEntries, err := readTraceFile(path)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, fileEntries...)
+	}
+	var from, to time.Time
+	if t := ctx.Timestamp(fromFlag.Name); t != nil {
+		from = *t
+	}
+	if t := ctx.Timestamp(toFlag.Name); t != nil {
+		to = *t
+	}
+	entries = FilterEntries(entries, from, to)
+	if len(entries) == 0 {
+		return fmt.Errorf("no trace entries to render")
+	}
+
+	outDir := ctx.Path(outDirFlag.Name)
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory %v: %w", outDir, err)
+	}
+	sequence := &event.SequenceTracer{StructTracer: event.StructTracer{Entries: entries}}
+	if err := writeOutput(filepath.Join(outDir, "sequence.html"), sequence.Output(ctx.Bool(durationsFlag.Name))); err != nil {
+		return err
+	}
+	timing := &event.TimingTracer{StructTracer: event.StructTracer{Entries: entries}}
+	return writeOutput(filepath.Join(outDir, "timing.html"), timing.Output())
+}
+
+func readTraceFile(path string) ([]event.TraceEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trace file: %w", err)
+	}
+	defer f.Close()
+	entries, err := event.ReadTraceEntries(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trace file %v: %w", path, err)
+	}
+	return entries, nil
+}
+
+func writeOutput(path string, content string) error {
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("failed to write %v: %w", path, err)
+	}
+	fmt.Println("Wrote", path)
+	return nil
+}
+
+// FilterEntries returns the entries of the derivations and emissions in the [from, to) time range.
+// A zero from or to leaves that end of the range open. Rate-limited entries have no time, and are kept if
+// the derivation they happened in is kept.
+func FilterEntries(entries []event.TraceEntry, from, to time.Time) []event.TraceEntry {
+	if from.IsZero() && to.IsZero() {
+		return entries
+	}
+	inRange := func(t time.Time) bool {
+		return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+	}
+	kept := make(map[uint64]struct{})
+	var out []event.TraceEntry
+	for _, entry := range entries {
+		switch entry.Kind {
+		case event.TraceDeriveStart:
+			if !inRange(entry.EventTime) {
+				continue
+			}
+			kept[entry.DerivContext] = struct{}{}
+		case event.TraceDeriveEnd:
+			// Derivation start and end entries carry the same start time, so are kept or dropped together
+			if _, ok := kept[entry.DerivContext]; !ok {
+				continue
+			}
+			delete(kept, entry.DerivContext)
+		case event.TraceRateLimited:
+			if _, ok := kept[entry.DerivContext]; !ok {
+				continue
+			}
+		case event.TraceEmit:
+			if !inRange(entry.EventTime) {
+				continue
+			}
+		}
+		out = append(out, entry)
+	}
+	return out
+}
@@ -0,0 +1,31 @@
+package eventtrace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+)
+
+func TestFilterEntries(t *testing.T) {
+	at := func(sec int64) time.Time {
+		return time.Unix(sec, 0)
+	}
+	entries := []event.TraceEntry{
+		{Kind: event.TraceEmit, Name: "a", EmitContext: 1, EventTime: at(1)},
+		{Kind: event.TraceDeriveStart, Name: "b", DerivContext: 1, EmitContext: 1, EventTime: at(2)},
+		{Kind: event.TraceRateLimited, Name: "b", DerivContext: 1},
+		{Kind: event.TraceDeriveEnd, Name: "b", DerivContext: 1, EmitContext: 1, EventTime: at(2)},
+		{Kind: event.TraceDeriveStart, Name: "c", DerivContext: 2, EmitContext: 1, EventTime: at(3)},
+		{Kind: event.TraceRateLimited, Name: "c", DerivContext: 2},
+		{Kind: event.TraceEmit, Name: "c", DerivContext: 2, EmitContext: 2, EventTime: at(4)},
+		{Kind: event.TraceDeriveEnd, Name: "c", DerivContext: 2, EmitContext: 1, EventTime: at(3)},
+	}
+	require.Equal(t, entries, FilterEntries(entries, time.Time{}, time.Time{}))
+	require.Equal(t, entries[:4], FilterEntries(entries, time.Time{}, at(3)))
+	require.Equal(t, entries[4:], FilterEntries(entries, at(3), time.Time{}))
+	require.Equal(t, []event.TraceEntry{entries[1], entries[2], entries[3]}, FilterEntries(entries, at(2), at(3)))
+	require.Empty(t, FilterEntries(entries, at(5), time.Time{}))
+}
@@ -11,9 +11,12 @@ import (
 
 	opnode "github.com/ethereum-optimism/optimism/op-node"
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/deriveinspect"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/eventtrace"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/genesis"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/networks"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/safedb"
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/node"
@@ -62,6 +65,18 @@ func main() {
 			Name:        "networks",
 			Subcommands: networks.Subcommands,
 		},
+		{
+			Name:        "derive-inspect",
+			Subcommands: deriveinspect.Subcommands,
+		},
+		{
+			Name:        "safedb",
+			Subcommands: safedb.Subcommands,
+		},
+		{
+			Name:        "event-trace",
+			Subcommands: eventtrace.Subcommands,
+		},
 	}
 
 	ctx := ctxinterrupt.WithSignalWaiterMain(context.Background())
@@ -0,0 +1,69 @@
+package safedb
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-node/flags"
+	opsafedb "github.com/ethereum-optimism/optimism/op-node/node/safedb"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+)
+
+var (
+	safeDBPathFlag = &cli.PathFlag{
+		Name:     flags.SafeDBPath.Name,
+		Usage:    "Path of the safe head database to backfill. The op-node using it must be stopped",
+		EnvVars:  flags.SafeDBPath.EnvVars,
+		Required: true,
+	}
+	l1RPCFlag = &cli.StringFlag{
+		Name:     "l1",
+		Usage:    "Address of the L1 RPC to fetch the finalized L1 block from",
+		Required: true,
+	}
+)
+
+var Subcommands = cli.Commands{
+	{
+		Name:  "backfill",
+		Usage: "Builds the reverse and finalized head indexes of a safe head database from its recorded safe heads",
+		Description: "Safe head databases that were written before the reverse and finalized head indexes existed only " +
+			"answer safe head queries by L1 block. Backfill indexes the L1 block that each recorded safe head became " +
+			"safe at, and records the safe head at each finalized L1 block as the finalized head at that L1 block. " +
+			"Backfilling is idempotent, and can be repeated as L1 finalizes.",
+		Flags:  append([]cli.Flag{safeDBPathFlag, l1RPCFlag}, oplog.CLIFlags(flags.EnvVarPrefix)...),
+		Action: backfill,
+	},
+}
+
+func backfill(ctx *cli.Context) error {
+	logger := oplog.NewLogger(oplog.AppOut(ctx), oplog.ReadCLIConfig(ctx))
+	l1RPC := ctx.String(l1RPCFlag.Name)
+	client, err := ethclient.DialContext(ctx.Context, l1RPC)
+	if err != nil {
+		return fmt.Errorf("cannot dial %s: %w", l1RPC, err)
+	}
+	defer client.Close()
+	finalized, err := client.HeaderByNumber(ctx.Context, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return fmt.Errorf("failed to fetch finalized L1 block: %w", err)
+	}
+
+	path := ctx.Path(safeDBPathFlag.Name)
+	db, err := opsafedb.NewSafeDB(logger, path)
+	if err != nil {
+		return fmt.Errorf("failed to open safe head database at %v: %w", path, err)
+	}
+	defer db.Close()
+	logger.Info("Backfilling safe head database", "path", path, "l1_finalized", finalized.Number)
+	safeHeads, finalizedHeads, err := db.Backfill(ctx.Context, finalized.Number.Uint64())
+	if err != nil {
+		return err
+	}
+	logger.Info("Backfilled safe head database", "safe_heads", safeHeads, "finalized_heads", finalizedHeads)
+	return db.Close()
+}
@@ -229,6 +229,24 @@ var (
 		Value:    0,
 		Category: SequencerCategory,
 	}
+	SequencerOrderingFlag = &cli.StringFlag{
+		Name:     "sequencer.ordering",
+		Usage:    "Ordering policy of the transaction-pool transactions in sequenced blocks: 'fee', 'fifo' or 'fair-fee'. The engine orders by fee if not set. Requires an engine that supports ordering policies.",
+		EnvVars:  prefixEnvVars("SEQUENCER_ORDERING"),
+		Category: SequencerCategory,
+	}
+	SequencerOrderingSenderCapFlag = &cli.Uint64Flag{
+		Name:     "sequencer.ordering.sender-cap",
+		Usage:    "Maximum number of transaction-pool transactions per sender in a sequenced block, with the 'fair-fee' ordering policy",
+		EnvVars:  prefixEnvVars("SEQUENCER_ORDERING_SENDER_CAP"),
+		Category: SequencerCategory,
+	}
+	SequencerPrivateLaneFlag = &cli.BoolFlag{
+		Name:     "sequencer.private-lane",
+		Usage:    "Include the transactions of the private lane of the engine at the top of sequenced blocks, ahead of the transaction-pool transactions. Requires an ordering policy.",
+		EnvVars:  prefixEnvVars("SEQUENCER_PRIVATE_LANE"),
+		Category: SequencerCategory,
+	}
 	SequencerL1Confs = &cli.Uint64Flag{
 		Name:     "sequencer.l1-confs",
 		Usage:    "Number of L1 blocks to keep distance from the L1 head as a sequencer for picking an L1 origin.",
@@ -316,6 +334,32 @@ var (
 		EnvVars:  prefixEnvVars("SAFEDB_PATH"),
 		Category: OperationsCategory,
 	}
+	EventTraceFile = &cli.StringFlag{
+		Name:     "event.trace.file",
+		Usage:    "File path to stream traces of the processed events to, in the JSONL format of the op-node event package. The file is rotated by size. Disabled if not set.",
+		EnvVars:  prefixEnvVars("EVENT_TRACE_FILE"),
+		Category: OperationsCategory,
+	}
+	EventTraceFileMaxSize = &cli.IntFlag{
+		Name:     "event.trace.file.max-size",
+		Usage:    "Size in megabytes at which the event trace file is rotated",
+		EnvVars:  prefixEnvVars("EVENT_TRACE_FILE_MAX_SIZE"),
+		Value:    100,
+		Category: OperationsCategory,
+	}
+	EventTraceFileMaxBackups = &cli.IntFlag{
+		Name:     "event.trace.file.max-backups",
+		Usage:    "Number of rotated event trace files to keep. All are kept if 0.",
+		EnvVars:  prefixEnvVars("EVENT_TRACE_FILE_MAX_BACKUPS"),
+		Value:    10,
+		Category: OperationsCategory,
+	}
+	EventTraceOTLPEndpoint = &cli.StringFlag{
+		Name:     "event.trace.otlp.endpoint",
+		Usage:    "OTLP/HTTP endpoint of an OpenTelemetry collector to export traces of the processed events to, e.g. http://localhost:4318. Disabled if not set.",
+		EnvVars:  prefixEnvVars("EVENT_TRACE_OTLP_ENDPOINT"),
+		Category: OperationsCategory,
+	}
 	/* Deprecated Flags */
 	L2EngineSyncEnabled = &cli.BoolFlag{
 		Name:    "l2.engine-sync",
@@ -400,6 +444,9 @@ var optionalFlags = []cli.Flag{
 	SequencerEnabledFlag,
 	SequencerStoppedFlag,
 	SequencerMaxSafeLagFlag,
+	SequencerOrderingFlag,
+	SequencerOrderingSenderCapFlag,
+	SequencerPrivateLaneFlag,
 	SequencerL1Confs,
 	L1EpochPollIntervalFlag,
 	RuntimeConfigReloadIntervalFlag,
@@ -418,6 +465,10 @@ var optionalFlags = []cli.Flag{
 	ConductorRpcFlag,
 	ConductorRpcTimeoutFlag,
 	SafeDBPath,
+	EventTraceFile,
+	EventTraceFileMaxSize,
+	EventTraceFileMaxBackups,
+	EventTraceOTLPEndpoint,
 	L2EngineKind,
 }
 
@@ -38,6 +38,9 @@ type driverClient interface {
 
 type SafeDBReader interface {
 	SafeHeadAtL1(ctx context.Context, l1BlockNum uint64) (l1 eth.BlockID, l2 eth.BlockID, err error)
+	L1BlockForSafeL2(ctx context.Context, l2BlockNum uint64) (l1 eth.BlockID, l2 eth.BlockID, err error)
+	FinalizedHeadAtL1(ctx context.Context, l1BlockNum uint64) (l1 eth.BlockID, l2 eth.BlockID, err error)
+	L1BlockForFinalizedL2(ctx context.Context, l2BlockNum uint64) (l1 eth.BlockID, l2 eth.BlockID, err error)
 }
 
 type adminAPI struct {
@@ -156,6 +159,54 @@ func (n *nodeAPI) SafeHeadAtL1Block(ctx context.Context, number hexutil.Uint64)
 	}, nil
 }
 
+// L1BlockForSafeL2 returns the first L1 block that the L2 block was safe at, and the safe head at that L1 block.
+func (n *nodeAPI) L1BlockForSafeL2(ctx context.Context, number hexutil.Uint64) (*eth.SafeHeadResponse, error) {
+	recordDur := n.m.RecordRPCServerRequest("optimism_l1BlockForSafeL2")
+	defer recordDur()
+	l1Block, safeHead, err := n.safeDB.L1BlockForSafeL2(ctx, uint64(number))
+	if errors.Is(err, safedb.ErrNotFound) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get l1 block for safe l2 block %s: %w", number, err)
+	}
+	return &eth.SafeHeadResponse{
+		L1Block:  l1Block,
+		SafeHead: safeHead,
+	}, nil
+}
+
+func (n *nodeAPI) FinalizedHeadAtL1Block(ctx context.Context, number hexutil.Uint64) (*eth.FinalizedHeadResponse, error) {
+	recordDur := n.m.RecordRPCServerRequest("optimism_finalizedHeadAtL1Block")
+	defer recordDur()
+	l1Block, finalizedHead, err := n.safeDB.FinalizedHeadAtL1(ctx, uint64(number))
+	if errors.Is(err, safedb.ErrNotFound) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get finalized head at l1 block %s: %w", number, err)
+	}
+	return &eth.FinalizedHeadResponse{
+		L1Block:       l1Block,
+		FinalizedHead: finalizedHead,
+	}, nil
+}
+
+// L1BlockForFinalizedL2 returns the first finalized L1 block that the L2 block was final at, and the finalized head at
+// that L1 block.
+func (n *nodeAPI) L1BlockForFinalizedL2(ctx context.Context, number hexutil.Uint64) (*eth.FinalizedHeadResponse, error) {
+	recordDur := n.m.RecordRPCServerRequest("optimism_l1BlockForFinalizedL2")
+	defer recordDur()
+	l1Block, finalizedHead, err := n.safeDB.L1BlockForFinalizedL2(ctx, uint64(number))
+	if errors.Is(err, safedb.ErrNotFound) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get l1 block for finalized l2 block %s: %w", number, err)
+	}
+	return &eth.FinalizedHeadResponse{
+		L1Block:       l1Block,
+		FinalizedHead: finalizedHead,
+	}, nil
+}
+
 func (n *nodeAPI) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	recordDur := n.m.RecordRPCServerRequest("optimism_syncStatus")
 	defer recordDur()
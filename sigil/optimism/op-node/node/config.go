@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/url"
 	"time"
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
@@ -58,6 +59,8 @@ type Config struct {
 	// Optional
 	Tracer Tracer
 
+	EventTrace EventTraceConfig
+
 	Sync sync.Config
 
 	// To halt when detecting the node does not support a signaled protocol version
@@ -76,6 +79,38 @@ type Config struct {
 	AltDA altda.CLIConfig
 }
 
+// EventTraceConfig configures the export of traces of the processed events.
+type EventTraceConfig struct {
+	// File to stream event traces to in the JSONL format. Disabled when set to empty string
+	File string
+	// FileMaxSize is the size in megabytes at which the file is rotated
+	FileMaxSize int
+	// FileMaxBackups is the number of rotated files to keep, or 0 to keep all
+	FileMaxBackups int
+	// OTLPEndpoint is the OTLP/HTTP endpoint of an OpenTelemetry collector to export event traces to.
+	// Disabled when set to empty string
+	OTLPEndpoint string
+}
+
+func (c EventTraceConfig) Check() error {
+	if c.File != "" && c.FileMaxSize <= 0 {
+		return errors.New("event trace file max size must be positive")
+	}
+	if c.FileMaxBackups < 0 {
+		return errors.New("event trace file max backups must not be negative")
+	}
+	if c.OTLPEndpoint != "" {
+		u, err := url.Parse(c.OTLPEndpoint)
+		if err != nil {
+			return fmt.Errorf("invalid OTLP endpoint: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("OTLP endpoint %q must be an http or https URL", c.OTLPEndpoint)
+		}
+	}
+	return nil
+}
+
 type RPCConfig struct {
 	ListenAddr  string
 	ListenPort  int
@@ -155,6 +190,9 @@ func (cfg *Config) Check() error {
 	if err := cfg.Pprof.Check(); err != nil {
 		return fmt.Errorf("pprof config error: %w", err)
 	}
+	if err := cfg.EventTrace.Check(); err != nil {
+		return fmt.Errorf("event trace config error: %w", err)
+	}
 	if cfg.P2P != nil {
 		if err := cfg.P2P.Check(); err != nil {
 			return fmt.Errorf("p2p config error: %w", err)
@@ -163,6 +201,11 @@ func (cfg *Config) Check() error {
 	if !(cfg.RollupHalt == "" || cfg.RollupHalt == "major" || cfg.RollupHalt == "minor" || cfg.RollupHalt == "patch") {
 		return fmt.Errorf("invalid rollup halting option: %q", cfg.RollupHalt)
 	}
+	if policy := cfg.Driver.SequencerOrderingPolicy; policy != nil {
+		if err := policy.Check(); err != nil {
+			return fmt.Errorf("sequencer ordering policy error: %w", err)
+		}
+	}
 	if cfg.ConductorEnabled {
 		if state, _ := cfg.ConfigPersistence.SequencerState(); state != StateUnset {
 			return fmt.Errorf("config persistence must be disabled when conductor is enabled")
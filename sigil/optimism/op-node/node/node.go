@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"gopkg.in/natefinch/lumberjack.v2"
 
 	"github.com/ethereum/go-ethereum"
 	gethevent "github.com/ethereum/go-ethereum/event"
@@ -54,6 +55,8 @@ type OpNode struct {
 
 	eventSys   event.System
 	eventDrain event.Drainer
+	// eventTracers export traces of the processed events, and are closed after the event system stops
+	eventTracers []*event.ExportTracer
 
 	l1Source  *sources.L1Client     // L1 Client to fetch data from
 	l2Driver  *driver.Driver        // L2 Engine to Sync
@@ -129,7 +132,7 @@ func (n *OpNode) init(ctx context.Context, cfg *Config) error {
 	if err := n.initTracer(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init the trace: %w", err)
 	}
-	n.initEventSystem()
+	n.initEventSystem(cfg)
 	if err := n.initL1(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init L1: %w", err)
 	}
@@ -163,11 +166,27 @@ func (n *OpNode) init(ctx context.Context, cfg *Config) error {
 	return nil
 }
 
-func (n *OpNode) initEventSystem() {
+func (n *OpNode) initEventSystem(cfg *Config) {
 	// This executor will be configurable in the future, for parallel event processing
 	executor := event.NewGlobalSynchronous(n.resourcesCtx)
 	sys := event.NewSystem(n.log, executor)
 	sys.AddTracer(event.NewMetricsTracer(n.metrics))
+	if path := cfg.EventTrace.File; path != "" {
+		n.log.Info("Streaming event traces to file", "path", path)
+		file := &lumberjack.Logger{
+			Filename:   path,
+			MaxSize:    cfg.EventTrace.FileMaxSize,
+			MaxBackups: cfg.EventTrace.FileMaxBackups,
+		}
+		n.eventTracers = append(n.eventTracers, event.NewExportTracer(n.log, event.NewJSONLExporter(file)))
+	}
+	if endpoint := cfg.EventTrace.OTLPEndpoint; endpoint != "" {
+		n.log.Info("Exporting event traces to OpenTelemetry collector", "endpoint", endpoint)
+		n.eventTracers = append(n.eventTracers, event.NewExportTracer(n.log, event.NewOTLPExporter(endpoint, "op-node")))
+	}
+	for _, tracer := range n.eventTracers {
+		sys.AddTracer(tracer)
+	}
 	sys.Register("node", event.DeriverFunc(n.onEvent), event.DefaultRegisterOpts())
 	n.eventSys = sys
 	n.eventDrain = executor
@@ -710,6 +729,11 @@ func (n *OpNode) Stop(ctx context.Context) error {
 	if n.eventSys != nil {
 		n.eventSys.Stop()
 	}
+	for _, tracer := range n.eventTracers {
+		if err := tracer.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to close event tracer: %w", err))
+		}
+	}
 
 	if n.safeDB != nil {
 		if err := n.safeDB.Close(); err != nil {
@@ -27,6 +27,25 @@ func (d *DisabledDB) SafeHeadAtL1(_ context.Context, _ uint64) (l1 eth.BlockID,
 	return
 }
 
+func (d *DisabledDB) FinalizedHeadUpdated(_ eth.L2BlockRef, _ eth.BlockID) error {
+	return nil
+}
+
+func (d *DisabledDB) FinalizedHeadAtL1(_ context.Context, _ uint64) (l1 eth.BlockID, finalizedHead eth.BlockID, err error) {
+	err = ErrNotEnabled
+	return
+}
+
+func (d *DisabledDB) L1BlockForSafeL2(_ context.Context, _ uint64) (l1 eth.BlockID, safeHead eth.BlockID, err error) {
+	err = ErrNotEnabled
+	return
+}
+
+func (d *DisabledDB) L1BlockForFinalizedL2(_ context.Context, _ uint64) (l1 eth.BlockID, finalizedHead eth.BlockID, err error) {
+	err = ErrNotEnabled
+	return
+}
+
 func (d *DisabledDB) SafeHeadReset(_ eth.L2BlockRef) error {
 	return nil
 }
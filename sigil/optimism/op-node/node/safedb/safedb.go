@@ -21,11 +21,20 @@ var (
 
 const (
 	// Keys are prefixed with a constant byte to allow us to differentiate different "columns" within the data
-	keyPrefixSafeByL1BlockNum byte = 0
+	keyPrefixSafeByL1BlockNum        byte = 0
+	keyPrefixL1BySafeL2BlockNum      byte = 1
+	keyPrefixFinalizedByL1BlockNum   byte = 2
+	keyPrefixL1ByFinalizedL2BlockNum byte = 3
+
+	// backfillBatchSize is the number of entries that are backfilled per committed batch
+	backfillBatchSize = 10_000
 )
 
 var (
-	safeByL1BlockNumKey = uint64Key{prefix: keyPrefixSafeByL1BlockNum}
+	safeByL1BlockNumKey        = uint64Key{prefix: keyPrefixSafeByL1BlockNum}
+	l1BySafeL2BlockNumKey      = uint64Key{prefix: keyPrefixL1BySafeL2BlockNum}
+	finalizedByL1BlockNumKey   = uint64Key{prefix: keyPrefixFinalizedByL1BlockNum}
+	l1ByFinalizedL2BlockNumKey = uint64Key{prefix: keyPrefixL1ByFinalizedL2BlockNum}
 )
 
 type uint64Key struct {
@@ -70,7 +79,12 @@ func safeByL1BlockNumValue(l1 eth.BlockID, l2 eth.BlockID) []byte {
 }
 
 func decodeSafeByL1BlockNum(key []byte, val []byte) (l1 eth.BlockID, l2 eth.BlockID, err error) {
-	if len(key) != 9 || len(val) != 72 || key[0] != keyPrefixSafeByL1BlockNum {
+	return decodeByL1BlockNum(keyPrefixSafeByL1BlockNum, key, val)
+}
+
+// decodeByL1BlockNum decodes an entry of a column keyed by L1 block number, that stores the L2 block of each L1 block.
+func decodeByL1BlockNum(prefix byte, key []byte, val []byte) (l1 eth.BlockID, l2 eth.BlockID, err error) {
+	if len(key) != 9 || len(val) != 72 || key[0] != prefix {
 		err = ErrInvalidEntry
 		return
 	}
@@ -81,6 +95,28 @@ func decodeSafeByL1BlockNum(key []byte, val []byte) (l1 eth.BlockID, l2 eth.Bloc
 	return
 }
 
+func l1ByL2BlockNumValue(l1 eth.BlockID, l2 eth.BlockID) []byte {
+	val := make([]byte, 0, 72)
+	val = append(val, l2.Hash.Bytes()...)
+	val = append(val, l1.Hash.Bytes()...)
+	val = binary.BigEndian.AppendUint64(val, l1.Number)
+	return val
+}
+
+// decodeByL2BlockNum decodes an entry of a reverse index keyed by L2 block number, that stores the L1 block of each
+// L2 block.
+func decodeByL2BlockNum(prefix byte, key []byte, val []byte) (l1 eth.BlockID, l2 eth.BlockID, err error) {
+	if len(key) != 9 || len(val) != 72 || key[0] != prefix {
+		err = ErrInvalidEntry
+		return
+	}
+	copy(l2.Hash[:], val[:32])
+	l2.Number = binary.BigEndian.Uint64(key[1:])
+	copy(l1.Hash[:], val[32:64])
+	l1.Number = binary.BigEndian.Uint64(val[64:])
+	return
+}
+
 func NewSafeDB(logger log.Logger, path string) (*SafeDB, error) {
 	db, err := pebble.Open(path, &pebble.Options{})
 	if err != nil {
@@ -106,12 +142,34 @@ func (d *SafeDB) SafeHeadUpdated(safeHead eth.L2BlockRef, l1Head eth.BlockID) er
 	if err := batch.Set(safeByL1BlockNumKey.Of(l1Head.Number), safeByL1BlockNumValue(l1Head, safeHead.ID()), d.writeOpts); err != nil {
 		return fmt.Errorf("failed to record safe head update: %w", err)
 	}
+	if err := batch.Set(l1BySafeL2BlockNumKey.Of(safeHead.Number), l1ByL2BlockNumValue(l1Head, safeHead.ID()), d.writeOpts); err != nil {
+		return fmt.Errorf("failed to record safe head update in reverse index: %w", err)
+	}
 	if err := batch.Commit(d.writeOpts); err != nil {
 		return fmt.Errorf("failed to commit safe head update: %w", err)
 	}
 	return nil
 }
 
+// FinalizedHeadUpdated records that the L2 chain up to finalizedHead is final as of the finalized L1 block l1Block.
+func (d *SafeDB) FinalizedHeadUpdated(finalizedHead eth.L2BlockRef, l1Block eth.BlockID) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	d.log.Info("Record finalized head", "l2", finalizedHead.ID(), "l1", l1Block)
+	batch := d.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(finalizedByL1BlockNumKey.Of(l1Block.Number), safeByL1BlockNumValue(l1Block, finalizedHead.ID()), d.writeOpts); err != nil {
+		return fmt.Errorf("failed to record finalized head update: %w", err)
+	}
+	if err := batch.Set(l1ByFinalizedL2BlockNumKey.Of(finalizedHead.Number), l1ByL2BlockNumValue(l1Block, finalizedHead.ID()), d.writeOpts); err != nil {
+		return fmt.Errorf("failed to record finalized head update in reverse index: %w", err)
+	}
+	if err := batch.Commit(d.writeOpts); err != nil {
+		return fmt.Errorf("failed to commit finalized head update: %w", err)
+	}
+	return nil
+}
+
 func (d *SafeDB) SafeHeadReset(safeHead eth.L2BlockRef) error {
 	d.m.Lock()
 	defer d.m.Unlock()
@@ -142,6 +200,9 @@ func (d *SafeDB) SafeHeadReset(safeHead eth.L2BlockRef) error {
 			if err := batch.DeleteRange(l1HeadKey, safeByL1BlockNumKey.Max(), d.writeOpts); err != nil {
 				return fmt.Errorf("reset failed to delete entries after %v: %w", l1HeadKey, err)
 			}
+			if err := batch.DeleteRange(l1BySafeL2BlockNumKey.Of(safeHead.Number), l1BySafeL2BlockNumKey.Max(), d.writeOpts); err != nil {
+				return fmt.Errorf("reset failed to delete reverse index entries after %v: %w", safeHead.Number, err)
+			}
 
 			// If we reset to a safe head before the first entry, we don't know if the new safe head actually became
 			// safe in that L1 block or if it was just before our records start, so don't record it as safe at the
@@ -150,6 +211,9 @@ func (d *SafeDB) SafeHeadReset(safeHead eth.L2BlockRef) error {
 				if err := batch.Set(l1HeadKey, safeByL1BlockNumValue(l1Block, safeHead.ID()), d.writeOpts); err != nil {
 					return fmt.Errorf("reset failed to record safe head update: %w", err)
 				}
+				if err := batch.Set(l1BySafeL2BlockNumKey.Of(safeHead.Number), l1ByL2BlockNumValue(l1Block, safeHead.ID()), d.writeOpts); err != nil {
+					return fmt.Errorf("reset failed to record safe head update in reverse index: %w", err)
+				}
 			}
 			if err := batch.Commit(d.writeOpts); err != nil {
 				return fmt.Errorf("reset failed to commit batch: %w", err)
@@ -164,14 +228,24 @@ func (d *SafeDB) SafeHeadReset(safeHead eth.L2BlockRef) error {
 }
 
 func (d *SafeDB) SafeHeadAtL1(ctx context.Context, l1BlockNum uint64) (l1Block eth.BlockID, safeHead eth.BlockID, err error) {
+	return d.headAtL1(ctx, safeByL1BlockNumKey, l1BlockNum)
+}
+
+// FinalizedHeadAtL1 returns the finalized head as of the finalized L1 block l1BlockNum, and the last finalized L1 block
+// at or before it that the finalized head was recorded at.
+func (d *SafeDB) FinalizedHeadAtL1(ctx context.Context, l1BlockNum uint64) (l1Block eth.BlockID, finalizedHead eth.BlockID, err error) {
+	return d.headAtL1(ctx, finalizedByL1BlockNumKey, l1BlockNum)
+}
+
+func (d *SafeDB) headAtL1(ctx context.Context, column uint64Key, l1BlockNum uint64) (l1Block eth.BlockID, l2Block eth.BlockID, err error) {
 	d.m.RLock()
 	defer d.m.RUnlock()
-	iter, err := d.db.NewIterWithContext(ctx, safeByL1BlockNumKey.IterRange())
+	iter, err := d.db.NewIterWithContext(ctx, column.IterRange())
 	if err != nil {
 		return
 	}
 	defer iter.Close()
-	if valid := iter.SeekLT(safeByL1BlockNumKey.Of(l1BlockNum + 1)); !valid {
+	if valid := iter.SeekLT(column.Of(l1BlockNum + 1)); !valid {
 		err = ErrNotFound
 		return
 	}
@@ -180,10 +254,156 @@ func (d *SafeDB) SafeHeadAtL1(ctx context.Context, l1BlockNum uint64) (l1Block e
 	if err != nil {
 		return
 	}
-	l1Block, safeHead, err = decodeSafeByL1BlockNum(iter.Key(), val)
+	l1Block, l2Block, err = decodeByL1BlockNum(column.prefix, iter.Key(), val)
 	return
 }
 
+// L1BlockForSafeL2 returns the first L1 block that the L2 block l2BlockNum was safe at, and the safe head at that L1
+// block. L2 blocks before the first recorded safe head are not found, as they may have become safe earlier.
+func (d *SafeDB) L1BlockForSafeL2(ctx context.Context, l2BlockNum uint64) (l1Block eth.BlockID, safeHead eth.BlockID, err error) {
+	return d.l1ForL2(ctx, l1BySafeL2BlockNumKey, l2BlockNum)
+}
+
+// L1BlockForFinalizedL2 returns the first finalized L1 block that the L2 block l2BlockNum was final at, and the
+// finalized head at that L1 block. L2 blocks before the first recorded finalized head are not found, as they may have
+// been finalized earlier.
+func (d *SafeDB) L1BlockForFinalizedL2(ctx context.Context, l2BlockNum uint64) (l1Block eth.BlockID, finalizedHead eth.BlockID, err error) {
+	return d.l1ForL2(ctx, l1ByFinalizedL2BlockNumKey, l2BlockNum)
+}
+
+func (d *SafeDB) l1ForL2(ctx context.Context, column uint64Key, l2BlockNum uint64) (l1Block eth.BlockID, l2Block eth.BlockID, err error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	iter, err := d.db.NewIterWithContext(ctx, column.IterRange())
+	if err != nil {
+		return
+	}
+	defer iter.Close()
+	if valid := iter.SeekGE(column.Of(l2BlockNum)); !valid {
+		err = ErrNotFound
+		return
+	}
+	// Found the first recorded head at or after the requested L2 block
+	val, err := iter.ValueAndErr()
+	if err != nil {
+		return
+	}
+	l1Block, l2Block, err = decodeByL2BlockNum(column.prefix, iter.Key(), val)
+	if err != nil {
+		return
+	}
+	if l2Block.Number != l2BlockNum && !iter.Prev() {
+		// The requested L2 block is before the first recorded head, so it may have been reached before the records
+		// start.
+		err = ErrNotFound
+		return
+	}
+	return
+}
+
+// SafeHeadsInRange calls fn with each recorded safe head update at an L1 block from l1Start up to and including l1End,
+// in order of L1 block number. Iteration stops at the first error returned by fn.
+func (d *SafeDB) SafeHeadsInRange(ctx context.Context, l1Start uint64, l1End uint64, fn func(l1Block eth.BlockID, safeHead eth.BlockID) error) error {
+	return d.headsInRange(ctx, safeByL1BlockNumKey, l1Start, l1End, fn)
+}
+
+// FinalizedHeadsInRange calls fn with each recorded finalized head update at an L1 block from l1Start up to and
+// including l1End, in order of L1 block number. Iteration stops at the first error returned by fn.
+func (d *SafeDB) FinalizedHeadsInRange(ctx context.Context, l1Start uint64, l1End uint64, fn func(l1Block eth.BlockID, finalizedHead eth.BlockID) error) error {
+	return d.headsInRange(ctx, finalizedByL1BlockNumKey, l1Start, l1End, fn)
+}
+
+func (d *SafeDB) headsInRange(ctx context.Context, column uint64Key, l1Start uint64, l1End uint64, fn func(l1Block eth.BlockID, l2Block eth.BlockID) error) error {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	iter, err := d.db.NewIterWithContext(ctx, column.IterRange())
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for valid := iter.SeekGE(column.Of(l1Start)); valid; valid = iter.Next() {
+		val, err := iter.ValueAndErr()
+		if err != nil {
+			return err
+		}
+		l1Block, l2Block, err := decodeByL1BlockNum(column.prefix, iter.Key(), val)
+		if err != nil {
+			return err
+		}
+		if l1Block.Number > l1End {
+			return nil
+		}
+		if err := fn(l1Block, l2Block); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// Backfill builds the indexes that were added after the safe heads of an existing database were recorded. It rebuilds
+// the reverse index of the safe heads, and records the safe head at each L1 block up to and including finalizedL1 as
+// the finalized head at that L1 block, as all L2 blocks derived from finalized L1 data are final.
+// Backfill is idempotent, and returns the number of safe and finalized heads that were written.
+func (d *SafeDB) Backfill(ctx context.Context, finalizedL1 uint64) (safeHeads int, finalizedHeads int, err error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	iter, err := d.db.NewIterWithContext(ctx, safeByL1BlockNumKey.IterRange())
+	if err != nil {
+		return 0, 0, fmt.Errorf("backfill failed to create iterator: %w", err)
+	}
+	defer iter.Close()
+	batch := d.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+	var prevSafeHead *eth.BlockID
+	for valid := iter.First(); valid; valid = iter.Next() {
+		val, err := iter.ValueAndErr()
+		if err != nil {
+			return safeHeads, finalizedHeads, fmt.Errorf("backfill failed to read entry: %w", err)
+		}
+		l1Block, safeHead, err := decodeSafeByL1BlockNum(iter.Key(), val)
+		if err != nil {
+			return safeHeads, finalizedHeads, fmt.Errorf("backfill encountered invalid entry: %w", err)
+		}
+		// The reverse indexes store the first L1 block of each head, so later L1 blocks with the same head are skipped.
+		if prevSafeHead != nil && prevSafeHead.Number == safeHead.Number {
+			continue
+		}
+		prevSafeHead = &safeHead
+		if err := batch.Set(l1BySafeL2BlockNumKey.Of(safeHead.Number), l1ByL2BlockNumValue(l1Block, safeHead), d.writeOpts); err != nil {
+			return safeHeads, finalizedHeads, fmt.Errorf("backfill failed to record safe head: %w", err)
+		}
+		safeHeads++
+		if l1Block.Number <= finalizedL1 {
+			if err := batch.Set(finalizedByL1BlockNumKey.Of(l1Block.Number), safeByL1BlockNumValue(l1Block, safeHead), d.writeOpts); err != nil {
+				return safeHeads, finalizedHeads, fmt.Errorf("backfill failed to record finalized head: %w", err)
+			}
+			if err := batch.Set(l1ByFinalizedL2BlockNumKey.Of(safeHead.Number), l1ByL2BlockNumValue(l1Block, safeHead), d.writeOpts); err != nil {
+				return safeHeads, finalizedHeads, fmt.Errorf("backfill failed to record finalized head in reverse index: %w", err)
+			}
+			finalizedHeads++
+		}
+		if batch.Count() >= backfillBatchSize {
+			if err := batch.Commit(d.writeOpts); err != nil {
+				return safeHeads, finalizedHeads, fmt.Errorf("backfill failed to commit batch: %w", err)
+			}
+			d.log.Info("Backfilled safe head database", "l1", l1Block, "safe_heads", safeHeads, "finalized_heads", finalizedHeads)
+			if err := batch.Close(); err != nil {
+				return safeHeads, finalizedHeads, fmt.Errorf("backfill failed to close batch: %w", err)
+			}
+			batch = d.db.NewBatch()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return safeHeads, finalizedHeads, fmt.Errorf("backfill failed to iterate entries: %w", err)
+	}
+	if err := batch.Commit(d.writeOpts); err != nil {
+		return safeHeads, finalizedHeads, fmt.Errorf("backfill failed to commit batch: %w", err)
+	}
+	return safeHeads, finalizedHeads, nil
+}
+
 func (d *SafeDB) Close() error {
 	d.m.Lock()
 	defer d.m.Unlock()
@@ -2,6 +2,7 @@ package safedb
 
 import (
 	"context"
+	"errors"
 	"math"
 	"slices"
 	"testing"
@@ -367,3 +368,178 @@ func TestDecodeSafeByL1BlockNum(t *testing.T) {
 		require.ErrorIs(t, err, ErrInvalidEntry)
 	})
 }
+
+func TestL1BlockForSafeL2(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	db, err := NewSafeDB(logger, t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	l2a := eth.L2BlockRef{Hash: common.Hash{0x02, 0xaa}, Number: 20}
+	l2b := eth.L2BlockRef{Hash: common.Hash{0x02, 0xbb}, Number: 22}
+	l2c := eth.L2BlockRef{Hash: common.Hash{0x02, 0xcc}, Number: 25}
+	l2d := eth.L2BlockRef{Hash: common.Hash{0x02, 0xdd}, Number: 30}
+	l1a := eth.BlockID{Hash: common.Hash{0x01, 0xaa}, Number: 100}
+	l1b := eth.BlockID{Hash: common.Hash{0x01, 0xbb}, Number: 150}
+	l1c := eth.BlockID{Hash: common.Hash{0x01, 0xcc}, Number: 160}
+	require.NoError(t, db.SafeHeadUpdated(l2a, l1a))
+	require.NoError(t, db.SafeHeadUpdated(l2c, l1b))
+	require.NoError(t, db.SafeHeadUpdated(l2d, l1c))
+
+	verify := func(l2BlockNum uint64, expectedL1 eth.BlockID, expectedSafeHead eth.BlockID) {
+		actualL1, actualL2, err := db.L1BlockForSafeL2(context.Background(), l2BlockNum)
+		require.NoError(t, err)
+		require.Equal(t, expectedL1, actualL1)
+		require.Equal(t, expectedSafeHead, actualL2)
+	}
+	verify(l2a.Number, l1a, l2a.ID())
+	verify(l2a.Number+1, l1b, l2c.ID())
+	verify(l2c.Number, l1b, l2c.ID())
+	verify(l2d.Number, l1c, l2d.ID())
+
+	// Blocks before the first safe head may have become safe before the records start
+	_, _, err = db.L1BlockForSafeL2(context.Background(), l2a.Number-1)
+	require.ErrorIs(t, err, ErrNotFound)
+	// Blocks after the last safe head are not safe yet
+	_, _, err = db.L1BlockForSafeL2(context.Background(), l2d.Number+1)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// A reset truncates the reverse index along with the safe heads
+	require.NoError(t, db.SafeHeadReset(l2b))
+	verify(l2a.Number, l1a, l2a.ID())
+	verify(l2b.Number, l1b, l2b.ID())
+	_, _, err = db.L1BlockForSafeL2(context.Background(), l2b.Number+1)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestStoreFinalizedHeads(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	dir := t.TempDir()
+	db, err := NewSafeDB(logger, dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	l2a := eth.L2BlockRef{Hash: common.Hash{0x02, 0xaa}, Number: 20}
+	l2b := eth.L2BlockRef{Hash: common.Hash{0x02, 0xbb}, Number: 25}
+	l1a := eth.BlockID{Hash: common.Hash{0x01, 0xaa}, Number: 100}
+	l1b := eth.BlockID{Hash: common.Hash{0x01, 0xbb}, Number: 132}
+	require.NoError(t, db.FinalizedHeadUpdated(l2a, l1a))
+	require.NoError(t, db.FinalizedHeadUpdated(l2b, l1b))
+	// Finalized heads are not safe head updates
+	_, _, err = db.SafeHeadAtL1(context.Background(), l1b.Number)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	verifyFinalizedHeads := func(db *SafeDB) {
+		_, _, err := db.FinalizedHeadAtL1(context.Background(), l1a.Number-1)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		actualL1, actualL2, err := db.FinalizedHeadAtL1(context.Background(), l1b.Number-1)
+		require.NoError(t, err)
+		require.Equal(t, l1a, actualL1)
+		require.Equal(t, l2a.ID(), actualL2)
+
+		actualL1, actualL2, err = db.FinalizedHeadAtL1(context.Background(), l1b.Number+10)
+		require.NoError(t, err)
+		require.Equal(t, l1b, actualL1)
+		require.Equal(t, l2b.ID(), actualL2)
+
+		actualL1, actualL2, err = db.L1BlockForFinalizedL2(context.Background(), l2a.Number+1)
+		require.NoError(t, err)
+		require.Equal(t, l1b, actualL1)
+		require.Equal(t, l2b.ID(), actualL2)
+
+		_, _, err = db.L1BlockForFinalizedL2(context.Background(), l2b.Number+1)
+		require.ErrorIs(t, err, ErrNotFound)
+	}
+	verifyFinalizedHeads(db)
+
+	require.NoError(t, db.Close())
+	newDB, err := NewSafeDB(logger, dir)
+	require.NoError(t, err)
+	defer newDB.Close()
+	verifyFinalizedHeads(newDB)
+}
+
+func TestSafeHeadsInRange(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	db, err := NewSafeDB(logger, t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	var l1Blocks []eth.BlockID
+	for i := uint64(0); i < 5; i++ {
+		l1 := eth.BlockID{Hash: common.Hash{0x01, byte(i)}, Number: 100 + 10*i}
+		require.NoError(t, db.SafeHeadUpdated(eth.L2BlockRef{Hash: common.Hash{0x02, byte(i)}, Number: 20 + i}, l1))
+		l1Blocks = append(l1Blocks, l1)
+	}
+
+	var actual []eth.BlockID
+	require.NoError(t, db.SafeHeadsInRange(context.Background(), 105, 130, func(l1Block eth.BlockID, safeHead eth.BlockID) error {
+		require.Equal(t, l1Block.Number, 100+10*(safeHead.Number-20))
+		actual = append(actual, l1Block)
+		return nil
+	}))
+	require.Equal(t, l1Blocks[1:4], actual)
+
+	stop := errors.New("stop")
+	calls := 0
+	err = db.SafeHeadsInRange(context.Background(), 0, math.MaxUint64, func(_ eth.BlockID, _ eth.BlockID) error {
+		calls++
+		return stop
+	})
+	require.ErrorIs(t, err, stop)
+	require.Equal(t, 1, calls)
+
+	require.NoError(t, db.FinalizedHeadsInRange(context.Background(), 0, math.MaxUint64, func(_ eth.BlockID, _ eth.BlockID) error {
+		t.Fatal("no finalized heads were recorded")
+		return nil
+	}))
+}
+
+func TestBackfill(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	db, err := NewSafeDB(logger, t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	l2a := eth.BlockID{Hash: common.Hash{0x02, 0xaa}, Number: 20}
+	l2b := eth.BlockID{Hash: common.Hash{0x02, 0xbb}, Number: 25}
+	l2c := eth.BlockID{Hash: common.Hash{0x02, 0xcc}, Number: 30}
+	l1a := eth.BlockID{Hash: common.Hash{0x01, 0xaa}, Number: 100}
+	l1b := eth.BlockID{Hash: common.Hash{0x01, 0xbb}, Number: 150}
+	l1b2 := eth.BlockID{Hash: common.Hash{0x01, 0xb2}, Number: 155}
+	l1c := eth.BlockID{Hash: common.Hash{0x01, 0xcc}, Number: 160}
+	// Write the safe heads as a database from before the reverse index did, with the same safe head at two L1 blocks
+	for _, entry := range []struct{ l1, l2 eth.BlockID }{{l1a, l2a}, {l1b, l2b}, {l1b2, l2b}, {l1c, l2c}} {
+		require.NoError(t, db.db.Set(safeByL1BlockNumKey.Of(entry.l1.Number), safeByL1BlockNumValue(entry.l1, entry.l2), db.writeOpts))
+	}
+	_, _, err = db.L1BlockForSafeL2(context.Background(), l2a.Number)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	for i := 0; i < 2; i++ {
+		safeHeads, finalizedHeads, err := db.Backfill(context.Background(), l1b2.Number)
+		require.NoError(t, err)
+		require.Equal(t, 3, safeHeads)
+		require.Equal(t, 2, finalizedHeads)
+	}
+
+	actualL1, actualL2, err := db.L1BlockForSafeL2(context.Background(), l2a.Number+1)
+	require.NoError(t, err)
+	require.Equal(t, l1b, actualL1, "the first L1 block of a safe head is kept")
+	require.Equal(t, l2b, actualL2)
+	actualL1, actualL2, err = db.L1BlockForSafeL2(context.Background(), l2c.Number)
+	require.NoError(t, err)
+	require.Equal(t, l1c, actualL1)
+	require.Equal(t, l2c, actualL2)
+
+	actualL1, actualL2, err = db.FinalizedHeadAtL1(context.Background(), l1c.Number)
+	require.NoError(t, err)
+	require.Equal(t, l1b, actualL1)
+	require.Equal(t, l2b, actualL2)
+	actualL1, actualL2, err = db.L1BlockForFinalizedL2(context.Background(), l2a.Number)
+	require.NoError(t, err)
+	require.Equal(t, l1a, actualL1)
+	require.Equal(t, l2a, actualL2)
+	_, _, err = db.L1BlockForFinalizedL2(context.Background(), l2c.Number)
+	require.ErrorIs(t, err, ErrNotFound)
+}
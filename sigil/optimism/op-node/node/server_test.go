@@ -246,6 +246,52 @@ func TestSafeHeadAtL1Block(t *testing.T) {
 	safeReader.Mock.AssertExpectations(t)
 }
 
+func TestSafeDBLookups(t *testing.T) {
+	log := testlog.Logger(t, log.LevelError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	safeReader := &mockSafeDBReader{}
+	l1Block := eth.BlockID{Hash: common.Hash{0xdd}, Number: 5221}
+	l2Block := eth.BlockID{Hash: common.Hash{0xee}, Number: 223}
+	safeReader.Mock.On("L1BlockForSafeL2", uint64(220)).Return(l1Block, l2Block, new(error))
+	safeReader.Mock.On("FinalizedHeadAtL1", uint64(5223)).Return(l1Block, l2Block, new(error))
+	safeReader.Mock.On("L1BlockForFinalizedL2", uint64(220)).Return(l1Block, l2Block, new(error))
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(rpcCfg, rollupCfg, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	require.NoError(t, err)
+
+	var safe *eth.SafeHeadResponse
+	err = client.CallContext(context.Background(), &safe, "optimism_l1BlockForSafeL2", hexutil.Uint64(220).String())
+	require.NoError(t, err)
+	require.Equal(t, &eth.SafeHeadResponse{L1Block: l1Block, SafeHead: l2Block}, safe)
+
+	expectedFinalized := &eth.FinalizedHeadResponse{L1Block: l1Block, FinalizedHead: l2Block}
+	var finalized *eth.FinalizedHeadResponse
+	err = client.CallContext(context.Background(), &finalized, "optimism_finalizedHeadAtL1Block", hexutil.Uint64(5223).String())
+	require.NoError(t, err)
+	require.Equal(t, expectedFinalized, finalized)
+
+	finalized = nil
+	err = client.CallContext(context.Background(), &finalized, "optimism_l1BlockForFinalizedL2", hexutil.Uint64(220).String())
+	require.NoError(t, err)
+	require.Equal(t, expectedFinalized, finalized)
+	safeReader.Mock.AssertExpectations(t)
+}
+
 type mockDriverClient struct {
 	mock.Mock
 }
@@ -299,3 +345,18 @@ func (m *mockSafeDBReader) SafeHeadAtL1(ctx context.Context, l1BlockNum uint64)
 func (m *mockSafeDBReader) ExpectSafeHeadAtL1(l1BlockNum uint64, l1 eth.BlockID, safeHead eth.BlockID, err error) {
 	m.Mock.On("SafeHeadAtL1", l1BlockNum).Return(l1, safeHead, &err)
 }
+
+func (m *mockSafeDBReader) L1BlockForSafeL2(ctx context.Context, l2BlockNum uint64) (l1 eth.BlockID, safeHead eth.BlockID, err error) {
+	r := m.Mock.MethodCalled("L1BlockForSafeL2", l2BlockNum)
+	return r[0].(eth.BlockID), r[1].(eth.BlockID), *r[2].(*error)
+}
+
+func (m *mockSafeDBReader) FinalizedHeadAtL1(ctx context.Context, l1BlockNum uint64) (l1 eth.BlockID, finalizedHead eth.BlockID, err error) {
+	r := m.Mock.MethodCalled("FinalizedHeadAtL1", l1BlockNum)
+	return r[0].(eth.BlockID), r[1].(eth.BlockID), *r[2].(*error)
+}
+
+func (m *mockSafeDBReader) L1BlockForFinalizedL2(ctx context.Context, l2BlockNum uint64) (l1 eth.BlockID, finalizedHead eth.BlockID, err error) {
+	r := m.Mock.MethodCalled("L1BlockForFinalizedL2", l2BlockNum)
+	return r[0].(eth.BlockID), r[1].(eth.BlockID), *r[2].(*error)
+}
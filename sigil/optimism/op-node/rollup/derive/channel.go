@@ -8,8 +8,10 @@ import (
 	"io"
 
 	"github.com/andybalholm/brotli"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/klauspost/compress/zstd"
 )
 
 const (
@@ -154,11 +156,40 @@ func (ch *Channel) Reader() io.Reader {
 	return io.MultiReader(readers...)
 }
 
+type batchReaderConfig struct {
+	zstd     bool
+	zstdDict []byte
+}
+
+// BatchReaderOption configures the compression algorithms that a batch reader accepts beyond zlib and brotli.
+type BatchReaderOption func(cfg *batchReaderConfig)
+
+// WithZstd makes the batch reader accept zstd compressed channels. They are decompressed with the dictionary,
+// if it isn't empty.
+func WithZstd(dict []byte) BatchReaderOption {
+	return func(cfg *batchReaderConfig) {
+		cfg.zstd = true
+		cfg.zstdDict = dict
+	}
+}
+
+// BatchReaderOptions returns the options of the batch reader of a channel that is read at the given L1 origin time.
+func BatchReaderOptions(rollupCfg *rollup.Config, l1Time uint64) []BatchReaderOption {
+	if rollupCfg.IsZstd(l1Time) {
+		return []BatchReaderOption{WithZstd(rollupCfg.ZstdDictionary())}
+	}
+	return nil
+}
+
 // BatchReader provides a function that iteratively consumes batches from the reader.
 // The L1Inclusion block is also provided at creation time.
 // Warning: the batch reader can read every batch-type.
 // The caller of the batch-reader should filter the results.
-func BatchReader(r io.Reader, maxRLPBytesPerChannel uint64, isFjord bool) (func() (*BatchData, error), error) {
+func BatchReader(r io.Reader, maxRLPBytesPerChannel uint64, isFjord bool, opts ...BatchReaderOption) (func() (*BatchData, error), error) {
+	var cfg batchReaderConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
 	// use buffered reader so can peek the first byte
 	bufReader := bufio.NewReader(r)
 	compressionType, err := bufReader.Peek(1)
@@ -189,6 +220,20 @@ func BatchReader(r io.Reader, maxRLPBytesPerChannel uint64, isFjord bool) (func(
 		}
 		zr = brotli.NewReader(bufReader)
 		comprAlgo = Brotli
+	} else if compressionType[0] == ChannelVersionZstd {
+		if !cfg.zstd {
+			return nil, fmt.Errorf("cannot accept zstd compressed batch before it is enabled")
+		}
+		// discard the first byte
+		_, err := bufReader.Discard(1)
+		if err != nil {
+			return nil, err
+		}
+		zr, err = newZstdReader(bufReader, cfg.zstdDict)
+		if err != nil {
+			return nil, err
+		}
+		comprAlgo = Zstd
 	} else {
 		return nil, fmt.Errorf("cannot distinguish the compression algo used given type byte %v", compressionType[0])
 	}
@@ -204,3 +249,16 @@ func BatchReader(r io.Reader, maxRLPBytesPerChannel uint64, isFjord bool) (func(
 		return &batchData, nil
 	}, nil
 }
+
+func newZstdReader(r io.Reader, dict []byte) (io.Reader, error) {
+	opts := []zstd.DOption{
+		zstd.WithDecoderMaxWindow(ZstdMaxWindowSize),
+		// decompress synchronously, so that the decoder doesn't need to be closed
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderLowmem(true),
+	}
+	if len(dict) > 0 {
+		opts = append(opts, zstd.WithDecoderDicts(dict))
+	}
+	return zstd.NewReader(r, opts...)
+}
@@ -7,10 +7,15 @@ import (
 	"io"
 
 	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 )
 
 const (
 	ChannelVersionBrotli byte = 0x01
+	ChannelVersionZstd   byte = 0x02
+
+	// ZstdMaxWindowSize is the largest zstd window size that channels may be compressed with.
+	ZstdMaxWindowSize = 8 << 20
 )
 
 type ChannelCompressor interface {
@@ -66,7 +71,23 @@ func (bc *BrotliCompressor) Reset() {
 	bc.CompressorWriter.Reset(bc.compressed)
 }
 
+type ZstdCompressor struct {
+	BaseChannelCompressor
+}
+
+func (zc *ZstdCompressor) Reset() {
+	zc.compressed.Reset()
+	zc.compressed.WriteByte(ChannelVersionZstd)
+	zc.CompressorWriter.Reset(zc.compressed)
+}
+
 func NewChannelCompressor(algo CompressionAlgo) (ChannelCompressor, error) {
+	return NewChannelCompressorWithDict(algo, nil)
+}
+
+// NewChannelCompressorWithDict creates a channel compressor that compresses with the given dictionary, which is only
+// used by zstd. It must be the zstd dictionary of the rollup config for the channel to be derived.
+func NewChannelCompressorWithDict(algo CompressionAlgo, dict []byte) (ChannelCompressor, error) {
 	compressed := &bytes.Buffer{}
 	if algo == Zlib {
 		writer, err := zlib.NewWriterLevel(compressed, zlib.BestCompression)
@@ -88,6 +109,27 @@ func NewChannelCompressor(algo CompressionAlgo) (ChannelCompressor, error) {
 				compressed:       compressed,
 			},
 		}, nil
+	} else if algo == Zstd {
+		compressed.WriteByte(ChannelVersionZstd)
+		opts := []zstd.EOption{
+			zstd.WithEncoderLevel(zstd.SpeedBestCompression),
+			zstd.WithWindowSize(ZstdMaxWindowSize),
+			// compress synchronously, in the calling goroutine
+			zstd.WithEncoderConcurrency(1),
+		}
+		if len(dict) > 0 {
+			opts = append(opts, zstd.WithEncoderDict(dict))
+		}
+		writer, err := zstd.NewWriter(compressed, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &ZstdCompressor{
+			BaseChannelCompressor{
+				CompressorWriter: writer,
+				compressed:       compressed,
+			},
+		}, nil
 	} else {
 		return nil, fmt.Errorf("unsupported compression algorithm: %s", algo)
 	}
@@ -1,9 +1,12 @@
 package derive
 
 import (
+	"bytes"
+	"math/big"
 	"math/rand"
 	"testing"
 
+	"github.com/klauspost/compress/dict"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,9 +41,13 @@ func TestChannelCompressor_NewReset(t *testing.T) {
 		},
 		{
 			name:              "zstd",
-			algo:              CompressionAlgo("zstd"),
-			expectedResetSize: 0,
-			expectErr:         true,
+			algo:              Zstd,
+			expectedResetSize: 1,
+		},
+		{
+			name:      "invalid",
+			algo:      CompressionAlgo("invalid"),
+			expectErr: true,
 		},
 	}
 
@@ -65,3 +72,42 @@ func TestChannelCompressor_NewReset(t *testing.T) {
 		})
 	}
 }
+
+func TestChannelCompressor_ZstdDict(t *testing.T) {
+	rng := rand.New(rand.NewSource(0x543331))
+	chainID := big.NewInt(333)
+	encodeBatch := func() []byte {
+		var buf bytes.Buffer
+		require.NoError(t, NewBatchData(RandomSingularBatch(rng, 4, chainID)).EncodeRLP(&buf))
+		return buf.Bytes()
+	}
+	samples := make([][]byte, 0, 64)
+	for i := 0; i < cap(samples); i++ {
+		samples = append(samples, encodeBatch())
+	}
+	zstdDict, err := dict.BuildZstdDict(samples, dict.Options{MaxDictSize: 8 << 10, HashBytes: 6, ZstdDictID: 1})
+	require.NoError(t, err)
+
+	batch := encodeBatch()
+	scc, err := NewChannelCompressorWithDict(Zstd, zstdDict)
+	require.NoError(t, err)
+	_, err = scc.Write(batch)
+	require.NoError(t, err)
+	require.NoError(t, scc.Close())
+	compressed := scc.GetCompressed().Bytes()
+
+	// the batch can only be read with the dictionary
+	reader, err := BatchReader(bytes.NewReader(compressed), 120000, true, WithZstd(zstdDict))
+	require.NoError(t, err)
+	batchData, err := reader()
+	require.NoError(t, err)
+	require.Equal(t, Zstd, batchData.ComprAlgo)
+	var buf bytes.Buffer
+	require.NoError(t, batchData.EncodeRLP(&buf))
+	require.Equal(t, batch, buf.Bytes())
+
+	reader, err = BatchReader(bytes.NewReader(compressed), 120000, true, WithZstd(nil))
+	require.NoError(t, err)
+	_, err = reader()
+	require.Error(t, err)
+}
@@ -121,7 +121,6 @@ func TestBatchReader(t *testing.T) {
 	err := batchDataInput.EncodeRLP(encodedBatch)
 	require.NoError(t, err)
 
-	const Zstd CompressionAlgo = "zstd" // invalid algo
 	compressor := func(ca CompressionAlgo) func(buf *bytes.Buffer, t *testing.T) {
 		switch {
 		case ca == Zlib:
@@ -140,9 +139,9 @@ func TestBatchReader(t *testing.T) {
 				require.NoError(t, err)
 				require.NoError(t, writer.Close())
 			}
-		case ca == Zstd: // invalid algo
+		case ca == Zstd:
 			return func(buf *bytes.Buffer, t *testing.T) {
-				buf.WriteByte(0x02) // invalid channel version byte
+				buf.WriteByte(ChannelVersionZstd)
 				writer, err := zstd.NewWriter(buf)
 				require.NoError(t, err)
 				_, err = writer.Write(encodedBatch.Bytes())
@@ -158,6 +157,7 @@ func TestBatchReader(t *testing.T) {
 		name      string
 		algo      CompressionAlgo
 		isFjord   bool
+		zstd      bool
 		expectErr bool
 	}{
 		{
@@ -205,9 +205,15 @@ func TestBatchReader(t *testing.T) {
 		{
 			name:      "zstd-post-fjord",
 			algo:      Zstd,
-			expectErr: true,
+			expectErr: true, // expect an error because zstd is not enabled
 			isFjord:   true,
 		},
+		{
+			name:    "zstd-enabled",
+			algo:    Zstd,
+			isFjord: true,
+			zstd:    true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -215,7 +221,11 @@ func TestBatchReader(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			compressor(tc.algo)(compressed, t)
-			reader, err := BatchReader(bytes.NewReader(compressed.Bytes()), 120000, tc.isFjord)
+			var opts []BatchReaderOption
+			if tc.zstd {
+				opts = append(opts, WithZstd(nil))
+			}
+			reader, err := BatchReader(bytes.NewReader(compressed.Bytes()), 120000, tc.isFjord, opts...)
 			if tc.expectErr {
 				require.Error(t, err)
 				return
@@ -44,6 +44,9 @@ type SpanChannelOut struct {
 	// to seal full span batches (that have reached the max block count) in the rlp slices.
 	sealedRLPBytes int
 
+	// compressionDict is the optional dictionary to compress with. It is only used by zstd.
+	compressionDict []byte
+
 	chainSpec *rollup.ChainSpec
 }
 
@@ -64,6 +67,13 @@ func WithMaxBlocksPerSpanBatch(maxBlock int) SpanChannelOutOption {
 	}
 }
 
+// WithCompressionDict sets the dictionary to compress the channel with. It is only used by zstd.
+func WithCompressionDict(dict []byte) SpanChannelOutOption {
+	return func(co *SpanChannelOut) {
+		co.compressionDict = dict
+	}
+}
+
 func NewSpanChannelOut(targetOutputSize uint64, compressionAlgo CompressionAlgo, chainSpec *rollup.ChainSpec, opts ...SpanChannelOutOption) (*SpanChannelOut, error) {
 	c := &SpanChannelOut{
 		id:        ChannelID{},
@@ -78,14 +88,14 @@ func NewSpanChannelOut(targetOutputSize uint64, compressionAlgo CompressionAlgo,
 		return nil, err
 	}
 
-	if c.compressor, err = NewChannelCompressor(compressionAlgo); err != nil {
-		return nil, err
-	}
-
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	if c.compressor, err = NewChannelCompressorWithDict(compressionAlgo, c.compressionDict); err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
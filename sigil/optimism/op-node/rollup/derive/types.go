@@ -14,6 +14,8 @@ const (
 	Brotli9  CompressionAlgo = "brotli-9"
 	Brotli10 CompressionAlgo = "brotli-10"
 	Brotli11 CompressionAlgo = "brotli-11"
+	// Zstd is experimental, and only accepted by the derivation if enabled in the rollup config.
+	Zstd CompressionAlgo = "zstd"
 )
 
 var CompressionAlgos = []CompressionAlgo{
@@ -22,6 +24,7 @@ var CompressionAlgos = []CompressionAlgo{
 	Brotli9,
 	Brotli10,
 	Brotli11,
+	Zstd,
 }
 
 var brotliRegexp = regexp.MustCompile(`^brotli(|-(9|10|11))$`)
@@ -1,5 +1,7 @@
 package driver
 
+import "github.com/ethereum-optimism/optimism/op-service/eth"
+
 type Config struct {
 	// VerifierConfDepth is the distance to keep from the L1 head when reading L1 data for L2 derivation.
 	VerifierConfDepth uint64 `json:"verifier_conf_depth"`
@@ -20,4 +22,8 @@ type Config struct {
 	// SequencerMaxSafeLag is the maximum number of L2 blocks for restricting the distance between L2 safe and unsafe.
 	// Disabled if 0.
 	SequencerMaxSafeLag uint64 `json:"sequencer_max_safe_lag"`
+
+	// SequencerOrderingPolicy is the ordering policy of the tx-pool transactions in sequenced blocks.
+	// The engine default is used if nil.
+	SequencerOrderingPolicy *eth.OrderingPolicy `json:"sequencer_ordering_policy,omitempty"`
 }
@@ -84,6 +84,9 @@ func (s *Driver) Start() error {
 		if err := s.sequencer.SetMaxSafeLag(s.driverCtx, s.driverConfig.SequencerMaxSafeLag); err != nil {
 			return fmt.Errorf("failed to set sequencer max safe lag: %w", err)
 		}
+		if err := s.sequencer.SetOrderingPolicy(s.driverCtx, s.driverConfig.SequencerOrderingPolicy); err != nil {
+			return fmt.Errorf("failed to set sequencer ordering policy: %w", err)
+		}
 		if err := s.sequencer.Init(s.driverCtx, !s.driverConfig.SequencerStopped); err != nil {
 			return fmt.Errorf("persist initial sequencer state: %w", err)
 		}
@@ -339,6 +342,8 @@ func (s *SyncDeriver) OnEvent(ev event.Event) bool {
 		s.Emitter.Emit(StepReqEvent{ResetBackoff: true})
 	case engine.SafeDerivedEvent:
 		s.onSafeDerivedBlock(x)
+	case engine.FinalizedUpdateEvent:
+		s.onFinalizedUpdate(x)
 	default:
 		return false
 	}
@@ -356,6 +361,18 @@ func (s *SyncDeriver) onSafeDerivedBlock(x engine.SafeDerivedEvent) {
 	}
 }
 
+func (s *SyncDeriver) onFinalizedUpdate(x engine.FinalizedUpdateEvent) {
+	// The finalized head is only recorded if the L1 block that finalized it is known.
+	if s.SafeHeadNotifs == nil || !s.SafeHeadNotifs.Enabled() || x.FinalizedL1 == (eth.L1BlockRef{}) {
+		return
+	}
+	// Unlike the safe head, the finalized head is not rolled back on failure: the next finalized head is recorded
+	// as normal, and only the L1 block of this update is missing.
+	if err := s.SafeHeadNotifs.FinalizedHeadUpdated(x.Ref, x.FinalizedL1.ID()); err != nil {
+		s.Log.Error("Failed to notify safe-head listener of finalized head", "finalized", x.Ref, "l1", x.FinalizedL1, "err", err)
+	}
+}
+
 func (s *SyncDeriver) onEngineConfirmedReset(x engine.EngineResetConfirmedEvent) {
 	// If the listener update fails, we return,
 	// and don't confirm the engine-reset with the derivation pipeline.
@@ -223,12 +223,24 @@ func (ev EngineResetConfirmedEvent) String() string {
 // PromoteFinalizedEvent signals that a block can be marked as finalized.
 type PromoteFinalizedEvent struct {
 	Ref eth.L2BlockRef
+	// FinalizedL1 is the finalized L1 block that finalized the block, zeroed if unknown.
+	FinalizedL1 eth.L1BlockRef
 }
 
 func (ev PromoteFinalizedEvent) String() string {
 	return "promote-finalized"
 }
 
+// FinalizedUpdateEvent signals that the finalized head has been updated.
+type FinalizedUpdateEvent struct {
+	Ref         eth.L2BlockRef
+	FinalizedL1 eth.L1BlockRef
+}
+
+func (ev FinalizedUpdateEvent) String() string {
+	return "finalized-update"
+}
+
 // CrossUpdateRequestEvent triggers update events to be emitted, repeating the current state.
 type CrossUpdateRequestEvent struct {
 	CrossUnsafe bool
@@ -419,6 +431,7 @@ func (d *EngDeriver) OnEvent(ev event.Event) bool {
 			return true
 		}
 		d.ec.SetFinalizedHead(x.Ref)
+		d.emitter.Emit(FinalizedUpdateEvent{Ref: x.Ref, FinalizedL1: x.FinalizedL1})
 		// Try to apply the forkchoice changes
 		d.emitter.Emit(TryUpdateEngineEvent{})
 	case CrossUpdateRequestEvent:
@@ -0,0 +1,136 @@
+package event
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	exportQueueSize     = 10_000
+	exportBatchSize     = 1_000
+	exportFlushInterval = time.Second
+)
+
+// TraceExporter exports batches of trace entries, in the order that they were traced.
+type TraceExporter interface {
+	Export(entries []TraceEntry) error
+	Close() error
+}
+
+// ExportTracer exports trace entries in batches in the background, so that a slow export does not hold up event
+// processing. Entries are dropped if the export falls behind.
+type ExportTracer struct {
+	log      log.Logger
+	exporter TraceExporter
+
+	entries chan TraceEntry
+	dropped atomic.Uint64
+
+	closing   chan struct{}
+	closeOnce sync.Once
+	done      chan struct{}
+	closeErr  error
+}
+
+var _ Tracer = (*ExportTracer)(nil)
+
+func NewExportTracer(log log.Logger, exporter TraceExporter) *ExportTracer {
+	t := &ExportTracer{
+		log:      log,
+		exporter: exporter,
+		entries:  make(chan TraceEntry, exportQueueSize),
+		closing:  make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go t.loop()
+	return t
+}
+
+func (t *ExportTracer) OnDeriveStart(name string, ev AnnotatedEvent, derivContext uint64, startTime time.Time) {
+	t.add(deriveStartEntry(name, ev, derivContext, startTime))
+}
+
+func (t *ExportTracer) OnDeriveEnd(name string, ev AnnotatedEvent, derivContext uint64, startTime time.Time, duration time.Duration, effect bool) {
+	t.add(deriveEndEntry(name, ev, derivContext, startTime, duration, effect))
+}
+
+func (t *ExportTracer) OnRateLimited(name string, derivContext uint64) {
+	t.add(rateLimitedEntry(name, derivContext))
+}
+
+func (t *ExportTracer) OnEmit(name string, ev AnnotatedEvent, derivContext uint64, emitTime time.Time) {
+	t.add(emitEntry(name, ev, derivContext, emitTime))
+}
+
+func (t *ExportTracer) add(entry TraceEntry) {
+	select {
+	case <-t.closing:
+	case t.entries <- entry:
+	default:
+		t.dropped.Add(1)
+	}
+}
+
+// Dropped returns the number of entries that were dropped because the export fell behind.
+func (t *ExportTracer) Dropped() uint64 {
+	return t.dropped.Load()
+}
+
+func (t *ExportTracer) loop() {
+	defer close(t.done)
+	ticker := time.NewTicker(exportFlushInterval)
+	defer ticker.Stop()
+	batch := make([]TraceEntry, 0, exportBatchSize)
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.exporter.Export(batch); err != nil {
+			t.log.Warn("Failed to export event trace entries", "entries", len(batch), "err", err)
+		}
+		batch = batch[:0]
+	}
+	var reportedDropped uint64
+	for {
+		select {
+		case entry := <-t.entries:
+			batch = append(batch, entry)
+			if len(batch) >= exportBatchSize {
+				export()
+			}
+		case <-ticker.C:
+			export()
+			if dropped := t.dropped.Load(); dropped > reportedDropped {
+				t.log.Warn("Dropped event trace entries, the export is falling behind", "dropped", dropped-reportedDropped)
+				reportedDropped = dropped
+			}
+		case <-t.closing:
+			for {
+				select {
+				case entry := <-t.entries:
+					batch = append(batch, entry)
+					if len(batch) >= exportBatchSize {
+						export()
+					}
+				default:
+					export()
+					t.closeErr = t.exporter.Close()
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close exports the remaining entries, and closes the exporter.
+// Entries that are traced after Close are dropped.
+func (t *ExportTracer) Close() error {
+	t.closeOnce.Do(func() {
+		close(t.closing)
+	})
+	<-t.done
+	return t.closeErr
+}
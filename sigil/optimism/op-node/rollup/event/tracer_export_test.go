@@ -0,0 +1,141 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// tracedSystem emits a TestEvent from outside any derivation, that is processed by the "foo" deriver and ignored by
+// the "bar" deriver, and returns the tracer that captured it.
+func tracedSystem(t *testing.T, tracer Tracer) *StructTracer {
+	logger := testlog.Logger(t, log.LevelError)
+	ex := NewGlobalSynchronous(context.Background())
+	sys := NewSystem(logger, ex)
+	t.Cleanup(sys.Stop)
+	st := NewStructTracer()
+	sys.AddTracer(st)
+	sys.AddTracer(tracer)
+	foo := DeriverFunc(func(ev Event) bool {
+		_, ok := ev.(TestEvent)
+		return ok
+	})
+	bar := DeriverFunc(func(ev Event) bool {
+		return false
+	})
+	em := sys.Register("foo", foo, DefaultRegisterOpts())
+	sys.Register("bar", bar, DefaultRegisterOpts())
+	em.Emit(TestEvent{})
+	require.NoError(t, ex.Drain())
+	return st
+}
+
+// utcEntries drops the monotonic clock readings and locations of the times, which are not encoded in traces.
+func utcEntries(entries []TraceEntry) []TraceEntry {
+	out := make([]TraceEntry, len(entries))
+	for i, e := range entries {
+		if e.EventTime != (time.Time{}) {
+			e.EventTime = e.EventTime.UTC()
+		}
+		out[i] = e
+	}
+	return out
+}
+
+func TestExportTracerJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := NewExportTracer(testlog.Logger(t, log.LevelError), NewJSONLExporter(nopCloser{&buf}))
+	st := tracedSystem(t, tracer)
+	require.NoError(t, tracer.Close())
+	require.Zero(t, tracer.Dropped())
+
+	entries, err := ReadTraceEntries(&buf)
+	require.NoError(t, err)
+	// An emit, and a derive start and end for each of the two derivers
+	require.Len(t, entries, 5)
+	require.Equal(t, utcEntries(st.Entries), utcEntries(entries))
+
+	// Entries traced after closing are dropped
+	tracer.OnRateLimited("foo", 1)
+	require.Zero(t, buf.Len())
+}
+
+func TestTraceEntryJSON(t *testing.T) {
+	entry := TraceEntry{Kind: TraceRateLimited, Name: "foo", DerivContext: 3}
+	data, err := json.Marshal(entry)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"kind":"rate-limited","name":"foo","deriv_context":3}`, string(data))
+	var decoded TraceEntry
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, entry, decoded)
+
+	entry = TraceEntry{Kind: TraceDeriveEnd, Name: "foo", DerivContext: 3, EmitContext: 2, EventName: "test", EventTime: time.Unix(10, 5).UTC()}
+	entry.DeriveEnd.Duration = 1500
+	data, err = json.Marshal(entry)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"kind":"derive-end","name":"foo","deriv_context":3,"emit_context":2,"event":"test","time":"1970-01-01T00:00:10.000000005Z","duration":1500,"effect":false}`, string(data))
+	decoded = TraceEntry{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, entry, decoded)
+
+	_, err = ReadTraceEntries(bytes.NewBufferString(`{"kind":"derive-start","name":"foo"}` + "\n" + `{"kind":"unknown"}`))
+	require.ErrorContains(t, err, "failed to decode trace entry 1")
+}
+
+func TestOTLPExporter(t *testing.T) {
+	requests := make(chan otlpTraceRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var req otlpTraceRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests <- req
+	}))
+	defer srv.Close()
+
+	tracer := NewExportTracer(testlog.Logger(t, log.LevelError), NewOTLPExporter(srv.URL+"/", "op-node-test"))
+	tracedSystem(t, tracer)
+	require.NoError(t, tracer.Close())
+
+	req := <-requests
+	require.Len(t, req.ResourceSpans, 1)
+	require.Equal(t, "op-node-test", *req.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+	// The root span of the emit, and the span of the derivation by foo. The derivation by bar had no effect.
+	require.Len(t, spans, 2)
+	root, derivation := spans[0], spans[1]
+	require.Equal(t, "emit X", root.Name)
+	require.Empty(t, root.ParentSpanID)
+	require.Equal(t, "X", derivation.Name)
+	require.Equal(t, root.TraceID, derivation.TraceID)
+	require.Equal(t, root.SpanID, derivation.ParentSpanID)
+	require.Len(t, derivation.TraceID, 32)
+	require.Len(t, derivation.SpanID, 16)
+	require.Equal(t, "foo", *derivation.Attributes[0].Value.StringValue)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	err := NewOTLPExporter(failing.URL, "op-node-test").Export([]TraceEntry{{Kind: TraceEmit, Name: "foo", EmitContext: 1, EventName: "X", EventTime: time.Now()}})
+	require.ErrorContains(t, err, "status 503")
+}
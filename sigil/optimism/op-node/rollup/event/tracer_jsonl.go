@@ -0,0 +1,160 @@
+package event
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// The JSONL trace format has a JSON object per line for each trace entry, in the order that the entries were traced:
+//
+//	{"kind":"derive-start","name":"engine","deriv_context":12,"emit_context":7,"event":"forkchoice-request","time":"2024-09-01T12:00:00.000000001Z"}
+//	{"kind":"emit","name":"engine","deriv_context":12,"emit_context":13,"event":"forkchoice-update","time":"2024-09-01T12:00:00.000002Z"}
+//	{"kind":"derive-end","name":"engine","deriv_context":12,"emit_context":7,"event":"forkchoice-request","time":"2024-09-01T12:00:00.000000001Z","duration":2500,"effect":true}
+//	{"kind":"rate-limited","name":"engine","deriv_context":12}
+//
+// The fields are:
+//   - kind: one of derive-start, derive-end, rate-limited and emit.
+//   - name: the name of the deriver, or of the emitter.
+//   - deriv_context: identifies the derivation of an event. It is omitted for emissions outside any derivation.
+//   - emit_context: identifies the emission of an event. Omitted for rate-limited entries.
+//   - event: the name of the event. Omitted for rate-limited entries.
+//   - time: the start time of the derivation, or the emit time, in RFC 3339 format with nanoseconds.
+//     Omitted for rate-limited entries.
+//   - duration: the duration of the derivation in nanoseconds. Only present for derive-end entries.
+//   - effect: whether the deriver processed the event, as opposed to ignoring it. Only present for derive-end entries.
+
+var traceEntryKindNames = map[TraceEntryKind]string{
+	TraceDeriveStart: "derive-start",
+	TraceDeriveEnd:   "derive-end",
+	TraceRateLimited: "rate-limited",
+	TraceEmit:        "emit",
+}
+
+func (k TraceEntryKind) String() string {
+	if name, ok := traceEntryKindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown-%d", int(k))
+}
+
+func (k TraceEntryKind) MarshalText() ([]byte, error) {
+	name, ok := traceEntryKindNames[k]
+	if !ok {
+		return nil, fmt.Errorf("unknown trace entry kind %d", int(k))
+	}
+	return []byte(name), nil
+}
+
+func (k *TraceEntryKind) UnmarshalText(text []byte) error {
+	for kind, name := range traceEntryKindNames {
+		if name == string(text) {
+			*k = kind
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown trace entry kind %q", text)
+}
+
+type jsonTraceEntry struct {
+	Kind         TraceEntryKind `json:"kind"`
+	Name         string         `json:"name"`
+	DerivContext uint64         `json:"deriv_context,omitempty"`
+	EmitContext  uint64         `json:"emit_context,omitempty"`
+	EventName    string         `json:"event,omitempty"`
+	EventTime    *time.Time     `json:"time,omitempty"`
+	Duration     *int64         `json:"duration,omitempty"`
+	Effect       *bool          `json:"effect,omitempty"`
+}
+
+func (e TraceEntry) MarshalJSON() ([]byte, error) {
+	out := jsonTraceEntry{
+		Kind:         e.Kind,
+		Name:         e.Name,
+		DerivContext: e.DerivContext,
+		EmitContext:  e.EmitContext,
+		EventName:    e.EventName,
+	}
+	if e.EventTime != (time.Time{}) {
+		out.EventTime = &e.EventTime
+	}
+	if e.Kind == TraceDeriveEnd {
+		duration := int64(e.DeriveEnd.Duration)
+		out.Duration = &duration
+		out.Effect = &e.DeriveEnd.Effect
+	}
+	return json.Marshal(out)
+}
+
+func (e *TraceEntry) UnmarshalJSON(data []byte) error {
+	var in jsonTraceEntry
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	*e = TraceEntry{
+		Kind:         in.Kind,
+		Name:         in.Name,
+		DerivContext: in.DerivContext,
+		EmitContext:  in.EmitContext,
+		EventName:    in.EventName,
+	}
+	if in.EventTime != nil {
+		e.EventTime = *in.EventTime
+	}
+	if in.Duration != nil {
+		e.DeriveEnd.Duration = time.Duration(*in.Duration)
+	}
+	if in.Effect != nil {
+		e.DeriveEnd.Effect = *in.Effect
+	}
+	return nil
+}
+
+// ReadTraceEntries reads all trace entries of a trace in the JSONL format.
+func ReadTraceEntries(r io.Reader) ([]TraceEntry, error) {
+	dec := json.NewDecoder(r)
+	var entries []TraceEntry
+	for {
+		var entry TraceEntry
+		if err := dec.Decode(&entry); errors.Is(err, io.EOF) {
+			return entries, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode trace entry %d: %w", len(entries), err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// JSONLExporter writes trace entries in the JSONL format.
+type JSONLExporter struct {
+	w   io.WriteCloser
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+var _ TraceExporter = (*JSONLExporter)(nil)
+
+func NewJSONLExporter(w io.WriteCloser) *JSONLExporter {
+	buf := bufio.NewWriter(w)
+	return &JSONLExporter{
+		w:   w,
+		buf: buf,
+		enc: json.NewEncoder(buf),
+	}
+}
+
+func (e *JSONLExporter) Export(entries []TraceEntry) error {
+	for _, entry := range entries {
+		if err := e.enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return e.buf.Flush()
+}
+
+func (e *JSONLExporter) Close() error {
+	return errors.Join(e.buf.Flush(), e.w.Close())
+}
@@ -0,0 +1,258 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	otlpTimeout = 10 * time.Second
+	// otlpMaxEmits is the number of emissions that are remembered to link derivations to the derivation that emitted
+	// their event. Derivations of events that were emitted longer ago become the root of a new trace.
+	otlpMaxEmits = 100_000
+
+	otlpSpanKindInternal = 1
+)
+
+// OTLPExporter exports trace entries as spans to an OpenTelemetry collector, with the OTLP/HTTP protocol in the JSON
+// encoding. Each derivation that processed an event is a span, that is a child of the derivation that emitted the
+// event. Events emitted outside any derivation are the root span of a trace. Emissions and rate-limiting are events of
+// the span of the derivation that they happened in.
+type OTLPExporter struct {
+	url         string
+	serviceName string
+	client      *http.Client
+
+	// active holds the spans of the derivations that started but did not end yet, by derivation context
+	active map[uint64]*otlpSpan
+	// emits and prevEmits hold the span that emitted an event by emit context. When emits is full it replaces
+	// prevEmits, to bound the memory use.
+	emits     map[uint64]otlpSpanContext
+	prevEmits map[uint64]otlpSpanContext
+}
+
+var _ TraceExporter = (*OTLPExporter)(nil)
+
+// NewOTLPExporter creates an exporter to the OTLP/HTTP endpoint of a collector, e.g. http://localhost:4318.
+func NewOTLPExporter(endpoint string, serviceName string) *OTLPExporter {
+	return &OTLPExporter{
+		url:         strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		serviceName: serviceName,
+		client:      &http.Client{Timeout: otlpTimeout},
+		active:      make(map[uint64]*otlpSpan),
+		emits:       make(map[uint64]otlpSpanContext),
+		prevEmits:   make(map[uint64]otlpSpanContext),
+	}
+}
+
+type otlpSpanContext struct {
+	traceID string
+	spanID  string
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	// IntValue is an int64, which is a string in the JSON encoding of OTLP
+	IntValue *string `json:"intValue,omitempty"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	} `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpTraceRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+func otlpString(key string, v string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: &v}}
+}
+
+func otlpInt(key string, v uint64) otlpKeyValue {
+	s := strconv.FormatUint(v, 10)
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{IntValue: &s}}
+}
+
+func otlpTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func otlpID(size int) string {
+	id := make([]byte, size)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+func (e *OTLPExporter) emitter(emitContext uint64) (otlpSpanContext, bool) {
+	if sc, ok := e.emits[emitContext]; ok {
+		return sc, true
+	}
+	sc, ok := e.prevEmits[emitContext]
+	return sc, ok
+}
+
+func (e *OTLPExporter) recordEmit(emitContext uint64, sc otlpSpanContext) {
+	if len(e.emits) >= otlpMaxEmits {
+		e.prevEmits = e.emits
+		e.emits = make(map[uint64]otlpSpanContext)
+	}
+	e.emits[emitContext] = sc
+}
+
+// spans turns trace entries into the spans of the derivations that ended, and the root spans of emissions.
+func (e *OTLPExporter) spans(entries []TraceEntry) []*otlpSpan {
+	var spans []*otlpSpan
+	for _, entry := range entries {
+		switch entry.Kind {
+		case TraceDeriveStart:
+			span := &otlpSpan{
+				SpanID:            otlpID(8),
+				Name:              entry.EventName,
+				Kind:              otlpSpanKindInternal,
+				StartTimeUnixNano: otlpTime(entry.EventTime),
+				Attributes: []otlpKeyValue{
+					otlpString("event.deriver", entry.Name),
+					otlpString("event.name", entry.EventName),
+					otlpInt("event.deriv_context", entry.DerivContext),
+					otlpInt("event.emit_context", entry.EmitContext),
+				},
+			}
+			if parent, ok := e.emitter(entry.EmitContext); ok {
+				span.TraceID = parent.traceID
+				span.ParentSpanID = parent.spanID
+			} else {
+				span.TraceID = otlpID(16)
+			}
+			e.active[entry.DerivContext] = span
+		case TraceDeriveEnd:
+			span, ok := e.active[entry.DerivContext]
+			if !ok {
+				continue
+			}
+			delete(e.active, entry.DerivContext)
+			// Like the sequence and timing traces, derivations that ignored the event are left out.
+			if !entry.DeriveEnd.Effect {
+				continue
+			}
+			span.EndTimeUnixNano = otlpTime(entry.EventTime.Add(entry.DeriveEnd.Duration))
+			span.Attributes = append(span.Attributes, otlpInt("event.duration_ns", uint64(entry.DeriveEnd.Duration)))
+			spans = append(spans, span)
+		case TraceEmit:
+			emitAttrs := []otlpKeyValue{
+				otlpString("event.emitter", entry.Name),
+				otlpInt("event.emit_context", entry.EmitContext),
+			}
+			if span, ok := e.active[entry.DerivContext]; ok && entry.DerivContext != 0 {
+				span.Events = append(span.Events, otlpEvent{
+					TimeUnixNano: otlpTime(entry.EventTime),
+					Name:         "emit " + entry.EventName,
+					Attributes:   emitAttrs,
+				})
+				e.recordEmit(entry.EmitContext, otlpSpanContext{traceID: span.TraceID, spanID: span.SpanID})
+				continue
+			}
+			root := &otlpSpan{
+				TraceID:           otlpID(16),
+				SpanID:            otlpID(8),
+				Name:              "emit " + entry.EventName,
+				Kind:              otlpSpanKindInternal,
+				StartTimeUnixNano: otlpTime(entry.EventTime),
+				EndTimeUnixNano:   otlpTime(entry.EventTime),
+				Attributes:        append(emitAttrs, otlpString("event.name", entry.EventName)),
+			}
+			e.recordEmit(entry.EmitContext, otlpSpanContext{traceID: root.TraceID, spanID: root.SpanID})
+			spans = append(spans, root)
+		case TraceRateLimited:
+			if span, ok := e.active[entry.DerivContext]; ok {
+				span.Events = append(span.Events, otlpEvent{
+					TimeUnixNano: span.StartTimeUnixNano,
+					Name:         "rate-limited",
+					Attributes:   []otlpKeyValue{otlpString("event.emitter", entry.Name)},
+				})
+			}
+		}
+	}
+	return spans
+}
+
+func (e *OTLPExporter) Export(entries []TraceEntry) error {
+	spans := e.spans(entries)
+	if len(spans) == 0 {
+		return nil
+	}
+	var resourceSpans otlpResourceSpans
+	resourceSpans.Resource.Attributes = []otlpKeyValue{otlpString("service.name", e.serviceName)}
+	scopeSpans := otlpScopeSpans{Spans: spans}
+	scopeSpans.Scope.Name = "op-node/rollup/event"
+	resourceSpans.ScopeSpans = []otlpScopeSpans{scopeSpans}
+	body, err := json.Marshal(otlpTraceRequest{ResourceSpans: []otlpResourceSpans{resourceSpans}})
+	if err != nil {
+		return fmt.Errorf("failed to encode spans: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), otlpTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to export %d spans: %w", len(spans), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to export %d spans: collector responded with status %d: %s", len(spans), resp.StatusCode, msg)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func (e *OTLPExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
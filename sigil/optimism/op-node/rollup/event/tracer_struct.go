@@ -50,20 +50,40 @@ func NewStructTracer() *StructTracer {
 func (st *StructTracer) OnDeriveStart(name string, ev AnnotatedEvent, derivContext uint64, startTime time.Time) {
 	st.l.Lock()
 	defer st.l.Unlock()
-	st.Entries = append(st.Entries, TraceEntry{
+	st.Entries = append(st.Entries, deriveStartEntry(name, ev, derivContext, startTime))
+}
+
+func (st *StructTracer) OnDeriveEnd(name string, ev AnnotatedEvent, derivContext uint64, startTime time.Time, duration time.Duration, effect bool) {
+	st.l.Lock()
+	defer st.l.Unlock()
+	st.Entries = append(st.Entries, deriveEndEntry(name, ev, derivContext, startTime, duration, effect))
+}
+
+func (st *StructTracer) OnRateLimited(name string, derivContext uint64) {
+	st.l.Lock()
+	defer st.l.Unlock()
+	st.Entries = append(st.Entries, rateLimitedEntry(name, derivContext))
+}
+
+func (st *StructTracer) OnEmit(name string, ev AnnotatedEvent, derivContext uint64, emitTime time.Time) {
+	st.l.Lock()
+	defer st.l.Unlock()
+	st.Entries = append(st.Entries, emitEntry(name, ev, derivContext, emitTime))
+}
+
+func deriveStartEntry(name string, ev AnnotatedEvent, derivContext uint64, startTime time.Time) TraceEntry {
+	return TraceEntry{
 		Kind:         TraceDeriveStart,
 		Name:         name,
 		EventName:    ev.Event.String(),
 		EmitContext:  ev.EmitContext,
 		DerivContext: derivContext,
 		EventTime:    startTime,
-	})
+	}
 }
 
-func (st *StructTracer) OnDeriveEnd(name string, ev AnnotatedEvent, derivContext uint64, startTime time.Time, duration time.Duration, effect bool) {
-	st.l.Lock()
-	defer st.l.Unlock()
-	st.Entries = append(st.Entries, TraceEntry{
+func deriveEndEntry(name string, ev AnnotatedEvent, derivContext uint64, startTime time.Time, duration time.Duration, effect bool) TraceEntry {
+	return TraceEntry{
 		Kind:         TraceDeriveEnd,
 		Name:         name,
 		EventName:    ev.Event.String(),
@@ -74,28 +94,24 @@ func (st *StructTracer) OnDeriveEnd(name string, ev AnnotatedEvent, derivContext
 			Duration time.Duration
 			Effect   bool
 		}{Duration: duration, Effect: effect},
-	})
+	}
 }
 
-func (st *StructTracer) OnRateLimited(name string, derivContext uint64) {
-	st.l.Lock()
-	defer st.l.Unlock()
-	st.Entries = append(st.Entries, TraceEntry{
+func rateLimitedEntry(name string, derivContext uint64) TraceEntry {
+	return TraceEntry{
 		Kind:         TraceRateLimited,
 		Name:         name,
 		DerivContext: derivContext,
-	})
+	}
 }
 
-func (st *StructTracer) OnEmit(name string, ev AnnotatedEvent, derivContext uint64, emitTime time.Time) {
-	st.l.Lock()
-	defer st.l.Unlock()
-	st.Entries = append(st.Entries, TraceEntry{
+func emitEntry(name string, ev AnnotatedEvent, derivContext uint64, emitTime time.Time) TraceEntry {
+	return TraceEntry{
 		Kind:         TraceEmit,
 		Name:         name,
 		EventName:    ev.Event.String(),
 		EmitContext:  ev.EmitContext,
 		DerivContext: derivContext,
 		EventTime:    emitTime,
-	})
+	}
 }
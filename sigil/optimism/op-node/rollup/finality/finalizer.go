@@ -244,7 +244,7 @@ func (fi *Finalizer) tryFinalize() {
 				finalizedDerivedFrom, derivedRef, fi.finalizedL1)})
 			return
 		}
-		fi.emitter.Emit(engine.PromoteFinalizedEvent{Ref: finalizedL2})
+		fi.emitter.Emit(engine.PromoteFinalizedEvent{Ref: finalizedL2, FinalizedL1: fi.finalizedL1})
 	}
 }
 
@@ -19,4 +19,8 @@ type SafeHeadListener interface {
 	// SafeHeadReset indicates that the derivation pipeline reset back to the specified safe head
 	// The L1 block that made the new safe head safe is unknown.
 	SafeHeadReset(resetSafeHead eth.L2BlockRef) error
+
+	// FinalizedHeadUpdated indicates that the finalized head has been updated in response to an L1 finality signal
+	// The l1Block specified is the finalized L1 block that finalized newFinalizedHead
+	FinalizedHeadUpdated(newFinalizedHead eth.L2BlockRef, l1Block eth.BlockID) error
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
 var ErrSequencerNotEnabled = errors.New("sequencer is not enabled")
@@ -44,6 +45,10 @@ func (ds DisabledSequencer) SetMaxSafeLag(ctx context.Context, v uint64) error {
 	return ErrSequencerNotEnabled
 }
 
+func (ds DisabledSequencer) SetOrderingPolicy(ctx context.Context, policy *eth.OrderingPolicy) error {
+	return ErrSequencerNotEnabled
+}
+
 func (ds DisabledSequencer) OverrideLeader(ctx context.Context) error {
 	return ErrSequencerNotEnabled
 }
@@ -85,6 +85,9 @@ type Sequencer struct {
 
 	maxSafeLag atomic.Uint64
 
+	// orderingPolicy is the ordering policy of the tx-pool transactions in sequenced blocks, engine default if nil
+	orderingPolicy atomic.Pointer[eth.OrderingPolicy]
+
 	// active identifies whether the sequencer is running.
 	// This is an atomic value, so it can be read without locking the whole sequencer.
 	active atomic.Bool
@@ -546,6 +549,10 @@ func (d *Sequencer) startBuildingBlock() {
 		d.log.Info("Sequencing Granite upgrade block")
 	}
 
+	if !attrs.NoTxPool {
+		attrs.OrderingPolicy = d.orderingPolicy.Load()
+	}
+
 	d.log.Debug("prepared attributes for new block",
 		"num", l2Head.Number+1, "time", uint64(attrs.Timestamp),
 		"origin", l1Origin, "origin_time", l1Origin.Time, "noTxPool", attrs.NoTxPool)
@@ -708,6 +715,11 @@ func (d *Sequencer) SetMaxSafeLag(ctx context.Context, v uint64) error {
 	return nil
 }
 
+func (d *Sequencer) SetOrderingPolicy(ctx context.Context, policy *eth.OrderingPolicy) error {
+	d.orderingPolicy.Store(policy)
+	return nil
+}
+
 func (d *Sequencer) OverrideLeader(ctx context.Context) error {
 	return d.conductor.OverrideLeader(ctx)
 }
@@ -608,6 +608,48 @@ func TestSequencerBuild(t *testing.T) {
 	require.Equal(t, testClock.Now(), nextTime, "start asap on the next block")
 }
 
+func TestSequencerOrderingPolicy(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelError)
+	seq, deps := createSequencer(logger)
+	testClock := clock.NewSimpleClock()
+	seq.timeNow = testClock.Now
+	testClock.SetTime(30000)
+	emitter := &testutils.MockEmitter{}
+	seq.AttachEmitter(emitter)
+
+	policy := &eth.OrderingPolicy{Name: eth.OrderingPolicyFairFee, SenderCap: 4, PrivateLane: true}
+	require.NoError(t, seq.SetOrderingPolicy(context.Background(), policy))
+
+	emitter.ExpectOnce(engine.ForkchoiceRequestEvent{})
+	require.NoError(t, seq.Init(context.Background(), true))
+	emitter.AssertExpectations(t)
+
+	head := eth.L2BlockRef{
+		Hash:     common.Hash{0x22},
+		Number:   100,
+		L1Origin: eth.BlockID{Hash: common.Hash{0x11, 0xa}, Number: 1000},
+		Time:     uint64(testClock.Now().Unix()),
+	}
+	seq.OnEvent(engine.ForkchoiceUpdateEvent{UnsafeL2Head: head})
+	deps.l1OriginSelector.l1OriginFn = func(l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+		return eth.L1BlockRef{
+			Hash:       common.Hash{0x11, 0xb},
+			ParentHash: common.Hash{0x11, 0xa},
+			Number:     1001,
+			Time:       29998,
+		}, nil
+	}
+	// The ordering policy is passed to the engine with the attributes of the block to build
+	emitter.ExpectOnceRun(func(ev event.Event) {
+		x, ok := ev.(engine.BuildStartEvent)
+		require.True(t, ok)
+		require.False(t, x.Attributes.Attributes.NoTxPool)
+		require.Equal(t, policy, x.Attributes.Attributes.OrderingPolicy)
+	})
+	seq.OnEvent(SequencerActionEvent{})
+	emitter.AssertExpectations(t)
+}
+
 type sequencerTestDeps struct {
 	cfg              *rollup.Config
 	attribBuilder    *FakeAttributesBuilder
@@ -8,9 +8,11 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/klauspost/compress/zstd"
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -63,6 +65,15 @@ type AltDAConfig struct {
 	DAResolveWindow uint64 `json:"da_resolve_window"`
 }
 
+// ZstdConfig enables the experimental zstd compression of channels.
+type ZstdConfig struct {
+	// ActivationTime is the L1 origin timestamp from which zstd compressed channels are accepted.
+	ActivationTime uint64 `json:"activation_time"`
+	// Dictionary is the zstd dictionary that channels are compressed with. Optional.
+	// Changing it invalidates all zstd compressed channels that weren't derived yet.
+	Dictionary hexutil.Bytes `json:"dictionary,omitempty"`
+}
+
 type Config struct {
 	// Genesis anchor point of the rollup
 	Genesis Genesis `json:"genesis"`
@@ -137,6 +148,9 @@ type Config struct {
 
 	// AltDAConfig. We are in the process of migrating to the AltDAConfig from these legacy top level values
 	AltDAConfig *AltDAConfig `json:"alt_da,omitempty"`
+
+	// ZstdConfig enables the experimental zstd compression of channels, if set.
+	ZstdConfig *ZstdConfig `json:"zstd,omitempty"`
 }
 
 // ValidateL1Config checks L1 config variables for errors.
@@ -307,6 +321,9 @@ func (cfg *Config) Check() error {
 	if err := validateAltDAConfig(cfg); err != nil {
 		return err
 	}
+	if err := validateZstdConfig(cfg); err != nil {
+		return err
+	}
 
 	if err := checkFork(cfg.RegolithTime, cfg.CanyonTime, Regolith, Canyon); err != nil {
 		return err
@@ -346,6 +363,19 @@ func validateAltDAConfig(cfg *Config) error {
 	return nil
 }
 
+// validateZstdConfig checks that the zstd dictionary, if any, can be used to decompress channels.
+func validateZstdConfig(cfg *Config) error {
+	if cfg.ZstdConfig == nil || len(cfg.ZstdConfig.Dictionary) == 0 {
+		return nil
+	}
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderDicts(cfg.ZstdConfig.Dictionary))
+	if err != nil {
+		return fmt.Errorf("invalid zstd dictionary: %w", err)
+	}
+	dec.Close()
+	return nil
+}
+
 // checkFork checks that fork A is before or at the same time as fork B
 func checkFork(a, b *uint64, aName, bName ForkName) error {
 	if a == nil && b == nil {
@@ -407,6 +437,19 @@ func (c *Config) IsInterop(timestamp uint64) bool {
 	return c.InteropTime != nil && timestamp >= *c.InteropTime
 }
 
+// IsZstd returns true if zstd compressed channels are accepted at or past the given L1 origin timestamp.
+func (c *Config) IsZstd(timestamp uint64) bool {
+	return c.ZstdConfig != nil && timestamp >= c.ZstdConfig.ActivationTime
+}
+
+// ZstdDictionary returns the zstd dictionary that channels are compressed with, or nil if there is none.
+func (c *Config) ZstdDictionary() []byte {
+	if c.ZstdConfig == nil {
+		return nil
+	}
+	return c.ZstdConfig.Dictionary
+}
+
 func (c *Config) IsRegolithActivationBlock(l2BlockTime uint64) bool {
 	return c.IsRegolith(l2BlockTime) &&
 		l2BlockTime >= c.BlockTime &&
@@ -614,6 +657,10 @@ func (c *Config) Description(l2Chains map[string]string) string {
 	if c.AltDAConfig != nil {
 		banner += fmt.Sprintf("Node supports Alt-DA Mode with CommitmentType %v\n", c.AltDAConfig.CommitmentType)
 	}
+	if c.ZstdConfig != nil {
+		banner += fmt.Sprintf("Node accepts zstd compressed channels %s, dictionary size %d\n",
+			fmtForkTimeOrUnset(&c.ZstdConfig.ActivationTime), len(c.ZstdConfig.Dictionary))
+	}
 	return banner
 }
 
@@ -646,6 +693,7 @@ func (c *Config) LogDescription(log log.Logger, l2Chains map[string]string) {
 		"holocene_time", fmtForkTimeOrUnset(c.HoloceneTime),
 		"interop_time", fmtForkTimeOrUnset(c.InteropTime),
 		"alt_da", c.AltDAConfig != nil,
+		"zstd", c.ZstdConfig != nil,
 	)
 }
 
@@ -528,6 +528,18 @@ func TestConfig_Check(t *testing.T) {
 	}
 }
 
+func TestConfig_CheckZstd(t *testing.T) {
+	cfg := randConfig()
+	cfg.ZstdConfig = &ZstdConfig{ActivationTime: 10}
+	require.NoError(t, cfg.Check())
+	require.False(t, cfg.IsZstd(9))
+	require.True(t, cfg.IsZstd(10))
+	require.Nil(t, cfg.ZstdDictionary())
+
+	cfg.ZstdConfig.Dictionary = []byte("not a zstd dictionary")
+	require.ErrorContains(t, cfg.Check(), "invalid zstd dictionary")
+}
+
 func TestTimestampForBlock(t *testing.T) {
 	config := randConfig()
 
@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/engine"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	opflags "github.com/ethereum-optimism/optimism/op-service/flags"
 )
 
@@ -105,8 +106,14 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		RuntimeConfigReloadInterval: ctx.Duration(flags.RuntimeConfigReloadIntervalFlag.Name),
 		ConfigPersistence:           configPersistence,
 		SafeDBPath:                  ctx.String(flags.SafeDBPath.Name),
-		Sync:                        *syncConfig,
-		RollupHalt:                  haltOption,
+		EventTrace: node.EventTraceConfig{
+			File:           ctx.String(flags.EventTraceFile.Name),
+			FileMaxSize:    ctx.Int(flags.EventTraceFileMaxSize.Name),
+			FileMaxBackups: ctx.Int(flags.EventTraceFileMaxBackups.Name),
+			OTLPEndpoint:   ctx.String(flags.EventTraceOTLPEndpoint.Name),
+		},
+		Sync:       *syncConfig,
+		RollupHalt: haltOption,
 
 		ConductorEnabled:    ctx.Bool(flags.ConductorEnabledFlag.Name),
 		ConductorRpc:        ctx.String(flags.ConductorRpcFlag.Name),
@@ -197,13 +204,21 @@ func NewConfigPersistence(ctx *cli.Context) node.ConfigPersistence {
 }
 
 func NewDriverConfig(ctx *cli.Context) *driver.Config {
-	return &driver.Config{
+	cfg := &driver.Config{
 		VerifierConfDepth:   ctx.Uint64(flags.VerifierL1Confs.Name),
 		SequencerConfDepth:  ctx.Uint64(flags.SequencerL1Confs.Name),
 		SequencerEnabled:    ctx.Bool(flags.SequencerEnabledFlag.Name),
 		SequencerStopped:    ctx.Bool(flags.SequencerStoppedFlag.Name),
 		SequencerMaxSafeLag: ctx.Uint64(flags.SequencerMaxSafeLagFlag.Name),
 	}
+	if ctx.IsSet(flags.SequencerOrderingFlag.Name) || ctx.Bool(flags.SequencerPrivateLaneFlag.Name) {
+		cfg.SequencerOrderingPolicy = &eth.OrderingPolicy{
+			Name:        eth.OrderingPolicyName(ctx.String(flags.SequencerOrderingFlag.Name)),
+			SenderCap:   eth.Uint64Quantity(ctx.Uint64(flags.SequencerOrderingSenderCapFlag.Name)),
+			PrivateLane: ctx.Bool(flags.SequencerPrivateLaneFlag.Name),
+		}
+	}
+	return cfg
 }
 
 func NewRollupConfigFromCLI(log log.Logger, ctx *cli.Context) (*rollup.Config, error) {
@@ -28,6 +28,18 @@ func TestSignerFactoryFromKey(t *testing.T) {
 	testSigner(t, priv, "", "", signer.CLIConfig{})
 }
 
+func TestSignerFactoryFromRemoteSigner(t *testing.T) {
+	server, err := signer.NewSignerServer(testlog.Logger(t, log.LevelDebug), signer.ServerConfig{
+		ListenAddr: "127.0.0.1",
+		Mnemonic:   "test test test test test test test test test test test junk",
+		HDPath:     "m/44'/60'/0'/0/1",
+	})
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	t.Cleanup(func() { _ = server.Stop() })
+	testSigner(t, "", "", "", signer.CLIConfig{Endpoint: server.Endpoint(), Address: server.Address().Hex()})
+}
+
 func testSigner(t *testing.T, priv, mnemonic, hdPath string, cfg signer.CLIConfig) {
 	logger := testlog.Logger(t, log.LevelDebug)
 
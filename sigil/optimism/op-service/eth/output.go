@@ -21,6 +21,11 @@ type SafeHeadResponse struct {
 	SafeHead BlockID `json:"safeHead"`
 }
 
+type FinalizedHeadResponse struct {
+	L1Block       BlockID `json:"l1Block"`
+	FinalizedHead BlockID `json:"finalizedHead"`
+}
+
 var (
 	ErrInvalidOutput        = errors.New("invalid output")
 	ErrInvalidOutputVersion = errors.New("invalid output version")
@@ -329,6 +329,45 @@ type PayloadAttributes struct {
 	NoTxPool bool `json:"noTxPool,omitempty"`
 	// GasLimit override
 	GasLimit *Uint64Quantity `json:"gasLimit,omitempty"`
+	// OrderingPolicy of the transactions from the transaction-pool, engine default if nil
+	OrderingPolicy *OrderingPolicy `json:"orderingPolicy,omitempty"`
+}
+
+type OrderingPolicyName string
+
+const (
+	// OrderingPolicyFee orders transaction-pool transactions by effective tip
+	OrderingPolicyFee OrderingPolicyName = "fee"
+	// OrderingPolicyFIFO orders transaction-pool transactions by the time the engine first saw them
+	OrderingPolicyFIFO OrderingPolicyName = "fifo"
+	// OrderingPolicyFairFee orders transaction-pool transactions by effective tip, with a cap per sender
+	OrderingPolicyFairFee OrderingPolicyName = "fair-fee"
+)
+
+// OrderingPolicy selects how the engine fills a block with transactions from its transaction-pool.
+type OrderingPolicy struct {
+	Name OrderingPolicyName `json:"name"`
+	// SenderCap is the maximum number of transaction-pool transactions per sender, with the fair-fee policy
+	SenderCap Uint64Quantity `json:"senderCap,omitempty"`
+	// PrivateLane includes the transactions that were submitted to the private lane of the engine,
+	// ahead of the transaction-pool transactions
+	PrivateLane bool `json:"privateLane,omitempty"`
+}
+
+func (p *OrderingPolicy) Check() error {
+	switch p.Name {
+	case OrderingPolicyFee, OrderingPolicyFIFO:
+		if p.SenderCap != 0 {
+			return fmt.Errorf("sender cap is not supported by the %q ordering policy", p.Name)
+		}
+	case OrderingPolicyFairFee:
+		if p.SenderCap == 0 {
+			return fmt.Errorf("the %q ordering policy requires a sender cap", p.Name)
+		}
+	default:
+		return fmt.Errorf("unknown ordering policy %q", p.Name)
+	}
+	return nil
 }
 
 type ExecutePayloadStatus string
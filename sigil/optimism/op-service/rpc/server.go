@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -37,6 +38,7 @@ type Server struct {
 	log            log.Logger
 	tls            *ServerTLSConfig
 	middlewares    []Middleware
+	wsEnabled      bool
 }
 
 type ServerTLSConfig struct {
@@ -118,6 +120,14 @@ func WithMiddleware(middleware func(http.Handler) (hdlr http.Handler)) ServerOpt
 	}
 }
 
+// WithWebsocketEnabled serves websocket RPC connections on the RPC path, next to HTTP requests.
+// Websockets are required by subscriptions.
+func WithWebsocketEnabled() ServerOption {
+	return func(b *Server) {
+		b.wsEnabled = true
+	}
+}
+
 func NewServer(host string, port int, appVersion string, opts ...ServerOption) *Server {
 	endpoint := net.JoinHostPort(host, strconv.Itoa(port))
 	bs := &Server{
@@ -169,6 +179,21 @@ func (b *Server) Start() error {
 		nodeHdlr = middleware(nodeHdlr)
 	}
 	nodeHdlr = node.NewHTTPHandlerStack(nodeHdlr, b.corsHosts, b.vHosts, b.jwtSecret)
+	if b.wsEnabled {
+		wsHdlr := srv.WebsocketHandler(b.corsHosts)
+		for _, middleware := range b.middlewares {
+			wsHdlr = middleware(wsHdlr)
+		}
+		wsHdlr = node.NewWSHandlerStack(wsHdlr, b.jwtSecret)
+		httpHdlr := nodeHdlr
+		nodeHdlr = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isWebsocket(r) {
+				wsHdlr.ServeHTTP(w, r)
+				return
+			}
+			httpHdlr.ServeHTTP(w, r)
+		})
+	}
 
 	mux := http.NewServeMux()
 	mux.Handle(b.rpcPath, nodeHdlr)
@@ -221,6 +246,11 @@ func (b *Server) Stop() error {
 	return nil
 }
 
+func isWebsocket(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
+
 type HealthzResponse struct {
 	Version string `json:"version"`
 }
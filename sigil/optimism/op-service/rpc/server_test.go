@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -69,3 +70,66 @@ func TestBaseServer(t *testing.T) {
 		require.Greater(t, port, 0)
 	})
 }
+
+type testSubscriptionAPI struct{}
+
+func (t *testSubscriptionAPI) Count(ctx context.Context, n int) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	go func() {
+		for i := 0; i < n; i++ {
+			if err := notifier.Notify(sub.ID, i); err != nil {
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+func TestWebsocketServer(t *testing.T) {
+	server := NewServer(
+		"127.0.0.1",
+		0,
+		"test",
+		WithAPIs([]rpc.API{
+			{
+				Namespace: "test",
+				Service:   new(testAPI),
+			},
+			{
+				Namespace: "sub",
+				Service:   new(testSubscriptionAPI),
+			},
+		}),
+		WithWebsocketEnabled(),
+	)
+	require.NoError(t, server.Start())
+	defer func() {
+		_ = server.Stop()
+	}()
+
+	t.Run("supports HTTP", func(t *testing.T) {
+		rpcClient, err := rpc.Dial(fmt.Sprintf("http://%s", server.Endpoint()))
+		require.NoError(t, err)
+		defer rpcClient.Close()
+		var res int
+		require.NoError(t, rpcClient.Call(&res, "test_frobnicate", 2))
+		require.Equal(t, 4, res)
+	})
+
+	t.Run("supports subscriptions over websocket", func(t *testing.T) {
+		rpcClient, err := rpc.Dial(fmt.Sprintf("ws://%s", server.Endpoint()))
+		require.NoError(t, err)
+		defer rpcClient.Close()
+		ch := make(chan int)
+		sub, err := rpcClient.Subscribe(context.Background(), "sub", ch, "count", 3)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+		for i := 0; i < 3; i++ {
+			require.Equal(t, i, <-ch)
+		}
+	})
+}
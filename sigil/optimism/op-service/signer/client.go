@@ -4,12 +4,15 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-service/retry"
 	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 	"github.com/ethereum-optimism/optimism/op-service/tls/certman"
 	"github.com/ethereum/go-ethereum/common"
@@ -19,14 +22,40 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+const (
+	// DefaultMaxAttempts is the default number of attempts of a request to the signer, when it can't be reached.
+	DefaultMaxAttempts = 3
+	healthTimeout      = 3 * time.Second
+)
+
 type SignerClient struct {
-	client *rpc.Client
-	status string
-	logger log.Logger
+	client  *rpc.Client
+	certMan *certman.CertMan
+	logger  log.Logger
+
+	statusLock sync.Mutex
+	status     string
+
+	maxAttempts int
+	strategy    retry.Strategy
+}
+
+type ClientOption func(s *SignerClient)
+
+// WithRetries sets how many times, and with which backoff, requests are attempted when the signer can't be reached.
+// Requests that the signer rejects are never retried.
+func WithRetries(maxAttempts int, strategy retry.Strategy) ClientOption {
+	return func(s *SignerClient) {
+		s.maxAttempts = maxAttempts
+		s.strategy = strategy
+	}
 }
 
-func NewSignerClient(logger log.Logger, endpoint string, tlsConfig optls.CLIConfig) (*SignerClient, error) {
-	var httpClient *http.Client
+func NewSignerClient(logger log.Logger, endpoint string, tlsConfig optls.CLIConfig, opts ...ClientOption) (*SignerClient, error) {
+	var (
+		httpClient *http.Client
+		cm         *certman.CertMan
+	)
 	if tlsConfig.TLSCaCert != "" {
 		logger.Info("tlsConfig specified, loading tls config")
 		caCert, err := os.ReadFile(tlsConfig.TLSCaCert)
@@ -37,7 +66,7 @@ func NewSignerClient(logger log.Logger, endpoint string, tlsConfig optls.CLIConf
 		caCertPool.AppendCertsFromPEM(caCert)
 
 		// certman watches for newer client certifictes and automatically reloads them
-		cm, err := certman.New(logger, tlsConfig.TLSCert, tlsConfig.TLSKey)
+		cm, err = certman.New(logger, tlsConfig.TLSCert, tlsConfig.TLSKey)
 		if err != nil {
 			logger.Error("failed to read tls cert or key", "err", err)
 			return nil, err
@@ -65,16 +94,27 @@ func NewSignerClient(logger log.Logger, endpoint string, tlsConfig optls.CLIConf
 
 	rpcClient, err := rpc.DialOptions(context.Background(), endpoint, rpc.WithHTTPClient(httpClient))
 	if err != nil {
+		if cm != nil {
+			cm.Stop()
+		}
 		return nil, err
 	}
 
-	signer := &SignerClient{logger: logger, client: rpcClient}
+	signer := &SignerClient{
+		logger:      logger,
+		client:      rpcClient,
+		certMan:     cm,
+		maxAttempts: DefaultMaxAttempts,
+		strategy:    retry.Exponential(),
+	}
+	for _, opt := range opts {
+		opt(signer)
+	}
 	// Check if reachable
-	version, err := signer.pingVersion()
-	if err != nil {
+	if _, err := signer.Health(context.Background()); err != nil {
+		signer.Close()
 		return nil, err
 	}
-	signer.status = fmt.Sprintf("ok [version=%v]", version)
 	return signer, nil
 }
 
@@ -82,22 +122,70 @@ func NewSignerClientFromConfig(logger log.Logger, config CLIConfig) (*SignerClie
 	return NewSignerClient(logger, config.Endpoint, config.TLSConfig)
 }
 
-func (s *SignerClient) pingVersion() (string, error) {
-	var v string
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
-	defer cancel()
-	if err := s.client.CallContext(ctx, &v, "health_status"); err != nil {
-		return "", err
+// Health checks that the signer is reachable, and returns its version.
+func (s *SignerClient) Health(ctx context.Context) (string, error) {
+	version, err := call[string](ctx, s, healthTimeout, "health_status")
+	if err != nil {
+		s.setStatus(fmt.Sprintf("unhealthy [err=%v]", err))
+		return "", fmt.Errorf("signer health check failed: %w", err)
 	}
-	return v, nil
+	s.setStatus(fmt.Sprintf("ok [version=%v]", version))
+	return version, nil
+}
+
+func (s *SignerClient) setStatus(status string) {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+	s.status = status
+}
+
+// Status describes the result of the last health check.
+func (s *SignerClient) Status() string {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+	return s.status
+}
+
+func (s *SignerClient) Close() {
+	s.client.Close()
+	if s.certMan != nil {
+		s.certMan.Stop()
+	}
+}
+
+// call calls the method, retrying if the signer can't be reached. Each attempt times out after timeout, if set.
+func call[T any](ctx context.Context, s *SignerClient, timeout time.Duration, method string, args ...any) (T, error) {
+	var rpcErr rpc.Error
+	result, err := retry.Do(ctx, s.maxAttempts, s.strategy, func() (T, error) {
+		var result T
+		cctx := ctx
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			cctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		err := s.client.CallContext(cctx, &result, method, args...)
+		if errors.As(err, &rpcErr) {
+			// the signer rejected the request, so retrying won't help
+			return result, nil
+		}
+		if err != nil {
+			s.logger.Warn("Signer request failed", "method", method, "err", err)
+		}
+		return result, err
+	})
+	if rpcErr != nil {
+		return result, rpcErr
+	}
+	return result, err
 }
 
 func (s *SignerClient) SignTransaction(ctx context.Context, chainId *big.Int, from common.Address, tx *types.Transaction) (*types.Transaction, error) {
 	sidecar := tx.BlobTxSidecar()
 	args := NewTransactionArgsFromTransaction(chainId, &from, tx.WithoutBlobTxSidecar())
 
-	var result hexutil.Bytes
-	if err := s.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+	result, err := call[hexutil.Bytes](ctx, s, 0, "eth_signTransaction", args)
+	if err != nil {
 		return nil, fmt.Errorf("eth_signTransaction failed: %w", err)
 	}
 
@@ -105,6 +193,13 @@ func (s *SignerClient) SignTransaction(ctx context.Context, chainId *big.Int, fr
 	if err := signed.UnmarshalBinary(result); err != nil {
 		return nil, err
 	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), &signed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature of signed tx: %w", err)
+	}
+	if sender != from {
+		return nil, fmt.Errorf("signed tx is from %s, expected %s", sender, from)
+	}
 	if sidecar != nil {
 		if err := signed.SetBlobTxSidecar(sidecar); err != nil {
 			return nil, fmt.Errorf("failed to attach sidecar to signed blob tx: %w", err)
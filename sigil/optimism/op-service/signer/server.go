@@ -0,0 +1,201 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	hdwallet "github.com/ethereum-optimism/go-ethereum-hdwallet"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	"github.com/ethereum-optimism/optimism/op-service/tls/certman"
+)
+
+// ServerVersion is the version reported by the health_status method of the local signer server.
+const ServerVersion = "local"
+
+// ServerConfig configures a local signer server. The key is either decrypted from a keystore file, or derived from a
+// mnemonic.
+type ServerConfig struct {
+	ListenAddr string
+	ListenPort int
+
+	KeystorePath     string
+	KeystorePassword string
+
+	Mnemonic string
+	HDPath   string
+
+	// TLSConfig is the certificate and key of the server, and the CA that client certificates must be signed by.
+	// The server doesn't use TLS if it's not enabled.
+	TLSConfig optls.CLIConfig
+}
+
+func (c ServerConfig) Check() error {
+	if (c.KeystorePath == "") == (c.Mnemonic == "") {
+		return errors.New("exactly one of a keystore path or a mnemonic must be set")
+	}
+	if c.Mnemonic != "" && c.HDPath == "" {
+		return errors.New("an HD path must be set with a mnemonic")
+	}
+	return c.TLSConfig.Check()
+}
+
+// loadKey loads the private key of the configured keystore file or mnemonic.
+func (c ServerConfig) loadKey() (*ecdsa.PrivateKey, error) {
+	if c.KeystorePath != "" {
+		keyJSON, err := os.ReadFile(c.KeystorePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read keystore: %w", err)
+		}
+		key, err := keystore.DecryptKey(keyJSON, c.KeystorePassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+		}
+		return key.PrivateKey, nil
+	}
+	wallet, err := hdwallet.NewFromMnemonic(c.Mnemonic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mnemonic: %w", err)
+	}
+	key, err := wallet.PrivateKey(accounts.Account{URL: accounts.URL{Path: c.HDPath}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	// Geth checks the curve for equality in the nocgo version, see op-service/crypto.SignerFactoryFromConfig
+	key.PublicKey.Curve = crypto.S256()
+	return key, nil
+}
+
+// SignerServer is a local stand-in for op-signer. It serves eth_signTransaction for a single key, with the same
+// arguments and result as op-signer, so that remote signing can be tested end to end with a SignerClient. With TLS,
+// clients must authenticate with a certificate signed by the configured CA.
+type SignerServer struct {
+	log     log.Logger
+	rpc     *oprpc.Server
+	certMan *certman.CertMan
+	tls     bool
+	address common.Address
+}
+
+func NewSignerServer(logger log.Logger, cfg ServerConfig) (*SignerServer, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, fmt.Errorf("invalid signer server config: %w", err)
+	}
+	key, err := cfg.loadKey()
+	if err != nil {
+		return nil, err
+	}
+	s := &SignerServer{
+		log:     logger,
+		address: crypto.PubkeyToAddress(key.PublicKey),
+		tls:     cfg.TLSConfig.TLSEnabled(),
+	}
+
+	opts := []oprpc.ServerOption{
+		oprpc.WithLogger(logger),
+		oprpc.WithAPIs([]rpc.API{{
+			Namespace: "eth",
+			Service:   &signerAPI{log: logger, key: key, address: s.address},
+		}}),
+	}
+	if s.tls {
+		caCert, err := os.ReadFile(cfg.TLSConfig.TLSCaCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tls.ca: %w", err)
+		}
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("failed to parse tls.ca")
+		}
+		// certman watches for newer server certificates and automatically reloads them
+		cm, err := certman.New(logger, cfg.TLSConfig.TLSCert, cfg.TLSConfig.TLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tls cert or key: %w", err)
+		}
+		if err := cm.Watch(); err != nil {
+			return nil, fmt.Errorf("failed to start certman watcher: %w", err)
+		}
+		s.certMan = cm
+		opts = append(opts, oprpc.WithTLSConfig(&oprpc.ServerTLSConfig{
+			Config: &tls.Config{
+				MinVersion:     tls.VersionTLS13,
+				GetCertificate: cm.GetCertificate,
+				ClientAuth:     tls.RequireAndVerifyClientCert,
+				ClientCAs:      caCertPool,
+			},
+			CLIConfig: &cfg.TLSConfig,
+		}))
+	}
+	s.rpc = oprpc.NewServer(cfg.ListenAddr, cfg.ListenPort, ServerVersion, opts...)
+	return s, nil
+}
+
+func (s *SignerServer) Start() error {
+	if err := s.rpc.Start(); err != nil {
+		return err
+	}
+	s.log.Info("Started signer server", "endpoint", s.Endpoint(), "address", s.address)
+	return nil
+}
+
+func (s *SignerServer) Stop() error {
+	if s.certMan != nil {
+		s.certMan.Stop()
+	}
+	return s.rpc.Stop()
+}
+
+// Endpoint returns the URL that clients connect to.
+func (s *SignerServer) Endpoint() string {
+	if s.tls {
+		return "https://" + s.rpc.Endpoint()
+	}
+	return "http://" + s.rpc.Endpoint()
+}
+
+// Address returns the address that the server signs transactions for.
+func (s *SignerServer) Address() common.Address {
+	return s.address
+}
+
+type signerAPI struct {
+	log     log.Logger
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// SignTransaction signs the transaction of the arguments, and returns it encoded. Blob transactions are signed and
+// returned without their sidecar.
+func (a *signerAPI) SignTransaction(_ context.Context, args TransactionArgs) (hexutil.Bytes, error) {
+	if err := args.Check(); err != nil {
+		return nil, fmt.Errorf("invalid transaction args: %w", err)
+	}
+	if args.From == nil || *args.From != a.address {
+		return nil, fmt.Errorf("cannot sign for %v, only for %s", args.From, a.address)
+	}
+	txData, err := args.ToTransactionData()
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction args: %w", err)
+	}
+	signer := types.LatestSignerForChainID(args.ChainID.ToInt())
+	signed, err := types.SignNewTx(a.key, signer, txData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	a.log.Debug("Signed transaction", "tx", signed.Hash(), "nonce", signed.Nonce(), "type", signed.Type())
+	return signed.MarshalBinary()
+}
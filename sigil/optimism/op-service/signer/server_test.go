@@ -0,0 +1,251 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+const (
+	testMnemonic = "test test test test test test test test test test test junk"
+	testHDPath   = "m/44'/60'/0'/0/0"
+)
+
+var testChainID = big.NewInt(901)
+
+// writeCert writes a certificate for the template, signed by the parent and its key, and the key of the certificate
+// as PEM files to dir. It self-signs the certificate if parent is nil.
+func writeCert(t *testing.T, dir, name string, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+// writeTLSConfigs writes a CA, and a server and a client certificate signed by it, and returns the TLS configs of the
+// server and the client.
+func writeTLSConfigs(t *testing.T) (server, client optls.CLIConfig) {
+	dir := t.TempDir()
+	notAfter := time.Now().Add(time.Hour)
+	ca, caKey := writeCert(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+	writeCert(t, dir, "server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "signer"},
+		NotAfter:     notAfter,
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, caKey)
+	writeCert(t, dir, "client", &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "batcher"},
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	caCert := filepath.Join(dir, "ca.crt")
+	server = optls.CLIConfig{TLSCaCert: caCert, TLSCert: filepath.Join(dir, "server.crt"), TLSKey: filepath.Join(dir, "server.key")}
+	client = optls.CLIConfig{TLSCaCert: caCert, TLSCert: filepath.Join(dir, "client.crt"), TLSKey: filepath.Join(dir, "client.key")}
+	return server, client
+}
+
+// certman logs when it stops watching the certificates after Stop returns, possibly after the test ended, so the
+// servers and clients of the tests only log warnings.
+
+func startSignerServer(t *testing.T, cfg ServerConfig) *SignerServer {
+	cfg.ListenAddr = "127.0.0.1"
+	server, err := NewSignerServer(testlog.Logger(t, log.LevelWarn), cfg)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	t.Cleanup(func() { _ = server.Stop() })
+	return server
+}
+
+func newSignerClient(t *testing.T, endpoint string, tlsConfig optls.CLIConfig, opts ...ClientOption) *SignerClient {
+	client, err := NewSignerClient(testlog.Logger(t, log.LevelWarn), endpoint, tlsConfig, opts...)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return client
+}
+
+func TestSignerServer(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(key, "password")
+	require.NoError(t, err)
+
+	serverTLS, clientTLS := writeTLSConfigs(t)
+	server := startSignerServer(t, ServerConfig{
+		KeystorePath:     account.URL.Path,
+		KeystorePassword: "password",
+		TLSConfig:        serverTLS,
+	})
+	require.Equal(t, account.Address, server.Address())
+
+	client := newSignerClient(t, server.Endpoint(), clientTLS)
+	require.Equal(t, "ok [version=local]", client.Status())
+	to := common.Address{0xaa}
+
+	t.Run("signs dynamic fee txs", func(t *testing.T) {
+		tx := types.NewTx(&types.DynamicFeeTx{
+			ChainID:   testChainID,
+			Nonce:     1,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(10),
+			Gas:       21_000,
+			To:        &to,
+			Value:     big.NewInt(100),
+		})
+		signed, err := client.SignTransaction(context.Background(), testChainID, server.Address(), tx)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+		require.NoError(t, err)
+		require.Equal(t, server.Address(), sender)
+		require.Equal(t, tx.Nonce(), signed.Nonce())
+	})
+
+	t.Run("signs blob txs and keeps their sidecar", func(t *testing.T) {
+		var blob kzg4844.Blob
+		commitment, err := kzg4844.BlobToCommitment(&blob)
+		require.NoError(t, err)
+		proof, err := kzg4844.ComputeBlobProof(&blob, commitment)
+		require.NoError(t, err)
+		sidecar := &types.BlobTxSidecar{
+			Blobs:       []kzg4844.Blob{blob},
+			Commitments: []kzg4844.Commitment{commitment},
+			Proofs:      []kzg4844.Proof{proof},
+		}
+		tx := types.NewTx(&types.BlobTx{
+			ChainID:    uint256.MustFromBig(testChainID),
+			Nonce:      2,
+			GasTipCap:  uint256.NewInt(1),
+			GasFeeCap:  uint256.NewInt(10),
+			Gas:        21_000,
+			To:         to,
+			BlobFeeCap: uint256.NewInt(1),
+			BlobHashes: sidecar.BlobHashes(),
+			Sidecar:    sidecar,
+		})
+		signed, err := client.SignTransaction(context.Background(), testChainID, server.Address(), tx)
+		require.NoError(t, err)
+		require.Equal(t, tx.BlobHashes(), signed.BlobHashes())
+		require.Equal(t, sidecar, signed.BlobTxSidecar())
+	})
+
+	t.Run("rejects other senders without retrying", func(t *testing.T) {
+		tx := types.NewTx(&types.DynamicFeeTx{ChainID: testChainID, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(1), Gas: 21_000, To: &to})
+		start := time.Now()
+		_, err := client.SignTransaction(context.Background(), testChainID, common.Address{0xbb}, tx)
+		require.ErrorContains(t, err, "cannot sign for")
+		require.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("requires a client certificate", func(t *testing.T) {
+		noCert := clientTLS
+		noCert.TLSCert = serverTLS.TLSCert
+		noCert.TLSKey = serverTLS.TLSKey
+		_, err := NewSignerClient(testlog.Logger(t, log.LevelWarn), server.Endpoint(), noCert, WithRetries(1, retry.Fixed(0)))
+		require.Error(t, err, "the server certificate isn't valid for client authentication")
+	})
+}
+
+func TestSignerServerMnemonic(t *testing.T) {
+	server := startSignerServer(t, ServerConfig{Mnemonic: testMnemonic, HDPath: testHDPath})
+	require.Equal(t, common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), server.Address())
+
+	_, err := NewSignerServer(testlog.Logger(t, log.LevelInfo), ServerConfig{Mnemonic: testMnemonic})
+	require.ErrorContains(t, err, "an HD path must be set with a mnemonic")
+	_, err = NewSignerServer(testlog.Logger(t, log.LevelInfo), ServerConfig{})
+	require.ErrorContains(t, err, "exactly one of a keystore path or a mnemonic must be set")
+}
+
+func TestSignerClientRetries(t *testing.T) {
+	server := startSignerServer(t, ServerConfig{Mnemonic: testMnemonic, HDPath: testHDPath})
+	target, err := url.Parse(server.Endpoint())
+	require.NoError(t, err)
+
+	// The proxy fails every other request, as if the signer was briefly unavailable.
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	var requests atomic.Int32
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		proxy.ServeHTTP(w, r)
+	}))
+	t.Cleanup(flaky.Close)
+
+	client := newSignerClient(t, flaky.URL, optls.CLIConfig{}, WithRetries(2, retry.Fixed(10*time.Millisecond)))
+	to := common.Address{0xaa}
+	tx := types.NewTx(&types.DynamicFeeTx{ChainID: testChainID, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(1), Gas: 21_000, To: &to})
+	_, err = client.SignTransaction(context.Background(), testChainID, server.Address(), tx)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, requests.Load(), "the health check and the signing request are retried once each")
+
+	_, err = NewSignerClient(testlog.Logger(t, log.LevelInfo), flaky.URL, optls.CLIConfig{}, WithRetries(1, retry.Fixed(0)))
+	require.ErrorContains(t, err, "signer health check failed")
+}
+
+func TestSignerClientConcurrentHealth(t *testing.T) {
+	server := startSignerServer(t, ServerConfig{Mnemonic: testMnemonic, HDPath: testHDPath})
+	client := newSignerClient(t, server.Endpoint(), optls.CLIConfig{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Health(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, "ok [version=local]", client.Status())
+		}()
+	}
+	wg.Wait()
+}
@@ -32,6 +32,24 @@ func (r *RollupClient) SafeHeadAtL1Block(ctx context.Context, blockNum uint64) (
 	return output, err
 }
 
+func (r *RollupClient) L1BlockForSafeL2(ctx context.Context, blockNum uint64) (*eth.SafeHeadResponse, error) {
+	var output *eth.SafeHeadResponse
+	err := r.rpc.CallContext(ctx, &output, "optimism_l1BlockForSafeL2", hexutil.Uint64(blockNum))
+	return output, err
+}
+
+func (r *RollupClient) FinalizedHeadAtL1Block(ctx context.Context, blockNum uint64) (*eth.FinalizedHeadResponse, error) {
+	var output *eth.FinalizedHeadResponse
+	err := r.rpc.CallContext(ctx, &output, "optimism_finalizedHeadAtL1Block", hexutil.Uint64(blockNum))
+	return output, err
+}
+
+func (r *RollupClient) L1BlockForFinalizedL2(ctx context.Context, blockNum uint64) (*eth.FinalizedHeadResponse, error) {
+	var output *eth.FinalizedHeadResponse
+	err := r.rpc.CallContext(ctx, &output, "optimism_l1BlockForFinalizedL2", hexutil.Uint64(blockNum))
+	return output, err
+}
+
 func (r *RollupClient) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	var output *eth.SyncStatus
 	err := r.rpc.CallContext(ctx, &output, "optimism_syncStatus")
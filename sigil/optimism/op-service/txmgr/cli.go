@@ -38,6 +38,7 @@ const (
 	TxSendTimeoutFlagName             = "txmgr.send-timeout"
 	TxNotInMempoolTimeoutFlagName     = "txmgr.not-in-mempool-timeout"
 	ReceiptQueryIntervalFlagName      = "txmgr.receipt-query-interval"
+	JournalPathFlagName               = "txmgr.journal-path"
 )
 
 var (
@@ -191,6 +192,11 @@ func CLIFlagsWithDefaults(envPrefix string, defaults DefaultFlagValues) []cli.Fl
 			Value:   defaults.ReceiptQueryInterval,
 			EnvVars: prefixEnvVars("TXMGR_RECEIPT_QUERY_INTERVAL"),
 		},
+		&cli.StringFlag{
+			Name:    JournalPathFlagName,
+			Usage:   "Directory of a journal that records the signed transactions, so that pending transactions are resumed after a restart. Disabled if empty.",
+			EnvVars: prefixEnvVars("TXMGR_JOURNAL_PATH"),
+		},
 	}, opsigner.CLIFlags(envPrefix)...)
 }
 
@@ -213,6 +219,7 @@ type CLIConfig struct {
 	NetworkTimeout            time.Duration
 	TxSendTimeout             time.Duration
 	TxNotInMempoolTimeout     time.Duration
+	JournalPath               string
 }
 
 func NewCLIConfig(l1RPCURL string, defaults DefaultFlagValues) CLIConfig {
@@ -288,6 +295,7 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 		NetworkTimeout:            ctx.Duration(NetworkTimeoutFlagName),
 		TxSendTimeout:             ctx.Duration(TxSendTimeoutFlagName),
 		TxNotInMempoolTimeout:     ctx.Duration(TxNotInMempoolTimeoutFlagName),
+		JournalPath:               ctx.String(JournalPathFlagName),
 	}
 }
 
@@ -338,6 +346,13 @@ func NewConfig(cfg CLIConfig, l log.Logger) (*Config, error) {
 		return nil, fmt.Errorf("invalid min tip cap: %w", err)
 	}
 
+	var journal TxJournal
+	if cfg.JournalPath != "" {
+		if journal, err = OpenPebbleJournal(l, cfg.JournalPath); err != nil {
+			return nil, fmt.Errorf("could not open tx journal: %w", err)
+		}
+	}
+
 	res := Config{
 		Backend:                   l1,
 		ChainID:                   chainID,
@@ -349,6 +364,7 @@ func NewConfig(cfg CLIConfig, l log.Logger) (*Config, error) {
 		SafeAbortNonceTooLowCount: cfg.SafeAbortNonceTooLowCount,
 		Signer:                    signerFactory(chainID),
 		From:                      from,
+		Journal:                   journal,
 	}
 
 	res.ResubmissionTimeout.Store(int64(cfg.ResubmissionTimeout))
@@ -418,6 +434,10 @@ type Config struct {
 	// Signer is used to sign transactions when the gas price is increased.
 	Signer opcrypto.SignerFn
 	From   common.Address
+
+	// Journal records the signed transactions, so that pending transactions are resumed when the
+	// tx manager is restarted. It is closed when the tx manager is closed. Optional.
+	Journal TxJournal
 }
 
 func (m *Config) Check() error {
@@ -0,0 +1,199 @@
+package txmgr
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	ErrJournalClosed       = errors.New("tx journal is closed")
+	ErrInvalidJournalEntry = errors.New("invalid tx journal entry")
+)
+
+// TxJournal durably records the signed transactions of a SimpleTxManager, so that the transactions that are still
+// pending when the manager stops can be resumed when it restarts.
+type TxJournal interface {
+	// Record records a signed transaction at its nonce. Fee bumps are recorded as later transactions at the same
+	// nonce.
+	Record(tx *types.Transaction) error
+	// Pending returns the recorded transactions, grouped by nonce in ascending order.
+	Pending() ([]JournalEntry, error)
+	// Prune removes the transactions at all nonces below the given nonce.
+	Prune(nonce uint64) error
+	Close() error
+}
+
+// JournalEntry holds the transactions recorded at a nonce.
+type JournalEntry struct {
+	Nonce uint64
+	// Txs are the transactions in the order they were recorded, so the last one is the latest fee bump.
+	Txs []*types.Transaction
+}
+
+// Latest returns the last transaction recorded at the nonce.
+func (e JournalEntry) Latest() *types.Transaction {
+	return e.Txs[len(e.Txs)-1]
+}
+
+const (
+	// Keys are prefixed with a constant byte to allow us to differentiate different "columns" within the data
+	keyPrefixTxByNonce byte = 0
+)
+
+// txByNonceKey returns the key of a transaction, which orders transactions by nonce and then by the sequence
+// number they were recorded with.
+func txByNonceKey(nonce uint64, seq uint64) []byte {
+	key := make([]byte, 0, 17)
+	key = append(key, keyPrefixTxByNonce)
+	key = binary.BigEndian.AppendUint64(key, nonce)
+	key = binary.BigEndian.AppendUint64(key, seq)
+	return key
+}
+
+func decodeTxByNonceKey(key []byte) (nonce uint64, seq uint64, err error) {
+	if len(key) != 17 || key[0] != keyPrefixTxByNonce {
+		err = ErrInvalidJournalEntry
+		return
+	}
+	nonce = binary.BigEndian.Uint64(key[1:9])
+	seq = binary.BigEndian.Uint64(key[9:])
+	return
+}
+
+var txByNonceRange = &pebble.IterOptions{
+	LowerBound: txByNonceKey(0, 0),
+	UpperBound: txByNonceKey(math.MaxUint64, math.MaxUint64),
+}
+
+// PebbleJournal is a TxJournal stored in a pebble database. Every write is synced to disk before it returns.
+type PebbleJournal struct {
+	// m ensures all read iterators are closed before closing the database by preventing concurrent read and write
+	// operations (with close considered a write operation).
+	m   sync.RWMutex
+	log log.Logger
+	db  *pebble.DB
+
+	writeOpts *pebble.WriteOptions
+
+	// seq is the sequence number of the next recorded transaction
+	seq uint64
+
+	closed bool
+}
+
+var _ TxJournal = (*PebbleJournal)(nil)
+
+func OpenPebbleJournal(logger log.Logger, path string) (*PebbleJournal, error) {
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, err
+	}
+	j := &PebbleJournal{
+		log:       logger,
+		db:        db,
+		writeOpts: &pebble.WriteOptions{Sync: true},
+	}
+	if err := j.loadSeq(); err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+	return j, nil
+}
+
+// loadSeq continues the sequence numbers after the highest one recorded.
+func (j *PebbleJournal) loadSeq() error {
+	iter, err := j.db.NewIter(txByNonceRange)
+	if err != nil {
+		return fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer iter.Close()
+	for valid := iter.First(); valid; valid = iter.Next() {
+		_, seq, err := decodeTxByNonceKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		j.seq = max(j.seq, seq+1)
+	}
+	return iter.Error()
+}
+
+func (j *PebbleJournal) Record(tx *types.Transaction) error {
+	j.m.Lock()
+	defer j.m.Unlock()
+	if j.closed {
+		return ErrJournalClosed
+	}
+	val, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode tx: %w", err)
+	}
+	if err := j.db.Set(txByNonceKey(tx.Nonce(), j.seq), val, j.writeOpts); err != nil {
+		return fmt.Errorf("failed to record tx: %w", err)
+	}
+	j.seq++
+	j.log.Debug("Recorded tx in journal", "tx", tx.Hash(), "nonce", tx.Nonce())
+	return nil
+}
+
+func (j *PebbleJournal) Pending() ([]JournalEntry, error) {
+	j.m.RLock()
+	defer j.m.RUnlock()
+	if j.closed {
+		return nil, ErrJournalClosed
+	}
+	iter, err := j.db.NewIter(txByNonceRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer iter.Close()
+	var entries []JournalEntry
+	for valid := iter.First(); valid; valid = iter.Next() {
+		nonce, _, err := decodeTxByNonceKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		val, err := iter.ValueAndErr()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read entry: %w", err)
+		}
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(val); err != nil {
+			return nil, fmt.Errorf("%w: failed to decode tx at nonce %d: %w", ErrInvalidJournalEntry, nonce, err)
+		}
+		if n := len(entries); n > 0 && entries[n-1].Nonce == nonce {
+			entries[n-1].Txs = append(entries[n-1].Txs, tx)
+		} else {
+			entries = append(entries, JournalEntry{Nonce: nonce, Txs: []*types.Transaction{tx}})
+		}
+	}
+	return entries, iter.Error()
+}
+
+func (j *PebbleJournal) Prune(nonce uint64) error {
+	j.m.Lock()
+	defer j.m.Unlock()
+	if j.closed {
+		return ErrJournalClosed
+	}
+	if err := j.db.DeleteRange(txByNonceKey(0, 0), txByNonceKey(nonce, 0), j.writeOpts); err != nil {
+		return fmt.Errorf("failed to prune txs below nonce %d: %w", nonce, err)
+	}
+	return nil
+}
+
+func (j *PebbleJournal) Close() error {
+	j.m.Lock()
+	defer j.m.Unlock()
+	if j.closed {
+		// Already closed
+		return nil
+	}
+	j.closed = true
+	return j.db.Close()
+}
@@ -0,0 +1,107 @@
+package txmgr
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func journalTestTx(nonce uint64, feeCap int64) *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(feeCap),
+		Gas:       21_000,
+		To:        &common.Address{0xaa},
+	})
+}
+
+func requireEntries(t *testing.T, j TxJournal, expected map[uint64][]common.Hash) {
+	t.Helper()
+	entries, err := j.Pending()
+	require.NoError(t, err)
+	actual := make(map[uint64][]common.Hash)
+	for i, e := range entries {
+		if i > 0 {
+			require.Less(t, entries[i-1].Nonce, e.Nonce, "entries must be ordered by nonce")
+		}
+		for _, tx := range e.Txs {
+			require.Equal(t, e.Nonce, tx.Nonce())
+			actual[e.Nonce] = append(actual[e.Nonce], tx.Hash())
+		}
+	}
+	require.Equal(t, expected, actual)
+}
+
+func TestPebbleJournal(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	dir := t.TempDir()
+	j, err := OpenPebbleJournal(logger, dir)
+	require.NoError(t, err)
+
+	tx1, tx1Bump, tx2 := journalTestTx(1, 10), journalTestTx(1, 11), journalTestTx(2, 10)
+	sidecar, blobHashes, err := MakeSidecar([]*eth.Blob{{}})
+	require.NoError(t, err)
+	blobTx := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		Nonce:      3,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(10),
+		Gas:        21_000,
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: blobHashes,
+		Sidecar:    sidecar,
+	})
+	// record out of nonce order, as concurrent sends do
+	for _, tx := range []*types.Transaction{tx2, tx1, blobTx, tx1Bump} {
+		require.NoError(t, j.Record(tx))
+	}
+	requireEntries(t, j, map[uint64][]common.Hash{
+		1: {tx1.Hash(), tx1Bump.Hash()},
+		2: {tx2.Hash()},
+		3: {blobTx.Hash()},
+	})
+
+	entries, err := j.Pending()
+	require.NoError(t, err)
+	require.Equal(t, tx1Bump.Hash(), entries[0].Latest().Hash())
+	require.Equal(t, sidecar, entries[2].Latest().BlobTxSidecar(), "blob txs must be recorded with their sidecar")
+
+	t.Run("persists across restarts", func(t *testing.T) {
+		require.NoError(t, j.Close())
+		j, err = OpenPebbleJournal(logger, dir)
+		require.NoError(t, err)
+		tx2Bump := journalTestTx(2, 11)
+		require.NoError(t, j.Record(tx2Bump))
+		requireEntries(t, j, map[uint64][]common.Hash{
+			1: {tx1.Hash(), tx1Bump.Hash()},
+			2: {tx2.Hash(), tx2Bump.Hash()},
+			3: {blobTx.Hash()},
+		})
+	})
+
+	t.Run("prunes lower nonces", func(t *testing.T) {
+		require.NoError(t, j.Prune(3))
+		requireEntries(t, j, map[uint64][]common.Hash{3: {blobTx.Hash()}})
+		require.NoError(t, j.Prune(4))
+		requireEntries(t, j, map[uint64][]common.Hash{})
+	})
+
+	t.Run("errors when closed", func(t *testing.T) {
+		require.NoError(t, j.Close())
+		require.NoError(t, j.Close(), "closing twice is a no-op")
+		require.ErrorIs(t, j.Record(tx1), ErrJournalClosed)
+		_, err := j.Pending()
+		require.ErrorIs(t, err, ErrJournalClosed)
+		require.ErrorIs(t, j.Prune(1), ErrJournalClosed)
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -52,3 +54,10 @@ func (a *SimpleTxmgrAPI) GetBumpFeeRetryTime(_ context.Context) time.Duration {
 func (a *SimpleTxmgrAPI) SetBumpFeeRetryTime(_ context.Context, val time.Duration) {
 	a.mgr.SetBumpFeeRetryTime(val)
 }
+
+// CancelNonce replaces the transaction at the given nonce with a zero-value transfer to the sender. The cancellation
+// is sent in the background, and the hash of the initial cancellation transaction is returned.
+func (a *SimpleTxmgrAPI) CancelNonce(ctx context.Context, nonce hexutil.Uint64) (common.Hash, error) {
+	a.l.Info("txmgr cancelling nonce", "nonce", uint64(nonce))
+	return a.mgr.cancelNonceAsync(ctx, uint64(nonce))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/holiman/uint256"
 
@@ -143,9 +144,20 @@ type SimpleTxManager struct {
 
 	nonce     *uint64
 	nonceLock sync.RWMutex
+	// inFlight counts the txs that are being sent at each nonce. New txs skip these nonces.
+	inFlight map[uint64]int
+
+	// journal records the signed txs, or is nil if the journal is disabled.
+	journal TxJournal
 
 	pending atomic.Int64
 
+	// shutdownCtx is canceled on Close, to stop the txs sent in the background: the resumed journal txs and the
+	// cancellations requested over RPC.
+	shutdownCtx    context.Context
+	cancelShutdown context.CancelFunc
+	background     sync.WaitGroup
+
 	closed atomic.Bool
 }
 
@@ -163,14 +175,31 @@ func NewSimpleTxManagerFromConfig(name string, l log.Logger, m metrics.TxMetrice
 	if err := conf.Check(); err != nil {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
-	return &SimpleTxManager{
+	mgr := &SimpleTxManager{
 		chainID: conf.ChainID,
 		name:    name,
 		cfg:     conf,
 		backend: conf.Backend,
 		l:       l.New("service", name),
 		metr:    m,
-	}, nil
+		journal: conf.Journal,
+	}
+	mgr.shutdownCtx, mgr.cancelShutdown = context.WithCancel(context.Background())
+	if mgr.journal != nil {
+		entries, err := mgr.journal.Pending()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tx journal: %w", err)
+		}
+		if len(entries) > 0 {
+			// Reserve the nonces of the journaled txs before any new tx is crafted.
+			for _, e := range entries {
+				mgr.addInFlight(e.Nonce)
+			}
+			mgr.background.Add(1)
+			go mgr.resumeJournal(entries)
+		}
+	}
+	return mgr, nil
 }
 
 func (m *SimpleTxManager) From() common.Address {
@@ -193,9 +222,19 @@ func (m *SimpleTxManager) API() rpc.API {
 
 // Close closes the underlying connection, and sets the closed flag.
 // once closed, the tx manager will refuse to send any new transactions, and may abandon pending ones.
+// Abandoned txs stay in the journal, if enabled, and are resumed when the tx manager is restarted.
 func (m *SimpleTxManager) Close() {
+	if m.cancelShutdown != nil {
+		m.cancelShutdown()
+		m.background.Wait()
+	}
 	m.backend.Close()
 	m.closed.Store(true)
+	if m.journal != nil {
+		if err := m.journal.Close(); err != nil {
+			m.l.Error("Failed to close tx journal", "err", err)
+		}
+	}
 }
 
 func (m *SimpleTxManager) txLogger(tx *types.Transaction, logGas bool) log.Logger {
@@ -257,7 +296,7 @@ func (m *SimpleTxManager) Send(ctx context.Context, candidate TxCandidate) (*typ
 		m.resetNonce()
 		return nil, err
 	}
-	receipt, err := m.sendTx(ctx, tx)
+	receipt, err := m.sendInFlightTx(ctx, tx)
 	if err != nil {
 		m.resetNonce()
 		return nil, err
@@ -303,7 +342,7 @@ func (m *SimpleTxManager) SendAsync(ctx context.Context, candidate TxCandidate,
 	go func() {
 		defer m.metr.RecordPendingTx(m.pending.Add(-1))
 		defer cancel()
-		receipt, err := m.sendTx(ctx, tx)
+		receipt, err := m.sendInFlightTx(ctx, tx)
 		if err != nil {
 			m.resetNonce()
 		}
@@ -488,8 +527,12 @@ func MakeSidecar(blobs []*eth.Blob) (*types.BlobTxSidecar, []common.Hash, error)
 // signWithNextNonce returns a signed transaction with the next available nonce.
 // The nonce is fetched once using eth_getTransactionCount with "latest", and
 // then subsequent calls simply increment this number. If the transaction manager
-// is reset, it will query the eth_getTransactionCount nonce again. If signing
-// fails, the nonce is not incremented.
+// is reset, it will query the eth_getTransactionCount nonce again. Nonces of txs
+// that are still being sent are skipped, so that a reset only refills the gaps
+// left by failed txs. If signing fails, the nonce is not incremented.
+//
+// The signed transaction is recorded in the journal, if enabled, and its nonce is
+// in flight until it is passed to sendInFlightTx.
 func (m *SimpleTxManager) signWithNextNonce(ctx context.Context, txMessage types.TxData) (*types.Transaction, error) {
 	m.nonceLock.Lock()
 	defer m.nonceLock.Unlock()
@@ -507,6 +550,9 @@ func (m *SimpleTxManager) signWithNextNonce(ctx context.Context, txMessage types
 	} else {
 		*m.nonce++
 	}
+	for m.inFlight[*m.nonce] > 0 {
+		*m.nonce++
+	}
 
 	switch x := txMessage.(type) {
 	case *types.DynamicFeeTx:
@@ -519,14 +565,41 @@ func (m *SimpleTxManager) signWithNextNonce(ctx context.Context, txMessage types
 	ctx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
 	defer cancel()
 	tx, err := m.cfg.Signer(ctx, m.cfg.From, types.NewTx(txMessage))
+	if err == nil && m.journal != nil {
+		if err = m.journal.Record(tx); err != nil {
+			err = fmt.Errorf("failed to record tx in journal: %w", err)
+		}
+	}
 	if err != nil {
 		// decrement the nonce, so we can retry signing with the same nonce next time
 		// signWithNextNonce is called
 		*m.nonce--
-	} else {
-		m.metr.RecordNonce(*m.nonce)
+		return nil, err
+	}
+	m.metr.RecordNonce(*m.nonce)
+	m.addInFlightLocked(*m.nonce)
+	return tx, nil
+}
+
+func (m *SimpleTxManager) addInFlight(nonce uint64) {
+	m.nonceLock.Lock()
+	defer m.nonceLock.Unlock()
+	m.addInFlightLocked(nonce)
+}
+
+func (m *SimpleTxManager) addInFlightLocked(nonce uint64) {
+	if m.inFlight == nil {
+		m.inFlight = make(map[uint64]int)
+	}
+	m.inFlight[nonce]++
+}
+
+func (m *SimpleTxManager) removeInFlight(nonce uint64) {
+	m.nonceLock.Lock()
+	defer m.nonceLock.Unlock()
+	if m.inFlight[nonce]--; m.inFlight[nonce] <= 0 {
+		delete(m.inFlight, nonce)
 	}
-	return tx, err
 }
 
 // resetNonce resets the internal nonce tracking. This is called if any pending send
@@ -537,6 +610,182 @@ func (m *SimpleTxManager) resetNonce() {
 	m.nonce = nil
 }
 
+// sendInFlightTx sends a transaction whose nonce is in flight with sendTx, and releases the nonce once it is done.
+// Once the transaction is confirmed, the journal is pruned up to its nonce. Transactions that fail stay in the
+// journal, until a later nonce is confirmed.
+func (m *SimpleTxManager) sendInFlightTx(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	defer m.removeInFlight(tx.Nonce())
+	receipt, err := m.sendTx(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if m.journal != nil {
+		if err := m.journal.Prune(tx.Nonce() + 1); err != nil {
+			m.txLogger(tx, false).Warn("Failed to prune tx journal", "err", err)
+		}
+	}
+	return receipt, nil
+}
+
+// resumeJournal resumes sending the latest journaled tx at each nonce that is still pending, bumping its fees as
+// needed. The nonces of the entries must be in flight. Entries below the account nonce were already mined, and
+// are pruned. The receipts of resumed txs are only logged, as nobody is waiting for them after a restart.
+func (m *SimpleTxManager) resumeJournal(entries []JournalEntry) {
+	defer m.background.Done()
+	ctx := m.shutdownCtx
+
+	cCtx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	nonce, err := m.backend.NonceAt(cCtx, m.cfg.From, nil)
+	cancel()
+	if err != nil {
+		m.metr.RPCError()
+		m.l.Warn("Failed to get nonce, resuming all journaled txs", "err", err)
+		nonce = 0
+	} else if err := m.journal.Prune(nonce); err != nil {
+		m.l.Warn("Failed to prune tx journal", "err", err)
+	}
+
+	for _, e := range entries {
+		if e.Nonce < nonce {
+			m.removeInFlight(e.Nonce)
+			continue
+		}
+		tx := e.Latest()
+		m.txLogger(tx, true).Info("Resuming journaled transaction", "attempts", len(e.Txs))
+		m.background.Add(1)
+		go func() {
+			defer m.background.Done()
+			if _, err := m.sendInFlightTx(ctx, tx); err != nil {
+				m.txLogger(tx, false).Warn("Failed to resume journaled transaction", "err", err)
+			}
+		}()
+	}
+}
+
+// CancelNonce replaces the transaction at the given nonce with a zero-value transfer to the sender, and waits for
+// it to be confirmed like Send. The cancellation pays bumped fees over the latest transaction at the nonce in the
+// journal. Without a journal entry, the current fees are used, and a pending blob transaction can't be replaced.
+func (m *SimpleTxManager) CancelNonce(ctx context.Context, nonce uint64) (*types.Receipt, error) {
+	if m.closed.Load() {
+		return nil, ErrClosed
+	}
+	tx, err := m.craftCancelTx(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return m.sendInFlightTx(ctx, tx)
+}
+
+// cancelNonceAsync crafts a cancellation of the transaction at the given nonce, and sends it in the background until
+// the tx manager is closed. It returns the hash of the initial cancellation transaction.
+func (m *SimpleTxManager) cancelNonceAsync(ctx context.Context, nonce uint64) (common.Hash, error) {
+	if m.closed.Load() {
+		return common.Hash{}, ErrClosed
+	}
+	tx, err := m.craftCancelTx(ctx, nonce)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	m.background.Add(1)
+	go func() {
+		defer m.background.Done()
+		if _, err := m.sendInFlightTx(m.shutdownCtx, tx); err != nil {
+			m.txLogger(tx, false).Warn("Failed to cancel nonce", "err", err)
+		}
+	}()
+	return tx.Hash(), nil
+}
+
+// craftCancelTx creates and signs a zero-value transfer to the sender at the given nonce. It replaces a journaled
+// blob transaction with a blob transaction, because the txpool doesn't allow to replace one with the other.
+// The cancellation is recorded in the journal, and its nonce is in flight.
+func (m *SimpleTxManager) craftCancelTx(ctx context.Context, nonce uint64) (*types.Transaction, error) {
+	cCtx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	defer cancel()
+	accountNonce, err := m.backend.NonceAt(cCtx, m.cfg.From, nil)
+	if err != nil {
+		m.metr.RPCError()
+		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	}
+	if nonce < accountNonce {
+		return nil, fmt.Errorf("nonce %d was already used, account nonce is %d", nonce, accountNonce)
+	}
+
+	var prev *types.Transaction
+	if m.journal != nil {
+		entries, err := m.journal.Pending()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tx journal: %w", err)
+		}
+		for _, e := range entries {
+			if e.Nonce == nonce {
+				prev = e.Latest()
+			}
+		}
+	}
+
+	tip, baseFee, blobBaseFee, err := m.SuggestGasPriceCaps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gas price info: %w", err)
+	}
+	feeCap := calcGasFeeCap(baseFee, tip)
+	isBlob := prev != nil && prev.Type() == types.BlobTxType
+	if prev != nil {
+		tip, feeCap = updateFees(prev.GasTipCap(), prev.GasFeeCap(), tip, baseFee, isBlob, m.l)
+	}
+
+	var txMessage types.TxData
+	if isBlob {
+		if blobBaseFee == nil {
+			return nil, errors.New("expected non-nil blobBaseFee")
+		}
+		blobFeeCap := m.calcBlobFeeCap(blobBaseFee)
+		if bumped := calcThresholdValue(prev.BlobGasFeeCap(), true); bumped.Cmp(blobFeeCap) > 0 {
+			blobFeeCap = bumped
+		}
+		sidecar, blobHashes, err := MakeSidecar([]*eth.Blob{{}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to make sidecar: %w", err)
+		}
+		message := &types.BlobTx{
+			Nonce:      nonce,
+			To:         m.cfg.From,
+			Gas:        params.TxGas,
+			BlobHashes: blobHashes,
+			Sidecar:    sidecar,
+		}
+		if err := finishBlobTx(message, m.chainID, tip, feeCap, blobFeeCap, common.Big0); err != nil {
+			return nil, fmt.Errorf("failed to create blob transaction: %w", err)
+		}
+		txMessage = message
+	} else {
+		txMessage = &types.DynamicFeeTx{
+			ChainID:   m.chainID,
+			Nonce:     nonce,
+			To:        &m.cfg.From,
+			GasTipCap: tip,
+			GasFeeCap: feeCap,
+			Value:     common.Big0,
+			Gas:       params.TxGas,
+		}
+	}
+
+	sCtx, sCancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	defer sCancel()
+	tx, err := m.cfg.Signer(sCtx, m.cfg.From, types.NewTx(txMessage))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign cancellation: %w", err)
+	}
+	if m.journal != nil {
+		if err := m.journal.Record(tx); err != nil {
+			return nil, fmt.Errorf("failed to record cancellation in journal: %w", err)
+		}
+	}
+	m.addInFlight(nonce)
+	m.txLogger(tx, true).Info("Cancelling nonce", "replaces", prev != nil)
+	return tx, nil
+}
+
 // send submits the same transaction several times with increasing gas prices as necessary.
 // It waits for the transaction to be confirmed on chain.
 func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
@@ -857,6 +1106,13 @@ func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transa
 		m.l.Warn("failed to sign new transaction", "err", err, "tx", tx.Hash())
 		return tx, nil
 	}
+	if m.journal != nil {
+		// Don't publish a fee bump that wouldn't be resumed after a restart.
+		if err := m.journal.Record(signedTx); err != nil {
+			m.l.Warn("failed to record new transaction in journal", "err", err, "tx", tx.Hash())
+			return tx, nil
+		}
+	}
 	return signedTx, nil
 }
 
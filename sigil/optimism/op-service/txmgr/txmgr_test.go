@@ -1597,3 +1597,152 @@ func TestSendAsyncUnbufferedChan(t *testing.T) {
 		h.mgr.SendAsync(context.Background(), TxCandidate{}, make(chan SendResponse))
 	})
 }
+
+// newJournaledTxManager creates a SimpleTxManager with a journal, which resumes the txs already recorded in it.
+func newJournaledTxManager(t *testing.T, backend *mockBackend, journal TxJournal) *SimpleTxManager {
+	conf := configWithNumConfs(1)
+	conf.ChainID = big.NewInt(1)
+	conf.NetworkTimeout = time.Second
+	conf.Backend = backend
+	conf.Journal = journal
+	mgr, err := NewSimpleTxManagerFromConfig("TEST", testlog.Logger(t, log.LevelCrit), &metrics.NoopTxMetrics{}, conf)
+	require.NoError(t, err)
+	t.Cleanup(mgr.Close)
+	return mgr
+}
+
+func TestTxMgrResumesJournal(t *testing.T) {
+	journal, err := OpenPebbleJournal(testlog.Logger(t, log.LevelCrit), t.TempDir())
+	require.NoError(t, err)
+	mined, pending := journalTestTx(startingNonce-1, 10), journalTestTx(startingNonce, 10)
+	pendingBump, next := journalTestTx(startingNonce, 11), journalTestTx(startingNonce+1, 10)
+	for _, tx := range []*types.Transaction{mined, pending, pendingBump, next} {
+		require.NoError(t, journal.Record(tx))
+	}
+
+	// Only the new tx is mined, so that the resumed txs keep their nonces in flight.
+	backend := newMockBackend(newGasPricer(1))
+	var (
+		mu   sync.Mutex
+		sent = make(map[common.Hash]bool)
+	)
+	backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+		mu.Lock()
+		defer mu.Unlock()
+		sent[tx.Hash()] = true
+		if tx.Nonce() > next.Nonce() {
+			txHash := tx.Hash()
+			backend.mine(&txHash, tx.GasFeeCap(), nil)
+		}
+		return nil
+	})
+	mgr := newJournaledTxManager(t, backend, journal)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return sent[pendingBump.Hash()] && sent[next.Hash()]
+	}, 5*time.Second, 10*time.Millisecond, "the latest journaled txs must be rebroadcast")
+	mu.Lock()
+	require.False(t, sent[mined.Hash()], "txs below the account nonce must not be rebroadcast")
+	require.False(t, sent[pending.Hash()], "replaced txs must not be rebroadcast")
+	mu.Unlock()
+
+	receipt, err := mgr.Send(context.Background(), TxCandidate{To: &common.Address{}, GasLimit: 21_000})
+	require.NoError(t, err)
+	mu.Lock()
+	require.True(t, sent[receipt.TxHash])
+	mu.Unlock()
+	entries, err := journal.Pending()
+	require.NoError(t, err)
+	require.Empty(t, entries, "the journal must be pruned once a later nonce is confirmed")
+}
+
+func TestTxMgrSkipsNoncesInFlight(t *testing.T) {
+	h := newTestHarness(t)
+	h.mgr.addInFlight(startingNonce + 1)
+
+	ctx := context.Background()
+	for _, expected := range []uint64{startingNonce, startingNonce + 2} {
+		tx, err := h.mgr.craftTx(ctx, h.createTxCandidate())
+		require.NoError(t, err)
+		require.Equal(t, expected, tx.Nonce())
+	}
+
+	// after a reset, the gap of a failed tx is refilled
+	h.mgr.removeInFlight(startingNonce)
+	h.mgr.resetNonce()
+	for _, expected := range []uint64{startingNonce, startingNonce + 3} {
+		tx, err := h.mgr.craftTx(ctx, h.createTxCandidate())
+		require.NoError(t, err)
+		require.Equal(t, expected, tx.Nonce())
+	}
+}
+
+func TestTxMgrCancelNonce(t *testing.T) {
+	var published []*types.Transaction
+	newBackend := func() *mockBackend {
+		published = nil
+		backend := newMockBackend(newGasPricer(1))
+		backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			published = append(published, tx)
+			txHash := tx.Hash()
+			backend.mine(&txHash, tx.GasFeeCap(), tx.BlobGasFeeCap())
+			return nil
+		})
+		return backend
+	}
+
+	t.Run("replaces journaled blob tx", func(t *testing.T) {
+		journal, err := OpenPebbleJournal(testlog.Logger(t, log.LevelCrit), t.TempDir())
+		require.NoError(t, err)
+		mgr := newJournaledTxManager(t, newBackend(), journal)
+		// record a pending blob tx, as if it had been sent before
+		sidecar, blobHashes, err := MakeSidecar([]*eth.Blob{{}})
+		require.NoError(t, err)
+		blobTx := types.NewTx(&types.BlobTx{
+			ChainID:    uint256.NewInt(1),
+			Nonce:      startingNonce,
+			GasTipCap:  uint256.NewInt(1_000_000_000),
+			GasFeeCap:  uint256.NewInt(100_000_000_000),
+			Gas:        21_000,
+			BlobFeeCap: uint256.NewInt(10_000_000_000),
+			BlobHashes: blobHashes,
+			Sidecar:    sidecar,
+		})
+		require.NoError(t, journal.Record(blobTx))
+
+		receipt, err := mgr.CancelNonce(context.Background(), startingNonce)
+		require.NoError(t, err)
+		cancelTx := published[len(published)-1]
+		require.Equal(t, receipt.TxHash, cancelTx.Hash())
+		require.Equal(t, uint64(startingNonce), cancelTx.Nonce())
+		require.Equal(t, types.BlobTxType, int(cancelTx.Type()))
+		require.Equal(t, mgr.From(), *cancelTx.To())
+		require.Zero(t, cancelTx.Value().Sign())
+		require.GreaterOrEqual(t, cancelTx.GasTipCap().Cmp(calcThresholdValue(blobTx.GasTipCap(), true)), 0)
+		require.GreaterOrEqual(t, cancelTx.GasFeeCap().Cmp(calcThresholdValue(blobTx.GasFeeCap(), true)), 0)
+		require.GreaterOrEqual(t, cancelTx.BlobGasFeeCap().Cmp(calcThresholdValue(blobTx.BlobGasFeeCap(), true)), 0)
+
+		entries, err := journal.Pending()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("without journal", func(t *testing.T) {
+		mgr := newJournaledTxManager(t, newBackend(), nil)
+		_, err := mgr.CancelNonce(context.Background(), startingNonce+1)
+		require.NoError(t, err)
+		require.Len(t, published, 1)
+		require.Equal(t, types.DynamicFeeTxType, int(published[0].Type()))
+		require.Equal(t, uint64(startingNonce+1), published[0].Nonce())
+		require.Equal(t, params.TxGas, published[0].Gas())
+	})
+
+	t.Run("rejects used nonces", func(t *testing.T) {
+		mgr := newJournaledTxManager(t, newBackend(), nil)
+		_, err := mgr.CancelNonce(context.Background(), startingNonce-1)
+		require.ErrorContains(t, err, "already used")
+		require.Empty(t, published)
+	})
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/ctxinterrupt"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/signer"
+	"github.com/ethereum-optimism/optimism/op-signer/flags"
+)
+
+var (
+	Version   = "v0.0.1"
+	GitCommit = ""
+	GitDate   = ""
+)
+
+func main() {
+	oplog.SetupDefaults()
+
+	app := cli.NewApp()
+	app.Flags = cliapp.ProtectFlags(flags.Flags)
+	app.Version = opservice.FormatVersion(Version, GitCommit, GitDate, "")
+	app.Name = "op-signer"
+	app.Usage = "Local remote signer"
+	app.Description = "op-signer signs the transactions of the batcher and proposer with a local key, " +
+		"for the signer client of the op-service txmgr"
+	app.Action = cliapp.LifecycleCmd(OpSignerMain)
+
+	ctx := ctxinterrupt.WithSignalWaiterMain(context.Background())
+	err := app.RunContext(ctx, os.Args)
+	if err != nil {
+		log.Crit("Application failed", "message", err)
+	}
+}
+
+func OpSignerMain(ctx *cli.Context, closeApp context.CancelCauseFunc) (cliapp.Lifecycle, error) {
+	logCfg := oplog.ReadCLIConfig(ctx)
+	log := oplog.NewLogger(oplog.AppOut(ctx), logCfg)
+	oplog.SetGlobalLogHandler(log.Handler())
+	opservice.ValidateEnvVars(flags.EnvVarPrefix, flags.Flags, log)
+
+	cfg, err := flags.NewConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	server, err := signer.NewSignerServer(log, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer server: %w", err)
+	}
+	return &signerService{server: server}, nil
+}
+
+// signerService runs a signer server as a cliapp.Lifecycle.
+type signerService struct {
+	server  *signer.SignerServer
+	stopped atomic.Bool
+}
+
+func (s *signerService) Start(context.Context) error {
+	return s.server.Start()
+}
+
+func (s *signerService) Stop(context.Context) error {
+	if s.stopped.Swap(true) {
+		return nil
+	}
+	return s.server.Stop()
+}
+
+func (s *signerService) Stopped() bool {
+	return s.stopped.Load()
+}
@@ -0,0 +1,90 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/signer"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+const EnvVarPrefix = "OP_SIGNER"
+
+func prefixEnvVars(name string) []string {
+	return opservice.PrefixEnvVar(EnvVarPrefix, name)
+}
+
+var (
+	ListenAddr = &cli.StringFlag{
+		Name:    "rpc.addr",
+		Usage:   "Address to listen for signing requests on",
+		EnvVars: prefixEnvVars("RPC_ADDR"),
+		Value:   "0.0.0.0",
+	}
+	ListenPort = &cli.IntFlag{
+		Name:    "rpc.port",
+		Usage:   "Port to listen for signing requests on",
+		EnvVars: prefixEnvVars("RPC_PORT"),
+		Value:   8080,
+	}
+	KeystorePath = &cli.StringFlag{
+		Name:    "keystore",
+		Usage:   "Path of the keystore file of the key to sign with. Exactly one of keystore and mnemonic must be set.",
+		EnvVars: prefixEnvVars("KEYSTORE"),
+	}
+	KeystorePassword = &cli.StringFlag{
+		Name:    "keystore.password",
+		Usage:   "Password to decrypt the keystore file with",
+		EnvVars: prefixEnvVars("KEYSTORE_PASSWORD"),
+	}
+	Mnemonic = &cli.StringFlag{
+		Name:    "mnemonic",
+		Usage:   "Mnemonic to derive the key to sign with. Exactly one of keystore and mnemonic must be set.",
+		EnvVars: prefixEnvVars("MNEMONIC"),
+	}
+	HDPath = &cli.StringFlag{
+		Name:    "hd-path",
+		Usage:   "HD path to derive the key to sign with from the mnemonic",
+		EnvVars: prefixEnvVars("HD_PATH"),
+	}
+)
+
+var optionalFlags = []cli.Flag{
+	ListenAddr,
+	ListenPort,
+	KeystorePath,
+	KeystorePassword,
+	Mnemonic,
+	HDPath,
+}
+
+func init() {
+	// Clients must authenticate with a certificate signed by the TLS CA, unless the TLS flags are set to "".
+	optionalFlags = append(optionalFlags, optls.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
+
+	Flags = optionalFlags
+}
+
+// Flags contains the list of configuration options available to the binary.
+var Flags []cli.Flag
+
+// NewConfig reads the signer server config from the flags, and checks it.
+func NewConfig(ctx *cli.Context) (signer.ServerConfig, error) {
+	cfg := signer.ServerConfig{
+		ListenAddr:       ctx.String(ListenAddr.Name),
+		ListenPort:       ctx.Int(ListenPort.Name),
+		KeystorePath:     ctx.String(KeystorePath.Name),
+		KeystorePassword: ctx.String(KeystorePassword.Name),
+		Mnemonic:         ctx.String(Mnemonic.Name),
+		HDPath:           ctx.String(HDPath.Name),
+		TLSConfig:        optls.ReadCLIConfig(ctx),
+	}
+	if err := cfg.Check(); err != nil {
+		return signer.ServerConfig{}, fmt.Errorf("invalid config: %w", err)
+	}
+	return cfg, nil
+}